@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 
 	id "github.com/golibry/go-common-domain/domain/identifier"
@@ -14,4 +15,8 @@ func main() {
 	// Recreate from string
 	j, _ := id.NewIntIdentifierFromString("42")
 	fmt.Println(i.Equals(j))
+
+	// Encode as a JSON string for JavaScript clients
+	encoded, _ := json.Marshal(i.AsStringIntIdentifier())
+	fmt.Println(string(encoded))
 }
@@ -13,4 +13,9 @@ func main() {
 	fmt.Println(fn.MiddleName())
 	fmt.Println(fn.LastName())
 	fmt.Println(fn.String())
+
+	formal, _ := p.NewFullNameWithAffixes("Dr.", "John", "", "Doe", "Jr.")
+	fmt.Println(formal.String())
+	fmt.Println(formal.Format(p.NameFormatLastFirst))
+	fmt.Println(formal.Format(p.NameFormatInitialsLast))
 }
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/schema"
+	"github.com/golibry/go-common-domain/domain/web"
+)
+
+func main() {
+	signup := schema.New(
+		schema.Field("email", web.NewEmail),
+		schema.Field("country", geography.NewCountryCode),
+		schema.OptionalField("website", web.NewURL),
+	)
+
+	values, _ := signup.Validate(map[string]string{"email": "john@example.com", "country": "ro"})
+	email, _ := schema.Get[web.Email](values, "email")
+	fmt.Println(email.Value())
+
+	_, err := signup.Validate(map[string]string{"email": "invalid"})
+	var validationErrs schema.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		for field, fieldErr := range validationErrs.ByField() {
+			fmt.Println(field, "=>", fieldErr)
+		}
+	}
+}
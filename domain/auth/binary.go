@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// Binary encodings carry the full secret values, unlike String and LogValue. Credentials
+// deliberately has no binary encoding because it holds a plaintext password.
+
+// MarshalBinary encodes the token value
+func (t Token) MarshalBinary() ([]byte, error) {
+	return []byte(t.value), nil
+}
+
+// UnmarshalBinary decodes the token, with validation
+func (t *Token) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewToken(string(data))
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// MarshalBinary encodes the session ID value
+func (s SessionID) MarshalBinary() ([]byte, error) {
+	return []byte(s.value), nil
+}
+
+// UnmarshalBinary decodes the session ID, with validation
+func (s *SessionID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewSessionID(string(data))
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+	return nil
+}
+
+// MarshalBinary encodes the hex-encoded token hash
+func (h HashedToken) MarshalBinary() ([]byte, error) {
+	return []byte(h.value), nil
+}
+
+// UnmarshalBinary decodes the token hash, with validation
+func (h *HashedToken) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewHashedToken(string(data))
+	if err != nil {
+		return err
+	}
+
+	*h = parsed
+	return nil
+}
+
+// MarshalBinary encodes the token together with its issue and expiry times
+func (t ExpiringToken) MarshalBinary() ([]byte, error) {
+	if t.token.value == "" {
+		return nil, nil
+	}
+
+	return domain.MarshalBinaryFields(
+		t.token.value,
+		t.issuedAt.Format(time.RFC3339Nano),
+		t.expiresAt.Format(time.RFC3339Nano),
+	), nil
+}
+
+// UnmarshalBinary decodes the expiring token, with validation
+func (t *ExpiringToken) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 3)
+	if err != nil {
+		return err
+	}
+
+	token, err := NewToken(fields[0])
+	if err != nil {
+		return err
+	}
+
+	issuedAt, err := time.Parse(time.RFC3339Nano, fields[1])
+	if err != nil {
+		return domain.NewErrorWithWrap(err, "invalid expiring token issue time")
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339Nano, fields[2])
+	if err != nil {
+		return domain.NewErrorWithWrap(err, "invalid expiring token expiry time")
+	}
+
+	parsed, err := NewExpiringToken(token, issuedAt, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// MarshalBinary encodes the JWT in its compact serialization
+func (j JWT) MarshalBinary() ([]byte, error) {
+	return []byte(j.value), nil
+}
+
+// UnmarshalBinary decodes the JWT, checking its structure (signatures are not verified)
+func (j *JWT) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewJWT(string(data))
+	if err != nil {
+		return err
+	}
+
+	*j = parsed
+	return nil
+}
+
+// MarshalBinary encodes the username as its canonical string
+func (u Username) MarshalBinary() ([]byte, error) {
+	return []byte(u.value), nil
+}
+
+// UnmarshalBinary decodes the username, with validation
+func (u *Username) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewUsername(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// MarshalBinary encodes the bcrypt hash of the password
+func (p Password) MarshalBinary() ([]byte, error) {
+	return []byte(p.hashedValue), nil
+}
+
+// UnmarshalBinary decodes the bcrypt hash of the password. Like ReconstitutePassword it does not
+// validate the hash; Verify fails for malformed hashes.
+func (p *Password) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	*p = ReconstitutePassword(string(data))
+	return nil
+}
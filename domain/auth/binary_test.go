@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type BinaryTestSuite struct {
+	suite.Suite
+}
+
+func TestBinarySuite(t *testing.T) {
+	suite.Run(t, new(BinaryTestSuite))
+}
+
+type cachedSession struct {
+	Username Username
+	Password Password
+	Token    Token
+	Session  SessionID
+	Hash     HashedToken
+	Reset    ExpiringToken
+	JWT      JWT
+}
+
+func (s *BinaryTestSuite) TestItCanRoundTripValueObjectsThroughGob() {
+	username, _ := NewUsername("Alice.Smith")
+	token, err := GenerateToken()
+	s.Require().NoError(err)
+	issuedAt := time.Date(2024, 5, 1, 10, 0, 0, 123456789, time.UTC)
+	reset, err := NewExpiringToken(token, issuedAt, issuedAt.Add(time.Hour))
+	s.Require().NoError(err)
+	jwt, err := NewJWT(sampleJWT)
+	s.Require().NoError(err)
+	session, err := GenerateSessionID()
+	s.Require().NoError(err)
+
+	original := cachedSession{
+		Username: username,
+		Password: ReconstitutePassword("$2a$10$abcdefghijklmnopqrstuu"),
+		Token:    token,
+		Session:  session,
+		Hash:     token.Hash(),
+		Reset:    reset,
+		JWT:      jwt,
+	}
+
+	var buffer bytes.Buffer
+	s.Require().NoError(gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded cachedSession
+	s.Require().NoError(gob.NewDecoder(&buffer).Decode(&decoded))
+
+	s.True(original.Username.Equals(decoded.Username))
+	s.True(original.Password.Equals(decoded.Password))
+	s.True(original.Token.Equals(decoded.Token))
+	s.True(original.Session.Equals(decoded.Session))
+	s.True(original.Hash.Equals(decoded.Hash))
+	s.True(original.Reset.Equals(decoded.Reset))
+	s.True(original.JWT.Equals(decoded.JWT))
+	subject, _ := decoded.JWT.StringClaim("sub")
+	s.Equal("1234567890", subject)
+}
+
+func (s *BinaryTestSuite) TestItValidatesWhenUnmarshalingBinary() {
+	var username Username
+	s.ErrorIs(username.UnmarshalBinary([]byte("a")), ErrTooShortUsername)
+
+	var token Token
+	s.ErrorIs(token.UnmarshalBinary([]byte("not a token!")), ErrInvalidTokenChars)
+
+	var session SessionID
+	s.ErrorIs(session.UnmarshalBinary([]byte("short")), ErrSessionIDTooShort)
+
+	var hash HashedToken
+	s.ErrorIs(hash.UnmarshalBinary([]byte("abc")), ErrInvalidHashedToken)
+
+	var jwt JWT
+	s.ErrorIs(jwt.UnmarshalBinary([]byte("not.a.jwt")), ErrInvalidJWTHeader)
+
+	generated, err := GenerateToken()
+	s.Require().NoError(err)
+	var expiring ExpiringToken
+	s.ErrorIs(
+		expiring.UnmarshalBinary(
+			domain.MarshalBinaryFields(
+				generated.Value(), "2024-05-01T10:00:00Z", "2024-05-01T09:00:00Z",
+			),
+		),
+		ErrTokenExpiresBeforeIssued,
+	)
+	s.Error(
+		expiring.UnmarshalBinary(
+			domain.MarshalBinaryFields(generated.Value(), "yesterday", "2024-05-01T09:00:00Z"),
+		),
+	)
+}
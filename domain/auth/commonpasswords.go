@@ -0,0 +1,12 @@
+package auth
+
+import _ "embed"
+
+// embeddedCommonPasswords holds the default denylist of 7,145 common passwords, taken from
+// the password frequency list of the Go port of zxcvbn. It is not a full top-10,000 list;
+// load a larger one with LoadDenylist and SetCommonPasswordDenylist when needed. Every build,
+// wasm and TinyGo included, embeds the same list, so ValidatePassword gives the same result in
+// the browser as on the server.
+//
+//go:embed data/common_passwords.txt
+var embeddedCommonPasswords string
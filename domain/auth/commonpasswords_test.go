@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CommonPasswordsTestSuite struct {
+	suite.Suite
+}
+
+func TestCommonPasswordsSuite(t *testing.T) {
+	suite.Run(t, new(CommonPasswordsTestSuite))
+}
+
+func (s *CommonPasswordsTestSuite) TestItEmbedsTheFullCommonPasswordList() {
+	s.Equal(7145, DefaultDenylist().Len())
+	s.True(DefaultDenylist().Contains("password"))
+	s.True(DefaultDenylist().Contains("sunshine"))
+}
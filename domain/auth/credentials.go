@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golibry/go-common-domain/domain/schema"
+	"github.com/golibry/go-common-domain/domain/web"
+)
+
+const (
+	// CredentialsIdentityField is the field name used for identity errors in NewCredentials
+	CredentialsIdentityField = "identity"
+	// CredentialsPasswordField is the field name used for password errors in NewCredentials
+	CredentialsPasswordField = "password"
+)
+
+// Credentials is the identity and plaintext password submitted in a login or sign-up flow.
+// The identity is either a Username or an Email. The password is never exposed by String
+// or LogValue.
+type Credentials struct {
+	username  Username
+	email     web.Email
+	isEmail   bool
+	plaintext string
+	hasher    *Hasher
+}
+
+// NewCredentials creates new Credentials, validating the identity and checking only that the
+// password is not empty and does not exceed MaxPasswordLength, so users whose stored password
+// predates the current rules can still log in. The strength and denylist rules of
+// ValidatePassword apply when a new password is stored with HashPassword.
+// An identity containing '@' is validated as an email address, otherwise as a username.
+// When either is invalid the error is a schema.ValidationErrors holding one error per
+// invalid field (CredentialsIdentityField, CredentialsPasswordField).
+func NewCredentials(identity, plaintext string, opts ...PasswordOption) (Credentials, error) {
+	var credentials Credentials
+	var errs schema.ValidationErrors
+
+	var err error
+	if strings.Contains(identity, "@") {
+		credentials.isEmail = true
+		credentials.email, err = web.NewEmail(identity)
+	} else {
+		credentials.username, err = NewUsername(identity)
+	}
+	if err != nil {
+		errs = append(errs, &schema.FieldError{Field: CredentialsIdentityField, Err: err})
+	}
+
+	options := applyPasswordOptions(opts)
+	credentials.plaintext = options.prepare(plaintext)
+	credentials.hasher = options.hasher
+	if err = validateLoginPassword(credentials.plaintext); err != nil {
+		errs = append(errs, &schema.FieldError{Field: CredentialsPasswordField, Err: err})
+	}
+
+	if len(errs) > 0 {
+		return Credentials{}, errs
+	}
+
+	return credentials, nil
+}
+
+// validateLoginPassword checks a submitted password without the strength rules, bounding its
+// length so oversized input is never hashed
+func validateLoginPassword(plaintext string) error {
+	if plaintext == "" {
+		return ErrEmptyPassword
+	}
+	if utf8.RuneCountInString(plaintext) > MaxPasswordLength {
+		return ErrPasswordTooLong
+	}
+	return nil
+}
+
+// Identity returns the normalized username or email address
+func (c Credentials) Identity() string {
+	if c.isEmail {
+		return c.email.Value()
+	}
+	return c.username.Value()
+}
+
+// Username returns the username identity, if the credentials use one
+func (c Credentials) Username() (Username, bool) {
+	return c.username, !c.isEmail
+}
+
+// Email returns the email identity, if the credentials use one
+func (c Credentials) Email() (web.Email, bool) {
+	return c.email, c.isEmail
+}
+
+// IsEmail reports whether the identity is an email address
+func (c Credentials) IsEmail() bool {
+	return c.isEmail
+}
+
+// Verify checks the submitted password against a stored Password hash
+func (c Credentials) Verify(stored Password) error {
+	return stored.Verify(c.plaintext, WithHasher(c.hasher))
+}
+
+// HashPassword hashes the submitted password for storage, e.g., during sign-up or a password
+// change. It fails when the password breaks the rules of ValidatePassword.
+func (c Credentials) HashPassword() (Password, error) {
+	return NewPassword(c.plaintext, WithHasher(c.hasher))
+}
+
+// Equals compares the identities of two Credentials; passwords are not compared
+func (c Credentials) Equals(other Credentials) bool {
+	return c.isEmail == other.isEmail && c.Identity() == other.Identity()
+}
+
+// String returns the identity with the password redacted
+func (c Credentials) String() string {
+	return c.Identity() + ":[PROTECTED]"
+}
+
+// LogValue implements slog.LogValuer so that loggers never record the password
+func (c Credentials) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String(CredentialsIdentityField, c.Identity()),
+		slog.String(CredentialsPasswordField, "[PROTECTED]"),
+	)
+}
+
+// Sensitive marks the credentials as a secret for domain.Sensitive
+func (c Credentials) Sensitive() {}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/schema"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type CredentialsTestSuite struct {
+	suite.Suite
+}
+
+func TestCredentialsSuite(t *testing.T) {
+	suite.Run(t, new(CredentialsTestSuite))
+}
+
+func (s *CredentialsTestSuite) TestItCanBuildCredentialsWithUsernameOrEmail() {
+	withUsername, err := NewCredentials(" Alice ", "Str0ng!Passw0rd")
+	s.NoError(err)
+	s.False(withUsername.IsEmail())
+	s.Equal("alice", withUsername.Identity())
+	username, ok := withUsername.Username()
+	s.True(ok)
+	s.Equal("alice", username.Value())
+	_, ok = withUsername.Email()
+	s.False(ok)
+
+	withEmail, err := NewCredentials("Alice@Example.com", "Str0ng!Passw0rd")
+	s.NoError(err)
+	s.True(withEmail.IsEmail())
+	email, ok := withEmail.Email()
+	s.True(ok)
+	s.Equal("alice@example.com", email.Value())
+	s.False(withEmail.Equals(withUsername))
+}
+
+func (s *CredentialsTestSuite) TestItAggregatesIdentityAndPasswordErrors() {
+	testCases := []struct {
+		name             string
+		identity         string
+		password         string
+		expectedIdentity error
+		expectedPassword error
+	}{
+		{"Both invalid", "a", "", ErrTooShortUsername, ErrEmptyPassword},
+		{"Invalid email", "alice@", "Str0ng!Passw0rd", web.ErrEmptyDomainPart, nil},
+		{
+			"Password too long", "alice", strings.Repeat("a", MaxPasswordLength+1),
+			nil, ErrPasswordTooLong,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewCredentials(tc.identity, tc.password)
+
+				var validationErrs schema.ValidationErrors
+				s.True(errors.As(err, &validationErrs))
+				s.Equal(tc.expectedIdentity, validationErrs.For(CredentialsIdentityField))
+				s.Equal(tc.expectedPassword, validationErrs.For(CredentialsPasswordField))
+			},
+		)
+	}
+}
+
+func (s *CredentialsTestSuite) TestItCanHashAndVerifyThePassword() {
+	credentials, err := NewCredentials("alice", "Str0ng!Passw0rd")
+	s.NoError(err)
+
+	stored, err := credentials.HashPassword()
+	s.NoError(err)
+	s.NoError(credentials.Verify(stored))
+
+	other, _ := NewCredentials("alice", "An0ther!Passw0rd")
+	s.ErrorIs(other.Verify(stored), ErrPasswordVerifyFailed)
+}
+
+func (s *CredentialsTestSuite) TestItAcceptsPasswordsThatPredateTheStrengthRules() {
+	legacy, err := DefaultHasher().hash("short")
+	s.Require().NoError(err)
+
+	credentials, err := NewCredentials("alice", "short")
+	s.Require().NoError(err)
+	s.NoError(credentials.Verify(legacy))
+
+	_, err = credentials.HashPassword()
+	s.ErrorIs(err, ErrPasswordTooShort)
+}
+
+func (s *CredentialsTestSuite) TestStringAndLogValueRedactThePassword() {
+	credentials, _ := NewCredentials("alice", "Str0ng!Passw0rd")
+
+	s.Equal("alice:[PROTECTED]", credentials.String())
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	logger.Info("login", "credentials", credentials)
+
+	s.Contains(buf.String(), "credentials.identity=alice")
+	s.Contains(buf.String(), "credentials.password=[PROTECTED]")
+	s.NotContains(buf.String(), "Str0ng!Passw0rd")
+}
@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"bufio"
+	"io"
+	"io/fs"
+	"strings"
+	"sync"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// Denylist reports whether a password is known to be common or compromised.
+// Implement it to back the check with a custom source, such as a remote breach database.
+type Denylist interface {
+	Contains(password string) bool
+}
+
+// SetDenylist is an in-memory Denylist backed by a hash set of lowercase entries
+type SetDenylist struct {
+	entries map[string]struct{}
+}
+
+// NewSetDenylist creates a new SetDenylist from the given entries (compared case-insensitively)
+func NewSetDenylist(entries ...string) *SetDenylist {
+	denylist := newSetDenylist(len(entries))
+	for _, entry := range entries {
+		denylist.add(entry)
+	}
+	return denylist
+}
+
+// newSetDenylist creates an empty SetDenylist with room for capacity entries
+func newSetDenylist(capacity int) *SetDenylist {
+	return &SetDenylist{
+		entries: make(map[string]struct{}, capacity),
+	}
+}
+
+// LoadDenylist reads a denylist with one password per line.
+// Blank lines and lines starting with '#' are ignored.
+func LoadDenylist(r io.Reader) (*SetDenylist, error) {
+	denylist := NewSetDenylist()
+	if err := denylist.load(r); err != nil {
+		return nil, err
+	}
+	return denylist, nil
+}
+
+// LoadDenylistFS reads a denylist file from a file system, such as an embed.FS or os.DirFS
+func LoadDenylistFS(fsys fs.FS, name string) (*SetDenylist, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, domain.NewInternalErrorWithWrap(err, "failed to open password denylist")
+	}
+	defer file.Close()
+
+	return LoadDenylist(file)
+}
+
+// Contains reports whether the password is in the denylist, ignoring case
+func (d *SetDenylist) Contains(password string) bool {
+	_, ok := d.entries[strings.ToLower(password)]
+	return ok
+}
+
+// Len returns the number of entries in the denylist
+func (d *SetDenylist) Len() int {
+	return len(d.entries)
+}
+
+// add inserts a trimmed, lowercase entry, skipping blank ones
+func (d *SetDenylist) add(entry string) {
+	entry = strings.ToLower(strings.TrimSpace(entry))
+	if entry != "" {
+		d.entries[entry] = struct{}{}
+	}
+}
+
+// load adds the entries read from r, one per line
+func (d *SetDenylist) load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		d.add(line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return domain.NewInternalErrorWithWrap(err, "failed to read password denylist")
+	}
+	return nil
+}
+
+var (
+	defaultDenylist     *SetDenylist
+	defaultDenylistOnce sync.Once
+
+	activeDenylistMu sync.RWMutex
+	activeDenylist   Denylist
+)
+
+// DefaultDenylist returns the embedded list of common passwords.
+// It is parsed once, on first use, into a set sized for the whole list, so lookups take
+// constant time however long the list is.
+func DefaultDenylist() *SetDenylist {
+	defaultDenylistOnce.Do(
+		func() {
+			lines := strings.Count(embeddedCommonPasswords, "\n") + 1
+			defaultDenylist = newSetDenylist(lines)
+			_ = defaultDenylist.load(strings.NewReader(embeddedCommonPasswords))
+		},
+	)
+	return defaultDenylist
+}
+
+// SetCommonPasswordDenylist replaces the denylist used by ValidatePassword and
+// CheckCommonPassword. Passing nil restores the embedded DefaultDenylist.
+func SetCommonPasswordDenylist(denylist Denylist) {
+	activeDenylistMu.Lock()
+	defer activeDenylistMu.Unlock()
+	activeDenylist = denylist
+}
+
+// CommonPasswordDenylist returns the denylist currently used by ValidatePassword
+func CommonPasswordDenylist() Denylist {
+	activeDenylistMu.RLock()
+	denylist := activeDenylist
+	activeDenylistMu.RUnlock()
+
+	if denylist == nil {
+		return DefaultDenylist()
+	}
+	return denylist
+}
+
+// CheckCommonPassword returns ErrPasswordCommon if the password is in the active denylist
+func CheckCommonPassword(password string) error {
+	if CommonPasswordDenylist().Contains(password) {
+		return ErrPasswordCommon
+	}
+	return nil
+}
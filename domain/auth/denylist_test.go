@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DenylistTestSuite struct {
+	suite.Suite
+}
+
+func TestDenylistSuite(t *testing.T) {
+	suite.Run(t, new(DenylistTestSuite))
+}
+
+func (s *DenylistTestSuite) TearDownTest() {
+	SetCommonPasswordDenylist(nil)
+}
+
+func (s *DenylistTestSuite) TestItCanCheckPasswordsAgainstDefaultDenylist() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{"Most common password", "password", ErrPasswordCommon},
+		{"Common password with uppercase", "PassWord123", ErrPasswordCommon},
+		{"Numeric password", "123456789", ErrPasswordCommon},
+		{"Uncommon password", "Tr0ub4dor&3xyz", nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				err := CheckCommonPassword(tc.input)
+				if tc.expected == nil {
+					s.NoError(err)
+					return
+				}
+				s.True(errors.Is(err, tc.expected))
+			},
+		)
+	}
+}
+
+func (s *DenylistTestSuite) TestItCanLoadDenylistFromReader() {
+	denylist, err := LoadDenylist(
+		strings.NewReader("# comment\n\nHunter2\n  correcthorse  \n"),
+	)
+
+	s.NoError(err)
+	s.Equal(2, denylist.Len())
+	s.True(denylist.Contains("hunter2"))
+	s.True(denylist.Contains("CorrectHorse"))
+	s.False(denylist.Contains("# comment"))
+}
+
+func (s *DenylistTestSuite) TestItCanLoadDenylistFromFS() {
+	fsys := fstest.MapFS{
+		"passwords.txt": &fstest.MapFile{Data: []byte("hunter2\n")},
+	}
+
+	denylist, err := LoadDenylistFS(fsys, "passwords.txt")
+	s.NoError(err)
+	s.True(denylist.Contains("hunter2"))
+
+	_, err = LoadDenylistFS(fsys, "missing.txt")
+	s.Error(err)
+}
+
+func (s *DenylistTestSuite) TestItCanUseCustomDenylist() {
+	SetCommonPasswordDenylist(NewSetDenylist("Company2024!"))
+
+	s.ErrorIs(CheckCommonPassword("company2024!"), ErrPasswordCommon)
+	s.NoError(CheckCommonPassword("password"))
+	s.ErrorIs(ValidatePassword("Company2024!"), ErrPasswordCommon)
+
+	SetCommonPasswordDenylist(nil)
+	s.ErrorIs(CheckCommonPassword("password"), ErrPasswordCommon)
+}
+
+func BenchmarkSetDenylistContains(b *testing.B) {
+	for _, size := range []int{1_000, 10_000, 100_000, 1_000_000} {
+		entries := make([]string, size)
+		for i := range entries {
+			entries[i] = fmt.Sprintf("common-password-%d", i)
+		}
+		denylist := NewSetDenylist(entries...)
+
+		b.Run(
+			fmt.Sprintf("entries=%d", size), func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					denylist.Contains("Common-Password-999")
+					denylist.Contains("Tr0ub4dor&3xyz")
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrInvalidTokenLifetime     = domain.NewError("token lifetime must be positive")
+	ErrTokenExpiresBeforeIssued = domain.NewError(
+		"token expiration time must be after its issue time",
+	)
+	ErrMissingTokenTimestamps = domain.NewError("token issue and expiration times are required")
+)
+
+// Clock supplies the current time, so expiration checks can be tested deterministically
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function such as time.Now to the Clock interface
+type ClockFunc func() time.Time
+
+// Now returns the time reported by the function
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is the Clock backed by time.Now
+var SystemClock Clock = ClockFunc(time.Now)
+
+// ExpiringToken is a Token that is only valid for a limited time, such as a password-reset
+// or invitation token
+type ExpiringToken struct {
+	token     Token
+	issuedAt  time.Time
+	expiresAt time.Time
+}
+
+// GenerateExpiringToken creates a new random token issued now and valid for the given lifetime
+func GenerateExpiringToken(clock Clock, lifetime time.Duration) (ExpiringToken, error) {
+	if lifetime <= 0 {
+		return ExpiringToken{}, ErrInvalidTokenLifetime
+	}
+
+	token, err := GenerateToken()
+	if err != nil {
+		return ExpiringToken{}, err
+	}
+
+	issuedAt := clock.Now()
+	return ExpiringToken{
+		token:     token,
+		issuedAt:  issuedAt,
+		expiresAt: issuedAt.Add(lifetime),
+	}, nil
+}
+
+// NewExpiringToken creates an ExpiringToken from an existing token, with validation
+func NewExpiringToken(token Token, issuedAt, expiresAt time.Time) (ExpiringToken, error) {
+	if err := IsValidToken(token.Value()); err != nil {
+		return ExpiringToken{}, err
+	}
+
+	if issuedAt.IsZero() || expiresAt.IsZero() {
+		return ExpiringToken{}, ErrMissingTokenTimestamps
+	}
+
+	if !expiresAt.After(issuedAt) {
+		return ExpiringToken{}, ErrTokenExpiresBeforeIssued
+	}
+
+	return ExpiringToken{
+		token:     token,
+		issuedAt:  issuedAt,
+		expiresAt: expiresAt,
+	}, nil
+}
+
+// ReconstituteExpiringToken creates an ExpiringToken instance without validation
+func ReconstituteExpiringToken(token Token, issuedAt, expiresAt time.Time) ExpiringToken {
+	return ExpiringToken{
+		token:     token,
+		issuedAt:  issuedAt,
+		expiresAt: expiresAt,
+	}
+}
+
+// Token returns the wrapped token
+func (t ExpiringToken) Token() Token {
+	return t.token
+}
+
+// IssuedAt returns the time the token was issued
+func (t ExpiringToken) IssuedAt() time.Time {
+	return t.issuedAt
+}
+
+// ExpiresAt returns the time from which the token is no longer valid
+func (t ExpiringToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+// Lifetime returns the total duration the token is valid for
+func (t ExpiringToken) Lifetime() time.Duration {
+	return t.expiresAt.Sub(t.issuedAt)
+}
+
+// IsExpired reports whether the token has expired according to the clock
+func (t ExpiringToken) IsExpired(clock Clock) bool {
+	return !clock.Now().Before(t.expiresAt)
+}
+
+// Remaining returns the time left before the token expires, or zero if it already has
+func (t ExpiringToken) Remaining(clock Clock) time.Duration {
+	return max(t.expiresAt.Sub(clock.Now()), 0)
+}
+
+// Equals compares two expiring tokens; the token values are compared in constant time
+func (t ExpiringToken) Equals(other ExpiringToken) bool {
+	return t.token.Equals(other.token) &&
+		t.issuedAt.Equal(other.issuedAt) &&
+		t.expiresAt.Equal(other.expiresAt)
+}
+
+// String returns the masked token with its expiration time
+func (t ExpiringToken) String() string {
+	return t.token.String() + " (expires " + t.expiresAt.Format(time.RFC3339) + ")"
+}
+
+type expiringTokenJSON struct {
+	Token     string    `json:"token"`
+	IssuedAt  time.Time `json:"issuedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// MarshalJSON encodes the expiring token as a JSON object, including the secret token value
+func (t ExpiringToken) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		expiringTokenJSON{
+			Token:     t.token.Value(),
+			IssuedAt:  t.issuedAt,
+			ExpiresAt: t.expiresAt,
+		},
+	)
+}
+
+// UnmarshalJSON decodes the expiring token from a JSON object, with validation
+func (t *ExpiringToken) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw expiringTokenJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid expiring token JSON")
+	}
+
+	token, err := NewToken(raw.Token)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewExpiringToken(token, raw.IssuedAt, raw.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
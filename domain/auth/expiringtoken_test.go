@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ExpiringTokenTestSuite struct {
+	suite.Suite
+	issuedAt time.Time
+}
+
+func TestExpiringTokenSuite(t *testing.T) {
+	suite.Run(t, new(ExpiringTokenTestSuite))
+}
+
+func (s *ExpiringTokenTestSuite) SetupTest() {
+	s.issuedAt = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *ExpiringTokenTestSuite) clockAt(moment time.Time) Clock {
+	return ClockFunc(
+		func() time.Time {
+			return moment
+		},
+	)
+}
+
+func (s *ExpiringTokenTestSuite) TestItCanGenerateExpiringToken() {
+	token, err := GenerateExpiringToken(s.clockAt(s.issuedAt), time.Hour)
+
+	s.NoError(err)
+	s.NoError(IsValidToken(token.Token().Value()))
+	s.Equal(s.issuedAt, token.IssuedAt())
+	s.Equal(s.issuedAt.Add(time.Hour), token.ExpiresAt())
+	s.Equal(time.Hour, token.Lifetime())
+
+	_, err = GenerateExpiringToken(SystemClock, 0)
+	s.ErrorIs(err, ErrInvalidTokenLifetime)
+}
+
+func (s *ExpiringTokenTestSuite) TestItCanTrackExpiration() {
+	token, err := NewExpiringToken(
+		ReconstituteToken("reset-token-value-0123456"),
+		s.issuedAt,
+		s.issuedAt.Add(30*time.Minute),
+	)
+	s.NoError(err)
+
+	testCases := []struct {
+		name              string
+		now               time.Time
+		expectedExpired   bool
+		expectedRemaining time.Duration
+	}{
+		{"Just issued", s.issuedAt, false, 30 * time.Minute},
+		{"Halfway", s.issuedAt.Add(15 * time.Minute), false, 15 * time.Minute},
+		{"At expiration", s.issuedAt.Add(30 * time.Minute), true, 0},
+		{"Long expired", s.issuedAt.Add(24 * time.Hour), true, 0},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				clock := s.clockAt(tc.now)
+				s.Equal(tc.expectedExpired, token.IsExpired(clock))
+				s.Equal(tc.expectedRemaining, token.Remaining(clock))
+			},
+		)
+	}
+}
+
+func (s *ExpiringTokenTestSuite) TestItFailsToBuildExpiringTokenFromInvalidValues() {
+	testCases := []struct {
+		name          string
+		token         Token
+		issuedAt      time.Time
+		expiresAt     time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty token",
+			token:         ReconstituteToken(""),
+			issuedAt:      s.issuedAt,
+			expiresAt:     s.issuedAt.Add(time.Hour),
+			expectedError: ErrEmptyToken,
+		},
+		{
+			name:          "missing issue time",
+			token:         ReconstituteToken("reset-token-value-0123456"),
+			expiresAt:     s.issuedAt,
+			expectedError: ErrMissingTokenTimestamps,
+		},
+		{
+			name:          "expires before issued",
+			token:         ReconstituteToken("reset-token-value-0123456"),
+			issuedAt:      s.issuedAt,
+			expiresAt:     s.issuedAt.Add(-time.Second),
+			expectedError: ErrTokenExpiresBeforeIssued,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewExpiringToken(tc.token, tc.issuedAt, tc.expiresAt)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *ExpiringTokenTestSuite) TestItCanMarshalAndUnmarshalJSON() {
+	token := ReconstituteExpiringToken(
+		ReconstituteToken("invite-token-0123456789"),
+		s.issuedAt,
+		s.issuedAt.Add(time.Hour),
+	)
+
+	encoded, err := json.Marshal(token)
+	s.NoError(err)
+	s.JSONEq(
+		`{"token":"invite-token-0123456789","issuedAt":"2024-05-01T12:00:00Z",`+
+			`"expiresAt":"2024-05-01T13:00:00Z"}`,
+		string(encoded),
+	)
+
+	var decoded ExpiringToken
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(token.Equals(decoded))
+
+	err = json.Unmarshal(
+		[]byte(`{"token":"invite-token-0123456789","issuedAt":"2024-05-01T12:00:00Z",`+
+			`"expiresAt":"2024-05-01T11:00:00Z"}`),
+		&decoded,
+	)
+	s.ErrorIs(err, ErrTokenExpiresBeforeIssued)
+}
+
+func (s *ExpiringTokenTestSuite) TestStringMasksTheToken() {
+	token := ReconstituteExpiringToken(
+		ReconstituteToken("AbCdEfGhIjKlMnOp"),
+		s.issuedAt,
+		s.issuedAt.Add(time.Hour),
+	)
+
+	s.Equal("AbCd•••• (expires 2024-05-01T13:00:00Z)", token.String())
+}
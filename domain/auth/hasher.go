@@ -0,0 +1,240 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/golibry/go-common-domain/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidBcryptCost = domain.NewError(
+		"bcrypt cost must be between %d and %d",
+		bcrypt.MinCost,
+		bcrypt.MaxCost,
+	)
+	ErrInvalidHashCost = domain.NewError("cost is not supported by the hashing algorithm")
+)
+
+// HashAlgorithm is a password hashing algorithm with a tunable work factor
+type HashAlgorithm interface {
+	// Hash returns the encoded hash of the plaintext, computed with the given cost
+	Hash(plaintext []byte, cost int) ([]byte, error)
+	// Compare returns nil when the hash matches the plaintext, and
+	// bcrypt.ErrMismatchedHashAndPassword (or an error wrapping it) when it does not
+	Compare(hash, plaintext []byte) error
+	// ValidCost reports whether the algorithm accepts the cost
+	ValidCost(cost int) bool
+}
+
+// Bcrypt is the bcrypt HashAlgorithm, used by default
+var Bcrypt HashAlgorithm = bcryptAlgorithm{}
+
+type bcryptAlgorithm struct{}
+
+func (bcryptAlgorithm) Hash(plaintext []byte, cost int) ([]byte, error) {
+	return bcrypt.GenerateFromPassword(plaintext, cost)
+}
+
+func (bcryptAlgorithm) Compare(hash, plaintext []byte) error {
+	return bcrypt.CompareHashAndPassword(hash, plaintext)
+}
+
+func (bcryptAlgorithm) ValidCost(cost int) bool {
+	return cost >= bcrypt.MinCost && cost <= bcrypt.MaxCost
+}
+
+// Hasher hashes and verifies passwords with a configurable algorithm, cost and pepper, on a
+// bounded number of concurrent workers, so registration endpoints can limit the CPU spent
+// hashing and give up on requests whose context is done. It is safe for concurrent use.
+//
+// NewPassword and Password.Verify use the default hasher, which SetDefaultHasher replaces at
+// startup (e.g., a low cost in staging), or the one passed with WithHasher.
+type Hasher struct {
+	algorithm HashAlgorithm
+	cost      int
+	pepper    []byte
+	slots     chan struct{}
+}
+
+// HasherOption configures a Hasher
+type HasherOption func(*hasherOptions)
+
+type hasherOptions struct {
+	algorithm   HashAlgorithm
+	cost        int
+	pepper      []byte
+	concurrency int
+}
+
+var (
+	// builtinHasher is the initial default hasher: bcrypt with BcryptCost and no pepper
+	builtinHasher *Hasher
+	// defaultHasher is used by NewPassword and Password.Verify without WithHasher
+	defaultHasher atomic.Pointer[Hasher]
+)
+
+func init() {
+	hasher, err := NewHasher()
+	if err != nil {
+		panic(err)
+	}
+	builtinHasher = hasher
+	defaultHasher.Store(hasher)
+}
+
+// DefaultHasher returns the hasher used by NewPassword and Password.Verify without WithHasher
+func DefaultHasher() *Hasher {
+	return defaultHasher.Load()
+}
+
+// SetDefaultHasher replaces the hasher used by NewPassword and Password.Verify without
+// WithHasher. Call it once at startup; existing hashes keep verifying as long as the
+// algorithm and pepper stay the same. A nil hasher restores the built-in bcrypt hasher.
+func SetDefaultHasher(hasher *Hasher) {
+	if hasher == nil {
+		hasher = builtinHasher
+	}
+	defaultHasher.Store(hasher)
+}
+
+// WithAlgorithm sets the hashing algorithm, Bcrypt by default
+func WithAlgorithm(algorithm HashAlgorithm) HasherOption {
+	return func(o *hasherOptions) {
+		o.algorithm = algorithm
+	}
+}
+
+// WithCost sets the cost of the algorithm, BcryptCost by default
+func WithCost(cost int) HasherOption {
+	return func(o *hasherOptions) {
+		o.cost = cost
+	}
+}
+
+// WithPepper sets a secret, kept outside the password store, mixed into every plaintext with
+// HMAC-SHA256 before hashing, so stolen hashes cannot be cracked without it. Changing the
+// pepper invalidates existing hashes.
+func WithPepper(pepper []byte) HasherOption {
+	return func(o *hasherOptions) {
+		o.pepper = append([]byte(nil), pepper...)
+	}
+}
+
+// WithConcurrency sets how many passwords are hashed at once, GOMAXPROCS by default. Values
+// below one are ignored.
+func WithConcurrency(concurrency int) HasherOption {
+	return func(o *hasherOptions) {
+		if concurrency >= 1 {
+			o.concurrency = concurrency
+		}
+	}
+}
+
+// NewHasher creates a new Hasher
+func NewHasher(opts ...HasherOption) (*Hasher, error) {
+	options := hasherOptions{
+		algorithm:   Bcrypt,
+		cost:        BcryptCost,
+		concurrency: runtime.GOMAXPROCS(0),
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if !options.algorithm.ValidCost(options.cost) {
+		if options.algorithm == Bcrypt {
+			return nil, ErrInvalidBcryptCost
+		}
+		return nil, ErrInvalidHashCost
+	}
+
+	return &Hasher{
+		algorithm: options.algorithm,
+		cost:      options.cost,
+		pepper:    options.pepper,
+		slots:     make(chan struct{}, options.concurrency),
+	}, nil
+}
+
+// Cost returns the cost the hasher hashes with
+func (h *Hasher) Cost() int {
+	return h.cost
+}
+
+// Algorithm returns the hashing algorithm of the hasher
+func (h *Hasher) Algorithm() HashAlgorithm {
+	return h.algorithm
+}
+
+// HashPassword validates the plaintext like NewPassword and hashes it once a worker is free.
+// It returns the context error when ctx is done before the hash is ready. A hash already
+// running keeps its worker until it finishes, so the concurrency bound always holds.
+// A WithHasher option is ignored; the receiver always hashes.
+func (h *Hasher) HashPassword(
+	ctx context.Context,
+	plaintext string,
+	opts ...PasswordOption,
+) (Password, error) {
+	plaintext = applyPasswordOptions(opts).prepare(plaintext)
+	if err := ValidatePassword(plaintext); err != nil {
+		return Password{}, err
+	}
+
+	select {
+	case h.slots <- struct{}{}:
+	case <-ctx.Done():
+		return Password{}, ctx.Err()
+	}
+
+	type result struct {
+		password Password
+		err      error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() { <-h.slots }()
+		password, err := h.hash(plaintext)
+		done <- result{password, err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.password, res.err
+	case <-ctx.Done():
+		return Password{}, ctx.Err()
+	}
+}
+
+// hash hashes a validated plaintext on the calling goroutine
+func (h *Hasher) hash(plaintext string) (Password, error) {
+	hashedBytes, err := h.algorithm.Hash(h.peppered(plaintext), h.cost)
+	if err != nil {
+		return Password{}, err
+	}
+	return Password{hashedValue: string(hashedBytes)}, nil
+}
+
+// compare checks a plaintext against a hash created by a hasher with the same algorithm and
+// pepper
+func (h *Hasher) compare(hashedValue, plaintext string) error {
+	return h.algorithm.Compare([]byte(hashedValue), h.peppered(plaintext))
+}
+
+// peppered returns the bytes to hash for the plaintext: the plaintext itself without a
+// pepper, or its base64 encoded HMAC keyed by the pepper, which also keeps long plaintexts
+// under the bcrypt input limit
+func (h *Hasher) peppered(plaintext string) []byte {
+	if len(h.pepper) == 0 {
+		return []byte(plaintext)
+	}
+
+	mac := hmac.New(sha256.New, h.pepper)
+	mac.Write([]byte(plaintext))
+	return base64.StdEncoding.AppendEncode(nil, mac.Sum(nil))
+}
@@ -0,0 +1,248 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type HasherTestSuite struct {
+	suite.Suite
+}
+
+func TestHasherSuite(t *testing.T) {
+	suite.Run(t, new(HasherTestSuite))
+}
+
+func (s *HasherTestSuite) TestItCanHashPasswordsWithTheConfiguredCost() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost))
+	s.Require().NoError(err)
+	s.Equal(bcrypt.MinCost, hasher.Cost())
+
+	password, err := hasher.HashPassword(context.Background(), "MySecure123!")
+	s.Require().NoError(err)
+
+	cost, err := bcrypt.Cost([]byte(password.HashedValue()))
+	s.NoError(err)
+	s.Equal(bcrypt.MinCost, cost)
+	s.NoError(password.Verify("MySecure123!"))
+}
+
+func (s *HasherTestSuite) TestItDefaultsToBcryptCost() {
+	hasher, err := NewHasher()
+	s.Require().NoError(err)
+	s.Equal(BcryptCost, hasher.Cost())
+}
+
+func (s *HasherTestSuite) TestItRejectsInvalidCosts() {
+	for _, cost := range []int{0, bcrypt.MinCost - 1, bcrypt.MaxCost + 1} {
+		hasher, err := NewHasher(WithCost(cost))
+		s.Nil(hasher)
+		s.ErrorIs(err, ErrInvalidBcryptCost)
+	}
+}
+
+func (s *HasherTestSuite) TestItValidatesBeforeHashing() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost))
+	s.Require().NoError(err)
+
+	_, err = hasher.HashPassword(context.Background(), "short")
+	s.ErrorIs(err, ErrPasswordTooShort)
+}
+
+func (s *HasherTestSuite) TestItAppliesPasswordOptions() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost))
+	s.Require().NoError(err)
+
+	password, err := hasher.HashPassword(
+		context.Background(), "Ｐassword123!", WithUnicodeNormalization(),
+	)
+	s.Require().NoError(err)
+	s.NoError(password.Verify("Password123!"))
+}
+
+func (s *HasherTestSuite) TestItReturnsTheContextErrorWhenCanceled() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost))
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = hasher.HashPassword(ctx, "MySecure123!")
+	s.ErrorIs(err, context.Canceled)
+}
+
+func (s *HasherTestSuite) TestItGivesUpWaitingForAWorkerWhenTheContextIsDone() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost), WithConcurrency(1))
+	s.Require().NoError(err)
+
+	// Occupy the only worker
+	hasher.slots <- struct{}{}
+	defer func() { <-hasher.slots }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = hasher.HashPassword(ctx, "MySecure123!")
+	s.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (s *HasherTestSuite) TestItBoundsConcurrentHashing() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost), WithConcurrency(2))
+	s.Require().NoError(err)
+	s.Equal(2, cap(hasher.slots))
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := hasher.HashPassword(context.Background(), "MySecure123!")
+			s.NoError(err)
+			s.LessOrEqual(len(hasher.slots), 2)
+		}()
+	}
+	wg.Wait()
+
+	s.Empty(hasher.slots)
+}
+
+func (s *HasherTestSuite) TestItIgnoresInvalidConcurrency() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost), WithConcurrency(0))
+	s.Require().NoError(err)
+	s.Positive(cap(hasher.slots))
+}
+
+func (s *HasherTestSuite) TestNewPasswordHashesWithTheGivenHasher() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost))
+	s.Require().NoError(err)
+
+	password, err := NewPassword("MySecure123!", WithHasher(hasher))
+	s.Require().NoError(err)
+
+	cost, err := bcrypt.Cost([]byte(password.HashedValue()))
+	s.NoError(err)
+	s.Equal(bcrypt.MinCost, cost)
+	s.NoError(password.Verify("MySecure123!", WithHasher(hasher)))
+}
+
+func (s *HasherTestSuite) TestNewPasswordHashesWithTheDefaultHasher() {
+	previous := DefaultHasher()
+	defer SetDefaultHasher(previous)
+
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost + 1))
+	s.Require().NoError(err)
+	SetDefaultHasher(hasher)
+	s.Same(hasher, DefaultHasher())
+
+	password, err := NewPassword("MySecure123!")
+	s.Require().NoError(err)
+
+	cost, err := bcrypt.Cost([]byte(password.HashedValue()))
+	s.NoError(err)
+	s.Equal(bcrypt.MinCost+1, cost)
+}
+
+func (s *HasherTestSuite) TestSettingANilDefaultHasherRestoresTheBuiltinOne() {
+	previous := DefaultHasher()
+	defer SetDefaultHasher(previous)
+
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost))
+	s.Require().NoError(err)
+	SetDefaultHasher(hasher)
+	SetDefaultHasher(nil)
+	s.Require().NotNil(DefaultHasher())
+	s.NotSame(hasher, DefaultHasher())
+
+	password, err := NewPassword("MySecure123!")
+	s.Require().NoError(err)
+
+	cost, err := bcrypt.Cost([]byte(password.HashedValue()))
+	s.NoError(err)
+	s.Equal(BcryptCost, cost)
+}
+
+func (s *HasherTestSuite) TestItVerifiesPepperedPasswordsOnlyWithTheSamePepper() {
+	peppered, err := NewHasher(WithCost(bcrypt.MinCost), WithPepper([]byte("pepper")))
+	s.Require().NoError(err)
+	otherPepper, err := NewHasher(WithCost(bcrypt.MinCost), WithPepper([]byte("salt")))
+	s.Require().NoError(err)
+
+	password, err := peppered.HashPassword(context.Background(), "MySecure123!")
+	s.Require().NoError(err)
+
+	s.NoError(password.Verify("MySecure123!", WithHasher(peppered)))
+	s.ErrorIs(password.Verify("MySecure123!"), ErrPasswordVerifyFailed)
+	s.ErrorIs(password.Verify("MySecure123!", WithHasher(otherPepper)), ErrPasswordVerifyFailed)
+	s.ErrorIs(password.Verify("MySecure124!", WithHasher(peppered)), ErrPasswordVerifyFailed)
+}
+
+func (s *HasherTestSuite) TestItHashesPepperedPasswordsLongerThanTheBcryptLimit() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost), WithPepper([]byte("pepper")))
+	s.Require().NoError(err)
+
+	plaintext := "MySecure123!" + strings.Repeat("Qm7#Rz", 14)
+	password, err := NewPassword(plaintext, WithHasher(hasher))
+	s.Require().NoError(err)
+
+	s.NoError(password.Verify(plaintext, WithHasher(hasher)))
+	s.ErrorIs(password.Verify(plaintext+"y", WithHasher(hasher)), ErrPasswordVerifyFailed)
+}
+
+func (s *HasherTestSuite) TestItHashesWithTheConfiguredAlgorithm() {
+	algorithm := &reversingAlgorithm{}
+	hasher, err := NewHasher(WithAlgorithm(algorithm), WithCost(1))
+	s.Require().NoError(err)
+	s.Same(algorithm, hasher.Algorithm())
+
+	password, err := NewPassword("MySecure123!", WithHasher(hasher))
+	s.Require().NoError(err)
+	s.Equal("!321eruceSyM", password.HashedValue())
+	s.NoError(password.Verify("MySecure123!", WithHasher(hasher)))
+	s.ErrorIs(password.Verify("MySecure124!", WithHasher(hasher)), ErrPasswordVerifyFailed)
+
+	_, err = NewHasher(WithAlgorithm(algorithm), WithCost(2))
+	s.ErrorIs(err, ErrInvalidHashCost)
+}
+
+func (s *HasherTestSuite) TestCredentialsUseTheGivenHasher() {
+	hasher, err := NewHasher(WithCost(bcrypt.MinCost), WithPepper([]byte("pepper")))
+	s.Require().NoError(err)
+
+	credentials, err := NewCredentials("jane", "MySecure123!", WithHasher(hasher))
+	s.Require().NoError(err)
+
+	password, err := credentials.HashPassword()
+	s.Require().NoError(err)
+	s.NoError(credentials.Verify(password))
+	s.ErrorIs(password.Verify("MySecure123!"), ErrPasswordVerifyFailed)
+}
+
+// reversingAlgorithm is a HashAlgorithm for tests that "hashes" by reversing the plaintext
+// and only accepts a cost of one
+type reversingAlgorithm struct{}
+
+func (*reversingAlgorithm) Hash(plaintext []byte, _ int) ([]byte, error) {
+	hash := slices.Clone(plaintext)
+	slices.Reverse(hash)
+	return hash, nil
+}
+
+func (a *reversingAlgorithm) Compare(hash, plaintext []byte) error {
+	expected, _ := a.Hash(plaintext, 1)
+	if !bytes.Equal(expected, hash) {
+		return bcrypt.ErrMismatchedHashAndPassword
+	}
+	return nil
+}
+
+func (*reversingAlgorithm) ValidCost(cost int) bool {
+	return cost == 1
+}
@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MaxJWTLength is the maximum size in bytes of a compact JWT accepted by NewJWT
+const MaxJWTLength = 8192
+
+var (
+	ErrEmptyJWT         = domain.NewError("JWT cannot be empty")
+	ErrJWTTooLong       = domain.NewError("JWT cannot exceed %d bytes", MaxJWTLength)
+	ErrInvalidJWT       = domain.NewError("JWT must consist of three base64url encoded parts")
+	ErrInvalidJWTHeader = domain.NewError("JWT header must be a JSON object with an alg field")
+	ErrInvalidJWTClaims = domain.NewError("JWT claims must be a JSON object")
+)
+
+// JWT is a structurally valid JSON Web Token in compact serialization (RFC 7519).
+//
+// JWT does NOT verify the signature: header and claims are untrusted input until the token
+// has been verified by a dedicated library using the expected key and algorithm. Use this
+// type to carry tokens through domain layers, not to make authorization decisions.
+type JWT struct {
+	value  string
+	header map[string]any
+	claims map[string]any
+}
+
+// NewJWT creates a new JWT from its compact serialization, validating its structure
+func NewJWT(value string) (JWT, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return JWT{}, ErrEmptyJWT
+	}
+
+	if len(value) > MaxJWTLength {
+		return JWT{}, ErrJWTTooLong
+	}
+
+	parts := strings.Split(value, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return JWT{}, ErrInvalidJWT
+	}
+
+	if _, err := base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		return JWT{}, ErrInvalidJWT
+	}
+
+	header, err := decodeJWTSegment(parts[0])
+	if err != nil {
+		return JWT{}, ErrInvalidJWTHeader
+	}
+
+	if alg, ok := header["alg"].(string); !ok || alg == "" {
+		return JWT{}, ErrInvalidJWTHeader
+	}
+
+	claims, err := decodeJWTSegment(parts[1])
+	if err != nil {
+		return JWT{}, ErrInvalidJWTClaims
+	}
+
+	return JWT{
+		value:  value,
+		header: header,
+		claims: claims,
+	}, nil
+}
+
+// decodeJWTSegment decodes a base64url encoded JSON object
+func decodeJWTSegment(segment string) (map[string]any, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(decoded))
+	decoder.UseNumber()
+
+	var result map[string]any
+	if err = decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	if result == nil || decoder.More() {
+		return nil, ErrInvalidJWT
+	}
+
+	return result, nil
+}
+
+// cloneJSONObject deep copies a decoded JSON object, so callers cannot alter the token
+func cloneJSONObject(object map[string]any) map[string]any {
+	if object == nil {
+		return nil
+	}
+
+	clone := make(map[string]any, len(object))
+	for name, value := range object {
+		clone[name] = cloneJSONValue(value)
+	}
+	return clone
+}
+
+// cloneJSONValue deep copies a decoded JSON value: objects and arrays are copied, scalars
+// are immutable and returned as they are
+func cloneJSONValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		return cloneJSONObject(value)
+	case []any:
+		clone := make([]any, len(value))
+		for i, element := range value {
+			clone[i] = cloneJSONValue(element)
+		}
+		return clone
+	default:
+		return value
+	}
+}
+
+// Value returns the compact serialization of the token
+func (j JWT) Value() string {
+	return j.value
+}
+
+// Header returns a deep copy of the decoded, unverified header
+func (j JWT) Header() map[string]any {
+	return cloneJSONObject(j.header)
+}
+
+// Claims returns a deep copy of the decoded, unverified claims
+func (j JWT) Claims() map[string]any {
+	return cloneJSONObject(j.claims)
+}
+
+// Algorithm returns the unverified "alg" header
+func (j JWT) Algorithm() string {
+	alg, _ := j.header["alg"].(string)
+	return alg
+}
+
+// Claim returns a deep copy of a single unverified claim and whether it is present
+func (j JWT) Claim(name string) (any, bool) {
+	value, ok := j.claims[name]
+	return cloneJSONValue(value), ok
+}
+
+// StringClaim returns an unverified claim that holds a string, such as "sub" or "iss"
+func (j JWT) StringClaim(name string) (string, bool) {
+	value, ok := j.claims[name].(string)
+	return value, ok
+}
+
+// NumericClaim returns an unverified numeric claim, such as "exp" or "iat", as seconds
+func (j JWT) NumericClaim(name string) (int64, bool) {
+	number, ok := j.claims[name].(json.Number)
+	if !ok {
+		return 0, false
+	}
+
+	if value, err := number.Int64(); err == nil {
+		return value, true
+	}
+
+	value, err := strconv.ParseFloat(number.String(), 64)
+	if err != nil {
+		return 0, false
+	}
+	return int64(value), true
+}
+
+// Equals compares two JWT objects by their compact serialization
+func (j JWT) Equals(other JWT) bool {
+	return j.value == other.value
+}
+
+// String returns the token with its signature hidden
+func (j JWT) String() string {
+	if j.value == "" {
+		return ""
+	}
+	return j.value[:strings.LastIndexByte(j.value, '.')+1] + "[PROTECTED]"
+}
@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const sampleJWT = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9." +
+	"eyJzdWIiOiIxMjM0NTY3ODkwIiwibmFtZSI6IkpvaG4gRG9lIiwiaWF0IjoxNTE2MjM5MDIyfQ." +
+	"SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c"
+
+type JWTTestSuite struct {
+	suite.Suite
+}
+
+func TestJWTSuite(t *testing.T) {
+	suite.Run(t, new(JWTTestSuite))
+}
+
+func (s *JWTTestSuite) segment(json string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(json))
+}
+
+func (s *JWTTestSuite) TestItCanBuildNewJWTFromValidValues() {
+	token, err := NewJWT("  " + sampleJWT + "  ")
+
+	s.NoError(err)
+	s.Equal(sampleJWT, token.Value())
+	s.Equal("HS256", token.Algorithm())
+	s.Equal("JWT", token.Header()["typ"])
+
+	subject, ok := token.StringClaim("sub")
+	s.True(ok)
+	s.Equal("1234567890", subject)
+
+	issuedAt, ok := token.NumericClaim("iat")
+	s.True(ok)
+	s.Equal(int64(1516239022), issuedAt)
+
+	_, ok = token.NumericClaim("sub")
+	s.False(ok)
+
+	name, ok := token.Claim("name")
+	s.True(ok)
+	s.Equal("John Doe", name)
+}
+
+func (s *JWTTestSuite) TestItAcceptsUnsecuredJWTWithEmptySignature() {
+	value := s.segment(`{"alg":"none"}`) + "." + s.segment(`{"sub":"x"}`) + "."
+
+	token, err := NewJWT(value)
+	s.NoError(err)
+	s.Equal("none", token.Algorithm())
+}
+
+func (s *JWTTestSuite) TestItFailsToBuildNewJWTFromInvalidValues() {
+	validHeader := s.segment(`{"alg":"HS256"}`)
+	validClaims := s.segment(`{"sub":"x"}`)
+	rest := "." + validClaims + ".sig"
+
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Empty", "   ", ErrEmptyJWT},
+		{"Too long", strings.Repeat("a", MaxJWTLength+1), ErrJWTTooLong},
+		{"Two parts", validHeader + "." + validClaims, ErrInvalidJWT},
+		{"Four parts", validHeader + "." + validClaims + ".sig.extra", ErrInvalidJWT},
+		{"Empty header", rest, ErrInvalidJWT},
+		{"Padded signature", validHeader + "." + validClaims + ".c2ln==", ErrInvalidJWT},
+		{"Header not base64url", "a+b/." + validClaims + ".sig", ErrInvalidJWTHeader},
+		{"Header not JSON", s.segment("alg") + rest, ErrInvalidJWTHeader},
+		{"Header without alg", s.segment(`{"typ":"JWT"}`) + rest, ErrInvalidJWTHeader},
+		{"Claims array", validHeader + "." + s.segment(`[1,2]`) + ".sig", ErrInvalidJWTClaims},
+		{"Claims null", validHeader + "." + s.segment(`null`) + ".sig", ErrInvalidJWTClaims},
+		{"Claims trailing data", validHeader + "." + s.segment(`{}{}`) + ".sig", ErrInvalidJWTClaims},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewJWT(tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *JWTTestSuite) TestClaimsAreReadOnly() {
+	token, _ := NewJWT(sampleJWT)
+
+	claims := token.Claims()
+	claims["sub"] = "tampered"
+	delete(token.Header(), "alg")
+
+	subject, _ := token.StringClaim("sub")
+	s.Equal("1234567890", subject)
+	s.Equal("HS256", token.Algorithm())
+}
+
+func (s *JWTTestSuite) TestNestedClaimsAreReadOnly() {
+	token, err := NewJWT(
+		s.segment(`{"alg":"HS256","crit":["exp"]}`) + "." +
+			s.segment(`{"roles":["user"],"address":{"country":"RO"}}`) + ".",
+	)
+	s.Require().NoError(err)
+
+	token.Claims()["roles"].([]any)[0] = "admin"
+	token.Claims()["address"].(map[string]any)["country"] = "US"
+	roles, _ := token.Claim("roles")
+	roles.([]any)[0] = "admin"
+	token.Header()["crit"].([]any)[0] = "nbf"
+
+	s.Equal([]any{"user"}, token.Claims()["roles"])
+	s.Equal(map[string]any{"country": "RO"}, token.Claims()["address"])
+	s.Equal([]any{"exp"}, token.Header()["crit"])
+}
+
+func (s *JWTTestSuite) TestEqualsAndString() {
+	token1, _ := NewJWT(sampleJWT)
+	token2, _ := NewJWT(sampleJWT)
+	token3, _ := NewJWT(s.segment(`{"alg":"none"}`) + "." + s.segment(`{}`) + ".")
+
+	s.True(token1.Equals(token2))
+	s.False(token1.Equals(token3))
+	s.False(strings.Contains(token1.String(), "SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c"))
+	s.True(strings.HasSuffix(token1.String(), ".[PROTECTED]"))
+}
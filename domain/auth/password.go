@@ -2,39 +2,48 @@ package auth
 
 import (
 	"errors"
-	"strings"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/golibry/go-common-domain/domain"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/text/unicode/norm"
 )
 
 const (
 	MinPasswordLength = 8
 	MaxPasswordLength = 128
-	BcryptCost        = 12 // Higher cost for better security
+	BcryptCost        = 12 // Default cost of DefaultHasher; higher cost for better security
 )
 
 var (
-	ErrPasswordTooShort = domain.NewError(
+	ErrEmptyPassword = domain.NewLocalizedError(
+		"auth.password.empty", nil,
+		"password cannot be empty",
+	)
+	ErrPasswordTooShort = domain.NewLocalizedError(
+		"auth.password.too_short", domain.MessageParams{"min": MinPasswordLength},
 		"password must be at least %d characters long",
 		MinPasswordLength,
 	)
-	ErrPasswordTooLong = domain.NewError(
+	ErrPasswordTooLong = domain.NewLocalizedError(
+		"auth.password.too_long", domain.MessageParams{"max": MaxPasswordLength},
 		"password cannot exceed %d characters",
 		MaxPasswordLength,
 	)
-	ErrPasswordTooWeak = domain.NewError(
-		"password must contain at least one uppercase letter," +
+	ErrPasswordTooWeak = domain.NewLocalizedError(
+		"auth.password.too_weak", nil,
+		"password must contain at least one uppercase letter,"+
 			" one lowercase letter, one number, and one special character",
 	)
-	ErrPasswordCommon = domain.NewError(
-		"password is too common or weak. " +
+	ErrPasswordCommon = domain.NewLocalizedError(
+		"auth.password.common", nil,
+		"password is too common or weak. "+
 			"Try to not use common names or repeating characters like \"123456\" or \"123456789\". ",
 	)
-	ErrInvalidPasswordChars = domain.NewError(
-		"password contains invalid characters; only letters, numbers, " +
+	ErrInvalidPasswordChars = domain.NewLocalizedError(
+		"auth.password.invalid_chars", nil,
+		"password contains invalid characters; only letters, numbers, "+
 			"and standard symbols are allowed",
 	)
 	ErrPasswordVerifyFailed = domain.NewError("failed to verify password")
@@ -45,20 +54,61 @@ type Password struct {
 	hashedValue string
 }
 
-// NewPassword creates a new Password instance with validation and secure hashing
-func NewPassword(plaintext string) (Password, error) {
-	if err := ValidatePassword(plaintext); err != nil {
-		return Password{}, err
+// PasswordOption configures how plaintext passwords are prepared before validation and hashing
+type PasswordOption func(*passwordOptions)
+
+type passwordOptions struct {
+	normalizeUnicode bool
+	hasher           *Hasher
+}
+
+// WithUnicodeNormalization applies Unicode NFKC normalization to the plaintext, so visually
+// identical passwords typed on different platforms (e.g., composed vs. decomposed accents or
+// full-width characters) hash and verify consistently. It is opt-in because hashes created
+// without it do not verify with it for such passwords; pass it to both NewPassword and Verify.
+func WithUnicodeNormalization() PasswordOption {
+	return func(o *passwordOptions) {
+		o.normalizeUnicode = true
+	}
+}
+
+// WithHasher hashes or verifies with the hasher instead of DefaultHasher. A password must be
+// verified by a hasher with the algorithm and pepper it was created with.
+func WithHasher(hasher *Hasher) PasswordOption {
+	return func(o *passwordOptions) {
+		o.hasher = hasher
+	}
+}
+
+// applyPasswordOptions applies the options over the defaults
+func applyPasswordOptions(opts []PasswordOption) passwordOptions {
+	var options passwordOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.hasher == nil {
+		options.hasher = DefaultHasher()
+	}
+	return options
+}
+
+// prepare applies the options to the plaintext password
+func (o passwordOptions) prepare(plaintext string) string {
+	if o.normalizeUnicode {
+		return norm.NFKC.String(plaintext)
 	}
+	return plaintext
+}
 
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(plaintext), BcryptCost)
-	if err != nil {
+// NewPassword creates a new Password instance with validation and secure hashing
+func NewPassword(plaintext string, opts ...PasswordOption) (Password, error) {
+	options := applyPasswordOptions(opts)
+	plaintext = options.prepare(plaintext)
+	if err := ValidatePassword(plaintext); err != nil {
 		return Password{}, err
 	}
 
-	return Password{
-		hashedValue: string(hashedBytes),
-	}, nil
+	return options.hasher.hash(plaintext)
 }
 
 // ReconstitutePassword creates a Password instance from a pre-hashed value without validation
@@ -69,16 +119,18 @@ func ReconstitutePassword(hashedValue string) Password {
 	}
 }
 
-// Verify checks if the provided plaintext password matches the stored hash
-func (p Password) Verify(plaintext string) error {
-	err := bcrypt.CompareHashAndPassword([]byte(p.hashedValue), []byte(plaintext))
+// Verify checks if the provided plaintext password matches the stored hash.
+// The options must match those used when the password was created.
+func (p Password) Verify(plaintext string, opts ...PasswordOption) error {
+	options := applyPasswordOptions(opts)
+	err := options.hasher.compare(p.hashedValue, options.prepare(plaintext))
 	if err == nil {
 		return nil
 	}
 	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
 		return ErrPasswordVerifyFailed
 	}
-	return domain.NewErrorWithWrap(err, "failed to verify password")
+	return domain.NewInternalErrorWithWrap(err, "failed to verify password")
 }
 
 // HashedValue returns the hashed password value
@@ -96,6 +148,9 @@ func (p Password) String() string {
 	return "[PROTECTED]"
 }
 
+// Sensitive marks the password as a secret for domain.Sensitive
+func (p Password) Sensitive() {}
+
 // ValidatePassword validates a plaintext password against OWASP security standards
 func ValidatePassword(password string) error {
 	// Check length constraints
@@ -158,23 +213,8 @@ func validatePasswordComplexity(password string) error {
 
 // validatePasswordStrength checks against common weak passwords
 func validatePasswordStrength(password string) error {
-	// Convert to lowercase for comparison
-	lowerPassword := strings.ToLower(password)
-
-	// Common weak passwords and patterns
-	commonPasswords := []string{
-		"password", "123456", "123456789", "12345678", "12345",
-		"1234567", "password123", "admin", "qwerty", "abc123",
-		"letmein", "monkey", "1234567890", "dragon", "111111",
-		"baseball", "iloveyou", "trustno1", "sunshine", "master",
-		"welcome", "shadow", "ashley", "football", "jesus",
-		"michael", "ninja", "mustang", "password1",
-	}
-
-	for _, common := range commonPasswords {
-		if lowerPassword == common {
-			return ErrPasswordCommon
-		}
+	if err := CheckCommonPassword(password); err != nil {
+		return err
 	}
 
 	// Check for simple patterns
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -200,6 +201,21 @@ func (s *PasswordTestSuite) TestPasswordVerification() {
 	s.True(errors.Is(err, ErrPasswordVerifyFailed))
 }
 
+func (s *PasswordTestSuite) TestPasswordVerificationWithUnicodeNormalization() {
+	composed := "Caf\u00e9Noir123!"
+	decomposed := "Cafe\u0301Noir123!"
+
+	password, err := NewPassword(composed, WithUnicodeNormalization())
+	s.NoError(err)
+	s.NoError(password.Verify(decomposed, WithUnicodeNormalization()))
+	s.NoError(password.Verify(composed, WithUnicodeNormalization()))
+
+	// Without the option, differently encoded input does not verify
+	legacy, err := NewPassword(composed)
+	s.NoError(err)
+	s.ErrorIs(legacy.Verify(decomposed), ErrPasswordVerifyFailed)
+}
+
 func (s *PasswordTestSuite) TestPasswordEquals() {
 	plaintext := "MySecure123!@"
 	password1, err := NewPassword(plaintext)
@@ -301,3 +317,33 @@ func (s *PasswordTestSuite) TestPasswordHashingConsistency() {
 	s.NoError(password1.Verify(plaintext))
 	s.NoError(password2.Verify(plaintext))
 }
+
+func BenchmarkValidatePassword(b *testing.B) {
+	defer SetCommonPasswordDenylist(nil)
+
+	large := make([]string, 1_000_000)
+	for i := range large {
+		large[i] = fmt.Sprintf("common-password-%d", i)
+	}
+	denylists := []struct {
+		name     string
+		denylist Denylist
+	}{
+		{"default", DefaultDenylist()},
+		{"entries=1000000", NewSetDenylist(large...)},
+	}
+
+	for _, tc := range denylists {
+		SetCommonPasswordDenylist(tc.denylist)
+		b.Run(
+			tc.name, func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					if err := ValidatePassword("MySecure123!@"); err != nil {
+						b.Fatal(err)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MinContextTokenLength is the shortest user-provided token checked by
+// ValidatePasswordWithContext; shorter tokens would reject too many passwords
+const MinContextTokenLength = 3
+
+var ErrPasswordContainsUserInfo = domain.NewLocalizedError(
+	"auth.password.contains_user_info", nil,
+	"password must not contain your username, email address or other personal information",
+)
+
+// leetReplacer folds common leetspeak substitutions and look-alike letters into one form
+var leetReplacer = strings.NewReplacer(
+	"0", "o", "1", "i", "l", "i", "!", "i", "|", "i", "3", "e", "4", "a", "@", "a",
+	"5", "s", "$", "s", "7", "t", "+", "t", "8", "b", "9", "g",
+)
+
+// ValidatePasswordWithContext validates a plaintext password like ValidatePassword and,
+// following NIST SP 800-63B, also rejects passwords containing user-provided context such
+// as the username, the email address (and its local part) or the person's name.
+// Comparison is case-insensitive and aware of leetspeak substitutions ("j0hn" matches "john").
+func ValidatePasswordWithContext(plaintext string, userInputs ...string) error {
+	if err := ValidatePassword(plaintext); err != nil {
+		return err
+	}
+
+	foldedPassword := foldForContextMatch(plaintext)
+	for _, token := range contextTokens(userInputs) {
+		if strings.Contains(foldedPassword, foldForContextMatch(token)) {
+			return ErrPasswordContainsUserInfo
+		}
+	}
+
+	return nil
+}
+
+// contextTokens splits user inputs into the tokens to look for in passwords.
+// Each input is checked whole and by its words (split on spaces and punctuation); for
+// emails, only the local part is split into words, since domain words such as "com" are not
+// personal. Tokens shorter than MinContextTokenLength are skipped.
+func contextTokens(userInputs []string) []string {
+	var tokens []string
+	add := func(token string) {
+		if len([]rune(token)) >= MinContextTokenLength {
+			tokens = append(tokens, token)
+		}
+	}
+
+	for _, input := range userInputs {
+		input = strings.TrimSpace(input)
+		add(input)
+
+		if localPart, _, isEmail := strings.Cut(input, "@"); isEmail {
+			input = localPart
+			add(input)
+		}
+
+		words := strings.FieldsFunc(
+			input, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			},
+		)
+		for _, word := range words {
+			add(word)
+		}
+	}
+
+	return tokens
+}
+
+// foldForContextMatch lowercases the value and folds leetspeak substitutions
+func foldForContextMatch(value string) string {
+	return leetReplacer.Replace(strings.ToLower(value))
+}
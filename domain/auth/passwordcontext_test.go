@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PasswordContextTestSuite struct {
+	suite.Suite
+}
+
+func TestPasswordContextSuite(t *testing.T) {
+	suite.Run(t, new(PasswordContextTestSuite))
+}
+
+func (s *PasswordContextTestSuite) TestItRejectsPasswordsContainingUserInfo() {
+	userInputs := []string{"jsmith", "john.smith@example.com", "John Smith", "Al"}
+
+	testCases := []struct {
+		name          string
+		password      string
+		expectedError error
+	}{
+		{"unrelated password", "Tr0ub4dor&Horse", nil},
+		{"contains username", "Xjsmith#2024", ErrPasswordContainsUserInfo},
+		{"contains username in other case", "Xy#JSmith2024", ErrPasswordContainsUserInfo},
+		{"contains leet username", "Xy#j5m1th2024", ErrPasswordContainsUserInfo},
+		{"contains email local part", "Q!john.smith9", ErrPasswordContainsUserInfo},
+		{"contains first name", "Johnny#Rocket9", ErrPasswordContainsUserInfo},
+		{"contains leet last name", "5M17H-rules#Q", ErrPasswordContainsUserInfo},
+		{"short inputs are ignored", "Always#Qu1et", nil},
+		{"email domain words are ignored", "Welcome#Example9", nil},
+		{"base rules still apply", "short", ErrPasswordTooShort},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				err := ValidatePasswordWithContext(tc.password, userInputs...)
+				if tc.expectedError == nil {
+					s.NoError(err)
+					return
+				}
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *PasswordContextTestSuite) TestItBehavesLikeValidatePasswordWithoutContext() {
+	s.NoError(ValidatePasswordWithContext("Tr0ub4dor&Horse"))
+	s.ErrorIs(ValidatePasswordWithContext("password"), ErrPasswordTooWeak)
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	// DefaultSessionIDEntropyBits is the number of random bits of a generated session ID
+	DefaultSessionIDEntropyBits = 128
+	// MinSessionIDEntropyBits is the minimum entropy accepted for session IDs, as recommended
+	// by OWASP
+	MinSessionIDEntropyBits = 64
+	// MaxSessionIDLength is the maximum length of an encoded session ID accepted by
+	// NewSessionID
+	MaxSessionIDLength = 256
+)
+
+var (
+	ErrEmptySessionID   = domain.NewError("session ID cannot be empty")
+	ErrInvalidSessionID = domain.NewError(
+		"session ID must be base64url encoded and at most %d characters long",
+		MaxSessionIDLength,
+	)
+	ErrSessionIDTooShort = domain.NewError(
+		"session ID must carry at least %d bits of entropy",
+		MinSessionIDEntropyBits,
+	)
+)
+
+// minSessionIDLength is the number of base64url characters carrying MinSessionIDEntropyBits
+var minSessionIDLength = base64.RawURLEncoding.EncodedLen(MinSessionIDEntropyBits / 8)
+
+// SessionID identifies a user session, usually in a cookie. It is a bearer secret: String and
+// LogValue only reveal its first characters, enough to correlate log lines.
+type SessionID struct {
+	value string
+}
+
+// GenerateSessionID creates a new random session ID of DefaultSessionIDEntropyBits bits
+func GenerateSessionID() (SessionID, error) {
+	return GenerateSessionIDWith(DefaultSessionIDEntropyBits)
+}
+
+// GenerateSessionIDWith creates a new random session ID with at least the given number of
+// bits of entropy, rounded up to whole bytes and encoded as unpadded base64url
+func GenerateSessionIDWith(entropyBits int) (SessionID, error) {
+	if entropyBits < MinSessionIDEntropyBits {
+		return SessionID{}, ErrSessionIDTooShort
+	}
+
+	raw := make([]byte, (entropyBits+7)/8)
+	if base64.RawURLEncoding.EncodedLen(len(raw)) > MaxSessionIDLength {
+		return SessionID{}, ErrInvalidSessionID
+	}
+	if _, err := rand.Read(raw); err != nil {
+		return SessionID{}, domain.NewInternalErrorWithWrap(err, "failed to generate session ID")
+	}
+
+	return SessionID{value: base64.RawURLEncoding.EncodeToString(raw)}, nil
+}
+
+// NewSessionID creates a SessionID from a value received from a client, such as a cookie,
+// with validation
+func NewSessionID(value string) (SessionID, error) {
+	value = strings.TrimSpace(value)
+	if err := IsValidSessionID(value); err != nil {
+		return SessionID{}, err
+	}
+
+	return SessionID{value: value}, nil
+}
+
+// ReconstituteSessionID creates a SessionID instance without validation
+func ReconstituteSessionID(value string) SessionID {
+	return SessionID{value: value}
+}
+
+// IsValidSessionID checks that the value looks like a session ID: base64url characters long
+// enough to carry MinSessionIDEntropyBits
+func IsValidSessionID(value string) error {
+	if value == "" {
+		return ErrEmptySessionID
+	}
+
+	if len(value) > MaxSessionIDLength {
+		return ErrInvalidSessionID
+	}
+
+	for i := 0; i < len(value); i++ {
+		if !isTokenChar(value[i]) {
+			return ErrInvalidSessionID
+		}
+	}
+
+	if len(value) < minSessionIDLength {
+		return ErrSessionIDTooShort
+	}
+
+	return nil
+}
+
+// Value returns the full session ID
+func (s SessionID) Value() string {
+	return s.value
+}
+
+// Equals compares two session IDs in constant time
+func (s SessionID) Equals(other SessionID) bool {
+	return subtle.ConstantTimeCompare([]byte(s.value), []byte(other.value)) == 1
+}
+
+// Hash returns the SHA-256 digest of the session ID, so session stores can key sessions
+// without keeping the IDs themselves
+func (s SessionID) Hash() HashedToken {
+	digest := sha256.Sum256([]byte(s.value))
+	return HashedToken{value: hex.EncodeToString(digest[:])}
+}
+
+// String returns a truncated representation of the session ID, e.g., "AbCd••••"
+func (s SessionID) String() string {
+	if len(s.value) <= tokenVisibleChars*2 {
+		return strings.Repeat(tokenMaskRune, len(s.value))
+	}
+	return s.value[:tokenVisibleChars] + strings.Repeat(tokenMaskRune, 4)
+}
+
+// LogValue implements slog.LogValuer so that loggers record the truncated session ID
+func (s SessionID) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+// Sensitive marks the session ID as a secret for domain.Sensitive
+func (s SessionID) Sensitive() {}
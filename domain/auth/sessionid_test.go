@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionIDTestSuite struct {
+	suite.Suite
+}
+
+func TestSessionIDSuite(t *testing.T) {
+	suite.Run(t, new(SessionIDTestSuite))
+}
+
+func (s *SessionIDTestSuite) TestItCanGenerateSessionIDs() {
+	testCases := []struct {
+		name           string
+		entropyBits    int
+		expectedLength int
+	}{
+		{"Default entropy", DefaultSessionIDEntropyBits, 22},
+		{"Minimum entropy", MinSessionIDEntropyBits, 11},
+		{"Entropy rounded up to bytes", 130, 23},
+		{"High entropy", 256, 43},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				sessionID, err := GenerateSessionIDWith(tc.entropyBits)
+				s.NoError(err)
+				s.Len(sessionID.Value(), tc.expectedLength)
+				s.NoError(IsValidSessionID(sessionID.Value()))
+
+				other, err := GenerateSessionIDWith(tc.entropyBits)
+				s.NoError(err)
+				s.False(sessionID.Equals(other))
+			},
+		)
+	}
+
+	sessionID, err := GenerateSessionID()
+	s.NoError(err)
+	s.Len(sessionID.Value(), 22)
+}
+
+func (s *SessionIDTestSuite) TestItFailsToGenerateSessionIDsWithInvalidEntropy() {
+	_, err := GenerateSessionIDWith(MinSessionIDEntropyBits - 1)
+	s.ErrorIs(err, ErrSessionIDTooShort)
+
+	_, err = GenerateSessionIDWith(MaxSessionIDLength * 8)
+	s.ErrorIs(err, ErrInvalidSessionID)
+}
+
+func (s *SessionIDTestSuite) TestItCanBuildSessionIDsFromClientValues() {
+	sessionID, err := NewSessionID("  3q2-7_8vZ9xAbCdEfGhIjK ")
+	s.NoError(err)
+	s.Equal("3q2-7_8vZ9xAbCdEfGhIjK", sessionID.Value())
+}
+
+func (s *SessionIDTestSuite) TestItFailsToBuildSessionIDsWithInvalidValues() {
+	testCases := []struct {
+		name     string
+		value    string
+		expected error
+	}{
+		{"Empty", "  ", ErrEmptySessionID},
+		{"Too short", "abcdefghij", ErrSessionIDTooShort},
+		{"Invalid characters", "abc+def/ghi=jkl", ErrInvalidSessionID},
+		{"Too long", strings.Repeat("a", MaxSessionIDLength+1), ErrInvalidSessionID},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewSessionID(tc.value)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *SessionIDTestSuite) TestItComparesSessionIDs() {
+	first := ReconstituteSessionID("3q2-7_8vZ9xAbCdEfGhIjK")
+	second := ReconstituteSessionID("3q2-7_8vZ9xAbCdEfGhIjK")
+	third := ReconstituteSessionID("3q2-7_8vZ9xAbCdEfGhIjL")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+	s.False(first.Equals(SessionID{}))
+}
+
+func (s *SessionIDTestSuite) TestItTruncatesTheSessionIDWhenLogged() {
+	sessionID := ReconstituteSessionID("3q2-7_8vZ9xAbCdEfGhIjK")
+
+	s.Equal("3q2-••••", sessionID.String())
+	s.Equal(slog.KindString, sessionID.LogValue().Kind())
+	s.Equal("3q2-••••", sessionID.LogValue().String())
+	s.Equal("••••", ReconstituteSessionID("abcd").String())
+	s.NotContains(sessionID.String(), "IjK")
+}
+
+func (s *SessionIDTestSuite) TestItHashesSessionIDs() {
+	sessionID, err := GenerateSessionID()
+	s.Require().NoError(err)
+
+	hash := sessionID.Hash()
+	s.Len(hash.Value(), 64)
+	s.True(hash.Equals(sessionID.Hash()))
+
+	other, err := GenerateSessionID()
+	s.Require().NoError(err)
+	s.False(hash.Equals(other.Hash()))
+}
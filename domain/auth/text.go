@@ -0,0 +1,19 @@
+package auth
+
+// Only the username decodes from text; secrets are deliberately kept out of CSV and form
+// decoding. Empty text is treated as a missing value.
+
+// UnmarshalText parses the username from text, with validation
+func (u *Username) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewUsername(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TextTestSuite struct {
+	suite.Suite
+}
+
+func TestTextSuite(t *testing.T) {
+	suite.Run(t, new(TextTestSuite))
+}
+
+func (s *TextTestSuite) TestItCanParseUsernamesFromText() {
+	var username Username
+	s.Require().NoError(username.UnmarshalText([]byte("jane.doe")))
+	s.Equal("jane.doe", username.Value())
+
+	s.Error(username.UnmarshalText([]byte("a")))
+	s.NoError(username.UnmarshalText(nil))
+	s.Equal("jane.doe", username.Value())
+}
+
+func (s *TextTestSuite) TestSecretsDoNotDecodeFromText() {
+	s.NotImplements((*encoding.TextUnmarshaler)(nil), new(Password))
+	s.NotImplements((*encoding.TextUnmarshaler)(nil), new(Token))
+}
@@ -0,0 +1,213 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	// DefaultTokenBytes is the number of random bytes used by GenerateToken
+	DefaultTokenBytes = 32
+	// MinTokenBytes is the minimum number of random bytes accepted by GenerateTokenWith
+	MinTokenBytes = 16
+	// MinTokenLength is the minimum length of an encoded token accepted by NewToken: the
+	// unpadded base64url length of MinTokenBytes, the shortest encoding GenerateTokenWith uses
+	MinTokenLength = 22
+	// MaxTokenLength is the maximum length of an encoded token accepted by NewToken
+	MaxTokenLength = 512
+	// tokenVisibleChars is the number of leading characters left visible by Token.String
+	tokenVisibleChars = 4
+	tokenMaskRune     = "•"
+)
+
+// TokenEncoding selects how the random bytes of a generated token are encoded
+type TokenEncoding int
+
+const (
+	// TokenEncodingBase64URL encodes tokens as unpadded base64url (RFC 4648 §5)
+	TokenEncodingBase64URL TokenEncoding = iota
+	// TokenEncodingHex encodes tokens as lowercase hexadecimal
+	TokenEncodingHex
+)
+
+var (
+	ErrEmptyToken        = domain.NewError("token cannot be empty")
+	ErrTokenTooLong      = domain.NewError("token cannot exceed %d characters", MaxTokenLength)
+	ErrInvalidTokenChars = domain.NewError(
+		"token contains invalid characters; only base64url or hex characters are allowed",
+	)
+	ErrTokenTooShort = domain.NewError(
+		"token must contain at least %d random bytes (%d encoded characters)",
+		MinTokenBytes,
+		MinTokenLength,
+	)
+	ErrInvalidTokenEncoding = domain.NewError("unknown token encoding")
+	ErrInvalidHashedToken   = domain.NewError("hashed token must be a hex encoded SHA-256 digest")
+)
+
+// Token represents an opaque secret such as a session, password-reset or email
+// verification token. Its String and LogValue never reveal the full secret.
+type Token struct {
+	value string
+}
+
+// GenerateToken creates a new random base64url token of DefaultTokenBytes bytes
+func GenerateToken() (Token, error) {
+	return GenerateTokenWith(DefaultTokenBytes, TokenEncodingBase64URL)
+}
+
+// GenerateTokenWith creates a new random token of the given byte length and encoding
+func GenerateTokenWith(byteLength int, encoding TokenEncoding) (Token, error) {
+	if byteLength < MinTokenBytes {
+		return Token{}, ErrTokenTooShort
+	}
+
+	raw := make([]byte, byteLength)
+	if _, err := rand.Read(raw); err != nil {
+		return Token{}, domain.NewInternalErrorWithWrap(err, "failed to generate token")
+	}
+
+	var value string
+	switch encoding {
+	case TokenEncodingBase64URL:
+		value = base64.RawURLEncoding.EncodeToString(raw)
+	case TokenEncodingHex:
+		value = hex.EncodeToString(raw)
+	default:
+		return Token{}, ErrInvalidTokenEncoding
+	}
+
+	if len(value) > MaxTokenLength {
+		return Token{}, ErrTokenTooLong
+	}
+
+	return Token{value: value}, nil
+}
+
+// NewToken creates a Token from a value received from a client, with validation
+func NewToken(value string) (Token, error) {
+	value = strings.TrimSpace(value)
+	if err := IsValidToken(value); err != nil {
+		return Token{}, err
+	}
+
+	return Token{value: value}, nil
+}
+
+// ReconstituteToken creates a Token instance without validation
+func ReconstituteToken(value string) Token {
+	return Token{value: value}
+}
+
+// IsValidToken checks that the value looks like an encoded token of at least MinTokenBytes
+// random bytes
+func IsValidToken(value string) error {
+	if value == "" {
+		return ErrEmptyToken
+	}
+
+	if len(value) > MaxTokenLength {
+		return ErrTokenTooLong
+	}
+
+	for i := 0; i < len(value); i++ {
+		if !isTokenChar(value[i]) {
+			return ErrInvalidTokenChars
+		}
+	}
+
+	if len(value) < MinTokenLength {
+		return ErrTokenTooShort
+	}
+
+	return nil
+}
+
+// isTokenChar reports whether c belongs to the base64url alphabet (a superset of hex)
+func isTokenChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9') || c == '-' || c == '_'
+}
+
+// Value returns the full secret token value
+func (t Token) Value() string {
+	return t.value
+}
+
+// Equals compares two tokens in constant time
+func (t Token) Equals(other Token) bool {
+	return subtle.ConstantTimeCompare([]byte(t.value), []byte(other.value)) == 1
+}
+
+// Hash returns the SHA-256 digest of the token, suitable for storing at rest
+func (t Token) Hash() HashedToken {
+	digest := sha256.Sum256([]byte(t.value))
+	return HashedToken{value: hex.EncodeToString(digest[:])}
+}
+
+// String returns a masked representation of the token, e.g., "AbCd••••"
+func (t Token) String() string {
+	if len(t.value) <= tokenVisibleChars*2 {
+		return strings.Repeat(tokenMaskRune, len(t.value))
+	}
+	return t.value[:tokenVisibleChars] + strings.Repeat(tokenMaskRune, 4)
+}
+
+// LogValue implements slog.LogValuer so that loggers record the masked token
+func (t Token) LogValue() slog.Value {
+	return slog.StringValue(t.String())
+}
+
+// Sensitive marks the token as a secret for domain.Sensitive
+func (t Token) Sensitive() {}
+
+// HashedToken is the at-rest form of a Token: a hex encoded SHA-256 digest
+type HashedToken struct {
+	value string
+}
+
+// NewHashedToken creates a HashedToken from a stored hex digest, with validation
+func NewHashedToken(value string) (HashedToken, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) != sha256.Size*2 {
+		return HashedToken{}, ErrInvalidHashedToken
+	}
+
+	if _, err := hex.DecodeString(value); err != nil {
+		return HashedToken{}, ErrInvalidHashedToken
+	}
+
+	return HashedToken{value: value}, nil
+}
+
+// ReconstituteHashedToken creates a HashedToken instance without validation
+func ReconstituteHashedToken(value string) HashedToken {
+	return HashedToken{value: value}
+}
+
+// Value returns the hex encoded digest
+func (h HashedToken) Value() string {
+	return h.value
+}
+
+// Matches reports, in constant time, whether the token hashes to this digest
+func (h HashedToken) Matches(token Token) bool {
+	return h.Equals(token.Hash())
+}
+
+// Equals compares two hashed tokens in constant time
+func (h HashedToken) Equals(other HashedToken) bool {
+	return subtle.ConstantTimeCompare([]byte(h.value), []byte(other.value)) == 1
+}
+
+// String returns the hex encoded digest
+func (h HashedToken) String() string {
+	return h.value
+}
@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenTestSuite struct {
+	suite.Suite
+}
+
+func TestTokenSuite(t *testing.T) {
+	suite.Run(t, new(TokenTestSuite))
+}
+
+func (s *TokenTestSuite) TestItCanGenerateTokens() {
+	testCases := []struct {
+		name           string
+		byteLength     int
+		encoding       TokenEncoding
+		expectedLength int
+	}{
+		{"Default base64url", DefaultTokenBytes, TokenEncodingBase64URL, 43},
+		{"Hex", DefaultTokenBytes, TokenEncodingHex, 64},
+		{"Minimum length", MinTokenBytes, TokenEncodingBase64URL, 22},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				token, err := GenerateTokenWith(tc.byteLength, tc.encoding)
+				s.NoError(err)
+				s.Len(token.Value(), tc.expectedLength)
+				s.NoError(IsValidToken(token.Value()))
+
+				other, err := GenerateTokenWith(tc.byteLength, tc.encoding)
+				s.NoError(err)
+				s.False(token.Equals(other))
+			},
+		)
+	}
+
+	token, err := GenerateToken()
+	s.NoError(err)
+	s.Len(token.Value(), 43)
+}
+
+func (s *TokenTestSuite) TestItFailsToGenerateTokensWithInvalidOptions() {
+	_, err := GenerateTokenWith(MinTokenBytes-1, TokenEncodingHex)
+	s.ErrorIs(err, ErrTokenTooShort)
+
+	_, err = GenerateTokenWith(DefaultTokenBytes, TokenEncoding(99))
+	s.ErrorIs(err, ErrInvalidTokenEncoding)
+
+	_, err = GenerateTokenWith(MaxTokenLength, TokenEncodingHex)
+	s.ErrorIs(err, ErrTokenTooLong)
+}
+
+func (s *TokenTestSuite) TestItCanBuildNewTokenFromValues() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Base64url token", "  dGhpcy1pc18tYS10b2tlbg  ", nil},
+		{"Hex token", "0123456789abcdef0123456789abcdef", nil},
+		{"Shortest token", strings.Repeat("a", MinTokenLength), nil},
+		{"Too short token", strings.Repeat("a", MinTokenLength-1), ErrTokenTooShort},
+		{"Empty token", "  ", ErrEmptyToken},
+		{"Padded base64", "dGVzdA==", ErrInvalidTokenChars},
+		{"Standard base64 characters", "ab+cd/ef", ErrInvalidTokenChars},
+		{"Too long token", strings.Repeat("a", MaxTokenLength+1), ErrTokenTooLong},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				token, err := NewToken(tc.input)
+				if tc.expectedError != nil {
+					s.ErrorIs(err, tc.expectedError)
+					return
+				}
+				s.NoError(err)
+				s.Equal(strings.TrimSpace(tc.input), token.Value())
+			},
+		)
+	}
+}
+
+func (s *TokenTestSuite) TestStringAndLogValueMaskTheSecret() {
+	token := ReconstituteToken("AbCdEfGhIjKlMnOp")
+	s.Equal("AbCd••••", token.String())
+	s.Equal("AbCd••••", token.LogValue().String())
+	s.Equal(slog.KindString, token.LogValue().Kind())
+
+	short := ReconstituteToken("abc")
+	s.Equal("•••", short.String())
+}
+
+func (s *TokenTestSuite) TestItCanHashTokensForStorage() {
+	token := ReconstituteToken("abc")
+	hashed := token.Hash()
+
+	s.Equal(
+		"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		hashed.Value(),
+	)
+	s.True(hashed.Matches(token))
+	s.False(hashed.Matches(ReconstituteToken("abd")))
+
+	stored, err := NewHashedToken(strings.ToUpper(hashed.Value()))
+	s.NoError(err)
+	s.True(stored.Equals(hashed))
+	s.Equal(hashed.Value(), stored.String())
+}
+
+func (s *TokenTestSuite) TestItFailsToBuildHashedTokenFromInvalidValues() {
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{"Empty", ""},
+		{"Too short", "abc"},
+		{"Not hex", strings.Repeat("z", 64)},
+		{"Too long", hex.EncodeToString(make([]byte, 33))},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewHashedToken(tc.input)
+				s.ErrorIs(err, ErrInvalidHashedToken)
+			},
+		)
+	}
+}
+
+func (s *TokenTestSuite) TestEquals() {
+	token1 := ReconstituteToken("same-token")
+	token2 := ReconstituteToken("same-token")
+	token3 := ReconstituteToken("other-token")
+
+	s.True(token1.Equals(token2))
+	s.False(token1.Equals(token3))
+}
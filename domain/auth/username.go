@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	MinUsernameLength = 3
+	MaxUsernameLength = 32
+)
+
+var (
+	ErrEmptyUsername = domain.NewLocalizedError(
+		"auth.username.empty", nil,
+		"username cannot be empty",
+	)
+	ErrTooShortUsername = domain.NewLocalizedError(
+		"auth.username.too_short", domain.MessageParams{"min": MinUsernameLength},
+		"username must be at least %d characters long",
+		MinUsernameLength,
+	)
+	ErrTooLongUsername = domain.NewLocalizedError(
+		"auth.username.too_long", domain.MessageParams{"max": MaxUsernameLength},
+		"username cannot exceed %d characters",
+		MaxUsernameLength,
+	)
+	ErrInvalidUsernameChars = domain.NewLocalizedError(
+		"auth.username.invalid_chars", nil,
+		"username may only contain letters, digits, '.', '_' and '-', "+
+			"and must start and end with a letter or digit",
+	)
+)
+
+// UsernameValidators holds extra rules run by NewUsername after its own validation
+var UsernameValidators domain.Validators[Username]
+
+// Username represents a login handle, stored in lowercase
+type Username struct {
+	value string
+}
+
+// NewUsername creates a new instance of Username with validation and normalization
+func NewUsername(value string) (Username, error) {
+	normalized, err := NormalizeUsername(value)
+	if err != nil {
+		return Username{}, err
+	}
+
+	username := Username{
+		value: normalized,
+	}
+	if err := UsernameValidators.Validate(username); err != nil {
+		return Username{}, err
+	}
+
+	return username, nil
+}
+
+// ReconstituteUsername creates a new Username instance without validation or normalization
+func ReconstituteUsername(value string) Username {
+	return Username{
+		value: value,
+	}
+}
+
+// Value returns the username value
+func (u Username) Value() string {
+	return u.value
+}
+
+// Equals compares two Username objects for equality
+func (u Username) Equals(other Username) bool {
+	return u.value == other.value
+}
+
+// String returns the username value
+func (u Username) String() string {
+	return u.value
+}
+
+// NormalizeUsername trims spaces and converts the username to lowercase
+func NormalizeUsername(username string) (string, error) {
+	username = strings.ToLower(strings.TrimSpace(username))
+
+	if err := IsValidUsername(username); err != nil {
+		return "", err
+	}
+
+	return username, nil
+}
+
+// IsValidUsername validates a username's length and characters
+func IsValidUsername(username string) error {
+	if username == "" {
+		return ErrEmptyUsername
+	}
+
+	if len(username) < MinUsernameLength {
+		return ErrTooShortUsername
+	}
+
+	if len(username) > MaxUsernameLength {
+		return ErrTooLongUsername
+	}
+
+	for i := 0; i < len(username); i++ {
+		c := username[i]
+		alphanumeric := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+		if alphanumeric {
+			continue
+		}
+
+		separator := c == '.' || c == '_' || c == '-'
+		if !separator || i == 0 || i == len(username)-1 {
+			return ErrInvalidUsernameChars
+		}
+	}
+
+	return nil
+}
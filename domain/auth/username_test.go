@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UsernameTestSuite struct {
+	suite.Suite
+}
+
+func TestUsernameSuite(t *testing.T) {
+	suite.Run(t, new(UsernameTestSuite))
+}
+
+func (s *UsernameTestSuite) TestItCanBuildNewUsernameWithValidValues() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Simple username", "alice", "alice"},
+		{"Uppercase gets lowercased", "  Alice.Smith  ", "alice.smith"},
+		{"Digits and separators", "bob_42-x", "bob_42-x"},
+		{"Minimum length", "abc", "abc"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				username, err := NewUsername(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, username.Value())
+				s.Equal(tc.expected, username.String())
+			},
+		)
+	}
+}
+
+func (s *UsernameTestSuite) TestItFailsToBuildNewUsernameFromInvalidValues() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Empty", "  ", ErrEmptyUsername},
+		{"Too short", "ab", ErrTooShortUsername},
+		{"Too long", strings.Repeat("a", MaxUsernameLength+1), ErrTooLongUsername},
+		{"Starts with separator", ".alice", ErrInvalidUsernameChars},
+		{"Ends with separator", "alice-", ErrInvalidUsernameChars},
+		{"Contains space", "alice smith", ErrInvalidUsernameChars},
+		{"Contains non-ASCII", "alicé", ErrInvalidUsernameChars},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewUsername(tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *UsernameTestSuite) TestEqualsAndReconstitute() {
+	username1, _ := NewUsername("Alice")
+	username2 := ReconstituteUsername("alice")
+	username3 := ReconstituteUsername("bob")
+
+	s.True(username1.Equals(username2))
+	s.False(username1.Equals(username3))
+}
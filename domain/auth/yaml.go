@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// Secrets (Token and JWT) are decoded from YAML in full but encoded masked, like their String
+// methods, so configuration dumps never leak them. Password and Credentials have no YAML
+// encoding. Zero values encode as null.
+
+// MarshalYAML encodes the username as a YAML string
+func (u Username) MarshalYAML() (any, error) {
+	if u.value == "" {
+		return nil, nil
+	}
+
+	return u.value, nil
+}
+
+// UnmarshalYAML decodes the username from a YAML string, with validation
+func (u *Username) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid username YAML")
+	}
+
+	parsed, err := NewUsername(raw)
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// MarshalYAML encodes the token as its masked string, so dumps never reveal it
+func (t Token) MarshalYAML() (any, error) {
+	if t.value == "" {
+		return nil, nil
+	}
+
+	return t.String(), nil
+}
+
+// UnmarshalYAML decodes the token from a YAML string, with validation
+func (t *Token) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid token YAML")
+	}
+
+	parsed, err := NewToken(raw)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// MarshalYAML encodes the token hash as a hex YAML string
+func (h HashedToken) MarshalYAML() (any, error) {
+	if h.value == "" {
+		return nil, nil
+	}
+
+	return h.value, nil
+}
+
+// UnmarshalYAML decodes the token hash from a YAML string, with validation
+func (h *HashedToken) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid token hash YAML")
+	}
+
+	parsed, err := NewHashedToken(raw)
+	if err != nil {
+		return err
+	}
+
+	*h = parsed
+	return nil
+}
+
+// MarshalYAML encodes the JWT as a YAML string with its signature
+// replaced by a placeholder
+func (j JWT) MarshalYAML() (any, error) {
+	if j.value == "" {
+		return nil, nil
+	}
+
+	return j.String(), nil
+}
+
+// UnmarshalYAML decodes the JWT from a YAML string, with validation
+func (j *JWT) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid JWT YAML")
+	}
+
+	parsed, err := NewJWT(raw)
+	if err != nil {
+		return err
+	}
+
+	*j = parsed
+	return nil
+}
+
+// expiringTokenYAML is the YAML representation of ExpiringToken
+type expiringTokenYAML struct {
+	Token     string    `yaml:"token"`
+	IssuedAt  time.Time `yaml:"issuedAt"`
+	ExpiresAt time.Time `yaml:"expiresAt"`
+}
+
+// MarshalYAML encodes the expiring token as a YAML mapping, including the secret token value
+// (like MarshalJSON)
+func (t ExpiringToken) MarshalYAML() (any, error) {
+	if t.token.value == "" {
+		return nil, nil
+	}
+
+	return expiringTokenYAML{
+		Token:     t.token.Value(),
+		IssuedAt:  t.issuedAt,
+		ExpiresAt: t.expiresAt,
+	}, nil
+}
+
+// UnmarshalYAML decodes the expiring token from a YAML mapping, with validation
+func (t *ExpiringToken) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw expiringTokenYAML
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid expiring token YAML")
+	}
+
+	token, err := NewToken(raw.Token)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewExpiringToken(token, raw.IssuedAt, raw.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
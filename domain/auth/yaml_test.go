@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLTestSuite struct {
+	suite.Suite
+}
+
+func TestYAMLSuite(t *testing.T) {
+	suite.Run(t, new(YAMLTestSuite))
+}
+
+type clientConfig struct {
+	Admin     Username      `yaml:"admin"`
+	APIToken  Token         `yaml:"apiToken"`
+	TokenHash HashedToken   `yaml:"tokenHash"`
+	Bearer    JWT           `yaml:"bearer"`
+	Invite    ExpiringToken `yaml:"invite"`
+}
+
+const sampleToken = "dGhpcyBpcyBhIHNhbXBsZSB0b2tlbg"
+
+func (s *YAMLTestSuite) TestItCanBindValueObjectsFromYAML() {
+	token, _ := NewToken(sampleToken)
+	document := "admin: Alice.Smith\n" +
+		"apiToken: " + sampleToken + "\n" +
+		"tokenHash: " + token.Hash().Value() + "\n" +
+		"bearer: " + sampleJWT + "\n" +
+		"invite:\n" +
+		"  token: " + sampleToken + "\n" +
+		"  issuedAt: 2024-05-01T10:00:00Z\n" +
+		"  expiresAt: 2024-05-02T10:00:00Z\n"
+
+	var config clientConfig
+	s.Require().NoError(yaml.Unmarshal([]byte(document), &config))
+
+	s.Equal("alice.smith", config.Admin.Value())
+	s.True(token.Equals(config.APIToken))
+	s.True(config.TokenHash.Matches(token))
+	s.Equal("HS256", config.Bearer.Algorithm())
+	s.True(token.Equals(config.Invite.Token()))
+	s.Equal(24*time.Hour, config.Invite.Lifetime())
+}
+
+func (s *YAMLTestSuite) TestItMasksSecretsWhenMarshalingYAML() {
+	token, _ := NewToken(sampleToken)
+	jwt, _ := NewJWT(sampleJWT)
+
+	encoded, err := yaml.Marshal(clientConfig{APIToken: token, Bearer: jwt})
+	s.Require().NoError(err)
+
+	s.NotContains(string(encoded), sampleToken)
+	s.Contains(string(encoded), "apiToken: "+token.String()+"\n")
+	s.Contains(string(encoded), "[PROTECTED]")
+}
+
+func (s *YAMLTestSuite) TestItCanRoundTripExpiringTokensThroughYAML() {
+	token, _ := NewToken(sampleToken)
+	issuedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	original, _ := NewExpiringToken(token, issuedAt, issuedAt.Add(time.Hour))
+
+	encoded, err := yaml.Marshal(original)
+	s.Require().NoError(err)
+
+	var decoded ExpiringToken
+	s.Require().NoError(yaml.Unmarshal(encoded, &decoded))
+	s.True(original.Equals(decoded))
+}
+
+func (s *YAMLTestSuite) TestItValidatesWhenUnmarshalingYAML() {
+	testCases := []struct {
+		name     string
+		document string
+		expected error
+	}{
+		{"Short username", "admin: al", ErrTooShortUsername},
+		{"Invalid token", "apiToken: not a token!", ErrInvalidTokenChars},
+		{"Invalid hash", "tokenHash: abc", ErrInvalidHashedToken},
+		{"Invalid JWT", "bearer: abc", ErrInvalidJWT},
+		{
+			"Expired before issued",
+			"invite: {token: " + sampleToken + ", issuedAt: 2024-05-02T10:00:00Z, " +
+				"expiresAt: 2024-05-01T10:00:00Z}",
+			ErrTokenExpiresBeforeIssued,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				var config clientConfig
+				s.ErrorIs(yaml.Unmarshal([]byte(tc.document), &config), tc.expected)
+			},
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "encoding/binary"
+
+// Value objects across the module implement encoding.BinaryMarshaler and
+// encoding.BinaryUnmarshaler for caches (e.g., Redis or memcached) and gob-based RPC. Zero
+// values encode as empty data, and empty data decodes to no change, mirroring how the JSON
+// decoders treat null.
+
+// binaryFieldsVersion prefixes multi-field binary encodings so the layout can evolve
+// without breaking values already stored in caches
+const binaryFieldsVersion byte = 1
+
+var ErrInvalidBinary = NewError("invalid binary encoding")
+
+// MarshalBinaryFields encodes string fields as a version byte followed by
+// uvarint length-prefixed fields. Value objects built from several parts use it to
+// implement encoding.BinaryMarshaler.
+func MarshalBinaryFields(fields ...string) []byte {
+	size := 1
+	for _, field := range fields {
+		size += binary.MaxVarintLen64 + len(field)
+	}
+
+	data := make([]byte, 0, size)
+	data = append(data, binaryFieldsVersion)
+	for _, field := range fields {
+		data = binary.AppendUvarint(data, uint64(len(field)))
+		data = append(data, field...)
+	}
+	return data
+}
+
+// UnmarshalBinaryFields decodes the fields encoded by MarshalBinaryFields
+func UnmarshalBinaryFields(data []byte) ([]string, error) {
+	if len(data) == 0 || data[0] != binaryFieldsVersion {
+		return nil, ErrInvalidBinary
+	}
+
+	var fields []string
+	rest := data[1:]
+	for len(rest) > 0 {
+		length, read := binary.Uvarint(rest)
+		if read <= 0 || length > uint64(len(rest)-read) {
+			return nil, ErrInvalidBinary
+		}
+		rest = rest[read:]
+		fields = append(fields, string(rest[:length]))
+		rest = rest[length:]
+	}
+
+	return fields, nil
+}
+
+// UnmarshalBinaryFieldsN decodes the fields encoded by MarshalBinaryFields and checks that
+// exactly count fields are present
+func UnmarshalBinaryFieldsN(data []byte, count int) ([]string, error) {
+	fields, err := UnmarshalBinaryFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) != count {
+		return nil, ErrInvalidBinary
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BinaryTestSuite struct {
+	suite.Suite
+}
+
+func TestBinarySuite(t *testing.T) {
+	suite.Run(t, new(BinaryTestSuite))
+}
+
+func (s *BinaryTestSuite) TestItCanRoundTripFields() {
+	testCases := []struct {
+		name   string
+		fields []string
+	}{
+		{"No fields", nil},
+		{"Single field", []string{"USD"}},
+		{"Empty fields", []string{"", "a", ""}},
+		{"Unicode fields", []string{"José", "Müller"}},
+		{"Long field", []string{string(make([]byte, 300))}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				fields, err := UnmarshalBinaryFields(MarshalBinaryFields(tc.fields...))
+				s.NoError(err)
+				s.Equal(tc.fields, fields)
+			},
+		)
+	}
+}
+
+func (s *BinaryTestSuite) TestItRejectsInvalidData() {
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{"Empty", nil},
+		{"Unknown version", []byte{2, 1, 'a'}},
+		{"Truncated field", []byte{1, 5, 'a'}},
+		{"Truncated length", []byte{1, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := UnmarshalBinaryFields(tc.data)
+				s.ErrorIs(err, ErrInvalidBinary)
+			},
+		)
+	}
+}
+
+func (s *BinaryTestSuite) TestItChecksTheFieldCount() {
+	data := MarshalBinaryFields("a", "b")
+
+	fields, err := UnmarshalBinaryFieldsN(data, 2)
+	s.NoError(err)
+	s.Equal([]string{"a", "b"}, fields)
+
+	_, err = UnmarshalBinaryFieldsN(data, 3)
+	s.ErrorIs(err, ErrInvalidBinary)
+}
@@ -0,0 +1,244 @@
+// Package cachecodec encodes value objects for caches such as Redis or memcached, where values
+// outlive the code that wrote them.
+//
+// A Codec wraps every value in a versioned Envelope naming its type and schema version, so a
+// reader can reject entries written for another type and upgrade entries written by older
+// releases instead of failing on them:
+//
+//	codec := cachecodec.NewCodec[finance.Money]("money", 2,
+//		cachecodec.WithUpgrade(1, moneyV1ToV2),
+//	)
+//	data, err := codec.Encode(price)
+//	err = rdb.Set(ctx, key, data, time.Hour).Err()
+//	...
+//	price, err := codec.Decode(cached)
+//
+// Values are encoded through their binary form (encoding.BinaryMarshaler) when they have one,
+// and through their text form otherwise, so every value object with a binary or text decoder
+// is supported. Every value is validated when decoded. Envelopes can also be encoded as
+// MessagePack, for stores and consumers that already speak it.
+package cachecodec
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// envelopeFormat prefixes binary envelopes so the envelope layout itself can evolve
+const envelopeFormat byte = 1
+
+var (
+	ErrInvalidEnvelope      = domain.NewError("invalid cache envelope")
+	ErrUnexpectedType       = domain.NewError("cache envelope holds another type")
+	ErrUnsupportedVersion   = domain.NewError("cache envelope version cannot be decoded")
+	ErrUnsupportedValueType = domain.NewError(
+		"value type has neither a binary nor a text decoder",
+	)
+)
+
+// Envelope is a cached payload tagged with the type and schema version that produced it
+type Envelope struct {
+	Type    string
+	Version uint64
+	Payload []byte
+}
+
+// MarshalBinary encodes the envelope as a format byte, the length-prefixed type, the version
+// as a uvarint and the payload
+func (e Envelope) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 1+2*binary.MaxVarintLen64+len(e.Type)+len(e.Payload))
+	data = append(data, envelopeFormat)
+	data = binary.AppendUvarint(data, uint64(len(e.Type)))
+	data = append(data, e.Type...)
+	data = binary.AppendUvarint(data, e.Version)
+	return append(data, e.Payload...), nil
+}
+
+// UnmarshalBinary decodes an envelope encoded by MarshalBinary
+func (e *Envelope) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != envelopeFormat {
+		return ErrInvalidEnvelope
+	}
+
+	rest := data[1:]
+	length, read := binary.Uvarint(rest)
+	if read <= 0 || length > uint64(len(rest)-read) {
+		return ErrInvalidEnvelope
+	}
+	rest = rest[read:]
+	typeName := string(rest[:length])
+	rest = rest[length:]
+
+	version, read := binary.Uvarint(rest)
+	if read <= 0 {
+		return ErrInvalidEnvelope
+	}
+
+	*e = Envelope{
+		Type:    typeName,
+		Version: version,
+		Payload: append([]byte(nil), rest[read:]...),
+	}
+	return nil
+}
+
+// Upgrade rewrites the payload of one schema version into the payload of the next one
+type Upgrade func(payload []byte) ([]byte, error)
+
+// CodecOption configures a Codec
+type CodecOption func(*codecOptions)
+
+type codecOptions struct {
+	upgrades map[uint64]Upgrade
+}
+
+// WithUpgrade registers how payloads of version from are turned into payloads of version
+// from+1. Upgrades are chained, so an entry several versions behind is upgraded step by step.
+func WithUpgrade(from uint64, upgrade Upgrade) CodecOption {
+	return func(o *codecOptions) {
+		o.upgrades[from] = upgrade
+	}
+}
+
+// Codec encodes values of type T into versioned envelopes and decodes them back, with
+// validation. It is safe for concurrent use.
+type Codec[T any] struct {
+	typeName string
+	version  uint64
+	upgrades map[uint64]Upgrade
+}
+
+// NewCodec creates a Codec writing envelopes tagged with typeName and version. It panics
+// when T has neither a binary nor a text decoder, as that is a programming error.
+func NewCodec[T any](typeName string, version uint64, opts ...CodecOption) *Codec[T] {
+	var zero T
+	switch any(&zero).(type) {
+	case encoding.BinaryUnmarshaler, encoding.TextUnmarshaler:
+	default:
+		panic(ErrUnsupportedValueType.WithField("type", fmt.Sprintf("%T", zero)))
+	}
+
+	config := codecOptions{
+		upgrades: make(map[uint64]Upgrade),
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return &Codec[T]{
+		typeName: typeName,
+		version:  version,
+		upgrades: config.upgrades,
+	}
+}
+
+// Type returns the type name written into envelopes
+func (c *Codec[T]) Type() string {
+	return c.typeName
+}
+
+// Version returns the schema version written into envelopes
+func (c *Codec[T]) Version() uint64 {
+	return c.version
+}
+
+// Seal wraps the value into an envelope of the current version
+func (c *Codec[T]) Seal(value T) (Envelope, error) {
+	payload, err := Marshal(value)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		Type:    c.typeName,
+		Version: c.version,
+		Payload: payload,
+	}, nil
+}
+
+// Open checks the envelope type, upgrades older payloads to the current version and decodes
+// the value, with validation
+func (c *Codec[T]) Open(envelope Envelope) (T, error) {
+	var zero T
+	if envelope.Type != c.typeName {
+		return zero, ErrUnexpectedType.WithField("type", envelope.Type)
+	}
+
+	if envelope.Version > c.version {
+		return zero, ErrUnsupportedVersion.WithField("version", envelope.Version)
+	}
+
+	payload := envelope.Payload
+	for version := envelope.Version; version < c.version; version++ {
+		upgrade, found := c.upgrades[version]
+		if !found {
+			return zero, ErrUnsupportedVersion.WithField("version", envelope.Version)
+		}
+
+		upgraded, err := upgrade(payload)
+		if err != nil {
+			return zero, err
+		}
+		payload = upgraded
+	}
+
+	return Unmarshal[T](payload)
+}
+
+// Encode seals the value and encodes the envelope in binary form
+func (c *Codec[T]) Encode(value T) ([]byte, error) {
+	envelope, err := c.Seal(value)
+	if err != nil {
+		return nil, err
+	}
+	return envelope.MarshalBinary()
+}
+
+// Decode decodes a binary envelope written by Encode and opens it
+func (c *Codec[T]) Decode(data []byte) (T, error) {
+	var envelope Envelope
+	if err := envelope.UnmarshalBinary(data); err != nil {
+		var zero T
+		return zero, err
+	}
+	return c.Open(envelope)
+}
+
+// Marshal encodes a value without an envelope, through its binary form when it has one and
+// through its text form otherwise
+func Marshal[T any](value T) ([]byte, error) {
+	switch marshaler := any(value).(type) {
+	case encoding.BinaryMarshaler:
+		return marshaler.MarshalBinary()
+	case encoding.TextMarshaler:
+		return marshaler.MarshalText()
+	case fmt.Stringer:
+		return []byte(marshaler.String()), nil
+	default:
+		return nil, ErrUnsupportedValueType.WithField("type", fmt.Sprintf("%T", value))
+	}
+}
+
+// Unmarshal decodes a value encoded by Marshal, with validation. Empty data decodes to the
+// zero value.
+func Unmarshal[T any](data []byte) (T, error) {
+	var value T
+	var err error
+	switch unmarshaler := any(&value).(type) {
+	case encoding.BinaryUnmarshaler:
+		err = unmarshaler.UnmarshalBinary(data)
+	case encoding.TextUnmarshaler:
+		err = unmarshaler.UnmarshalText(data)
+	default:
+		err = ErrUnsupportedValueType.WithField("type", fmt.Sprintf("%T", value))
+	}
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return value, nil
+}
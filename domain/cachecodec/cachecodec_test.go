@@ -0,0 +1,162 @@
+package cachecodec
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/file"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type CacheCodecTestSuite struct {
+	suite.Suite
+}
+
+func TestCacheCodecSuite(t *testing.T) {
+	suite.Run(t, new(CacheCodecTestSuite))
+}
+
+func (s *CacheCodecTestSuite) TestItCanRoundTripValuesWithABinaryForm() {
+	price, err := finance.NewMoneyFromString("19.99", "EUR")
+	s.Require().NoError(err)
+
+	codec := NewCodec[finance.Money]("money", 1)
+	data, err := codec.Encode(price)
+	s.Require().NoError(err)
+
+	decoded, err := codec.Decode(data)
+	s.Require().NoError(err)
+	s.True(price.Equals(decoded))
+}
+
+func (s *CacheCodecTestSuite) TestItCanRoundTripValuesWithATextFormOnly() {
+	size := file.NewFileSize(1536)
+	sizeCodec := NewCodec[file.FileSize]("file-size", 1)
+	data, err := sizeCodec.Encode(size)
+	s.Require().NoError(err)
+	decodedSize, err := sizeCodec.Decode(data)
+	s.Require().NoError(err)
+	s.True(size.Equals(decodedSize))
+
+	etag, err := web.NewWeakETag("v1")
+	s.Require().NoError(err)
+	etagCodec := NewCodec[web.ETag]("etag", 1)
+	data, err = etagCodec.Encode(etag)
+	s.Require().NoError(err)
+	decodedETag, err := etagCodec.Decode(data)
+	s.Require().NoError(err)
+	s.True(etag.Equals(decodedETag))
+}
+
+func (s *CacheCodecTestSuite) TestItValidatesWhenDecoding() {
+	codec := NewCodec[geography.CountryCode]("country", 1)
+	data, err := Envelope{Type: "country", Version: 1, Payload: []byte("XYZ")}.MarshalBinary()
+	s.Require().NoError(err)
+
+	_, err = codec.Decode(data)
+	s.ErrorIs(err, geography.ErrInvalidCountryCode)
+}
+
+func (s *CacheCodecTestSuite) TestItRejectsEnvelopesOfAnotherType() {
+	ulid, err := identifier.NewULID()
+	s.Require().NoError(err)
+	data, err := NewCodec[identifier.ULID]("ulid", 1).Encode(ulid)
+	s.Require().NoError(err)
+
+	_, err = NewCodec[identifier.ULID]("order-id", 1).Decode(data)
+	s.ErrorIs(err, ErrUnexpectedType)
+
+	var domainErr *domain.Error
+	s.Require().True(errors.As(err, &domainErr))
+	s.Equal("ulid", domainErr.Fields()["type"])
+}
+
+func (s *CacheCodecTestSuite) TestItUpgradesOlderPayloadsStepByStep() {
+	lower := func(payload []byte) ([]byte, error) {
+		return []byte(strings.ToLower(string(payload))), nil
+	}
+	trim := func(payload []byte) ([]byte, error) {
+		return []byte(strings.TrimPrefix(string(payload), "mailto:")), nil
+	}
+	codec := NewCodec[web.Email]("email", 3, WithUpgrade(1, trim), WithUpgrade(2, lower))
+
+	decoded, err := codec.Open(
+		Envelope{Type: "email", Version: 1, Payload: []byte("mailto:Jane@Example.com")},
+	)
+	s.Require().NoError(err)
+	s.Equal("jane@example.com", decoded.Value())
+
+	decoded, err = codec.Open(
+		Envelope{Type: "email", Version: 2, Payload: []byte("Jane@Example.com")},
+	)
+	s.Require().NoError(err)
+	s.Equal("jane@example.com", decoded.Value())
+}
+
+func (s *CacheCodecTestSuite) TestItFailsOnVersionsItCannotDecode() {
+	codec := NewCodec[web.Email]("email", 2)
+
+	_, err := codec.Open(Envelope{Type: "email", Version: 1, Payload: []byte("a@b.co")})
+	s.ErrorIs(err, ErrUnsupportedVersion)
+
+	_, err = codec.Open(Envelope{Type: "email", Version: 3, Payload: []byte("a@b.co")})
+	s.ErrorIs(err, ErrUnsupportedVersion)
+}
+
+func (s *CacheCodecTestSuite) TestItPropagatesUpgradeErrors() {
+	failure := errors.New("cannot upgrade")
+	codec := NewCodec[web.Email]("email", 2, WithUpgrade(1, func([]byte) ([]byte, error) {
+		return nil, failure
+	}))
+
+	_, err := codec.Open(Envelope{Type: "email", Version: 1, Payload: []byte("a@b.co")})
+	s.ErrorIs(err, failure)
+}
+
+func (s *CacheCodecTestSuite) TestItRejectsMalformedBinaryEnvelopes() {
+	codec := NewCodec[web.Email]("email", 1)
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"unknown format", []byte{9, 5, 'e'}},
+		{"truncated type", []byte{envelopeFormat, 5, 'e'}},
+		{"missing version", []byte{envelopeFormat, 1, 'e'}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := codec.Decode(tc.data)
+			s.ErrorIs(err, ErrInvalidEnvelope)
+		})
+	}
+}
+
+func (s *CacheCodecTestSuite) TestItDecodesEmptyPayloadsToTheZeroValue() {
+	codec := NewCodec[web.Email]("email", 1)
+	data, err := codec.Encode(web.Email{})
+	s.Require().NoError(err)
+
+	decoded, err := codec.Decode(data)
+	s.Require().NoError(err)
+	s.Empty(decoded.Value())
+}
+
+func (s *CacheCodecTestSuite) TestItPanicsForTypesWithoutADecoder() {
+	s.Panics(func() {
+		NewCodec[struct{ Name string }]("anonymous", 1)
+	})
+}
+
+func (s *CacheCodecTestSuite) TestItExposesTheTypeAndVersion() {
+	codec := NewCodec[web.Email]("email", 4)
+	s.Equal("email", codec.Type())
+	s.Equal(uint64(4), codec.Version())
+}
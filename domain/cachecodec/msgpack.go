@@ -0,0 +1,217 @@
+package cachecodec
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// MessagePack format bytes used by envelopes (https://github.com/msgpack/msgpack)
+const (
+	msgpackFixArray3  byte = 0x93
+	msgpackFixStr     byte = 0xa0
+	msgpackFixStrMax  byte = 0xbf
+	msgpackBin8       byte = 0xc4
+	msgpackBin16      byte = 0xc5
+	msgpackBin32      byte = 0xc6
+	msgpackUint8      byte = 0xcc
+	msgpackUint16     byte = 0xcd
+	msgpackUint32     byte = 0xce
+	msgpackUint64     byte = 0xcf
+	msgpackStr8       byte = 0xd9
+	msgpackStr16      byte = 0xda
+	msgpackStr32      byte = 0xdb
+	msgpackFixIntMax  byte = 0x7f
+	msgpackFixStrMask byte = 0x1f
+)
+
+// Sizes of the big-endian length or value following each MessagePack format byte
+var (
+	msgpackStringSizes = map[byte]int{msgpackStr8: 1, msgpackStr16: 2, msgpackStr32: 4}
+	msgpackBinarySizes = map[byte]int{msgpackBin8: 1, msgpackBin16: 2, msgpackBin32: 4}
+	msgpackUintSizes   = map[byte]int{
+		msgpackUint8: 1, msgpackUint16: 2, msgpackUint32: 4, msgpackUint64: 8,
+	}
+)
+
+// MarshalMsgpack encodes the envelope as a MessagePack array of the type (str), the version
+// (uint) and the payload (bin). The method matches the Marshaler interface of the common Go
+// MessagePack libraries, so envelopes can be embedded in larger MessagePack documents.
+func (e Envelope) MarshalMsgpack() ([]byte, error) {
+	data := make([]byte, 0, 1+3*9+len(e.Type)+len(e.Payload))
+	data = append(data, msgpackFixArray3)
+	data = appendMsgpackString(data, e.Type)
+	data = appendMsgpackUint(data, e.Version)
+	return appendMsgpackBinary(data, e.Payload), nil
+}
+
+// UnmarshalMsgpack decodes an envelope encoded by MarshalMsgpack
+func (e *Envelope) UnmarshalMsgpack(data []byte) error {
+	if len(data) == 0 || data[0] != msgpackFixArray3 {
+		return ErrInvalidEnvelope
+	}
+
+	typeName, rest, ok := readMsgpackString(data[1:])
+	if !ok {
+		return ErrInvalidEnvelope
+	}
+
+	version, rest, ok := readMsgpackUint(rest)
+	if !ok {
+		return ErrInvalidEnvelope
+	}
+
+	payload, rest, ok := readMsgpackBinary(rest)
+	if !ok || len(rest) != 0 {
+		return ErrInvalidEnvelope
+	}
+
+	*e = Envelope{
+		Type:    typeName,
+		Version: version,
+		Payload: append([]byte(nil), payload...),
+	}
+	return nil
+}
+
+// EncodeMsgpack seals the value and encodes the envelope as MessagePack
+func (c *Codec[T]) EncodeMsgpack(value T) ([]byte, error) {
+	envelope, err := c.Seal(value)
+	if err != nil {
+		return nil, err
+	}
+	return envelope.MarshalMsgpack()
+}
+
+// DecodeMsgpack decodes a MessagePack envelope written by EncodeMsgpack and opens it
+func (c *Codec[T]) DecodeMsgpack(data []byte) (T, error) {
+	var envelope Envelope
+	if err := envelope.UnmarshalMsgpack(data); err != nil {
+		var zero T
+		return zero, err
+	}
+	return c.Open(envelope)
+}
+
+func appendMsgpackString(data []byte, value string) []byte {
+	length := len(value)
+	switch {
+	case length <= int(msgpackFixStrMask):
+		data = append(data, msgpackFixStr|byte(length))
+	case length <= math.MaxUint8:
+		data = append(data, msgpackStr8, byte(length))
+	case length <= math.MaxUint16:
+		data = binary.BigEndian.AppendUint16(append(data, msgpackStr16), uint16(length))
+	default:
+		data = binary.BigEndian.AppendUint32(append(data, msgpackStr32), uint32(length))
+	}
+	return append(data, value...)
+}
+
+func appendMsgpackBinary(data []byte, value []byte) []byte {
+	length := len(value)
+	switch {
+	case length <= math.MaxUint8:
+		data = append(data, msgpackBin8, byte(length))
+	case length <= math.MaxUint16:
+		data = binary.BigEndian.AppendUint16(append(data, msgpackBin16), uint16(length))
+	default:
+		data = binary.BigEndian.AppendUint32(append(data, msgpackBin32), uint32(length))
+	}
+	return append(data, value...)
+}
+
+func appendMsgpackUint(data []byte, value uint64) []byte {
+	switch {
+	case value <= uint64(msgpackFixIntMax):
+		return append(data, byte(value))
+	case value <= math.MaxUint8:
+		return append(data, msgpackUint8, byte(value))
+	case value <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(data, msgpackUint16), uint16(value))
+	case value <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(data, msgpackUint32), uint32(value))
+	default:
+		return binary.BigEndian.AppendUint64(append(data, msgpackUint64), value)
+	}
+}
+
+// readMsgpackLength reads a big-endian length of size bytes
+func readMsgpackLength(data []byte, size int) (int, []byte, bool) {
+	if len(data) < size {
+		return 0, nil, false
+	}
+
+	var length uint64
+	for _, b := range data[:size] {
+		length = length<<8 | uint64(b)
+	}
+	if length > uint64(len(data)-size) {
+		return 0, nil, false
+	}
+	return int(length), data[size:], true
+}
+
+func readMsgpackString(data []byte) (string, []byte, bool) {
+	if len(data) == 0 {
+		return "", nil, false
+	}
+
+	format := data[0]
+	if format >= msgpackFixStr && format <= msgpackFixStrMax {
+		length := int(format & msgpackFixStrMask)
+		if length > len(data)-1 {
+			return "", nil, false
+		}
+		return string(data[1 : 1+length]), data[1+length:], true
+	}
+
+	size, found := msgpackStringSizes[format]
+	if !found {
+		return "", nil, false
+	}
+
+	length, rest, ok := readMsgpackLength(data[1:], size)
+	if !ok {
+		return "", nil, false
+	}
+	return string(rest[:length]), rest[length:], true
+}
+
+func readMsgpackBinary(data []byte) ([]byte, []byte, bool) {
+	if len(data) == 0 {
+		return nil, nil, false
+	}
+
+	size, found := msgpackBinarySizes[data[0]]
+	if !found {
+		return nil, nil, false
+	}
+
+	length, rest, ok := readMsgpackLength(data[1:], size)
+	if !ok {
+		return nil, nil, false
+	}
+	return rest[:length], rest[length:], true
+}
+
+func readMsgpackUint(data []byte) (uint64, []byte, bool) {
+	if len(data) == 0 {
+		return 0, nil, false
+	}
+
+	format := data[0]
+	if format <= msgpackFixIntMax {
+		return uint64(format), data[1:], true
+	}
+
+	size, found := msgpackUintSizes[format]
+	if !found || len(data) < 1+size {
+		return 0, nil, false
+	}
+
+	var value uint64
+	for _, b := range data[1 : 1+size] {
+		value = value<<8 | uint64(b)
+	}
+	return value, data[1+size:], true
+}
@@ -0,0 +1,91 @@
+package cachecodec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgpackTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgpackSuite(t *testing.T) {
+	suite.Run(t, new(MsgpackTestSuite))
+}
+
+func (s *MsgpackTestSuite) TestItCanRoundTripValuesThroughMsgpack() {
+	price, err := finance.NewMoneyFromString("250.00", "RON")
+	s.Require().NoError(err)
+
+	codec := NewCodec[finance.Money]("money", 1)
+	data, err := codec.EncodeMsgpack(price)
+	s.Require().NoError(err)
+
+	decoded, err := codec.DecodeMsgpack(data)
+	s.Require().NoError(err)
+	s.True(price.Equals(decoded))
+}
+
+func (s *MsgpackTestSuite) TestItEncodesEnvelopesAsStandardMsgpack() {
+	data, err := Envelope{Type: "cc", Version: 1, Payload: []byte("RO")}.MarshalMsgpack()
+	s.Require().NoError(err)
+	s.Equal([]byte{0x93, 0xa2, 'c', 'c', 0x01, 0xc4, 0x02, 'R', 'O'}, data)
+}
+
+func (s *MsgpackTestSuite) TestItCanRoundTripEnvelopesOfEverySize() {
+	testCases := []struct {
+		name     string
+		typeName string
+		version  uint64
+		payload  int
+	}{
+		{"fixed sizes", "money", 7, 10},
+		{"8-bit sizes", strings.Repeat("t", 40), 200, 200},
+		{"16-bit sizes", strings.Repeat("t", 300), 60000, 70000},
+		{"32-bit version", "money", 1 << 20, 0},
+		{"64-bit version", "money", 1 << 40, 1},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			envelope := Envelope{
+				Type:    tc.typeName,
+				Version: tc.version,
+				Payload: []byte(strings.Repeat("p", tc.payload)),
+			}
+			data, err := envelope.MarshalMsgpack()
+			s.Require().NoError(err)
+
+			var decoded Envelope
+			s.Require().NoError(decoded.UnmarshalMsgpack(data))
+			s.Equal(envelope.Type, decoded.Type)
+			s.Equal(envelope.Version, decoded.Version)
+			s.Equal(len(envelope.Payload), len(decoded.Payload))
+		})
+	}
+}
+
+func (s *MsgpackTestSuite) TestItRejectsMalformedMsgpackEnvelopes() {
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"not an array", []byte{0xa2, 'c', 'c'}},
+		{"truncated type", []byte{0x93, 0xa5, 'c'}},
+		{"negative version", []byte{0x93, 0xa1, 'c', 0xff, 0xc4, 0x00}},
+		{"string payload", []byte{0x93, 0xa1, 'c', 0x01, 0xa1, 'p'}},
+		{"truncated payload", []byte{0x93, 0xa1, 'c', 0x01, 0xc4, 0x05, 'p'}},
+		{"trailing bytes", []byte{0x93, 0xa1, 'c', 0x01, 0xc4, 0x00, 0x00}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var envelope Envelope
+			s.ErrorIs(envelope.UnmarshalMsgpack(tc.data), ErrInvalidEnvelope)
+		})
+	}
+}
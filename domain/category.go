@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Category classifies an error by intent, so infrastructure layers (HTTP handlers, gRPC
+// interceptors, retry policies) can react to it without matching individual errors
+type Category string
+
+const (
+	// CategoryValidation marks input that breaks a value object's rules
+	CategoryValidation Category = "validation"
+	// CategoryInvariant marks an operation that would break a business rule of an aggregate,
+	// even though each input is valid on its own
+	CategoryInvariant Category = "invariant"
+	// CategoryNotFound marks a missing entity or resource
+	CategoryNotFound Category = "not_found"
+	// CategoryConflict marks an operation that clashes with the current state, such as a
+	// duplicate or a stale version
+	CategoryConflict Category = "conflict"
+	// CategoryForbidden marks an operation the caller is not allowed to perform
+	CategoryForbidden Category = "forbidden"
+	// CategoryInternal marks a failure the caller cannot fix
+	CategoryInternal Category = "internal"
+)
+
+// categoryStatuses maps each category to the HTTP status reported by HTTPStatus
+var categoryStatuses = map[Category]int{
+	CategoryValidation: http.StatusBadRequest,
+	CategoryInvariant:  http.StatusUnprocessableEntity,
+	CategoryNotFound:   http.StatusNotFound,
+	CategoryConflict:   http.StatusConflict,
+	CategoryForbidden:  http.StatusForbidden,
+	CategoryInternal:   http.StatusInternalServerError,
+}
+
+// categorized is implemented by errors that carry a category
+type categorized interface {
+	error
+	Category() Category
+}
+
+// categorizedError tags an error with a category
+type categorizedError struct {
+	err      error
+	category Category
+}
+
+// Categorize tags err with a category, keeping it matchable with errors.Is and errors.As. It
+// returns nil when err is nil.
+func Categorize(err error, category Category) error {
+	if err == nil {
+		return nil
+	}
+	return &categorizedError{
+		err:      err,
+		category: category,
+	}
+}
+
+func (e *categorizedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *categorizedError) Unwrap() error {
+	return e.err
+}
+
+// Category returns the category the error was tagged with
+func (e *categorizedError) Category() Category {
+	return e.category
+}
+
+// CategoryOf returns the category of the outermost error in the chain that has one, whether
+// it was created with a categorized constructor or tagged with Categorize. Untagged domain
+// errors report invalid input and are CategoryValidation; any other error is
+// CategoryInternal. Infrastructure failures wrapped in a domain error must therefore be
+// created with NewInternalErrorWithWrap, or they are reported as invalid input. It returns an
+// empty Category for a nil error.
+func CategoryOf(err error) Category {
+	if err == nil {
+		return ""
+	}
+
+	if category := findCategory(err); category != "" {
+		return category
+	}
+
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return CategoryValidation
+	}
+	return CategoryInternal
+}
+
+// findCategory returns the first category found walking the error tree depth-first, so
+// outer errors win over the causes they wrap
+func findCategory(err error) Category {
+	if tagged, ok := err.(categorized); ok && tagged.Category() != "" {
+		return tagged.Category()
+	}
+
+	switch wrapper := err.(type) {
+	case interface{ Unwrap() error }:
+		if cause := wrapper.Unwrap(); cause != nil {
+			return findCategory(cause)
+		}
+	case interface{ Unwrap() []error }:
+		for _, cause := range wrapper.Unwrap() {
+			if category := findCategory(cause); category != "" {
+				return category
+			}
+		}
+	}
+	return ""
+}
+
+// HTTPStatus returns the HTTP status code for the category of err: 400 for validation, 422
+// for invariant, 403 for forbidden, 404 for not-found, 409 for conflict and 500 for internal
+// errors. It returns 200 for a nil error, so handlers can call it unconditionally.
+func HTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	if status, ok := categoryStatuses[CategoryOf(err)]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
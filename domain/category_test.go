@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CategoryTestSuite struct {
+	suite.Suite
+}
+
+func TestCategorySuite(t *testing.T) {
+	suite.Run(t, new(CategoryTestSuite))
+}
+
+var errOrderNotFound = NewError("order not found")
+
+func (s *CategoryTestSuite) TestCategorizeKeepsTheErrorMatchable() {
+	err := Categorize(errOrderNotFound, CategoryNotFound)
+
+	s.EqualError(err, "order not found")
+	s.ErrorIs(err, errOrderNotFound)
+
+	var domainErr *Error
+	s.ErrorAs(err, &domainErr)
+	s.NoError(Categorize(nil, CategoryConflict))
+}
+
+func (s *CategoryTestSuite) TestCategoryOf() {
+	notFound := Categorize(errOrderNotFound, CategoryNotFound)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected Category
+	}{
+		{"nil error", nil, ""},
+		{"tagged error", notFound, CategoryNotFound},
+		{"wrapped tagged error", fmt.Errorf("loading: %w", notFound), CategoryNotFound},
+		{"outermost tag wins", Categorize(notFound, CategoryConflict), CategoryConflict},
+		{"empty tag is skipped", Categorize(notFound, ""), CategoryNotFound},
+		{"untagged domain error", NewError("invalid input"), CategoryValidation},
+		{"categorized domain error", NewConflictError("duplicate"), CategoryConflict},
+		{
+			"untagged wrap of categorized error",
+			NewErrorWithWrap(NewNotFoundError("order"), "loading"),
+			CategoryNotFound,
+		},
+		{"wrapped domain error", fmt.Errorf("field: %w", errInvalidCode), CategoryValidation},
+		{
+			"internal wrap of foreign error",
+			NewInternalErrorWithWrap(errors.New("connection reset"), "lookup failed"),
+			CategoryInternal,
+		},
+		{"internal domain error", ErrUnsupportedOptionalScan, CategoryInternal},
+		{"foreign error", errors.New("connection reset"), CategoryInternal},
+		{
+			"tagged foreign error",
+			Categorize(errors.New("duplicate key"), CategoryConflict),
+			CategoryConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, CategoryOf(tt.err))
+		})
+	}
+}
+
+func (s *CategoryTestSuite) TestHTTPStatus() {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"nil error", nil, http.StatusOK},
+		{"validation", NewError("invalid input"), http.StatusBadRequest},
+		{"not found", Categorize(errOrderNotFound, CategoryNotFound), http.StatusNotFound},
+		{"conflict", Categorize(errors.New("stale"), CategoryConflict), http.StatusConflict},
+		{"invariant", NewInvariantError("already shipped"), http.StatusUnprocessableEntity},
+		{"forbidden", NewForbiddenError("not the owner"), http.StatusForbidden},
+		{"internal", errors.New("disk full"), http.StatusInternalServerError},
+		{
+			"unknown category",
+			Categorize(errors.New("rate limited"), Category("throttled")),
+			http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, HTTPStatus(tt.err))
+		})
+	}
+}
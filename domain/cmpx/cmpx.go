@@ -0,0 +1,62 @@
+// Package cmpx provides go-cmp options for the value objects.
+//
+// Value objects keep their state in unexported fields, so cmp.Diff panics on them unless it
+// is told how to compare them. EquateValueObjects compares every type with an Equals method
+// through that method, wherever it appears in the compared values:
+//
+//	if diff := cmp.Diff(want, got, cmpx.EquateValueObjects()); diff != "" {
+//		t.Errorf("order mismatch (-want +got):\n%s", diff)
+//	}
+package cmpx
+
+import (
+	"reflect"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/google/go-cmp/cmp"
+)
+
+var boolType = reflect.TypeOf(true)
+
+// EquateValueObjects returns options comparing values of any type that has an
+// "(T) Equals(T) bool" method with that method, as cmp does on its own for Equal methods. It
+// covers every value object as well as domain.Optional and domain.Set.
+func EquateValueObjects() cmp.Option {
+	return cmp.FilterValues(
+		func(x, y any) bool {
+			return x != nil && y != nil && reflect.TypeOf(x) == reflect.TypeOf(y) &&
+				hasEqualsMethod(reflect.TypeOf(x))
+		},
+		cmp.Comparer(
+			func(x, y any) bool {
+				result := reflect.ValueOf(x).MethodByName("Equals").Call(
+					[]reflect.Value{reflect.ValueOf(y)},
+				)
+				return result[0].Bool()
+			},
+		),
+	)
+}
+
+// Equate returns an option comparing values of type T with their Equals method. Unlike
+// EquateValueObjects, it only applies to T.
+func Equate[T domain.Equatable[T]]() cmp.Option {
+	return cmp.Comparer(
+		func(x, y T) bool {
+			return x.Equals(y)
+		},
+	)
+}
+
+// hasEqualsMethod reports whether t has an "Equals(t) bool" method
+func hasEqualsMethod(t reflect.Type) bool {
+	method, ok := t.MethodByName("Equals")
+	if !ok {
+		return false
+	}
+
+	// Method types obtained from a reflect.Type include the receiver as first input
+	signature := method.Type
+	return signature.NumIn() == 2 && signature.In(1) == t &&
+		signature.NumOut() == 1 && signature.Out(0) == boolType
+}
@@ -0,0 +1,102 @@
+package cmpx
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/suite"
+)
+
+type customer struct {
+	ID        identifier.IntIdentifier
+	Name      person.FullName
+	Email     web.Email
+	Countries domain.Set[geography.CountryCode]
+	Backup    domain.Optional[web.Email]
+	Balances  map[string]finance.Money
+	Tags      []string
+}
+
+// loose has an Equals method comparing against another type, so it is not a value object
+type loose struct {
+	value string
+}
+
+func (l loose) Equals(other string) bool {
+	return l.value == other
+}
+
+type CmpXTestSuite struct {
+	suite.Suite
+}
+
+func TestCmpXSuite(t *testing.T) {
+	suite.Run(t, new(CmpXTestSuite))
+}
+
+func (s *CmpXTestSuite) newCustomer() customer {
+	id, _ := identifier.NewIntIdentifier(42)
+	name, _ := person.NewFullName("Jane", "", "Doe")
+	email, _ := web.NewEmail("jane@example.com")
+	ro, _ := geography.NewCountryCode("RO")
+	us, _ := geography.NewCountryCode("US")
+	balance, _ := finance.NewMoneyFromString("10.50", "EUR")
+
+	return customer{
+		ID:        id,
+		Name:      name,
+		Email:     email,
+		Countries: domain.NewSet(ro, us),
+		Backup:    domain.None[web.Email](),
+		Balances:  map[string]finance.Money{"main": balance},
+		Tags:      []string{"vip"},
+	}
+}
+
+func (s *CmpXTestSuite) TestCmpPanicsOnValueObjectsWithoutTheOption() {
+	s.Panics(func() { cmp.Diff(s.newCustomer(), s.newCustomer()) })
+}
+
+func (s *CmpXTestSuite) TestEquateValueObjectsComparesThroughEquals() {
+	want, got := s.newCustomer(), s.newCustomer()
+	s.Empty(cmp.Diff(want, got, EquateValueObjects()))
+
+	// Sets compare regardless of order and money regardless of trailing zeros
+	ro, _ := geography.NewCountryCode("RO")
+	us, _ := geography.NewCountryCode("US")
+	got.Countries = domain.NewSet(us, ro)
+	got.Balances["main"], _ = finance.NewMoneyFromString("10.5", "EUR")
+	s.Empty(cmp.Diff(want, got, EquateValueObjects()))
+
+	got.Email, _ = web.NewEmail("john@example.com")
+	got.Backup = domain.Some(want.Email)
+	got.Tags = append(got.Tags, "new")
+	diff := cmp.Diff(want, got, EquateValueObjects())
+	s.Contains(diff, "Email")
+	s.Contains(diff, "Backup")
+	s.Contains(diff, "Tags")
+}
+
+func (s *CmpXTestSuite) TestEquateAppliesToASingleType() {
+	first, _ := web.NewEmail("jane@example.com")
+	second, _ := web.NewEmail("JANE@example.com")
+	s.True(cmp.Equal(first, second, Equate[web.Email]()))
+
+	s.Panics(
+		func() {
+			cmp.Equal(s.newCustomer(), s.newCustomer(), Equate[web.Email]())
+		},
+	)
+}
+
+func (s *CmpXTestSuite) TestItIgnoresEqualsMethodsWithOtherSignatures() {
+	s.False(hasEqualsMethod(reflect.TypeOf(loose{})))
+	s.True(hasEqualsMethod(reflect.TypeOf(web.Email{})))
+}
@@ -0,0 +1,178 @@
+package commerce
+
+import (
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyBarcode = domain.NewLocalizedError(
+		"commerce.barcode.empty", nil,
+		"barcode cannot be empty",
+	)
+	ErrInvalidBarcodeFormat = domain.NewLocalizedError(
+		"commerce.barcode.invalid_format", nil,
+		"barcode must have 8, 12 or 13 digits",
+	)
+	ErrInvalidBarcodeChecksum = domain.NewLocalizedError(
+		"commerce.barcode.invalid_checksum", nil,
+		"barcode has an invalid check digit",
+	)
+)
+
+// Symbology is the encoding standard of a Barcode
+type Symbology int
+
+const (
+	// SymbologyEAN13 is the 13-digit European (International) Article Number
+	SymbologyEAN13 Symbology = iota
+	// SymbologyEAN8 is the 8-digit EAN used on small packages
+	SymbologyEAN8
+	// SymbologyUPCA is the 12-digit Universal Product Code used in North America
+	SymbologyUPCA
+)
+
+// String returns the name of the symbology, e.g., "EAN-13"
+func (s Symbology) String() string {
+	switch s {
+	case SymbologyEAN13:
+		return "EAN-13"
+	case SymbologyEAN8:
+		return "EAN-8"
+	case SymbologyUPCA:
+		return "UPC-A"
+	default:
+		return "unknown"
+	}
+}
+
+// Barcode is a retail product barcode in one of the EAN-13, EAN-8 or UPC-A symbologies, which
+// is told apart by its number of digits
+type Barcode struct {
+	value string
+}
+
+// NewBarcode creates a new instance of Barcode with validation and normalization
+func NewBarcode(value string) (Barcode, error) {
+	normalized, err := NormalizeBarcode(value)
+	if err != nil {
+		return Barcode{}, err
+	}
+
+	return Barcode{
+		value: normalized,
+	}, nil
+}
+
+// ReconstituteBarcode creates a new Barcode instance without validation or normalization
+func ReconstituteBarcode(value string) Barcode {
+	return Barcode{
+		value: value,
+	}
+}
+
+// NormalizeBarcode removes the spaces and hyphens barcodes are often printed with, e.g.,
+// "5 901234 123457", and validates the digits and the check digit
+func NormalizeBarcode(value string) (string, error) {
+	value = strings.Map(
+		func(r rune) rune {
+			if r == ' ' || r == '-' {
+				return -1
+			}
+			return r
+		},
+		value,
+	)
+	if value == "" {
+		return "", ErrEmptyBarcode
+	}
+
+	switch len(value) {
+	case 8, 12, 13:
+	default:
+		return "", ErrInvalidBarcodeFormat
+	}
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return "", ErrInvalidBarcodeFormat
+		}
+	}
+
+	last := len(value) - 1
+	if value[last]-'0' != barcodeCheckDigit(value[:last]) {
+		return "", ErrInvalidBarcodeChecksum
+	}
+
+	return value, nil
+}
+
+// Value returns the digits of the barcode
+func (b Barcode) Value() string {
+	return b.value
+}
+
+// Symbology returns the symbology of the barcode
+func (b Barcode) Symbology() Symbology {
+	switch len(b.value) {
+	case 8:
+		return SymbologyEAN8
+	case 12:
+		return SymbologyUPCA
+	default:
+		return SymbologyEAN13
+	}
+}
+
+// CountryPrefix returns the three-digit GS1 prefix of the barcode, e.g., "590" for Poland. It
+// names the GS1 member organization that assigned the company prefix, not where the product
+// was made, and some ranges are not countries at all: "978" and "979" are books and "200" to
+// "299" are for in-store use. A UPC-A barcode reads as an EAN-13 with a leading zero, so
+// "036000291452" has the prefix "003", from the US and Canada range.
+func (b Barcode) CountryPrefix() string {
+	if b.Symbology() == SymbologyUPCA {
+		return "0" + b.value[:2]
+	}
+	return b.value[:3]
+}
+
+// Equals compares two Barcode objects for equality
+func (b Barcode) Equals(other Barcode) bool {
+	return b.value == other.value
+}
+
+// String returns a string representation of the barcode
+func (b Barcode) String() string {
+	return b.value
+}
+
+// UnmarshalText parses the barcode from text, with validation and normalization. Empty text
+// leaves the value unchanged.
+func (b *Barcode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewBarcode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*b = parsed
+	return nil
+}
+
+// barcodeCheckDigit computes the GS1 check digit of the digits before it: weighting them 3
+// and 1 alternately from the right, it is the amount that rounds their sum up to a multiple
+// of ten
+func barcodeCheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte((10 - sum%10) % 10)
+}
@@ -0,0 +1,95 @@
+package commerce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BarcodeTestSuite struct {
+	suite.Suite
+}
+
+func TestBarcodeSuite(t *testing.T) {
+	suite.Run(t, new(BarcodeTestSuite))
+}
+
+func (s *BarcodeTestSuite) TestItCanBuildNewBarcodeWithValidValues() {
+	testCases := []struct {
+		value         string
+		expected      string
+		symbology     Symbology
+		countryPrefix string
+	}{
+		{"4006381333931", "4006381333931", SymbologyEAN13, "400"},
+		{"5 901234 123457", "5901234123457", SymbologyEAN13, "590"},
+		{"978-0-306-40615-7", "9780306406157", SymbologyEAN13, "978"},
+		{"96385074", "96385074", SymbologyEAN8, "963"},
+		{"036000291452", "036000291452", SymbologyUPCA, "003"},
+		{"0 12345 67890 5", "012345678905", SymbologyUPCA, "001"},
+	}
+
+	for _, tc := range testCases {
+		barcode, err := NewBarcode(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, barcode.Value())
+		s.Equal(tc.expected, barcode.String())
+		s.Equal(tc.symbology, barcode.Symbology(), tc.value)
+		s.Equal(tc.countryPrefix, barcode.CountryPrefix(), tc.value)
+	}
+}
+
+func (s *BarcodeTestSuite) TestItFailsToBuildNewBarcodeWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyBarcode},
+		{" - ", ErrEmptyBarcode},
+		{"1234567", ErrInvalidBarcodeFormat},
+		{"12345678901", ErrInvalidBarcodeFormat},
+		{"40063813339310", ErrInvalidBarcodeFormat},
+		{"400638133393A", ErrInvalidBarcodeFormat},
+		{"４006381333931", ErrInvalidBarcodeFormat},
+		{"4006381333932", ErrInvalidBarcodeChecksum},
+		{"96385075", ErrInvalidBarcodeChecksum},
+		{"036000291453", ErrInvalidBarcodeChecksum},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewBarcode(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *BarcodeTestSuite) TestItCanReconstituteBarcode() {
+	barcode := ReconstituteBarcode("123")
+	s.Equal("123", barcode.Value())
+}
+
+func (s *BarcodeTestSuite) TestItComparesBarcodes() {
+	first, _ := NewBarcode("5901234123457")
+	second, _ := NewBarcode("5 901234 123457")
+	third, _ := NewBarcode("4006381333931")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *BarcodeTestSuite) TestItNamesSymbologies() {
+	s.Equal("EAN-13", SymbologyEAN13.String())
+	s.Equal("EAN-8", SymbologyEAN8.String())
+	s.Equal("UPC-A", SymbologyUPCA.String())
+	s.Equal("unknown", Symbology(-1).String())
+}
+
+func (s *BarcodeTestSuite) TestItUnmarshalsText() {
+	var barcode Barcode
+	s.NoError(barcode.UnmarshalText([]byte("96385074")))
+	s.Equal("96385074", barcode.Value())
+
+	s.NoError(barcode.UnmarshalText(nil))
+	s.Equal("96385074", barcode.Value())
+
+	s.ErrorIs(barcode.UnmarshalText([]byte("96385075")), ErrInvalidBarcodeChecksum)
+}
@@ -0,0 +1,124 @@
+package commerce
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyOrderNumber = domain.NewLocalizedError(
+		"commerce.order_number.empty", nil,
+		"order number cannot be empty",
+	)
+	ErrInvalidOrderNumber = domain.NewLocalizedError(
+		"commerce.order_number.invalid", nil,
+		"order number has invalid format",
+	)
+)
+
+// DefaultOrderNumberPattern accepts 3 to 32 upper case letters, digits and hyphens, starting
+// and ending with a letter or digit, e.g., "ORD-2024-000042"
+var DefaultOrderNumberPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9-]{1,30}[A-Z0-9]$`)
+
+// OrderNumberOption configures how order numbers are validated
+type OrderNumberOption func(*orderNumberOptions)
+
+type orderNumberOptions struct {
+	pattern *regexp.Regexp
+}
+
+// WithOrderNumberPattern validates order numbers against the pattern instead of
+// DefaultOrderNumberPattern. The pattern sees the normalized, upper case value and should be
+// anchored. Nil keeps the default.
+func WithOrderNumberPattern(pattern *regexp.Regexp) OrderNumberOption {
+	return func(o *orderNumberOptions) {
+		if pattern != nil {
+			o.pattern = pattern
+		}
+	}
+}
+
+// OrderNumber is the customer-facing reference of an order, e.g., "ORD-2024-000042"
+type OrderNumber struct {
+	value string
+}
+
+// NewOrderNumber creates a new instance of OrderNumber with validation and normalization. The
+// value is trimmed and upper cased, so references read out over the phone still match.
+func NewOrderNumber(value string, opts ...OrderNumberOption) (OrderNumber, error) {
+	config := orderNumberOptions{pattern: DefaultOrderNumberPattern}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return OrderNumber{}, ErrEmptyOrderNumber
+	}
+	if !config.pattern.MatchString(value) {
+		return OrderNumber{}, ErrInvalidOrderNumber
+	}
+
+	return OrderNumber{
+		value: value,
+	}, nil
+}
+
+// FormatOrderNumber builds an order number from the next value of a sequence, such as a
+// database sequence, zero-padded to width digits after the prefix: FormatOrderNumber("ORD-",
+// 42, 6) is "ORD-000042". Padding keeps order numbers of the same width sorting in sequence
+// order; longer sequence values are not truncated. The result is validated like NewOrderNumber.
+func FormatOrderNumber(
+	prefix string,
+	sequence uint64,
+	width int,
+	opts ...OrderNumberOption,
+) (OrderNumber, error) {
+	digits := strconv.FormatUint(sequence, 10)
+	if padding := width - len(digits); padding > 0 {
+		digits = strings.Repeat("0", padding) + digits
+	}
+	return NewOrderNumber(prefix+digits, opts...)
+}
+
+// ReconstituteOrderNumber creates a new OrderNumber instance without validation or
+// normalization
+func ReconstituteOrderNumber(value string) OrderNumber {
+	return OrderNumber{
+		value: value,
+	}
+}
+
+// Value returns the order number
+func (o OrderNumber) Value() string {
+	return o.value
+}
+
+// Equals compares two OrderNumber objects for equality
+func (o OrderNumber) Equals(other OrderNumber) bool {
+	return o.value == other.value
+}
+
+// String returns a string representation of the order number
+func (o OrderNumber) String() string {
+	return o.value
+}
+
+// UnmarshalText parses the order number from text, with validation against
+// DefaultOrderNumberPattern. Empty text leaves the value unchanged.
+func (o *OrderNumber) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewOrderNumber(string(text))
+	if err != nil {
+		return err
+	}
+
+	*o = parsed
+	return nil
+}
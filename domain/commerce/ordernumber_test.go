@@ -0,0 +1,125 @@
+package commerce
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderNumberTestSuite struct {
+	suite.Suite
+}
+
+func TestOrderNumberSuite(t *testing.T) {
+	suite.Run(t, new(OrderNumberTestSuite))
+}
+
+func (s *OrderNumberTestSuite) TestItCanBuildNewOrderNumberWithValidValues() {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"ORD-2024-000042", "ORD-2024-000042"},
+		{" ord-42 ", "ORD-42"},
+		{"100", "100"},
+		{strings.Repeat("A", 32), strings.Repeat("A", 32)},
+	}
+
+	for _, tc := range testCases {
+		orderNumber, err := NewOrderNumber(tc.value)
+		s.NoError(err, tc.value)
+		s.Equal(tc.expected, orderNumber.Value())
+		s.Equal(tc.expected, orderNumber.String())
+	}
+}
+
+func (s *OrderNumberTestSuite) TestItFailsToBuildNewOrderNumberWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyOrderNumber},
+		{"   ", ErrEmptyOrderNumber},
+		{"AB", ErrInvalidOrderNumber},
+		{strings.Repeat("A", 33), ErrInvalidOrderNumber},
+		{"-ORD42", ErrInvalidOrderNumber},
+		{"ORD42-", ErrInvalidOrderNumber},
+		{"ORD 42", ErrInvalidOrderNumber},
+		{"ORD_42", ErrInvalidOrderNumber},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewOrderNumber(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *OrderNumberTestSuite) TestItValidatesAgainstACustomPattern() {
+	pattern := regexp.MustCompile(`^SO\d{8}$`)
+
+	orderNumber, err := NewOrderNumber("so00001234", WithOrderNumberPattern(pattern))
+	s.NoError(err)
+	s.Equal("SO00001234", orderNumber.Value())
+
+	_, err = NewOrderNumber("ORD-42", WithOrderNumberPattern(pattern))
+	s.ErrorIs(err, ErrInvalidOrderNumber)
+
+	_, err = NewOrderNumber("ORD-42", WithOrderNumberPattern(nil))
+	s.NoError(err)
+}
+
+func (s *OrderNumberTestSuite) TestItFormatsOrderNumbersFromASequence() {
+	testCases := []struct {
+		prefix   string
+		sequence uint64
+		width    int
+		expected string
+	}{
+		{"ORD-", 42, 6, "ORD-000042"},
+		{"ORD-2024-", 1, 4, "ORD-2024-0001"},
+		{"ORD-", 1234567, 6, "ORD-1234567"},
+		{"", 42, 3, "042"},
+		{"inv", 7, 3, "INV007"},
+	}
+
+	for _, tc := range testCases {
+		orderNumber, err := FormatOrderNumber(tc.prefix, tc.sequence, tc.width)
+		s.NoError(err, tc.expected)
+		s.Equal(tc.expected, orderNumber.Value())
+	}
+
+	_, err := FormatOrderNumber("ORD_", 42, 6)
+	s.ErrorIs(err, ErrInvalidOrderNumber)
+
+	pattern := regexp.MustCompile(`^SO\d{8}$`)
+	orderNumber, err := FormatOrderNumber("SO", 42, 8, WithOrderNumberPattern(pattern))
+	s.NoError(err)
+	s.Equal("SO00000042", orderNumber.Value())
+}
+
+func (s *OrderNumberTestSuite) TestItCanReconstituteOrderNumber() {
+	orderNumber := ReconstituteOrderNumber("any value")
+	s.Equal("any value", orderNumber.Value())
+}
+
+func (s *OrderNumberTestSuite) TestItComparesOrderNumbers() {
+	first, _ := NewOrderNumber("ORD-42")
+	second, _ := NewOrderNumber("ord-42")
+	third, _ := NewOrderNumber("ORD-43")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *OrderNumberTestSuite) TestItUnmarshalsText() {
+	var orderNumber OrderNumber
+	s.NoError(orderNumber.UnmarshalText([]byte("ord-42")))
+	s.Equal("ORD-42", orderNumber.Value())
+
+	s.NoError(orderNumber.UnmarshalText(nil))
+	s.Equal("ORD-42", orderNumber.Value())
+
+	s.ErrorIs(orderNumber.UnmarshalText([]byte("-")), ErrInvalidOrderNumber)
+}
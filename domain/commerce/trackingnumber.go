@@ -0,0 +1,161 @@
+package commerce
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyTrackingNumber = domain.NewLocalizedError(
+		"commerce.tracking_number.empty", nil,
+		"tracking number cannot be empty",
+	)
+	ErrInvalidTrackingNumber = domain.NewLocalizedError(
+		"commerce.tracking_number.invalid", nil,
+		"tracking number has invalid format",
+	)
+)
+
+// Carrier is the shipping company that issued a TrackingNumber
+type Carrier int
+
+const (
+	// CarrierUnknown is used for tracking numbers that match no known carrier format
+	CarrierUnknown Carrier = iota
+	CarrierUPS
+	CarrierFedEx
+	CarrierUSPS
+	CarrierDHL
+)
+
+// String returns the name of the carrier, e.g., "UPS"
+func (c Carrier) String() string {
+	switch c {
+	case CarrierUPS:
+		return "UPS"
+	case CarrierFedEx:
+		return "FedEx"
+	case CarrierUSPS:
+		return "USPS"
+	case CarrierDHL:
+		return "DHL"
+	default:
+		return "unknown"
+	}
+}
+
+// trackingNumberPattern accepts the letters and digits every carrier format is made of
+var trackingNumberPattern = regexp.MustCompile(`^[A-Z0-9]{8,40}$`)
+
+// carrierFormats lists the tracking number formats of each carrier. Formats overlap, e.g.,
+// a 12-digit number could come from several carriers, so the first match wins and the order
+// favors the carrier most likely to use the format.
+var carrierFormats = []struct {
+	carrier Carrier
+	pattern *regexp.Regexp
+}{
+	// UPS: "1Z", a six-character shipper number, a service code and a package number
+	{CarrierUPS, regexp.MustCompile(`^1Z[A-Z0-9]{16}$`)},
+	// USPS: Intelligent Mail package barcodes and UPU S10 numbers ending in "US"
+	{CarrierUSPS, regexp.MustCompile(`^(9[2-5]\d{18,20}|[A-Z]{2}\d{9}US)$`)},
+	// FedEx: 12-digit Express, 15-digit Ground and 22-digit Ground "96" numbers
+	{CarrierFedEx, regexp.MustCompile(`^(\d{12}|\d{15}|96\d{20})$`)},
+	// DHL: 10-digit Express waybills and "JJD" parcel numbers
+	{CarrierDHL, regexp.MustCompile(`^(\d{10}|JJD\d{10,20})$`)},
+}
+
+// TrackingNumber is the reference a carrier gives a shipment, stored without the spaces and
+// hyphens it is often printed with
+type TrackingNumber struct {
+	value string
+}
+
+// NewTrackingNumber creates a new instance of TrackingNumber with validation and
+// normalization. Numbers of unknown carriers are accepted as long as they are 8 to 40 letters
+// and digits.
+func NewTrackingNumber(value string) (TrackingNumber, error) {
+	normalized, err := NormalizeTrackingNumber(value)
+	if err != nil {
+		return TrackingNumber{}, err
+	}
+
+	return TrackingNumber{
+		value: normalized,
+	}, nil
+}
+
+// ReconstituteTrackingNumber creates a new TrackingNumber instance without validation or
+// normalization
+func ReconstituteTrackingNumber(value string) TrackingNumber {
+	return TrackingNumber{
+		value: value,
+	}
+}
+
+// NormalizeTrackingNumber removes spaces and hyphens, upper cases the letters and validates
+// the result
+func NormalizeTrackingNumber(value string) (string, error) {
+	value = strings.ToUpper(
+		strings.Map(
+			func(r rune) rune {
+				if r == ' ' || r == '-' {
+					return -1
+				}
+				return r
+			},
+			value,
+		),
+	)
+	if value == "" {
+		return "", ErrEmptyTrackingNumber
+	}
+	if !trackingNumberPattern.MatchString(value) {
+		return "", ErrInvalidTrackingNumber
+	}
+
+	return value, nil
+}
+
+// Value returns the tracking number
+func (t TrackingNumber) Value() string {
+	return t.value
+}
+
+// Carrier guesses the carrier from the format of the tracking number. Formats are heuristics
+// that may overlap or change, so use it to preselect a carrier, not to reject numbers.
+func (t TrackingNumber) Carrier() Carrier {
+	for _, format := range carrierFormats {
+		if format.pattern.MatchString(t.value) {
+			return format.carrier
+		}
+	}
+	return CarrierUnknown
+}
+
+// Equals compares two TrackingNumber objects for equality
+func (t TrackingNumber) Equals(other TrackingNumber) bool {
+	return t.value == other.value
+}
+
+// String returns a string representation of the tracking number
+func (t TrackingNumber) String() string {
+	return t.value
+}
+
+// UnmarshalText parses the tracking number from text, with validation and normalization.
+// Empty text leaves the value unchanged.
+func (t *TrackingNumber) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewTrackingNumber(string(text))
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,97 @@
+package commerce
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TrackingNumberTestSuite struct {
+	suite.Suite
+}
+
+func TestTrackingNumberSuite(t *testing.T) {
+	suite.Run(t, new(TrackingNumberTestSuite))
+}
+
+func (s *TrackingNumberTestSuite) TestItDetectsTheCarrier() {
+	testCases := []struct {
+		value    string
+		expected string
+		carrier  Carrier
+	}{
+		{"1Z999AA10123456784", "1Z999AA10123456784", CarrierUPS},
+		{"1z 999 aa1 01 2345 6784", "1Z999AA10123456784", CarrierUPS},
+		{"9400 1000 0000 0000 0000 00", "9400100000000000000000", CarrierUSPS},
+		{"92055901755477000000000015", "92055901755477000000000015", CarrierUnknown},
+		{"EC123456785US", "EC123456785US", CarrierUSPS},
+		{"123456789012", "123456789012", CarrierFedEx},
+		{"123456789012345", "123456789012345", CarrierFedEx},
+		{"9611020987654312345672", "9611020987654312345672", CarrierFedEx},
+		{"1234567890", "1234567890", CarrierDHL},
+		{"JJD000390007827221", "JJD000390007827221", CarrierDHL},
+		{"RR123456785DE", "RR123456785DE", CarrierUnknown},
+		{"ABC12345", "ABC12345", CarrierUnknown},
+	}
+
+	for _, tc := range testCases {
+		trackingNumber, err := NewTrackingNumber(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, trackingNumber.Value())
+		s.Equal(tc.expected, trackingNumber.String())
+		s.Equal(tc.carrier, trackingNumber.Carrier(), tc.value)
+	}
+}
+
+func (s *TrackingNumberTestSuite) TestItFailsToBuildNewTrackingNumberWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyTrackingNumber},
+		{" - ", ErrEmptyTrackingNumber},
+		{"1234567", ErrInvalidTrackingNumber},
+		{strings.Repeat("1", 41), ErrInvalidTrackingNumber},
+		{"1Z999AA1/0123456784", ErrInvalidTrackingNumber},
+		{"1Z999AA1_0123456784", ErrInvalidTrackingNumber},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewTrackingNumber(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *TrackingNumberTestSuite) TestItNamesCarriers() {
+	s.Equal("UPS", CarrierUPS.String())
+	s.Equal("FedEx", CarrierFedEx.String())
+	s.Equal("USPS", CarrierUSPS.String())
+	s.Equal("DHL", CarrierDHL.String())
+	s.Equal("unknown", CarrierUnknown.String())
+}
+
+func (s *TrackingNumberTestSuite) TestItCanReconstituteTrackingNumber() {
+	trackingNumber := ReconstituteTrackingNumber("1z 999")
+	s.Equal("1z 999", trackingNumber.Value())
+}
+
+func (s *TrackingNumberTestSuite) TestItComparesTrackingNumbers() {
+	first, _ := NewTrackingNumber("1Z999AA10123456784")
+	second, _ := NewTrackingNumber("1z 999 aa1 01 2345 6784")
+	third, _ := NewTrackingNumber("1234567890")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *TrackingNumberTestSuite) TestItUnmarshalsText() {
+	var trackingNumber TrackingNumber
+	s.NoError(trackingNumber.UnmarshalText([]byte("1z999aa10123456784")))
+	s.Equal("1Z999AA10123456784", trackingNumber.Value())
+
+	s.NoError(trackingNumber.UnmarshalText(nil))
+	s.Equal("1Z999AA10123456784", trackingNumber.Value())
+
+	s.ErrorIs(trackingNumber.UnmarshalText([]byte("short")), ErrInvalidTrackingNumber)
+}
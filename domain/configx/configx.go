@@ -0,0 +1,181 @@
+// Package configx reads configuration into value objects, so a malformed URL, duration, money
+// limit, country code or locale stops the application at startup with a domain error instead
+// of failing on first use.
+//
+// A Loader reads environment variables and collects every failure, so one run reports all
+// misconfigured variables:
+//
+//	env := configx.NewLoader()
+//	cfg := Config{
+//		APIURL:   configx.Get[web.URL](env, "API_URL", configx.Required()),
+//		Timeout:  configx.Get[time.Duration](env, "TIMEOUT", configx.Default("5s")),
+//		MaxOrder: configx.Get[finance.Money](env, "MAX_ORDER", configx.Default("500 EUR")),
+//		Country:  configx.Get[geography.CountryCode](env, "DEFAULT_COUNTRY"),
+//		Locale:   configx.Get[language.Tag](env, "LOCALE", configx.Default("en")),
+//	}
+//	if err := env.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// Value objects already implement encoding.TextUnmarshaler, which envconfig uses for struct
+// fields. For viper, DecodeHook parses strings the same way while unmarshaling:
+//
+//	err := viper.Unmarshal(&cfg, viper.DecodeHook(configx.DecodeHook()))
+//
+// Any type with an UnmarshalText method is supported, as well as time.Duration.
+package configx
+
+import (
+	"encoding"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrMissingVariable = domain.NewError("required configuration variable is not set")
+	ErrUnsupportedType = domain.NewError(
+		"configuration type has neither an UnmarshalText method nor is a time.Duration",
+	)
+)
+
+// LoaderOption configures a Loader
+type LoaderOption func(*Loader)
+
+// WithLookup sets how variables are looked up, instead of os.LookupEnv (e.g., to read a
+// prefixed environment or a map in tests)
+func WithLookup(lookup func(name string) (string, bool)) LoaderOption {
+	return func(l *Loader) {
+		l.lookup = lookup
+	}
+}
+
+// VarOption configures how a single variable is read
+type VarOption func(*varOptions)
+
+type varOptions struct {
+	required     bool
+	defaultValue string
+}
+
+// Required reports a variable that is not set or empty with ErrMissingVariable
+func Required() VarOption {
+	return func(o *varOptions) {
+		o.required = true
+	}
+}
+
+// Default sets the text parsed when the variable is not set or empty. The default is validated
+// like any other value.
+func Default(value string) VarOption {
+	return func(o *varOptions) {
+		o.defaultValue = value
+	}
+}
+
+// Loader reads configuration variables and collects the errors. It is not safe for
+// concurrent use.
+type Loader struct {
+	lookup func(name string) (string, bool)
+	errs   []error
+}
+
+// NewLoader creates a Loader reading the environment
+func NewLoader(opts ...LoaderOption) *Loader {
+	loader := &Loader{
+		lookup: os.LookupEnv,
+	}
+	for _, opt := range opts {
+		opt(loader)
+	}
+	return loader
+}
+
+// Err returns an error wrapping every error collected so far, or nil. Each cause carries the
+// "variable" field.
+func (l *Loader) Err() error {
+	if len(l.errs) == 0 {
+		return nil
+	}
+	return domain.NewErrorWithWrapAll(l.errs, "invalid configuration")
+}
+
+// Get reads the variable name into a T, validating it. A variable that is not set or empty
+// reads as the default, if any, and otherwise as the zero value.
+func Get[T any](l *Loader, name string, opts ...VarOption) T {
+	var config varOptions
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	var value T
+	text, _ := l.lookup(name)
+	if text == "" {
+		text = config.defaultValue
+	}
+	if text == "" {
+		if config.required {
+			l.errs = append(l.errs, ErrMissingVariable.WithField("variable", name))
+		}
+		return value
+	}
+
+	if err := decode(&value, text); err != nil {
+		wrapped := domain.NewErrorWithWrap(err, "invalid configuration variable %q", name)
+		l.errs = append(l.errs, wrapped.WithField("variable", name))
+		var zero T
+		return zero
+	}
+	return value
+}
+
+// DecodeHook returns a viper (mapstructure) decode hook parsing strings into value objects and
+// durations, with validation. Other conversions are left to mapstructure.
+func DecodeHook() func(from reflect.Type, to reflect.Type, data any) (any, error) {
+	return func(from reflect.Type, to reflect.Type, data any) (any, error) {
+		if from.Kind() != reflect.String {
+			return data, nil
+		}
+
+		target := reflect.New(to)
+		if !isDecodable(target.Interface()) {
+			return data, nil
+		}
+
+		text := reflect.ValueOf(data).String()
+		if err := decode(target.Interface(), text); err != nil {
+			return nil, domain.NewErrorWithWrap(err, "invalid configuration value for %s", to).
+				WithField("type", to.String())
+		}
+		return target.Elem().Interface(), nil
+	}
+}
+
+// isDecodable reports whether decode supports the target pointer
+func isDecodable(target any) bool {
+	switch target.(type) {
+	case *time.Duration, encoding.TextUnmarshaler:
+		return true
+	default:
+		return false
+	}
+}
+
+// decode parses text into the target pointer
+func decode(target any, text string) error {
+	switch target := target.(type) {
+	case *time.Duration:
+		duration, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		*target = duration
+		return nil
+	case encoding.TextUnmarshaler:
+		return target.UnmarshalText([]byte(text))
+	default:
+		return ErrUnsupportedType.WithField("type", reflect.TypeOf(target).Elem().String())
+	}
+}
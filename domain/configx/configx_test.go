@@ -0,0 +1,118 @@
+package configx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/text/language"
+)
+
+type ConfigxTestSuite struct {
+	suite.Suite
+}
+
+func TestConfigxSuite(t *testing.T) {
+	suite.Run(t, new(ConfigxTestSuite))
+}
+
+func mapLookup(values map[string]string) LoaderOption {
+	return WithLookup(func(name string) (string, bool) {
+		value, found := values[name]
+		return value, found
+	})
+}
+
+func (s *ConfigxTestSuite) TestItReadsValueObjects() {
+	env := NewLoader(mapLookup(map[string]string{
+		"API_URL":         "https://api.example.com",
+		"TIMEOUT":         "1m30s",
+		"MAX_ORDER":       "500 EUR",
+		"DEFAULT_COUNTRY": "ro",
+		"LOCALE":          "fr-CA",
+	}))
+
+	apiURL := Get[web.URL](env, "API_URL", Required())
+	timeout := Get[time.Duration](env, "TIMEOUT")
+	maxOrder := Get[finance.Money](env, "MAX_ORDER")
+	country := Get[geography.CountryCode](env, "DEFAULT_COUNTRY")
+	locale := Get[language.Tag](env, "LOCALE")
+
+	s.Require().NoError(env.Err())
+	s.Equal("https://api.example.com", apiURL.Value())
+	s.Equal(90*time.Second, timeout)
+	s.Equal("500 EUR", maxOrder.String())
+	s.Equal("RO", country.Value())
+	s.Equal(language.CanadianFrench, locale)
+}
+
+func (s *ConfigxTestSuite) TestItAppliesDefaults() {
+	env := NewLoader(mapLookup(map[string]string{"TIMEOUT": ""}))
+
+	timeout := Get[time.Duration](env, "TIMEOUT", Default("5s"))
+	country := Get[geography.CountryCode](env, "DEFAULT_COUNTRY", Default("DE"), Required())
+	locale := Get[language.Tag](env, "LOCALE")
+
+	s.Require().NoError(env.Err())
+	s.Equal(5*time.Second, timeout)
+	s.Equal("DE", country.Value())
+	s.Equal(language.Und, locale)
+}
+
+func (s *ConfigxTestSuite) TestItCollectsEveryError() {
+	env := NewLoader(mapLookup(map[string]string{
+		"TIMEOUT":         "soon",
+		"DEFAULT_COUNTRY": "XYZ",
+	}))
+
+	Get[web.URL](env, "API_URL", Required())
+	Get[time.Duration](env, "TIMEOUT")
+	country := Get[geography.CountryCode](env, "DEFAULT_COUNTRY")
+	Get[finance.Money](env, "MAX_ORDER", Default("lots"))
+	Get[struct{}](env, "TIMEOUT")
+
+	err := env.Err()
+	s.Require().Error(err)
+	s.ErrorIs(err, ErrMissingVariable)
+	s.ErrorIs(err, geography.ErrInvalidCountryCode)
+	s.ErrorIs(err, finance.ErrInvalidMoney)
+	s.ErrorIs(err, ErrUnsupportedType)
+	s.Empty(country.Value())
+
+	var domainErr *domain.Error
+	s.Require().True(errors.As(err, &domainErr))
+	causes := domainErr.Causes()
+	s.Len(causes, 5)
+	s.Require().True(errors.As(causes[0], &domainErr))
+	s.Equal("API_URL", domainErr.Fields()["variable"])
+}
+
+func (s *ConfigxTestSuite) TestItProvidesAViperDecodeHook() {
+	hook := DecodeHook()
+	stringType := reflect.TypeFor[string]()
+
+	country, err := hook(stringType, reflect.TypeFor[geography.CountryCode](), "fr")
+	s.Require().NoError(err)
+	s.Equal(geography.ReconstituteCountryCode("FR"), country)
+
+	timeout, err := hook(stringType, reflect.TypeFor[time.Duration](), "2s")
+	s.Require().NoError(err)
+	s.Equal(2*time.Second, timeout)
+
+	_, err = hook(stringType, reflect.TypeFor[web.URL](), "not a url")
+	s.ErrorIs(err, web.ErrInvalidURL)
+
+	untouched, err := hook(stringType, reflect.TypeFor[int](), "42")
+	s.Require().NoError(err)
+	s.Equal("42", untouched)
+
+	untouched, err = hook(reflect.TypeFor[int](), reflect.TypeFor[time.Duration](), 42)
+	s.Require().NoError(err)
+	s.Equal(42, untouched)
+}
@@ -0,0 +1,296 @@
+package content
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MaxHTMLLength is the default maximum length of sanitized HTML, in characters
+const MaxHTMLLength = 100000
+
+var (
+	ErrEmptyHTML = domain.NewLocalizedError(
+		"content.html.empty", nil,
+		"HTML content cannot be empty",
+	)
+	ErrTooLongHTML = domain.NewLocalizedError(
+		"content.html.too_long", domain.MessageParams{"max": MaxHTMLLength},
+		"HTML content cannot exceed %d characters",
+		MaxHTMLLength,
+	)
+)
+
+// Sanitizer removes everything but an allowlist of markup from untrusted HTML. A
+// *bluemonday.Policy satisfies it, so applications can plug in a richer policy with
+// WithSanitizer.
+type Sanitizer interface {
+	// Sanitize returns the HTML with every disallowed element and attribute removed
+	Sanitize(html string) string
+}
+
+// DefaultSanitizer keeps basic formatting (b, strong, i, em, u, s, p, br, ul, ol, li,
+// blockquote, code, pre) and links to http, https, mailto or relative URLs, which get
+// rel="nofollow noopener". Other tags are removed and their text kept, except for script,
+// style and similar elements whose content is dropped too. Comments are removed, stray
+// closing tags dropped and unclosed tags closed, so the result never breaks the page it is
+// embedded in.
+var DefaultSanitizer Sanitizer = allowlistSanitizer{}
+
+// allowedTags maps the tags DefaultSanitizer keeps to their allowed attributes
+var allowedTags = map[string][]string{
+	"a":          {"href", "title"},
+	"b":          nil,
+	"blockquote": nil,
+	"br":         nil,
+	"code":       nil,
+	"em":         nil,
+	"i":          nil,
+	"li":         nil,
+	"ol":         nil,
+	"p":          nil,
+	"pre":        nil,
+	"s":          nil,
+	"strong":     nil,
+	"u":          nil,
+	"ul":         nil,
+}
+
+// droppedContentTags lists the tags whose content DefaultSanitizer removes with them
+var droppedContentTags = map[string]struct{}{
+	"iframe": {}, "math": {}, "noembed": {}, "noframes": {}, "noscript": {}, "object": {},
+	"script": {}, "style": {}, "svg": {}, "template": {}, "textarea": {}, "title": {},
+	"xmp": {},
+}
+
+var (
+	// tagPattern matches a start or end tag at the beginning of the input. Quoted values
+	// cannot hold '<' or '>', which keeps sanitizing linear in the input length.
+	tagPattern = regexp.MustCompile(
+		`^<(/?)([a-zA-Z][a-zA-Z0-9]*)((?:\s+[^\s"'<>/=]+(?:\s*=\s*` +
+			`(?:"[^"<>]*"|'[^'<>]*'|[^\s"'=<>` + "`" + `]+))?)*)\s*/?>`,
+	)
+	attributePattern = regexp.MustCompile(
+		`([^\s"'<>/=]+)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'=<>` + "`" + `]+)))?`,
+	)
+)
+
+// SanitizedHTML is user-written rich text, such as a comment or a bio, that has been
+// sanitized and is safe to render unescaped
+type SanitizedHTML struct {
+	value string
+}
+
+// NewSanitizedHTML sanitizes untrusted HTML with DefaultSanitizer, or the one given with
+// WithSanitizer, and creates a new instance of SanitizedHTML from the result. It fails when
+// nothing but whitespace is left or the result is longer than the maximum length.
+func NewSanitizedHTML(value string, opts ...Option) (SanitizedHTML, error) {
+	config := applyOptions(
+		options{maxLength: MaxHTMLLength, sanitizer: DefaultSanitizer},
+		opts,
+	)
+
+	sanitized := strings.TrimSpace(config.sanitizer.Sanitize(value))
+	if sanitized == "" {
+		return SanitizedHTML{}, ErrEmptyHTML
+	}
+	if utf8.RuneCountInString(sanitized) > config.maxLength {
+		return SanitizedHTML{}, tooLong(ErrTooLongHTML, config.maxLength, MaxHTMLLength)
+	}
+
+	return SanitizedHTML{
+		value: sanitized,
+	}, nil
+}
+
+// ReconstituteSanitizedHTML creates a new SanitizedHTML instance without sanitization. Only
+// use it for values that were sanitized before being stored.
+func ReconstituteSanitizedHTML(value string) SanitizedHTML {
+	return SanitizedHTML{
+		value: value,
+	}
+}
+
+// Value returns the sanitized HTML
+func (h SanitizedHTML) Value() string {
+	return h.value
+}
+
+// Equals compares two SanitizedHTML objects for equality
+func (h SanitizedHTML) Equals(other SanitizedHTML) bool {
+	return h.value == other.value
+}
+
+// String returns a string representation of the sanitized HTML
+func (h SanitizedHTML) String() string {
+	return h.value
+}
+
+// UnmarshalText sanitizes the HTML from text with DefaultSanitizer under the default limits.
+// Empty text leaves the value unchanged.
+func (h *SanitizedHTML) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewSanitizedHTML(string(text))
+	if err != nil {
+		return err
+	}
+
+	*h = parsed
+	return nil
+}
+
+type allowlistSanitizer struct{}
+
+func (allowlistSanitizer) Sanitize(value string) string {
+	value = strings.ToValidUTF8(strings.ReplaceAll(value, "\x00", ""), "\uFFFD")
+
+	var result strings.Builder
+	result.Grow(len(value))
+	var open []string
+	for value != "" {
+		index := strings.IndexByte(value, '<')
+		if index < 0 {
+			writeText(&result, value)
+			break
+		}
+		writeText(&result, value[:index])
+		value = value[index:]
+
+		if strings.HasPrefix(value, "<!--") {
+			_, value, _ = strings.Cut(value[len("<!--"):], "-->")
+			continue
+		}
+
+		match := tagPattern.FindStringSubmatch(value)
+		if match == nil {
+			result.WriteString("&lt;")
+			value = value[1:]
+			continue
+		}
+		value = value[len(match[0]):]
+
+		name := strings.ToLower(match[2])
+		_, dropContent := droppedContentTags[name]
+		switch {
+		case match[1] == "/":
+			open = writeEndTag(&result, open, name)
+		case dropContent:
+			value = skipContent(value, name)
+		default:
+			open = writeStartTag(&result, open, name, match[3])
+		}
+	}
+
+	for i := len(open) - 1; i >= 0; i-- {
+		result.WriteString("</" + open[i] + ">")
+	}
+	return result.String()
+}
+
+// writeText writes a run of text, escaping it consistently whatever entities it used
+func writeText(result *strings.Builder, text string) {
+	result.WriteString(html.EscapeString(html.UnescapeString(text)))
+}
+
+// writeStartTag writes an allowed start tag with its allowed attributes and returns the open
+// tags, including it unless it is void
+func writeStartTag(result *strings.Builder, open []string, name, attributes string) []string {
+	allowedAttributes, allowed := allowedTags[name]
+	if !allowed {
+		return open
+	}
+
+	result.WriteString("<" + name)
+	var written []string
+	for _, attribute := range attributePattern.FindAllStringSubmatch(attributes, -1) {
+		attributeName := strings.ToLower(attribute[1])
+		if !slices.Contains(allowedAttributes, attributeName) ||
+			slices.Contains(written, attributeName) {
+			continue
+		}
+
+		attributeValue := html.UnescapeString(attribute[2] + attribute[3] + attribute[4])
+		if attributeName == "href" {
+			var safe bool
+			if attributeValue, safe = safeURL(attributeValue); !safe {
+				continue
+			}
+		}
+
+		written = append(written, attributeName)
+		result.WriteString(" " + attributeName + `="` + html.EscapeString(attributeValue) + `"`)
+	}
+	if name == "a" {
+		result.WriteString(` rel="nofollow noopener"`)
+	}
+	result.WriteString(">")
+
+	if name == "br" {
+		return open
+	}
+	return append(open, name)
+}
+
+// writeEndTag closes the innermost open tag with the name, and any tag opened inside it, and
+// returns the tags still open. End tags of tags that are not open are dropped.
+func writeEndTag(result *strings.Builder, open []string, name string) []string {
+	index := len(open) - 1
+	for index >= 0 && open[index] != name {
+		index--
+	}
+	if index < 0 {
+		return open
+	}
+
+	for i := len(open) - 1; i >= index; i-- {
+		result.WriteString("</" + open[i] + ">")
+	}
+	return open[:index]
+}
+
+// skipContent returns the input after the end tag with the name, or nothing when the element
+// is never closed
+func skipContent(value, name string) string {
+	for {
+		index := strings.Index(value, "</")
+		if index < 0 {
+			return ""
+		}
+		value = value[index+len("</"):]
+		if len(value) >= len(name) && strings.EqualFold(value[:len(name)], name) {
+			_, rest, _ := strings.Cut(value, ">")
+			return rest
+		}
+	}
+}
+
+// safeURL removes the whitespace browsers ignore in a link target and reports whether it
+// uses a safe scheme: http, https, mailto or none for relative URLs
+func safeURL(value string) (string, bool) {
+	value = strings.TrimFunc(
+		value, func(r rune) bool {
+			return unicode.IsSpace(r) || unicode.IsControl(r)
+		},
+	)
+	value = strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(value)
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return "", false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "", "http", "https", "mailto":
+		return value, true
+	default:
+		return "", false
+	}
+}
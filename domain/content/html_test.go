@@ -0,0 +1,154 @@
+package content
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type SanitizedHTMLTestSuite struct {
+	suite.Suite
+}
+
+func TestSanitizedHTMLSuite(t *testing.T) {
+	suite.Run(t, new(SanitizedHTMLTestSuite))
+}
+
+func (s *SanitizedHTMLTestSuite) TestItKeepsAllowedMarkup() {
+	for _, value := range []string{
+		"Plain text",
+		"<p>Some <b>bold</b>, <strong>strong</strong>, <i>italic</i> and <em>em</em></p>",
+		"<ul><li>one</li><li>two</li></ul><ol><li>three</li></ol>",
+		"<blockquote>quote</blockquote><pre><code>x := 1</code></pre>",
+		"<u>under</u><s>struck</s>line<br>break",
+		`<a href="https://example.com/?a=1&amp;b=2" title="Example" rel="nofollow noopener">` +
+			"link</a>",
+		`<a href="/relative" rel="nofollow noopener">link</a>`,
+		`<a href="mailto:jane@example.com" rel="nofollow noopener">mail</a>`,
+		"1 &lt; 2 &amp;&amp; 3 &gt; 2",
+	} {
+		sanitized, err := NewSanitizedHTML(value)
+		s.NoError(err, value)
+		s.Equal(value, sanitized.Value())
+		s.Equal(value, sanitized.String())
+	}
+}
+
+func (s *SanitizedHTMLTestSuite) TestItSanitizesUntrustedMarkup() {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"<b>hi</b><script>alert(1)</script>", "<b>hi</b>"},
+		{"<STYLE>body{}</style>text", "text"},
+		{"<script>never closed", ""},
+		{"<div class=\"x\"><span>kept</span></div>", "kept"},
+		{`<img src=x onerror="alert(1)">image`, "image"},
+		{`<p onclick="alert(1)" style="color:red">p</p>`, "<p>p</p>"},
+		{`<a href="javascript:alert(1)">x</a>`, `<a rel="nofollow noopener">x</a>`},
+		{`<a href=" JaVa&#09;Script:alert(1)">x</a>`, `<a rel="nofollow noopener">x</a>`},
+		{`<a href="data:text/html,x">x</a>`, `<a rel="nofollow noopener">x</a>`},
+		{
+			`<a HREF='https://example.com' target=_blank rel=opener>x</a>`,
+			`<a href="https://example.com" rel="nofollow noopener">x</a>`,
+		},
+		{`<a title='"><script>' href=/x>x</a>`, `&lt;a title=&#39;&#34;&gt;`},
+		{"<!-- comment -->visible<!-- unclosed", "visible"},
+		{"<B>bold</B><BR/>", "<b>bold</b><br>"},
+		{"1 < 2 & 3 > 2", "1 &lt; 2 &amp; 3 &gt; 2"},
+		{"&eacute;t&#233;", "été"},
+		{"<scr<script>ipt>alert(1)</script>", "&lt;scr"},
+		{"<p>a<i>b</p>c</i>", "<p>a<i>b</i></p>c"},
+		{"<b>unclosed <i>tags", "<b>unclosed <i>tags</i></b>"},
+		{"stray</b></p>", "stray"},
+		{"null\x00byte \xff", "nullbyte \uFFFD"},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.expected, DefaultSanitizer.Sanitize(tc.value), tc.value)
+	}
+}
+
+func (s *SanitizedHTMLTestSuite) TestItFailsToBuildNewSanitizedHTMLWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyHTML},
+		{"  \n ", ErrEmptyHTML},
+		{"<script>alert(1)</script>", ErrEmptyHTML},
+		{"<img src=x> <!-- -->", ErrEmptyHTML},
+		{strings.Repeat("a", MaxHTMLLength+1), ErrTooLongHTML},
+		{strings.Repeat("&", MaxHTMLLength/5+1), ErrTooLongHTML},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewSanitizedHTML(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *SanitizedHTMLTestSuite) TestItAppliesACustomMaxLength() {
+	_, err := NewSanitizedHTML("<b>bold</b>", WithMaxLength(11))
+	s.NoError(err)
+
+	_, err = NewSanitizedHTML("<b>bold!</b>", WithMaxLength(11))
+	s.ErrorIs(err, ErrTooLongHTML)
+	var domainErr *domain.Error
+	s.Require().True(errors.As(err, &domainErr))
+	s.Equal(domain.MessageParams{"max": 11}, domainErr.MessageParams())
+}
+
+func (s *SanitizedHTMLTestSuite) TestItUsesTheGivenSanitizer() {
+	sanitized, err := NewSanitizedHTML(
+		"<h1>Title</h1>", WithSanitizer(upperCaseSanitizer{}), WithSanitizer(nil),
+	)
+	s.NoError(err)
+	s.Equal("<H1>TITLE</H1>", sanitized.Value())
+}
+
+func (s *SanitizedHTMLTestSuite) TestItCanReconstituteSanitizedHTML() {
+	sanitized := ReconstituteSanitizedHTML("<h1>Stored</h1>")
+	s.Equal("<h1>Stored</h1>", sanitized.Value())
+}
+
+func (s *SanitizedHTMLTestSuite) TestItComparesSanitizedHTML() {
+	first, _ := NewSanitizedHTML("<b>Hello</b>")
+	second, _ := NewSanitizedHTML("<B>Hello</B><script></script>")
+	third, _ := NewSanitizedHTML("<i>Hello</i>")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *SanitizedHTMLTestSuite) TestItUnmarshalsText() {
+	var sanitized SanitizedHTML
+	s.NoError(sanitized.UnmarshalText([]byte("<b onclick=x>Hello</b>")))
+	s.Equal("<b>Hello</b>", sanitized.Value())
+
+	s.NoError(sanitized.UnmarshalText(nil))
+	s.Equal("<b>Hello</b>", sanitized.Value())
+
+	s.ErrorIs(sanitized.UnmarshalText([]byte("<script></script>")), ErrEmptyHTML)
+}
+
+func BenchmarkDefaultSanitizer(b *testing.B) {
+	value := strings.Repeat(
+		`<p>Some <b>bold</b> text with a <a href="https://example.com" onclick="x">link</a>`+
+			`<script>alert(1)</script> &amp; 1 < 2</p>`,
+		100,
+	)
+	for b.Loop() {
+		DefaultSanitizer.Sanitize(value)
+	}
+}
+
+// upperCaseSanitizer is a Sanitizer for tests that upper cases the HTML
+type upperCaseSanitizer struct{}
+
+func (upperCaseSanitizer) Sanitize(html string) string {
+	return strings.ToUpper(html)
+}
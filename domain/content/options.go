@@ -0,0 +1,46 @@
+package content
+
+// Option tunes how NewPlainText and NewSanitizedHTML validate content
+type Option func(*options)
+
+type options struct {
+	maxLength          int
+	rejectControlChars bool
+	sanitizer          Sanitizer
+}
+
+// WithMaxLength overrides the maximum length in characters, MaxPlainTextLength for plain text
+// and MaxHTMLLength for HTML. Values below one keep the default.
+func WithMaxLength(maxLength int) Option {
+	return func(o *options) {
+		if maxLength > 0 {
+			o.maxLength = maxLength
+		}
+	}
+}
+
+// RejectControlChars makes NewPlainText reject text holding control characters instead of
+// stripping them
+func RejectControlChars() Option {
+	return func(o *options) {
+		o.rejectControlChars = true
+	}
+}
+
+// WithSanitizer makes NewSanitizedHTML clean the markup with the sanitizer instead of
+// DefaultSanitizer. Nil keeps the default.
+func WithSanitizer(sanitizer Sanitizer) Option {
+	return func(o *options) {
+		if sanitizer != nil {
+			o.sanitizer = sanitizer
+		}
+	}
+}
+
+// applyOptions applies the options on top of the defaults
+func applyOptions(defaults options, opts []Option) options {
+	for _, opt := range opts {
+		opt(&defaults)
+	}
+	return defaults
+}
@@ -0,0 +1,145 @@
+package content
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MaxPlainTextLength is the default maximum length of plain text, in characters
+const MaxPlainTextLength = 10000
+
+var (
+	ErrEmptyText = domain.NewLocalizedError(
+		"content.text.empty", nil,
+		"text cannot be empty",
+	)
+	ErrTooLongText = domain.NewLocalizedError(
+		"content.text.too_long", domain.MessageParams{"max": MaxPlainTextLength},
+		"text cannot exceed %d characters",
+		MaxPlainTextLength,
+	)
+	ErrInvalidTextChars = domain.NewLocalizedError(
+		"content.text.invalid_chars", nil,
+		"text contains invalid characters",
+	)
+)
+
+// PlainText is user-written text, such as a comment or a product description, free of
+// control characters that could corrupt logs, terminals or the rendering of other content
+type PlainText struct {
+	value string
+}
+
+// NewPlainText creates a new instance of PlainText with validation and normalization.
+// Surrounding whitespace is trimmed, line endings become "\n", and control characters other
+// than line feeds and tabs, as well as bidirectional overrides that can disguise text, are
+// stripped unless RejectControlChars is given. Invalid UTF-8 is always rejected.
+func NewPlainText(value string, opts ...Option) (PlainText, error) {
+	config := applyOptions(options{maxLength: MaxPlainTextLength}, opts)
+	normalized, err := normalizePlainText(value, config)
+	if err != nil {
+		return PlainText{}, err
+	}
+
+	return PlainText{
+		value: normalized,
+	}, nil
+}
+
+// ReconstitutePlainText creates a new PlainText instance without validation or normalization
+func ReconstitutePlainText(value string) PlainText {
+	return PlainText{
+		value: value,
+	}
+}
+
+// Value returns the text
+func (p PlainText) Value() string {
+	return p.value
+}
+
+// Length returns the length of the text in characters
+func (p PlainText) Length() int {
+	return utf8.RuneCountInString(p.value)
+}
+
+// Equals compares two PlainText objects for equality
+func (p PlainText) Equals(other PlainText) bool {
+	return p.value == other.value
+}
+
+// String returns a string representation of the text
+func (p PlainText) String() string {
+	return p.value
+}
+
+// UnmarshalText parses the text from text, with validation and normalization under the
+// default limits. Empty text leaves the value unchanged.
+func (p *PlainText) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewPlainText(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// normalizePlainText trims the text, unifies line endings and strips or rejects control
+// characters, then checks its length
+func normalizePlainText(value string, config options) (string, error) {
+	if !utf8.ValidString(value) {
+		return "", ErrInvalidTextChars
+	}
+
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	value = strings.ReplaceAll(value, "\r", "\n")
+
+	var result strings.Builder
+	result.Grow(len(value))
+	for _, r := range value {
+		if isDisallowedTextRune(r) {
+			if config.rejectControlChars {
+				return "", ErrInvalidTextChars
+			}
+			continue
+		}
+		result.WriteRune(r)
+	}
+
+	normalized := strings.TrimSpace(result.String())
+	if normalized == "" {
+		return "", ErrEmptyText
+	}
+	if utf8.RuneCountInString(normalized) > config.maxLength {
+		return "", tooLong(ErrTooLongText, config.maxLength, MaxPlainTextLength)
+	}
+
+	return normalized, nil
+}
+
+// isDisallowedTextRune reports whether r is a control character other than a line feed or
+// tab, or a bidirectional override or isolate
+func isDisallowedTextRune(r rune) bool {
+	if r == '\n' || r == '\t' {
+		return false
+	}
+	return unicode.IsControl(r) ||
+		(r >= '\u202A' && r <= '\u202E') ||
+		(r >= '\u2066' && r <= '\u2069')
+}
+
+// tooLong returns err, carrying the configured limit when it differs from the default
+func tooLong(err *domain.Error, maxLength, defaultMaxLength int) error {
+	if maxLength == defaultMaxLength {
+		return err
+	}
+	return err.WithParams(domain.MessageParams{"max": maxLength})
+}
@@ -0,0 +1,120 @@
+package content
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type PlainTextTestSuite struct {
+	suite.Suite
+}
+
+func TestPlainTextSuite(t *testing.T) {
+	suite.Run(t, new(PlainTextTestSuite))
+}
+
+func (s *PlainTextTestSuite) TestItCanBuildNewPlainTextWithValidValues() {
+	for _, value := range []string{
+		"Hello", "Line one\nLine two", "Tab\tseparated", "Ünïcödé 日本語 👋",
+		strings.Repeat("a", MaxPlainTextLength), strings.Repeat("é", MaxPlainTextLength),
+	} {
+		text, err := NewPlainText(value)
+		s.NoError(err, value)
+		s.Equal(value, text.Value())
+		s.Equal(value, text.String())
+	}
+}
+
+func (s *PlainTextTestSuite) TestItNormalizesPlainText() {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"  Hello  ", "Hello"},
+		{"one\r\ntwo\rthree", "one\ntwo\nthree"},
+		{"bell\a and null\x00", "bell and null"},
+		{"escape \x1b[31mred", "escape [31mred"},
+		{"evil\u202Etxt.exe", "eviltxt.exe"},
+		{"isolate\u2066d\u2069", "isolated"},
+		{"next line\u0085", "next line"},
+	}
+
+	for _, tc := range testCases {
+		text, err := NewPlainText(tc.value)
+		s.NoError(err, tc.value)
+		s.Equal(tc.expected, text.Value(), tc.value)
+	}
+}
+
+func (s *PlainTextTestSuite) TestItFailsToBuildNewPlainTextWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyText},
+		{" \n\t ", ErrEmptyText},
+		{"\x00\x01", ErrEmptyText},
+		{strings.Repeat("a", MaxPlainTextLength+1), ErrTooLongText},
+		{"invalid \xff UTF-8", ErrInvalidTextChars},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewPlainText(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *PlainTextTestSuite) TestItRejectsControlCharsWhenConfigured() {
+	for _, value := range []string{"bell\a", "evil\u202Etxt", "null\x00"} {
+		_, err := NewPlainText(value, RejectControlChars())
+		s.ErrorIs(err, ErrInvalidTextChars, value)
+	}
+
+	text, err := NewPlainText(" one\r\ntwo\tthree ", RejectControlChars())
+	s.NoError(err)
+	s.Equal("one\ntwo\tthree", text.Value())
+}
+
+func (s *PlainTextTestSuite) TestItAppliesACustomMaxLength() {
+	text, err := NewPlainText("héllo", WithMaxLength(5))
+	s.NoError(err)
+	s.Equal(5, text.Length())
+
+	_, err = NewPlainText("héllo!", WithMaxLength(5))
+	s.ErrorIs(err, ErrTooLongText)
+	var domainErr *domain.Error
+	s.Require().True(errors.As(err, &domainErr))
+	s.Equal(domain.MessageParams{"max": 5}, domainErr.MessageParams())
+
+	_, err = NewPlainText(strings.Repeat("a", MaxPlainTextLength), WithMaxLength(0))
+	s.NoError(err)
+}
+
+func (s *PlainTextTestSuite) TestItCanReconstitutePlainText() {
+	text := ReconstitutePlainText("any\x00value")
+	s.Equal("any\x00value", text.Value())
+}
+
+func (s *PlainTextTestSuite) TestItComparesPlainText() {
+	first, _ := NewPlainText("Hello")
+	second, _ := NewPlainText(" Hello ")
+	third, _ := NewPlainText("World")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *PlainTextTestSuite) TestItUnmarshalsText() {
+	var text PlainText
+	s.NoError(text.UnmarshalText([]byte(" Hello\x07 ")))
+	s.Equal("Hello", text.Value())
+
+	s.NoError(text.UnmarshalText(nil))
+	s.Equal("Hello", text.Value())
+
+	s.ErrorIs(text.UnmarshalText([]byte("   ")), ErrEmptyText)
+}
@@ -0,0 +1,170 @@
+// Package dataexport describes value objects for analytics pipelines consuming domain events:
+// Avro schemas for event streams and flat, Parquet-friendly columns for warehouse tables.
+//
+// Both forms agree on how each value object is split: Money is an amount and a currency,
+// FullName a first, middle and last name, integer identifiers are longs and every other value
+// object is its canonical string. Amounts are exact decimal strings, as in the JSON form.
+//
+//	schema := dataexport.AvroRecord("OrderPlaced", "com.example.orders",
+//		dataexport.AvroFieldOf[identifier.IntIdentifier]("orderId"),
+//		dataexport.AvroFieldOf[finance.Money]("total"),
+//		dataexport.OptionalAvroFieldOf[web.Email]("customerEmail"),
+//	)
+//	columns, err := dataexport.NewRow().
+//		Add("order_id", event.OrderID).
+//		Add("total", event.Total). // total_amount, total_currency
+//		Columns()
+package dataexport
+
+import (
+	"encoding/json"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+)
+
+// Avro primitive and logical type names used by the value objects
+const (
+	AvroString     = "string"
+	AvroLong       = "long"
+	AvroNull       = "null"
+	AvroRecordType = "record"
+	AvroUUID       = "uuid"
+)
+
+// AvroSchema is an Avro schema, modelling only the attributes the value objects need. It
+// marshals to the JSON schema declaration Avro libraries parse.
+type AvroSchema struct {
+	Type        string      `json:"type"`
+	Name        string      `json:"name,omitempty"`
+	Namespace   string      `json:"namespace,omitempty"`
+	Doc         string      `json:"doc,omitempty"`
+	LogicalType string      `json:"logicalType,omitempty"`
+	Fields      []AvroField `json:"fields,omitempty"`
+}
+
+// AvroField is a field of an Avro record. Type is a type name, an AvroSchema or a union
+// ([]any).
+type AvroField struct {
+	Name string
+	Type any
+	Doc  string
+	// Optional fields are a union with null and default to null
+	Optional bool
+}
+
+// avroFieldJSON is the JSON declaration of an AvroField
+type avroFieldJSON struct {
+	Name    string `json:"name"`
+	Type    any    `json:"type"`
+	Doc     string `json:"doc,omitempty"`
+	Default *any   `json:"default,omitempty"`
+}
+
+// MarshalJSON encodes the field declaration, with a null default for optional fields
+func (f AvroField) MarshalJSON() ([]byte, error) {
+	field := avroFieldJSON{
+		Name: f.Name,
+		Type: f.Type,
+		Doc:  f.Doc,
+	}
+	if f.Optional {
+		field.Default = new(any)
+	}
+	return json.Marshal(field)
+}
+
+// AvroRecord returns a record schema with the fields. Named types used by several fields,
+// such as two Money fields, are declared on first use and referenced by name afterwards, as
+// Avro requires.
+func AvroRecord(name, namespace string, fields ...AvroField) AvroSchema {
+	declared := make(map[string]bool)
+	resolved := make([]AvroField, len(fields))
+	for i, field := range fields {
+		field.Type = declareOnce(field.Type, declared)
+		resolved[i] = field
+	}
+
+	return AvroSchema{
+		Type:      AvroRecordType,
+		Name:      name,
+		Namespace: namespace,
+		Fields:    resolved,
+	}
+}
+
+// AvroTypeOf returns the Avro type of the value object T: a record for Money and FullName,
+// a long for integer identifiers, a uuid string for UUID and a string otherwise
+func AvroTypeOf[T any]() any {
+	var value T
+	switch any(value).(type) {
+	case finance.Money:
+		return AvroSchema{
+			Type: AvroRecordType,
+			Name: "Money",
+			Doc:  "Amount of money in a currency",
+			Fields: []AvroField{
+				{Name: "amount", Type: AvroString, Doc: "Exact decimal amount"},
+				{Name: "currency", Type: AvroString, Doc: "ISO 4217 currency code"},
+			},
+		}
+	case person.FullName:
+		return AvroSchema{
+			Type: AvroRecordType,
+			Name: "FullName",
+			Fields: []AvroField{
+				{Name: "firstName", Type: AvroString},
+				{Name: "middleName", Type: AvroString},
+				{Name: "lastName", Type: AvroString},
+			},
+		}
+	case identifier.IntIdentifier, identifier.StringIntIdentifier, identifier.Snowflake:
+		return AvroLong
+	case identifier.UUID:
+		return AvroSchema{Type: AvroString, LogicalType: AvroUUID}
+	default:
+		return AvroString
+	}
+}
+
+// AvroFieldOf returns a required field holding the value object T
+func AvroFieldOf[T any](name string) AvroField {
+	return AvroField{
+		Name: name,
+		Type: AvroTypeOf[T](),
+	}
+}
+
+// OptionalAvroFieldOf returns a field holding the value object T or null, null by default
+func OptionalAvroFieldOf[T any](name string) AvroField {
+	return AvroField{
+		Name:     name,
+		Type:     []any{AvroNull, AvroTypeOf[T]()},
+		Optional: true,
+	}
+}
+
+// declareOnce replaces named record types already declared with a reference by name,
+// including inside unions
+func declareOnce(avroType any, declared map[string]bool) any {
+	switch avroType := avroType.(type) {
+	case AvroSchema:
+		if avroType.Name == "" {
+			return avroType
+		}
+		if declared[avroType.Name] {
+			return avroType.Name
+		}
+		declared[avroType.Name] = true
+		return avroType
+	case []any:
+		union := make([]any, len(avroType))
+		for i, member := range avroType {
+			union[i] = declareOnce(member, declared)
+		}
+		return union
+	default:
+		return avroType
+	}
+}
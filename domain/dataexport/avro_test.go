@@ -0,0 +1,78 @@
+package dataexport
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type AvroTestSuite struct {
+	suite.Suite
+}
+
+func TestAvroSuite(t *testing.T) {
+	suite.Run(t, new(AvroTestSuite))
+}
+
+func (s *AvroTestSuite) TestItMapsValueObjectsToAvroTypes() {
+	s.Equal(AvroString, AvroTypeOf[web.Email]())
+	s.Equal(AvroString, AvroTypeOf[geography.CountryCode]())
+	s.Equal(AvroLong, AvroTypeOf[identifier.IntIdentifier]())
+	s.Equal(AvroLong, AvroTypeOf[identifier.Snowflake]())
+	s.Equal(AvroSchema{Type: AvroString, LogicalType: AvroUUID}, AvroTypeOf[identifier.UUID]())
+
+	money, ok := AvroTypeOf[finance.Money]().(AvroSchema)
+	s.Require().True(ok)
+	s.Equal("Money", money.Name)
+	s.Len(money.Fields, 2)
+
+	name, ok := AvroTypeOf[person.FullName]().(AvroSchema)
+	s.Require().True(ok)
+	s.Equal([]string{"firstName", "middleName", "lastName"}, fieldNames(name))
+}
+
+func (s *AvroTestSuite) TestItEmitsARecordSchema() {
+	schema := AvroRecord("OrderPlaced", "com.example.orders",
+		AvroFieldOf[identifier.IntIdentifier]("orderId"),
+		OptionalAvroFieldOf[web.Email]("customerEmail"),
+	)
+
+	data, err := json.Marshal(schema)
+	s.Require().NoError(err)
+	s.JSONEq(`{
+		"type": "record",
+		"name": "OrderPlaced",
+		"namespace": "com.example.orders",
+		"fields": [
+			{"name": "orderId", "type": "long"},
+			{"name": "customerEmail", "type": ["null", "string"], "default": null}
+		]
+	}`, string(data))
+}
+
+func (s *AvroTestSuite) TestItDeclaresNamedTypesOnce() {
+	schema := AvroRecord("Invoice", "",
+		AvroFieldOf[finance.Money]("subtotal"),
+		OptionalAvroFieldOf[finance.Money]("discount"),
+		AvroFieldOf[finance.Money]("total"),
+	)
+
+	_, declared := schema.Fields[0].Type.(AvroSchema)
+	s.True(declared)
+	s.Equal([]any{AvroNull, "Money"}, schema.Fields[1].Type)
+	s.Equal("Money", schema.Fields[2].Type)
+}
+
+func fieldNames(schema AvroSchema) []string {
+	names := make([]string, len(schema.Fields))
+	for i, field := range schema.Fields {
+		names[i] = field.Name
+	}
+	return names
+}
@@ -0,0 +1,153 @@
+package dataexport
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+)
+
+// ColumnSeparator joins a field name and the name of a part of a value object, as in
+// "total_amount"
+const ColumnSeparator = "_"
+
+var ErrIdentifierOutOfRange = domain.NewError("identifier does not fit in a signed 64-bit column")
+
+// Column is a named column value: a string, an int64, or nil for zero values
+type Column struct {
+	Name  string
+	Value any
+}
+
+// Row flattens value objects into columns, keeping their order, for Parquet writers and
+// warehouse loaders. It is not safe for concurrent use.
+type Row struct {
+	columns []Column
+	errs    []error
+}
+
+// NewRow creates an empty Row
+func NewRow() *Row {
+	return &Row{}
+}
+
+// Add appends the columns of the value object stored under name
+func (r *Row) Add(name string, value any) *Row {
+	columns, err := flatten(name, value)
+	if err != nil {
+		r.errs = append(r.errs, domain.NewErrorWithWrap(err, "invalid column %q", name).
+			WithField("column", name))
+	}
+	r.columns = append(r.columns, columns...)
+	return r
+}
+
+// Columns returns the columns added so far, or an error wrapping every failure
+func (r *Row) Columns() ([]Column, error) {
+	if len(r.errs) > 0 {
+		return nil, domain.NewErrorWithWrapAll(r.errs, "invalid row")
+	}
+	return r.columns, nil
+}
+
+// Map returns the columns added so far keyed by name, as accepted by most Parquet writers
+func (r *Row) Map() (map[string]any, error) {
+	columns, err := r.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]any, len(columns))
+	for _, column := range columns {
+		values[column.Name] = column.Value
+	}
+	return values, nil
+}
+
+// ColumnNames returns the names of the columns a value object T stored under name is split
+// into, for table definitions
+func ColumnNames[T any](name string) []string {
+	var value T
+	parts := partsOf(value)
+	if parts == nil {
+		return []string{name}
+	}
+
+	names := make([]string, len(parts))
+	for i, part := range parts {
+		names[i] = name + ColumnSeparator + part.Name
+	}
+	return names
+}
+
+// partsOf returns the parts of a value object split over several columns, or nil
+func partsOf(value any) []Column {
+	switch value := value.(type) {
+	case finance.Money:
+		return []Column{
+			{Name: "amount", Value: value.Amount().String()},
+			{Name: "currency", Value: value.Currency().Value()},
+		}
+	case person.FullName:
+		return []Column{
+			{Name: "first_name", Value: value.FirstName()},
+			{Name: "middle_name", Value: value.MiddleName()},
+			{Name: "last_name", Value: value.LastName()},
+		}
+	default:
+		return nil
+	}
+}
+
+// flatten returns the columns of the value object, all nil for the zero value
+func flatten(name string, value any) ([]Column, error) {
+	isZero := value == nil || reflect.ValueOf(value).IsZero()
+	if parts := partsOf(value); parts != nil {
+		for i := range parts {
+			parts[i].Name = name + ColumnSeparator + parts[i].Name
+			if isZero {
+				parts[i].Value = nil
+			}
+		}
+		return parts, nil
+	}
+
+	if isZero {
+		return []Column{{Name: name}}, nil
+	}
+
+	column, err := scalarValue(value)
+	return []Column{{Name: name, Value: column}}, err
+}
+
+// scalarValue returns the single-column value of a value object: an int64 for integer
+// identifiers and the canonical string otherwise
+func scalarValue(value any) (any, error) {
+	switch value := value.(type) {
+	case identifier.IntIdentifier:
+		return toInt64(value.Value())
+	case identifier.StringIntIdentifier:
+		return toInt64(value.Value())
+	case identifier.Snowflake:
+		return toInt64(value.Value())
+	case encoding.TextMarshaler:
+		text, err := value.MarshalText()
+		return string(text), err
+	case interface{ Value() string }:
+		return value.Value(), nil
+	default:
+		return fmt.Sprint(value), nil
+	}
+}
+
+func toInt64(value uint64) (any, error) {
+	if value > math.MaxInt64 {
+		return nil, ErrIdentifierOutOfRange
+	}
+	return int64(value), nil
+}
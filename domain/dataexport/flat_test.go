@@ -0,0 +1,77 @@
+package dataexport
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type FlatTestSuite struct {
+	suite.Suite
+}
+
+func TestFlatSuite(t *testing.T) {
+	suite.Run(t, new(FlatTestSuite))
+}
+
+func (s *FlatTestSuite) TestItFlattensValueObjectsIntoColumns() {
+	total, err := finance.NewMoneyFromString("19.99", "EUR")
+	s.Require().NoError(err)
+
+	columns, err := NewRow().
+		Add("order_id", identifier.ReconstituteIntIdentifier(42)).
+		Add("total", total).
+		Add("customer", person.ReconstituteFullName("Jane", "", "Doe")).
+		Add("country", geography.ReconstituteCountryCode("RO")).
+		Columns()
+	s.Require().NoError(err)
+
+	s.Equal([]Column{
+		{Name: "order_id", Value: int64(42)},
+		{Name: "total_amount", Value: "19.99"},
+		{Name: "total_currency", Value: "EUR"},
+		{Name: "customer_first_name", Value: "Jane"},
+		{Name: "customer_middle_name", Value: ""},
+		{Name: "customer_last_name", Value: "Doe"},
+		{Name: "country", Value: "RO"},
+	}, columns)
+}
+
+func (s *FlatTestSuite) TestItStoresZeroValuesAsNull() {
+	values, err := NewRow().
+		Add("total", finance.Money{}).
+		Add("email", web.Email{}).
+		Add("note", nil).
+		Map()
+	s.Require().NoError(err)
+
+	s.Equal(map[string]any{
+		"total_amount":   nil,
+		"total_currency": nil,
+		"email":          nil,
+		"note":           nil,
+	}, values)
+}
+
+func (s *FlatTestSuite) TestItReportsIdentifiersOutOfRange() {
+	_, err := NewRow().
+		Add("order_id", identifier.ReconstituteIntIdentifier(math.MaxUint64)).
+		Add("country", geography.ReconstituteCountryCode("RO")).
+		Map()
+	s.ErrorIs(err, ErrIdentifierOutOfRange)
+}
+
+func (s *FlatTestSuite) TestItNamesTheColumnsOfAValueObject() {
+	s.Equal([]string{"total_amount", "total_currency"}, ColumnNames[finance.Money]("total"))
+	s.Equal(
+		[]string{"buyer_first_name", "buyer_middle_name", "buyer_last_name"},
+		ColumnNames[person.FullName]("buyer"),
+	)
+	s.Equal([]string{"email"}, ColumnNames[web.Email]("email"))
+}
@@ -0,0 +1,248 @@
+// Package domainevent wraps domain events in a versioned JSON envelope, so event-sourced
+// systems store and publish events built from the value objects in one consistent format:
+//
+//	{"type": "order.placed", "version": 2, "occurredAt": "...", "payload": {...}}
+//
+// Event types are registered once, with their current schema version and the upgrades turning
+// payloads of older versions into the current one:
+//
+//	events := domainevent.NewRegistry()
+//	err := domainevent.Register[OrderPlaced](events, "order.placed", 2,
+//		domainevent.WithUpgrade(1, orderPlacedV1ToV2),
+//	)
+//	envelope, err := events.Encode(OrderPlaced{OrderID: id, Total: total})
+//	...
+//	event, err := events.Decode(envelope) // an OrderPlaced
+//
+// Payloads are encoded with encoding/json, which goes through the value objects' own JSON
+// codecs, so every value object in an event is validated again when the event is decoded.
+package domainevent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrInvalidEnvelope = domain.NewError(
+		"event envelope needs a type, a version and a payload",
+	)
+	ErrUnknownEventType     = domain.NewError("event type is not registered")
+	ErrUnregisteredEvent    = domain.NewError("event has no registered type")
+	ErrDuplicateEventType   = domain.NewError("event type is already registered")
+	ErrUnsupportedVersion   = domain.NewError("event version cannot be decoded")
+	ErrUnexpectedEventValue = domain.NewError("event is of another Go type")
+)
+
+// Envelope is a serialized event together with its type, schema version and occurrence time
+type Envelope struct {
+	Type       string
+	Version    uint64
+	OccurredAt time.Time
+	Payload    json.RawMessage
+}
+
+// envelopeJSON is the JSON representation of Envelope
+type envelopeJSON struct {
+	Type       string          `json:"type"`
+	Version    uint64          `json:"version"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// MarshalJSON encodes the envelope as a JSON object
+func (e Envelope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(envelopeJSON(e))
+}
+
+// UnmarshalJSON decodes the envelope, checking that the type, version and payload are set
+func (e *Envelope) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw envelopeJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid event envelope JSON")
+	}
+	if raw.Type == "" || raw.Version == 0 || len(raw.Payload) == 0 {
+		return ErrInvalidEnvelope
+	}
+
+	*e = Envelope(raw)
+	return nil
+}
+
+// Upgrade rewrites the payload of one schema version into the payload of the next one
+type Upgrade func(payload json.RawMessage) (json.RawMessage, error)
+
+// CodecOption configures a registered event type
+type CodecOption func(*codec)
+
+// WithUpgrade registers how payloads of version from are turned into payloads of version
+// from+1. Upgrades are chained, so an event several versions behind is upgraded step by step.
+func WithUpgrade(from uint64, upgrade Upgrade) CodecOption {
+	return func(c *codec) {
+		c.upgrades[from] = upgrade
+	}
+}
+
+// codec holds how one event type is versioned and decoded
+type codec struct {
+	eventType string
+	version   uint64
+	upgrades  map[uint64]Upgrade
+	decode    func(payload json.RawMessage) (any, error)
+}
+
+// RegistryOption configures a Registry
+type RegistryOption func(*Registry)
+
+// WithClock sets the clock stamping OccurredAt on encoded events, instead of the current UTC
+// time
+func WithClock(now func() time.Time) RegistryOption {
+	return func(r *Registry) {
+		r.now = now
+	}
+}
+
+// Registry maps event types to Go types and back. It is safe for concurrent use.
+type Registry struct {
+	mu     sync.RWMutex
+	byName map[string]*codec
+	byGo   map[reflect.Type]*codec
+	now    func() time.Time
+}
+
+// NewRegistry creates a Registry without event types
+func NewRegistry(opts ...RegistryOption) *Registry {
+	registry := &Registry{
+		byName: make(map[string]*codec),
+		byGo:   make(map[reflect.Type]*codec),
+		now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+	for _, opt := range opts {
+		opt(registry)
+	}
+	return registry
+}
+
+// Register adds the event type T under eventType, written with the given schema version
+// (starting at 1)
+func Register[T any](
+	r *Registry,
+	eventType string,
+	version uint64,
+	opts ...CodecOption,
+) error {
+	if eventType == "" || version == 0 {
+		return ErrInvalidEnvelope
+	}
+
+	eventCodec := &codec{
+		eventType: eventType,
+		version:   version,
+		upgrades:  make(map[uint64]Upgrade),
+		decode: func(payload json.RawMessage) (any, error) {
+			var event T
+			if err := json.Unmarshal(payload, &event); err != nil {
+				return nil, err
+			}
+			return event, nil
+		},
+	}
+	for _, opt := range opts {
+		opt(eventCodec)
+	}
+
+	goType := reflect.TypeFor[T]()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.byName[eventType]; exists {
+		return ErrDuplicateEventType.WithField("type", eventType)
+	}
+	if _, exists := r.byGo[goType]; exists {
+		return ErrDuplicateEventType.WithField("type", goType.String())
+	}
+
+	r.byName[eventType] = eventCodec
+	r.byGo[goType] = eventCodec
+	return nil
+}
+
+// Encode wraps the event into an envelope of its registered type and current version,
+// stamped with the registry clock
+func (r *Registry) Encode(event any) (Envelope, error) {
+	r.mu.RLock()
+	eventCodec, found := r.byGo[reflect.TypeOf(event)]
+	r.mu.RUnlock()
+	if !found {
+		return Envelope{}, ErrUnregisteredEvent.WithField("type", fmt.Sprintf("%T", event))
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		Type:       eventCodec.eventType,
+		Version:    eventCodec.version,
+		OccurredAt: r.now(),
+		Payload:    payload,
+	}, nil
+}
+
+// Decode upgrades the payload to the current version of its event type and decodes it into
+// the registered Go type, validating every value object
+func (r *Registry) Decode(envelope Envelope) (any, error) {
+	r.mu.RLock()
+	eventCodec, found := r.byName[envelope.Type]
+	r.mu.RUnlock()
+	if !found {
+		return nil, ErrUnknownEventType.WithField("type", envelope.Type)
+	}
+
+	if envelope.Version > eventCodec.version {
+		return nil, ErrUnsupportedVersion.WithField("version", envelope.Version)
+	}
+
+	payload := envelope.Payload
+	for version := envelope.Version; version < eventCodec.version; version++ {
+		upgrade, found := eventCodec.upgrades[version]
+		if !found {
+			return nil, ErrUnsupportedVersion.WithField("version", envelope.Version)
+		}
+
+		upgraded, err := upgrade(payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = upgraded
+	}
+
+	return eventCodec.decode(payload)
+}
+
+// Decode decodes the envelope with the registry and checks that the event is a T
+func Decode[T any](r *Registry, envelope Envelope) (T, error) {
+	var zero T
+	event, err := r.Decode(envelope)
+	if err != nil {
+		return zero, err
+	}
+
+	typed, ok := event.(T)
+	if !ok {
+		return zero, ErrUnexpectedEventValue.WithField("type", envelope.Type)
+	}
+	return typed, nil
+}
@@ -0,0 +1,180 @@
+package domainevent
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderPlaced struct {
+	OrderID identifier.IntIdentifier `json:"orderId"`
+	Total   finance.Money            `json:"total"`
+}
+
+type orderCancelled struct {
+	Reason string `json:"reason"`
+}
+
+type DomainEventTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func TestDomainEventSuite(t *testing.T) {
+	suite.Run(t, new(DomainEventTestSuite))
+}
+
+func (s *DomainEventTestSuite) SetupTest() {
+	s.now = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *DomainEventTestSuite) newRegistry() *Registry {
+	return NewRegistry(WithClock(func() time.Time {
+		return s.now
+	}))
+}
+
+func (s *DomainEventTestSuite) newOrderPlaced() orderPlaced {
+	orderID, err := identifier.NewIntIdentifier(42)
+	s.Require().NoError(err)
+	total, err := finance.NewMoneyFromString("19.99", "EUR")
+	s.Require().NoError(err)
+	return orderPlaced{OrderID: orderID, Total: total}
+}
+
+func (s *DomainEventTestSuite) TestItCanRoundTripEventsThroughJSONEnvelopes() {
+	registry := s.newRegistry()
+	s.Require().NoError(Register[orderPlaced](registry, "order.placed", 1))
+	event := s.newOrderPlaced()
+
+	envelope, err := registry.Encode(event)
+	s.Require().NoError(err)
+	s.Equal("order.placed", envelope.Type)
+	s.Equal(uint64(1), envelope.Version)
+	s.Equal(s.now, envelope.OccurredAt)
+
+	data, err := json.Marshal(envelope)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{"type":"order.placed","version":1,"occurredAt":"2024-03-01T12:00:00Z",`+
+			`"payload":`+string(envelope.Payload)+`}`,
+		string(data),
+	)
+
+	var decodedEnvelope Envelope
+	s.Require().NoError(json.Unmarshal(data, &decodedEnvelope))
+	decoded, err := Decode[orderPlaced](registry, decodedEnvelope)
+	s.Require().NoError(err)
+	s.True(event.OrderID.Equals(decoded.OrderID))
+	s.True(event.Total.Equals(decoded.Total))
+}
+
+func (s *DomainEventTestSuite) TestItValidatesValueObjectsWhenDecoding() {
+	registry := s.newRegistry()
+	s.Require().NoError(Register[orderPlaced](registry, "order.placed", 1))
+
+	_, err := registry.Decode(Envelope{
+		Type:    "order.placed",
+		Version: 1,
+		Payload: json.RawMessage(`{"orderId":0}`),
+	})
+	s.Error(err)
+}
+
+func (s *DomainEventTestSuite) TestItUpgradesOlderPayloadsStepByStep() {
+	registry := s.newRegistry()
+	rename := func(from, to string) Upgrade {
+		return func(payload json.RawMessage) (json.RawMessage, error) {
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(payload, &fields); err != nil {
+				return nil, err
+			}
+			fields[to] = fields[from]
+			delete(fields, from)
+			return json.Marshal(fields)
+		}
+	}
+	s.Require().NoError(Register[orderCancelled](registry, "order.cancelled", 3,
+		WithUpgrade(1, rename("why", "cause")),
+		WithUpgrade(2, rename("cause", "reason")),
+	))
+
+	decoded, err := Decode[orderCancelled](registry, Envelope{
+		Type:    "order.cancelled",
+		Version: 1,
+		Payload: json.RawMessage(`{"why":"out of stock"}`),
+	})
+	s.Require().NoError(err)
+	s.Equal("out of stock", decoded.Reason)
+}
+
+func (s *DomainEventTestSuite) TestItFailsToDecodeUnsupportedVersions() {
+	registry := s.newRegistry()
+	s.Require().NoError(Register[orderCancelled](registry, "order.cancelled", 2))
+
+	for _, version := range []uint64{1, 3} {
+		_, err := registry.Decode(Envelope{
+			Type:    "order.cancelled",
+			Version: version,
+			Payload: json.RawMessage(`{}`),
+		})
+		s.True(errors.Is(err, ErrUnsupportedVersion), "version %d", version)
+	}
+}
+
+func (s *DomainEventTestSuite) TestItFailsOnUnknownOrUnregisteredEvents() {
+	registry := s.newRegistry()
+
+	_, err := registry.Encode(orderCancelled{})
+	s.True(errors.Is(err, ErrUnregisteredEvent))
+	_, err = registry.Encode(nil)
+	s.True(errors.Is(err, ErrUnregisteredEvent))
+
+	_, err = registry.Decode(Envelope{Type: "unknown", Version: 1, Payload: []byte(`{}`)})
+	s.True(errors.Is(err, ErrUnknownEventType))
+}
+
+func (s *DomainEventTestSuite) TestItFailsToDecodeIntoAnotherGoType() {
+	registry := s.newRegistry()
+	s.Require().NoError(Register[orderCancelled](registry, "order.cancelled", 1))
+
+	_, err := Decode[orderPlaced](registry, Envelope{
+		Type:    "order.cancelled",
+		Version: 1,
+		Payload: json.RawMessage(`{"reason":"duplicate"}`),
+	})
+	s.True(errors.Is(err, ErrUnexpectedEventValue))
+}
+
+func (s *DomainEventTestSuite) TestItRejectsDuplicateRegistrations() {
+	registry := s.newRegistry()
+	s.Require().NoError(Register[orderCancelled](registry, "order.cancelled", 1))
+
+	err := Register[orderCancelled](registry, "order.voided", 1)
+	s.True(errors.Is(err, ErrDuplicateEventType))
+	err = Register[orderPlaced](registry, "order.cancelled", 1)
+	s.True(errors.Is(err, ErrDuplicateEventType))
+	err = Register[orderPlaced](registry, "order.placed", 0)
+	s.True(errors.Is(err, ErrInvalidEnvelope))
+}
+
+func (s *DomainEventTestSuite) TestItRejectsIncompleteEnvelopes() {
+	inputs := []string{
+		`{"version":1,"payload":{}}`,
+		`{"type":"order.placed","payload":{}}`,
+		`{"type":"order.placed","version":1}`,
+	}
+	for _, input := range inputs {
+		var envelope Envelope
+		err := json.Unmarshal([]byte(input), &envelope)
+		s.True(errors.Is(err, ErrInvalidEnvelope), input)
+	}
+
+	var envelope Envelope
+	s.Error(json.Unmarshal([]byte(`{"type":1}`), &envelope))
+}
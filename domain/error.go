@@ -3,18 +3,76 @@
 // and provides additional error comparison capabilities.
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+)
 
 // Error represents a domain-specific error in the system.
 // It's advised that all domain layer errors "inherit" from this type.
 type Error struct {
-	prevErr error
-	msg     string // internal error message
+	prevErr  error
+	msg      string // internal error message
+	key      string // message key used to translate the error
+	params   MessageParams
+	category Category
+	fields   map[string]any
+	stack    []uintptr // program counters captured when stack traces are enabled
+	origin   *Error    // error this copy was derived from
 }
 
 func NewError(format string, a ...any) *Error {
 	return &Error{
-		msg: fmt.Sprintf(format, a...),
+		msg:   fmt.Sprintf(format, a...),
+		stack: callers(callersSkip),
+	}
+}
+
+// NewLocalizedError creates a new Error carrying a message key and parameters, so a Translator
+// can render it in the user's language. The formatted message remains the default text.
+func NewLocalizedError(key string, params MessageParams, format string, a ...any) *Error {
+	return &Error{
+		msg:    fmt.Sprintf(format, a...),
+		key:    key,
+		params: params,
+		stack:  callers(callersSkip),
+	}
+}
+
+// NewInvariantError creates a new Error for an operation that would break a business rule.
+func NewInvariantError(format string, a ...any) *Error {
+	return newCategorizedError(CategoryInvariant, format, a...)
+}
+
+// NewNotFoundError creates a new Error for a missing entity or resource.
+func NewNotFoundError(format string, a ...any) *Error {
+	return newCategorizedError(CategoryNotFound, format, a...)
+}
+
+// NewConflictError creates a new Error for an operation that clashes with the current state.
+func NewConflictError(format string, a ...any) *Error {
+	return newCategorizedError(CategoryConflict, format, a...)
+}
+
+// NewForbiddenError creates a new Error for an operation the caller is not allowed to perform.
+func NewForbiddenError(format string, a ...any) *Error {
+	return newCategorizedError(CategoryForbidden, format, a...)
+}
+
+// NewInternalError creates a new Error for a failure the caller cannot fix, such as a
+// programming mistake.
+func NewInternalError(format string, a ...any) *Error {
+	return newCategorizedError(CategoryInternal, format, a...)
+}
+
+func newCategorizedError(category Category, format string, a ...any) *Error {
+	return &Error{
+		msg:      fmt.Sprintf(format, a...),
+		category: category,
+		stack:    callers(callersSkip + 1),
 	}
 }
 
@@ -23,6 +81,30 @@ func NewErrorWithWrap(err error, format string, a ...any) *Error {
 	return &Error{
 		prevErr: err,
 		msg:     fmt.Sprintf(format, a...),
+		stack:   callers(callersSkip),
+	}
+}
+
+// NewInternalErrorWithWrap creates a new Error that wraps an infrastructure failure, such as
+// an I/O or entropy error, so CategoryOf reports it as CategoryInternal rather than invalid
+// input.
+func NewInternalErrorWithWrap(err error, format string, a ...any) *Error {
+	return &Error{
+		prevErr:  err,
+		msg:      fmt.Sprintf(format, a...),
+		category: CategoryInternal,
+		stack:    callers(callersSkip),
+	}
+}
+
+// NewErrorWithWrapAll creates a new Error that wraps several causes, such as the failures
+// collected while validating a form. errors.Is and errors.As match each cause; nil causes are
+// dropped.
+func NewErrorWithWrapAll(errs []error, format string, a ...any) *Error {
+	return &Error{
+		prevErr: joinErrors(errs),
+		msg:     fmt.Sprintf(format, a...),
+		stack:   callers(callersSkip),
 	}
 }
 
@@ -38,3 +120,148 @@ func (e *Error) Error() string {
 func (e *Error) Unwrap() error {
 	return e.prevErr
 }
+
+// Causes returns the errors wrapped by the error: every cause given to NewErrorWithWrapAll,
+// the single wrapped error, or nil.
+func (e *Error) Causes() []error {
+	if joined, ok := e.prevErr.(joinedErrors); ok {
+		return slices.Clone(joined)
+	}
+	if e.prevErr != nil {
+		return []error{e.prevErr}
+	}
+	return nil
+}
+
+// Category returns the category the error was created with, or an empty Category. Use
+// CategoryOf to classify a whole error chain.
+func (e *Error) Category() Category {
+	return e.category
+}
+
+// MessageKey returns the key used to translate the error, or an empty string.
+func (e *Error) MessageKey() string {
+	return e.key
+}
+
+// MessageParams returns the parameters substituted into the translated message.
+func (e *Error) MessageParams() MessageParams {
+	return e.params
+}
+
+// WithParams returns a copy of the error with the parameters added to its own, e.g., the limit
+// a constructor option configured. The copy still matches the original with errors.Is.
+func (e *Error) WithParams(params MessageParams) *Error {
+	derived := e.derive()
+	derived.params = make(MessageParams, len(e.params)+len(params))
+	maps.Copy(derived.params, e.params)
+	maps.Copy(derived.params, params)
+	return derived
+}
+
+// WithField returns a copy of the error carrying a diagnostic field, such as the value a
+// caller attempted, so context travels with the error instead of being concatenated into
+// its message. The copy still matches the original with errors.Is.
+func (e *Error) WithField(name string, value any) *Error {
+	derived := e.derive()
+	derived.fields = make(map[string]any, len(e.fields)+1)
+	maps.Copy(derived.fields, e.fields)
+	derived.fields[name] = value
+	return derived
+}
+
+// Fields returns the diagnostic fields of the error and of the domain errors it wraps,
+// including every cause of NewErrorWithWrapAll or errors.Join. Fields set on outer errors
+// take precedence over wrapped ones with the same name, and earlier causes over later ones.
+func (e *Error) Fields() map[string]any {
+	fields := make(map[string]any)
+	collectFields(e, fields)
+	return fields
+}
+
+// collectFields adds the fields of the error tree to fields, visiting it depth-first in the
+// order errors.Is does and keeping the first value found for each name
+func collectFields(err error, fields map[string]any) {
+	if domainErr, ok := err.(*Error); ok {
+		for name, value := range domainErr.fields {
+			if _, exists := fields[name]; !exists {
+				fields[name] = value
+			}
+		}
+	}
+
+	switch err := err.(type) {
+	case interface{ Unwrap() error }:
+		if cause := err.Unwrap(); cause != nil {
+			collectFields(cause, fields)
+		}
+	case interface{ Unwrap() []error }:
+		for _, cause := range err.Unwrap() {
+			if cause != nil {
+				collectFields(cause, fields)
+			}
+		}
+	}
+}
+
+// LogValue implements slog.LogValuer, logging the message, the category and message key when
+// set, and the diagnostic fields in name order.
+func (e *Error) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.String("msg", e.Error())}
+	if category := CategoryOf(e); category != "" {
+		attrs = append(attrs, slog.String("category", string(category)))
+	}
+	if e.key != "" {
+		attrs = append(attrs, slog.String("key", e.key))
+	}
+
+	fields := e.Fields()
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		attrs = append(attrs, slog.Any(name, fields[name]))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// derive returns a copy of the error that matches the original with errors.Is.
+func (e *Error) derive() *Error {
+	derived := *e
+	if derived.origin == nil {
+		derived.origin = e
+	}
+	return &derived
+}
+
+// Is reports whether the error is a copy of target made by WithParams, WithField or WithStack.
+func (e *Error) Is(target error) bool {
+	return e.origin != nil && e.origin == target
+}
+
+// joinedErrors holds the causes of an Error wrapping several errors
+type joinedErrors []error
+
+// joinErrors drops the nil errors and returns the rest as a joinedErrors, the single
+// remaining error, or nil
+func joinErrors(errs []error) error {
+	causes := slices.DeleteFunc(slices.Clone(errs), func(err error) bool { return err == nil })
+	switch len(causes) {
+	case 0:
+		return nil
+	case 1:
+		return causes[0]
+	}
+	return joinedErrors(causes)
+}
+
+// Error returns the messages of the causes separated by semicolons.
+func (j joinedErrors) Error() string {
+	messages := make([]string, len(j))
+	for i, err := range j {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the causes, so errors.Is and errors.As inspect each of them.
+func (j joinedErrors) Unwrap() []error {
+	return j
+}
@@ -1,8 +1,11 @@
 package domain
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io/fs"
+	"log/slog"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -112,3 +115,169 @@ func (s *ErrorTestSuite) TestItCanHandleMixedWrappingWithFmtErrorf() {
 	secondUnwrap := errors.Unwrap(firstUnwrap)
 	s.Equal(domainErr, secondUnwrap, "Second unwrap should return the original domain error")
 }
+
+func (s *ErrorTestSuite) TestItCanCreateLocalizedError() {
+	err := NewLocalizedError(
+		"auth.password.too_short", MessageParams{"min": 8},
+		"password must be at least %d characters long", 8,
+	)
+
+	s.Equal("password must be at least 8 characters long", err.Error())
+	s.Equal("auth.password.too_short", err.MessageKey())
+	s.Equal(MessageParams{"min": 8}, err.MessageParams())
+	s.Empty(NewError("plain error").MessageKey())
+}
+
+func (s *ErrorTestSuite) TestWithParamsKeepsTheIdentityOfTheOriginal() {
+	sentinel := NewLocalizedError("web.email.too_long", MessageParams{"unit": "chars"}, "too long")
+
+	withParams := sentinel.WithParams(MessageParams{"max": 320})
+	s.ErrorIs(withParams, sentinel)
+	s.ErrorIs(fmt.Errorf("wrapped: %w", withParams), sentinel)
+	s.ErrorIs(withParams.WithParams(MessageParams{"max": 100}), sentinel)
+	s.NotErrorIs(withParams, NewLocalizedError("web.email.too_long", nil, "too long"))
+
+	s.Equal("too long", withParams.Error())
+	s.Equal("web.email.too_long", withParams.MessageKey())
+	s.Equal(MessageParams{"unit": "chars", "max": 320}, withParams.MessageParams())
+	s.Equal(MessageParams{"unit": "chars"}, sentinel.MessageParams())
+}
+
+func (s *ErrorTestSuite) TestItCanCreateCategorizedErrors() {
+	tests := []struct {
+		err      *Error
+		expected Category
+	}{
+		{NewInvariantError("order %s is already shipped", "A-1"), CategoryInvariant},
+		{NewNotFoundError("order %s not found", "A-1"), CategoryNotFound},
+		{NewConflictError("order %s was modified", "A-1"), CategoryConflict},
+		{NewForbiddenError("order %s belongs to another customer", "A-1"), CategoryForbidden},
+		{NewInternalError("order %s has no pricing rules", "A-1"), CategoryInternal},
+	}
+
+	for _, tt := range tests {
+		s.Run(string(tt.expected), func() {
+			s.Contains(tt.err.Error(), "order A-1")
+			s.Equal(tt.expected, tt.err.Category())
+			s.Equal(tt.expected, CategoryOf(tt.err))
+			s.Equal(tt.expected, CategoryOf(NewErrorWithWrap(tt.err, "placing order")))
+			s.Equal(tt.expected, tt.err.WithParams(MessageParams{"id": "A-1"}).Category())
+		})
+	}
+
+	s.Empty(NewError("plain error").Category())
+}
+
+func (s *ErrorTestSuite) TestItCarriesDiagnosticFields() {
+	sentinel := NewConflictError("order was modified")
+
+	withFields := sentinel.WithField("order", "A-1").WithField("version", 3)
+	s.ErrorIs(withFields, sentinel)
+	s.Equal("order was modified", withFields.Error())
+	s.Equal(CategoryConflict, withFields.Category())
+	s.Equal(map[string]any{"order": "A-1", "version": 3}, withFields.Fields())
+	s.Empty(sentinel.Fields())
+
+	wrapped := NewErrorWithWrap(
+		fmt.Errorf("saving: %w", withFields), "checkout failed",
+	).WithField("version", 4).WithField("attempt", 2)
+	s.Equal(
+		map[string]any{"order": "A-1", "version": 4, "attempt": 2},
+		wrapped.Fields(),
+		"outer fields take precedence",
+	)
+}
+
+func (s *ErrorTestSuite) TestItCollectsFieldsFromEveryCause() {
+	sentinel := NewError("invalid parameter")
+	wrapped := NewErrorWithWrapAll(
+		[]error{
+			sentinel.WithField("parameter", "page").WithField("source", "query"),
+			fmt.Errorf("decoding: %w", NewError("invalid body").WithField("offset", 12)),
+			errors.Join(
+				errors.New("plain cause"),
+				sentinel.WithField("parameter", "limit").WithField("header", "X-Limit"),
+			),
+		},
+		"request binding failed",
+	).WithField("source", "request")
+
+	s.Equal(
+		map[string]any{
+			"parameter": "page",
+			"source":    "request",
+			"offset":    12,
+			"header":    "X-Limit",
+		},
+		wrapped.Fields(),
+		"outer fields and earlier causes take precedence",
+	)
+}
+
+func (s *ErrorTestSuite) TestItLogsAsAStructuredGroup() {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}))
+
+	err := NewLocalizedError("finance.currency.invalid", nil, "invalid currency").
+		WithField("input", "EURO").
+		WithField("attempt", 1)
+	logger.Info("rejected", "err", err)
+
+	s.Equal(
+		`level=INFO msg=rejected err.msg="invalid currency" err.category=validation `+
+			`err.key=finance.currency.invalid err.attempt=1 err.input=EURO`+"\n",
+		buf.String(),
+	)
+}
+
+func (s *ErrorTestSuite) TestItCanWrapSeveralCauses() {
+	errEmail := NewError("email is invalid")
+	errCountry := NewNotFoundError("country is unknown")
+	errIO := errors.New("connection reset")
+
+	err := NewErrorWithWrapAll(
+		[]error{errEmail, nil, fmt.Errorf("lookup: %w", errCountry), errIO},
+		"signup of %s failed", "jane",
+	)
+
+	s.Equal(
+		"signup of jane failed: email is invalid; lookup: country is unknown; connection reset",
+		err.Error(),
+	)
+	s.ErrorIs(err, errEmail)
+	s.ErrorIs(err, errCountry)
+	s.ErrorIs(err, errIO)
+	s.NotErrorIs(err, errors.New("connection reset"))
+
+	var pathErr *fs.PathError
+	withPathErr := NewErrorWithWrapAll([]error{errEmail, &fs.PathError{Op: "open"}}, "failed")
+	s.Require().ErrorAs(withPathErr, &pathErr)
+	s.Equal("open", pathErr.Op)
+
+	s.Equal(CategoryNotFound, CategoryOf(err), "causes are searched in order")
+	s.Equal([]error{errEmail, fmt.Errorf("lookup: %w", errCountry), errIO}, err.Causes())
+
+	_, ok := err.Unwrap().(interface{ Unwrap() []error })
+	s.True(ok, "causes are exposed as Unwrap() []error")
+}
+
+func (s *ErrorTestSuite) TestWrapAllWithFewCauses() {
+	cause := errors.New("cause")
+
+	single := NewErrorWithWrapAll([]error{nil, cause}, "failed")
+	s.Equal("failed: cause", single.Error())
+	s.Equal(cause, single.Unwrap())
+	s.Equal([]error{cause}, single.Causes())
+
+	none := NewErrorWithWrapAll([]error{nil}, "failed")
+	s.Equal("failed", none.Error())
+	s.Nil(none.Unwrap())
+	s.Nil(none.Causes())
+}
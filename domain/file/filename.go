@@ -0,0 +1,269 @@
+package file
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MaxFileNameLength is the maximum length of a file name in bytes, the limit of most file
+// systems
+const MaxFileNameLength = 255
+
+var (
+	ErrEmptyFileName = domain.NewLocalizedError(
+		"file.name.empty", nil,
+		"file name cannot be empty",
+	)
+	ErrTooLongFileName = domain.NewLocalizedError(
+		"file.name.too_long", domain.MessageParams{"max": MaxFileNameLength},
+		"file name cannot exceed %d bytes",
+		MaxFileNameLength,
+	)
+	ErrInvalidFileNameChars = domain.NewLocalizedError(
+		"file.name.invalid_chars", nil,
+		"file name contains invalid characters",
+	)
+	ErrReservedFileName = domain.NewLocalizedError(
+		"file.name.reserved", nil,
+		"file name is reserved by the operating system",
+	)
+)
+
+// Platform selects the file system rules a FileName is validated against
+type Platform int
+
+const (
+	// PlatformPortable accepts only names valid on both Windows and POSIX systems
+	PlatformPortable Platform = iota
+	// PlatformPOSIX rejects only '/' and NUL, as Linux and macOS do
+	PlatformPOSIX
+	// PlatformWindows applies the Windows rules: no control characters, none of <>:"/\|?*,
+	// no reserved device names such as CON or COM1, and no trailing dot or space
+	PlatformWindows
+)
+
+// Option configures how file names are validated
+type Option func(*options)
+
+type options struct {
+	platform Platform
+}
+
+// WithPlatform validates file names against the rules of a platform, PlatformPortable by
+// default
+func WithPlatform(platform Platform) Option {
+	return func(o *options) {
+		o.platform = platform
+	}
+}
+
+// windowsReservedNames lists the device names Windows reserves, with or without extension
+var windowsReservedNames = map[string]struct{}{
+	"CON": {}, "PRN": {}, "AUX": {}, "NUL": {},
+	"COM1": {}, "COM2": {}, "COM3": {}, "COM4": {}, "COM5": {},
+	"COM6": {}, "COM7": {}, "COM8": {}, "COM9": {},
+	"LPT1": {}, "LPT2": {}, "LPT3": {}, "LPT4": {}, "LPT5": {},
+	"LPT6": {}, "LPT7": {}, "LPT8": {}, "LPT9": {},
+}
+
+// FileName is a single path element, such as "report.pdf", that is safe to create on disk
+type FileName struct {
+	value string
+}
+
+// NewFileName creates a new instance of FileName with validation. The name is not trimmed or
+// otherwise changed; use SanitizeFileName for names supplied by users.
+func NewFileName(value string, opts ...Option) (FileName, error) {
+	config := options{platform: PlatformPortable}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if err := isValidFileName(value, config.platform); err != nil {
+		return FileName{}, err
+	}
+
+	return FileName{
+		value: value,
+	}, nil
+}
+
+// ReconstituteFileName creates a new FileName instance without validation
+func ReconstituteFileName(value string) FileName {
+	return FileName{
+		value: value,
+	}
+}
+
+// SanitizeFileName turns an uploaded file name into a FileName valid on every platform: it
+// drops any directories, replaces invalid characters with '_', trims spaces and trailing
+// dots, prefixes reserved names with '_' and shortens long names, keeping the extension.
+func SanitizeFileName(value string) (FileName, error) {
+	if index := strings.LastIndexAny(value, `/\`); index >= 0 {
+		value = value[index+1:]
+	}
+
+	value = strings.Map(
+		func(r rune) rune {
+			if !isPortableFileNameRune(r) {
+				return '_'
+			}
+			return r
+		},
+		strings.ToValidUTF8(value, "_"),
+	)
+	value = strings.TrimRight(strings.TrimSpace(value), ". ")
+	if value == "" || value == "." || value == ".." {
+		return FileName{}, ErrEmptyFileName
+	}
+
+	if isWindowsReservedName(value) {
+		value = "_" + value
+	}
+	value = truncateFileName(value)
+
+	return NewFileName(value)
+}
+
+// IsValidFileName validates a file name against the portable rules
+func IsValidFileName(value string) error {
+	return isValidFileName(value, PlatformPortable)
+}
+
+// Value returns the file name
+func (f FileName) Value() string {
+	return f.value
+}
+
+// Extension returns the extension without its dot, e.g., "gz" for "backup.tar.gz", or an
+// empty string. A leading dot marks a hidden file, not an extension, so ".env" has none.
+func (f FileName) Extension() string {
+	index := strings.LastIndexByte(f.value, '.')
+	if index <= 0 {
+		return ""
+	}
+	return f.value[index+1:]
+}
+
+// Stem returns the file name without its extension, e.g., "backup.tar" for "backup.tar.gz"
+func (f FileName) Stem() string {
+	index := strings.LastIndexByte(f.value, '.')
+	if index <= 0 {
+		return f.value
+	}
+	return f.value[:index]
+}
+
+// HasExtension reports whether the file name has one of the extensions, compared
+// case-insensitively and given with or without their dot, e.g., an upload allowlist
+func (f FileName) HasExtension(extensions ...string) bool {
+	extension := f.Extension()
+	if extension == "" {
+		return false
+	}
+	for _, candidate := range extensions {
+		if strings.EqualFold(extension, strings.TrimPrefix(candidate, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equals compares two FileName objects for equality
+func (f FileName) Equals(other FileName) bool {
+	return f.value == other.value
+}
+
+// String returns a string representation of the file name
+func (f FileName) String() string {
+	return f.value
+}
+
+// UnmarshalText parses the file name from text, with validation against the portable rules.
+// Empty text leaves the value unchanged.
+func (f *FileName) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewFileName(string(text))
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+	return nil
+}
+
+// isValidFileName validates a file name against the rules of a platform
+func isValidFileName(value string, platform Platform) error {
+	if value == "" {
+		return ErrEmptyFileName
+	}
+	if len(value) > MaxFileNameLength {
+		return ErrTooLongFileName
+	}
+	if value == "." || value == ".." {
+		return ErrReservedFileName
+	}
+	if !utf8.ValidString(value) {
+		return ErrInvalidFileNameChars
+	}
+
+	windows := platform != PlatformPOSIX
+	for _, r := range value {
+		valid := r != 0 && r != '/'
+		if windows {
+			valid = isPortableFileNameRune(r)
+		}
+		if !valid {
+			return ErrInvalidFileNameChars
+		}
+	}
+
+	if windows {
+		if strings.HasSuffix(value, ".") || strings.HasSuffix(value, " ") {
+			return ErrInvalidFileNameChars
+		}
+		if isWindowsReservedName(value) {
+			return ErrReservedFileName
+		}
+	}
+
+	return nil
+}
+
+// isPortableFileNameRune reports whether r is allowed in file names on every platform
+func isPortableFileNameRune(r rune) bool {
+	return !unicode.IsControl(r) && !strings.ContainsRune(`<>:"/\|?*`, r)
+}
+
+// isWindowsReservedName reports whether the name, ignoring case and any extension, is a
+// Windows device name
+func isWindowsReservedName(value string) bool {
+	stem, _, _ := strings.Cut(value, ".")
+	_, reserved := windowsReservedNames[strings.ToUpper(strings.TrimRight(stem, " "))]
+	return reserved
+}
+
+// truncateFileName shortens a name to MaxFileNameLength bytes without splitting a character,
+// keeping a short extension
+func truncateFileName(value string) string {
+	if len(value) <= MaxFileNameLength {
+		return value
+	}
+
+	extension := ""
+	if index := strings.LastIndexByte(value, '.'); index > 0 && len(value)-index <= 16 {
+		value, extension = value[:index], value[index:]
+	}
+
+	limit := MaxFileNameLength - len(extension)
+	for limit > 0 && !utf8.RuneStart(value[limit]) {
+		limit--
+	}
+	return strings.TrimRight(value[:limit], ". ") + extension
+}
@@ -0,0 +1,146 @@
+package file
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FileNameTestSuite struct {
+	suite.Suite
+}
+
+func TestFileNameSuite(t *testing.T) {
+	suite.Run(t, new(FileNameTestSuite))
+}
+
+func (s *FileNameTestSuite) TestItCanBuildNewFileNameWithValidValues() {
+	for _, value := range []string{
+		"report.pdf", "backup.tar.gz", ".env", "Résumé 2024.docx", "a", "CONSOLE.txt",
+		strings.Repeat("a", MaxFileNameLength),
+	} {
+		name, err := NewFileName(value)
+		s.NoError(err, value)
+		s.Equal(value, name.Value())
+		s.Equal(value, name.String())
+	}
+}
+
+func (s *FileNameTestSuite) TestItFailsToBuildNewFileNameWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyFileName},
+		{strings.Repeat("a", MaxFileNameLength+1), ErrTooLongFileName},
+		{".", ErrReservedFileName},
+		{"..", ErrReservedFileName},
+		{"a/b", ErrInvalidFileNameChars},
+		{"a\x00b", ErrInvalidFileNameChars},
+		{"a\nb", ErrInvalidFileNameChars},
+		{"what?.txt", ErrInvalidFileNameChars},
+		{"a:b", ErrInvalidFileNameChars},
+		{`a\b`, ErrInvalidFileNameChars},
+		{"trailing.", ErrInvalidFileNameChars},
+		{"trailing ", ErrInvalidFileNameChars},
+		{"\xff.txt", ErrInvalidFileNameChars},
+		{"CON", ErrReservedFileName},
+		{"con.txt", ErrReservedFileName},
+		{"Lpt1.tar.gz", ErrReservedFileName},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewFileName(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+		s.ErrorIs(IsValidFileName(tc.value), tc.expected, tc.value)
+	}
+}
+
+func (s *FileNameTestSuite) TestItValidatesAgainstThePlatformRules() {
+	for _, value := range []string{"a:b", "what?.txt", "trailing.", "CON", "tab\there"} {
+		_, err := NewFileName(value, WithPlatform(PlatformPOSIX))
+		s.NoError(err, value)
+
+		_, err = NewFileName(value, WithPlatform(PlatformWindows))
+		s.Error(err, value)
+	}
+
+	for _, value := range []string{"a/b", "a\x00b", ".."} {
+		_, err := NewFileName(value, WithPlatform(PlatformPOSIX))
+		s.Error(err, value)
+	}
+}
+
+func (s *FileNameTestSuite) TestItExposesTheExtension() {
+	testCases := []struct {
+		value     string
+		extension string
+		stem      string
+	}{
+		{"report.pdf", "pdf", "report"},
+		{"backup.tar.gz", "gz", "backup.tar"},
+		{"README", "", "README"},
+		{".env", "", ".env"},
+		{".config.json", "json", ".config"},
+		{"Photo.JPG", "JPG", "Photo"},
+	}
+
+	for _, tc := range testCases {
+		name := ReconstituteFileName(tc.value)
+		s.Equal(tc.extension, name.Extension(), tc.value)
+		s.Equal(tc.stem, name.Stem(), tc.value)
+	}
+
+	photo := ReconstituteFileName("Photo.JPG")
+	s.True(photo.HasExtension("png", ".jpg"))
+	s.False(photo.HasExtension("png", "gif"))
+	s.False(ReconstituteFileName(".env").HasExtension("env"))
+}
+
+func (s *FileNameTestSuite) TestItSanitizesUploadedFileNames() {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"report.pdf", "report.pdf"},
+		{"../../etc/passwd", "passwd"},
+		{`C:\Users\jane\My Report.docx`, "My Report.docx"},
+		{"  what?<>.txt  ", "what___.txt"},
+		{"bad\x00name\n.txt", "bad_name_.txt"},
+		{"trailing...", "trailing"},
+		{"CON.txt", "_CON.txt"},
+		{"nul", "_nul"},
+		{"in\xffvalid.txt", "in_valid.txt"},
+		{strings.Repeat("a", 300) + ".pdf", strings.Repeat("a", 251) + ".pdf"},
+		{strings.Repeat("é", 200), strings.Repeat("é", 127)},
+	}
+
+	for _, tc := range testCases {
+		name, err := SanitizeFileName(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, name.Value())
+		s.NoError(IsValidFileName(name.Value()))
+	}
+
+	for _, value := range []string{"", "   ", "dir/", "..", "...", "/"} {
+		_, err := SanitizeFileName(value)
+		s.ErrorIs(err, ErrEmptyFileName, value)
+	}
+}
+
+func (s *FileNameTestSuite) TestItComparesFileNamesByValue() {
+	s.True(ReconstituteFileName("a.txt").Equals(ReconstituteFileName("a.txt")))
+	s.False(ReconstituteFileName("a.txt").Equals(ReconstituteFileName("A.txt")))
+}
+
+func (s *FileNameTestSuite) TestItCanDecodeText() {
+	var name FileName
+	s.NoError(name.UnmarshalText([]byte("report.pdf")))
+	s.Equal("report.pdf", name.Value())
+
+	s.NoError(name.UnmarshalText(nil))
+	s.Equal("report.pdf", name.Value())
+
+	s.ErrorIs(name.UnmarshalText([]byte("a/b")), ErrInvalidFileNameChars)
+}
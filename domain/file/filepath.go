@@ -0,0 +1,147 @@
+package file
+
+import (
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MaxFilePathLength is the maximum length of a file path in bytes, the Linux PATH_MAX
+const MaxFilePathLength = 4096
+
+var (
+	ErrEmptyFilePath = domain.NewLocalizedError(
+		"file.path.empty", nil,
+		"file path cannot be empty",
+	)
+	ErrTooLongFilePath = domain.NewLocalizedError(
+		"file.path.too_long", domain.MessageParams{"max": MaxFilePathLength},
+		"file path cannot exceed %d bytes",
+		MaxFilePathLength,
+	)
+	ErrInvalidFilePathChars = domain.NewLocalizedError(
+		"file.path.invalid_chars", nil,
+		"file path contains invalid characters",
+	)
+	ErrFilePathTraversal = domain.NewLocalizedError(
+		"file.path.traversal", nil,
+		"file path cannot refer to a parent directory",
+	)
+)
+
+// FilePath is a slash-separated path, such as "invoices/2024/march.pdf", normalized and free
+// of ".." elements, so it cannot escape the directory it is resolved against
+type FilePath struct {
+	value string
+}
+
+// NewFilePath creates a new instance of FilePath with validation and normalization.
+// Repeated slashes, "." elements and trailing slashes are removed. Any ".." element is
+// rejected, even one that would resolve inside the path, as are backslashes, which Windows
+// treats as separators.
+func NewFilePath(value string) (FilePath, error) {
+	normalized, err := NormalizeFilePath(value)
+	if err != nil {
+		return FilePath{}, err
+	}
+
+	return FilePath{
+		value: normalized,
+	}, nil
+}
+
+// ReconstituteFilePath creates a new FilePath instance without validation or normalization
+func ReconstituteFilePath(value string) FilePath {
+	return FilePath{
+		value: value,
+	}
+}
+
+// NormalizeFilePath validates a file path and returns its normalized form
+func NormalizeFilePath(value string) (string, error) {
+	if strings.TrimSpace(value) == "" {
+		return "", ErrEmptyFilePath
+	}
+	if len(value) > MaxFilePathLength {
+		return "", ErrTooLongFilePath
+	}
+	if !utf8.ValidString(value) {
+		return "", ErrInvalidFilePathChars
+	}
+	for _, r := range value {
+		if r == '\\' || unicode.IsControl(r) {
+			return "", ErrInvalidFilePathChars
+		}
+	}
+	for element := range strings.SplitSeq(value, "/") {
+		if element == ".." {
+			return "", ErrFilePathTraversal
+		}
+	}
+
+	normalized := path.Clean(value)
+	if normalized == "." {
+		return "", ErrEmptyFilePath
+	}
+	return normalized, nil
+}
+
+// Value returns the file path
+func (f FilePath) Value() string {
+	return f.value
+}
+
+// IsAbsolute reports whether the path starts at the root
+func (f FilePath) IsAbsolute() bool {
+	return strings.HasPrefix(f.value, "/")
+}
+
+// Base returns the last element of the path, e.g., "march.pdf"
+func (f FilePath) Base() FileName {
+	return ReconstituteFileName(path.Base(f.value))
+}
+
+// Join returns the path with the file name appended
+func (f FilePath) Join(name FileName) FilePath {
+	return FilePath{
+		value: path.Join(f.value, name.Value()),
+	}
+}
+
+// IsWithin reports whether the path is the directory or lies below it, e.g., whether a
+// requested file stays inside a tenant's folder
+func (f FilePath) IsWithin(directory FilePath) bool {
+	if directory.value == "/" {
+		return f.IsAbsolute()
+	}
+	return f.value == directory.value || strings.HasPrefix(f.value, directory.value+"/")
+}
+
+// Equals compares two FilePath objects for equality
+func (f FilePath) Equals(other FilePath) bool {
+	return f.value == other.value
+}
+
+// String returns a string representation of the file path
+func (f FilePath) String() string {
+	return f.value
+}
+
+// UnmarshalText parses the file path from text, with validation and normalization. Empty
+// text leaves the value unchanged.
+func (f *FilePath) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewFilePath(string(text))
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+	return nil
+}
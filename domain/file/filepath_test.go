@@ -0,0 +1,111 @@
+package file
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FilePathTestSuite struct {
+	suite.Suite
+}
+
+func TestFilePathSuite(t *testing.T) {
+	suite.Run(t, new(FilePathTestSuite))
+}
+
+func (s *FilePathTestSuite) TestItCanBuildNewFilePathWithNormalization() {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"invoices/2024/march.pdf", "invoices/2024/march.pdf"},
+		{"/var//data/./file.txt", "/var/data/file.txt"},
+		{"uploads/", "uploads"},
+		{"./uploads/a.txt", "uploads/a.txt"},
+		{"/", "/"},
+		{"..hidden/x..y", "..hidden/x..y"},
+	}
+
+	for _, tc := range testCases {
+		filePath, err := NewFilePath(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, filePath.Value())
+		s.Equal(tc.expected, filePath.String())
+	}
+}
+
+func (s *FilePathTestSuite) TestItFailsToBuildNewFilePathWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyFilePath},
+		{"  ", ErrEmptyFilePath},
+		{".", ErrEmptyFilePath},
+		{"./", ErrEmptyFilePath},
+		{"..", ErrFilePathTraversal},
+		{"../etc/passwd", ErrFilePathTraversal},
+		{"/var/../etc/passwd", ErrFilePathTraversal},
+		{"uploads/a/../b", ErrFilePathTraversal},
+		{"uploads/..", ErrFilePathTraversal},
+		{`..\..\windows`, ErrInvalidFilePathChars},
+		{"a\x00b", ErrInvalidFilePathChars},
+		{"a\nb", ErrInvalidFilePathChars},
+		{"\xffa", ErrInvalidFilePathChars},
+		{strings.Repeat("a/", MaxFilePathLength/2+1), ErrTooLongFilePath},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewFilePath(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *FilePathTestSuite) TestItReportsWhetherThePathIsAbsolute() {
+	s.True(mustFilePath("/var/data").IsAbsolute())
+	s.False(mustFilePath("var/data").IsAbsolute())
+}
+
+func (s *FilePathTestSuite) TestItExposesAndJoinsElements() {
+	filePath := mustFilePath("invoices/2024/march.pdf")
+	s.Equal("march.pdf", filePath.Base().Value())
+	s.Equal("pdf", filePath.Base().Extension())
+
+	joined := mustFilePath("invoices/2024").Join(ReconstituteFileName("april.pdf"))
+	s.Equal("invoices/2024/april.pdf", joined.Value())
+	s.Equal("/a.txt", mustFilePath("/").Join(ReconstituteFileName("a.txt")).Value())
+}
+
+func (s *FilePathTestSuite) TestItReportsWhetherThePathIsWithinADirectory() {
+	tenant := mustFilePath("tenants/42")
+	s.True(mustFilePath("tenants/42").IsWithin(tenant))
+	s.True(mustFilePath("tenants/42/files/a.txt").IsWithin(tenant))
+	s.False(mustFilePath("tenants/420/a.txt").IsWithin(tenant))
+	s.False(mustFilePath("tenants").IsWithin(tenant))
+
+	s.True(mustFilePath("/etc").IsWithin(mustFilePath("/")))
+	s.False(mustFilePath("etc").IsWithin(mustFilePath("/")))
+}
+
+func (s *FilePathTestSuite) TestItComparesFilePathsByValue() {
+	s.True(mustFilePath("a//b/").Equals(mustFilePath("a/b")))
+	s.False(mustFilePath("/a/b").Equals(mustFilePath("a/b")))
+	s.Equal("a/../b", ReconstituteFilePath("a/../b").Value())
+}
+
+func (s *FilePathTestSuite) TestItCanDecodeText() {
+	var filePath FilePath
+	s.NoError(filePath.UnmarshalText([]byte("a//b")))
+	s.Equal("a/b", filePath.Value())
+	s.ErrorIs(filePath.UnmarshalText([]byte("../b")), ErrFilePathTraversal)
+}
+
+func mustFilePath(value string) FilePath {
+	filePath, err := NewFilePath(value)
+	if err != nil {
+		panic(err)
+	}
+	return filePath
+}
@@ -0,0 +1,274 @@
+package file
+
+import (
+	"bytes"
+	"cmp"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// Decimal (SI) and binary (IEC) file size units, in bytes
+const (
+	Byte uint64 = 1
+	KB          = 1000 * Byte
+	MB          = 1000 * KB
+	GB          = 1000 * MB
+	TB          = 1000 * GB
+	PB          = 1000 * TB
+	EB          = 1000 * PB
+	KiB         = 1 << 10 * Byte
+	MiB         = 1 << 10 * KiB
+	GiB         = 1 << 10 * MiB
+	TiB         = 1 << 10 * GiB
+	PiB         = 1 << 10 * TiB
+	EiB         = 1 << 10 * PiB
+)
+
+var (
+	ErrEmptyFileSize = domain.NewLocalizedError(
+		"file.size.empty", nil,
+		"file size cannot be empty",
+	)
+	ErrInvalidFileSize = domain.NewLocalizedError(
+		"file.size.invalid", nil,
+		"file size must be a number with an optional unit, e.g., 10MB or 1.5GiB",
+	)
+	ErrFileSizeTooLarge = domain.NewLocalizedError(
+		"file.size.too_large", nil,
+		"file size is too large",
+	)
+	ErrNegativeFileSize = domain.NewError("file size cannot be negative")
+)
+
+// fileSizeUnit is a unit symbol and its size in bytes
+type fileSizeUnit struct {
+	symbol string
+	size   uint64
+}
+
+// decimalUnits and binaryUnits list the units from the largest down
+var (
+	decimalUnits = []fileSizeUnit{
+		{"EB", EB}, {"PB", PB}, {"TB", TB}, {"GB", GB}, {"MB", MB}, {"KB", KB},
+	}
+	binaryUnits = []fileSizeUnit{
+		{"EiB", EiB}, {"PiB", PiB}, {"TiB", TiB}, {"GiB", GiB}, {"MiB", MiB}, {"KiB", KiB},
+	}
+)
+
+// FileSize is a non-negative number of bytes, such as an upload limit
+type FileSize struct {
+	bytes uint64
+}
+
+// NewFileSize creates a new instance of FileSize from a number of bytes
+func NewFileSize(bytes uint64) FileSize {
+	return FileSize{
+		bytes: bytes,
+	}
+}
+
+// NewFileSizeFromString creates a new instance of FileSize from a number and an optional
+// decimal (KB, MB, ...) or binary (KiB, MiB, ...) unit, e.g., "10MB", "1.5 GiB" or "512".
+// Units are case-insensitive and fractional sizes are rounded to the nearest byte.
+func NewFileSizeFromString(value string) (FileSize, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return FileSize{}, ErrEmptyFileSize
+	}
+
+	end := strings.IndexFunc(
+		value, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.'
+		},
+	)
+	if end < 0 {
+		end = len(value)
+	}
+	number, symbol := value[:end], strings.TrimSpace(value[end:])
+
+	unit, ok := parseFileSizeUnit(symbol)
+	if !ok || !isDecimalNumber(number) {
+		return FileSize{}, ErrInvalidFileSize
+	}
+
+	amount, _ := new(big.Rat).SetString(number)
+	amount.Mul(amount, new(big.Rat).SetUint64(unit))
+
+	// Round half up to a whole number of bytes
+	quotient, remainder := new(big.Int).QuoRem(amount.Num(), amount.Denom(), new(big.Int))
+	if remainder.Lsh(remainder, 1).Cmp(amount.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if !quotient.IsUint64() {
+		return FileSize{}, ErrFileSizeTooLarge
+	}
+
+	return NewFileSize(quotient.Uint64()), nil
+}
+
+// Bytes returns the size in bytes
+func (f FileSize) Bytes() uint64 {
+	return f.bytes
+}
+
+// IsZero reports whether the size is zero bytes
+func (f FileSize) IsZero() bool {
+	return f.bytes == 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether the size is smaller than, equal to or
+// larger than other
+func (f FileSize) Compare(other FileSize) int {
+	return cmp.Compare(f.bytes, other.bytes)
+}
+
+// LessThan reports whether the size is smaller than other
+func (f FileSize) LessThan(other FileSize) bool {
+	return f.bytes < other.bytes
+}
+
+// Exceeds reports whether the size is larger than limit, e.g., an upload limit
+func (f FileSize) Exceeds(limit FileSize) bool {
+	return f.bytes > limit.bytes
+}
+
+// Add returns the sum of both sizes
+func (f FileSize) Add(other FileSize) (FileSize, error) {
+	sum, carry := bits.Add64(f.bytes, other.bytes, 0)
+	if carry != 0 {
+		return FileSize{}, ErrFileSizeTooLarge
+	}
+	return NewFileSize(sum), nil
+}
+
+// Subtract returns the difference of both sizes
+func (f FileSize) Subtract(other FileSize) (FileSize, error) {
+	if other.bytes > f.bytes {
+		return FileSize{}, ErrNegativeFileSize
+	}
+	return NewFileSize(f.bytes - other.bytes), nil
+}
+
+// Multiply returns the size multiplied by factor, e.g., the size of several equal chunks
+func (f FileSize) Multiply(factor uint64) (FileSize, error) {
+	high, product := bits.Mul64(f.bytes, factor)
+	if high != 0 {
+		return FileSize{}, ErrFileSizeTooLarge
+	}
+	return NewFileSize(product), nil
+}
+
+// Equals compares two FileSize objects for equality
+func (f FileSize) Equals(other FileSize) bool {
+	return f.bytes == other.bytes
+}
+
+// String returns the size in decimal units, rounded to two decimals, e.g., "1.61 GB"
+func (f FileSize) String() string {
+	return f.format(decimalUnits)
+}
+
+// BinaryString returns the size in binary units, rounded to two decimals, e.g., "1.5 GiB"
+func (f FileSize) BinaryString() string {
+	return f.format(binaryUnits)
+}
+
+// MarshalText encodes the size exactly, in the largest unit it is a whole multiple of, e.g.,
+// "10MB", "1536MiB" or "1001B"
+func (f FileSize) MarshalText() ([]byte, error) {
+	for i := range decimalUnits {
+		// Binary units are larger than their decimal counterparts, so try them first
+		for _, unit := range []fileSizeUnit{binaryUnits[i], decimalUnits[i]} {
+			if f.bytes != 0 && f.bytes%unit.size == 0 {
+				return []byte(strconv.FormatUint(f.bytes/unit.size, 10) + unit.symbol), nil
+			}
+		}
+	}
+	return []byte(strconv.FormatUint(f.bytes, 10) + "B"), nil
+}
+
+// UnmarshalText parses the size from text, with validation. Empty text leaves the value
+// unchanged.
+func (f *FileSize) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewFileSizeFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+	return nil
+}
+
+// MarshalJSON encodes the size as a JSON number of bytes
+func (f FileSize) MarshalJSON() ([]byte, error) {
+	return strconv.AppendUint(nil, f.bytes, 10), nil
+}
+
+// UnmarshalJSON decodes the size from a JSON number of bytes or a JSON string with a unit
+func (f *FileSize) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+		if len(data) == 0 {
+			return ErrEmptyFileSize
+		}
+	}
+
+	parsed, err := NewFileSizeFromString(string(data))
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+	return nil
+}
+
+// format renders the size in the largest of the units it reaches
+func (f FileSize) format(units []fileSizeUnit) string {
+	for _, unit := range units {
+		if f.bytes >= unit.size {
+			value := float64(f.bytes) / float64(unit.size)
+			value = math.Round(value*100) / 100
+			return strconv.FormatFloat(value, 'f', -1, 64) + " " + unit.symbol
+		}
+	}
+	return strconv.FormatUint(f.bytes, 10) + " B"
+}
+
+// parseFileSizeUnit returns the size of a unit symbol, matched case-insensitively. An empty
+// symbol means bytes.
+func parseFileSizeUnit(symbol string) (uint64, bool) {
+	if symbol == "" || strings.EqualFold(symbol, "B") {
+		return Byte, true
+	}
+	for i := range decimalUnits {
+		for _, unit := range []fileSizeUnit{decimalUnits[i], binaryUnits[i]} {
+			if strings.EqualFold(symbol, unit.symbol) {
+				return unit.size, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// isDecimalNumber reports whether value is digits with at most one decimal point between them
+func isDecimalNumber(value string) bool {
+	integer, fraction, hasPoint := strings.Cut(value, ".")
+	if integer == "" || (hasPoint && fraction == "") {
+		return false
+	}
+	return strings.IndexByte(fraction, '.') < 0
+}
@@ -0,0 +1,164 @@
+package file
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FileSizeTestSuite struct {
+	suite.Suite
+}
+
+func TestFileSizeSuite(t *testing.T) {
+	suite.Run(t, new(FileSizeTestSuite))
+}
+
+func (s *FileSizeTestSuite) TestItCanBuildNewFileSizeFromValidStrings() {
+	testCases := []struct {
+		input    string
+		expected uint64
+	}{
+		{"0", 0},
+		{"512", 512},
+		{"512B", 512},
+		{"10MB", 10_000_000},
+		{"10mb", 10_000_000},
+		{" 10 MB ", 10_000_000},
+		{"1.5GiB", 1_610_612_736},
+		{"1.5 gib", 1_610_612_736},
+		{"2KiB", 2048},
+		{"0.5KB", 500},
+		{"1.0005KB", 1001},
+		{"1.0004KB", 1000},
+		{"15EiB", 15 * EiB},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.input, func() {
+				size, err := NewFileSizeFromString(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, size.Bytes())
+			},
+		)
+	}
+}
+
+func (s *FileSizeTestSuite) TestItFailsToBuildNewFileSizeFromInvalidStrings() {
+	_, err := NewFileSizeFromString(" ")
+	s.ErrorIs(err, ErrEmptyFileSize)
+
+	for _, input := range []string{"MB", "-1MB", "1.MB", ".5MB", "1.2.3KB", "10 XB", "10M", "1e3"} {
+		_, err := NewFileSizeFromString(input)
+		s.ErrorIs(err, ErrInvalidFileSize, input)
+	}
+
+	for _, input := range []string{"16EiB", "18446744073709551616", "20EB"} {
+		_, err := NewFileSizeFromString(input)
+		s.ErrorIs(err, ErrFileSizeTooLarge, input)
+	}
+}
+
+func (s *FileSizeTestSuite) TestItComparesSizes() {
+	small, large := NewFileSize(MB), NewFileSize(MiB)
+	s.Equal(-1, small.Compare(large))
+	s.Equal(1, large.Compare(small))
+	s.Equal(0, small.Compare(NewFileSize(1_000_000)))
+	s.True(small.LessThan(large))
+	s.True(large.Exceeds(small))
+	s.False(small.Exceeds(small))
+	s.True(small.Equals(NewFileSize(1_000_000)))
+	s.True(NewFileSize(0).IsZero())
+}
+
+func (s *FileSizeTestSuite) TestItCanDoArithmetic() {
+	sum, err := NewFileSize(GB).Add(NewFileSize(MB))
+	s.NoError(err)
+	s.Equal(GB+MB, sum.Bytes())
+
+	_, err = NewFileSize(math.MaxUint64).Add(NewFileSize(1))
+	s.ErrorIs(err, ErrFileSizeTooLarge)
+
+	difference, err := NewFileSize(GB).Subtract(NewFileSize(MB))
+	s.NoError(err)
+	s.Equal(GB-MB, difference.Bytes())
+
+	_, err = NewFileSize(MB).Subtract(NewFileSize(GB))
+	s.ErrorIs(err, ErrNegativeFileSize)
+
+	product, err := NewFileSize(4 * MiB).Multiply(3)
+	s.NoError(err)
+	s.Equal(12*MiB, product.Bytes())
+
+	_, err = NewFileSize(EiB).Multiply(16)
+	s.ErrorIs(err, ErrFileSizeTooLarge)
+}
+
+func (s *FileSizeTestSuite) TestItFormatsHumanReadableSizes() {
+	testCases := []struct {
+		bytes   uint64
+		decimal string
+		binary  string
+	}{
+		{0, "0 B", "0 B"},
+		{999, "999 B", "999 B"},
+		{1000, "1 KB", "1000 B"},
+		{1536, "1.54 KB", "1.5 KiB"},
+		{10 * MB, "10 MB", "9.54 MiB"},
+		{1_610_612_736, "1.61 GB", "1.5 GiB"},
+		{math.MaxUint64, "18.45 EB", "16 EiB"},
+	}
+
+	for _, tc := range testCases {
+		size := NewFileSize(tc.bytes)
+		s.Equal(tc.decimal, size.String())
+		s.Equal(tc.binary, size.BinaryString())
+	}
+}
+
+func (s *FileSizeTestSuite) TestItEncodesTextExactly() {
+	testCases := []struct {
+		bytes    uint64
+		expected string
+	}{
+		{0, "0B"},
+		{1001, "1001B"},
+		{2048, "2KiB"},
+		{10 * MB, "10MB"},
+		{1_610_612_736, "1536MiB"},
+		{3 * GiB, "3GiB"},
+	}
+
+	for _, tc := range testCases {
+		text, err := NewFileSize(tc.bytes).MarshalText()
+		s.NoError(err)
+		s.Equal(tc.expected, string(text))
+
+		var decoded FileSize
+		s.NoError(decoded.UnmarshalText(text))
+		s.Equal(tc.bytes, decoded.Bytes())
+	}
+}
+
+func (s *FileSizeTestSuite) TestItCanEncodeAndDecodeJSON() {
+	type limits struct {
+		Upload FileSize `json:"upload"`
+	}
+
+	data, err := json.Marshal(limits{Upload: NewFileSize(10 * MB)})
+	s.NoError(err)
+	s.JSONEq(`{"upload":10000000}`, string(data))
+
+	var decoded limits
+	s.NoError(json.Unmarshal(data, &decoded))
+	s.Equal(10*MB, decoded.Upload.Bytes())
+
+	s.NoError(json.Unmarshal([]byte(`{"upload":"25MiB"}`), &decoded))
+	s.Equal(25*MiB, decoded.Upload.Bytes())
+
+	s.ErrorIs(json.Unmarshal([]byte(`{"upload":""}`), &decoded), ErrEmptyFileSize)
+	s.ErrorIs(json.Unmarshal([]byte(`{"upload":-1}`), &decoded), ErrInvalidFileSize)
+}
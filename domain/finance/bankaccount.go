@@ -0,0 +1,346 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	MinAccountNumberLength = 4
+	MaxAccountNumberLength = 34 // longest basic bank account number (BBAN) in use
+	MinRoutingNumberLength = 4
+	MaxRoutingNumberLength = 15
+	ABARoutingNumberLength = 9
+
+	// accountVisibleDigits is the number of trailing characters left visible by Masked
+	accountVisibleDigits = 4
+	accountMaskRune      = "•"
+)
+
+var (
+	ErrEmptyAccountNumber = domain.NewLocalizedError(
+		"finance.account_number.empty", nil,
+		"account number cannot be empty",
+	)
+	ErrInvalidAccountNumberChars = domain.NewLocalizedError(
+		"finance.account_number.invalid_chars", nil,
+		"account number may only contain letters and digits",
+	)
+	ErrInvalidAccountNumberLength = domain.NewLocalizedError(
+		"finance.account_number.invalid_length",
+		domain.MessageParams{"min": MinAccountNumberLength, "max": MaxAccountNumberLength},
+		"account number must be between %d and %d characters long",
+		MinAccountNumberLength,
+		MaxAccountNumberLength,
+	)
+
+	ErrEmptyRoutingNumber = domain.NewLocalizedError(
+		"finance.routing_number.empty", nil,
+		"routing number cannot be empty",
+	)
+	ErrInvalidRoutingNumberChars = domain.NewLocalizedError(
+		"finance.routing_number.invalid_chars", nil,
+		"routing number may only contain digits",
+	)
+	ErrInvalidRoutingNumberLength = domain.NewLocalizedError(
+		"finance.routing_number.invalid_length",
+		domain.MessageParams{"min": MinRoutingNumberLength, "max": MaxRoutingNumberLength},
+		"routing number must be between %d and %d digits long",
+		MinRoutingNumberLength,
+		MaxRoutingNumberLength,
+	)
+	ErrInvalidABARoutingNumber = domain.NewLocalizedError(
+		"finance.routing_number.invalid_aba",
+		domain.MessageParams{"length": ABARoutingNumberLength},
+		"ABA routing number must be %d digits with a valid check digit",
+		ABARoutingNumberLength,
+	)
+)
+
+// AccountNumberValidators holds extra rules run by NewAccountNumber after its own validation
+var AccountNumberValidators domain.Validators[AccountNumber]
+
+// AccountNumber represents a domestic bank account number. It is sensitive: String,
+// LogValue and MarshalJSON only reveal the last four characters; use Value to persist it.
+type AccountNumber struct {
+	value string
+}
+
+// NewAccountNumber creates a new instance of AccountNumber with validation and normalization
+func NewAccountNumber(value string) (AccountNumber, error) {
+	normalized, err := NormalizeAccountNumber(value)
+	if err != nil {
+		return AccountNumber{}, err
+	}
+
+	accountNumber := AccountNumber{
+		value: normalized,
+	}
+	if err := AccountNumberValidators.Validate(accountNumber); err != nil {
+		return AccountNumber{}, err
+	}
+
+	return accountNumber, nil
+}
+
+// ReconstituteAccountNumber creates a new AccountNumber instance without validation or
+// normalization
+func ReconstituteAccountNumber(value string) AccountNumber {
+	return AccountNumber{
+		value: value,
+	}
+}
+
+// Value returns the full account number
+func (a AccountNumber) Value() string {
+	return a.value
+}
+
+// LastFour returns the last four characters of the account number
+func (a AccountNumber) LastFour() string {
+	return a.value[max(len(a.value)-accountVisibleDigits, 0):]
+}
+
+// Masked returns the account number with all but the last four characters hidden,
+// e.g., "••••••6789"
+func (a AccountNumber) Masked() string {
+	hidden := max(len(a.value)-accountVisibleDigits, 0)
+	return strings.Repeat(accountMaskRune, hidden) + a.LastFour()
+}
+
+// Equals compares two AccountNumber objects for equality
+func (a AccountNumber) Equals(other AccountNumber) bool {
+	return a.value == other.value
+}
+
+// String returns the masked account number
+func (a AccountNumber) String() string {
+	return a.Masked()
+}
+
+// LogValue implements slog.LogValuer so that loggers record the masked account number
+func (a AccountNumber) LogValue() slog.Value {
+	return slog.StringValue(a.Masked())
+}
+
+// Sensitive marks the account number as personal data for domain.Sensitive
+func (a AccountNumber) Sensitive() {}
+
+// MarshalJSON encodes the masked account number as a JSON string
+func (a AccountNumber) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, a.Masked()), nil
+}
+
+// UnmarshalJSON decodes a full account number from a JSON string, with validation
+func (a *AccountNumber) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid account number JSON")
+	}
+
+	parsed, err := NewAccountNumber(raw)
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
+
+// NormalizeAccountNumber removes spaces and dashes and converts letters to uppercase
+func NormalizeAccountNumber(accountNumber string) (string, error) {
+	normalized := stripSeparators(accountNumber)
+
+	if err := IsValidAccountNumber(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// IsValidAccountNumber validates the characters and length of an account number
+func IsValidAccountNumber(accountNumber string) error {
+	if accountNumber == "" {
+		return ErrEmptyAccountNumber
+	}
+
+	for i := 0; i < len(accountNumber); i++ {
+		c := accountNumber[i]
+		if (c < '0' || c > '9') && (c < 'A' || c > 'Z') {
+			return ErrInvalidAccountNumberChars
+		}
+	}
+
+	length := len(accountNumber)
+	if length < MinAccountNumberLength || length > MaxAccountNumberLength {
+		return ErrInvalidAccountNumberLength
+	}
+
+	return nil
+}
+
+// RoutingNumberValidators holds extra rules run by NewRoutingNumber after its own validation
+var RoutingNumberValidators domain.Validators[RoutingNumber]
+
+// RoutingNumber identifies a bank or branch for domestic transfers, such as a US ABA routing
+// transit number, a UK sort code or an Australian BSB
+type RoutingNumber struct {
+	value string
+	aba   bool
+}
+
+// NewRoutingNumber creates a new generic RoutingNumber with validation and normalization
+func NewRoutingNumber(value string) (RoutingNumber, error) {
+	normalized, err := NormalizeRoutingNumber(value)
+	if err != nil {
+		return RoutingNumber{}, err
+	}
+
+	routingNumber := RoutingNumber{
+		value: normalized,
+		aba:   IsValidABARoutingNumber(normalized) == nil,
+	}
+	if err := RoutingNumberValidators.Validate(routingNumber); err != nil {
+		return RoutingNumber{}, err
+	}
+
+	return routingNumber, nil
+}
+
+// NewABARoutingNumber creates a new RoutingNumber that must be a valid US ABA routing
+// transit number
+func NewABARoutingNumber(value string) (RoutingNumber, error) {
+	routingNumber, err := NewRoutingNumber(value)
+	if err != nil {
+		return RoutingNumber{}, err
+	}
+
+	if !routingNumber.aba {
+		return RoutingNumber{}, ErrInvalidABARoutingNumber
+	}
+
+	return routingNumber, nil
+}
+
+// ReconstituteRoutingNumber creates a new RoutingNumber instance without validation or
+// normalization
+func ReconstituteRoutingNumber(value string) RoutingNumber {
+	return RoutingNumber{
+		value: value,
+		aba:   IsValidABARoutingNumber(value) == nil,
+	}
+}
+
+// Value returns the routing number value
+func (r RoutingNumber) Value() string {
+	return r.value
+}
+
+// IsABA reports whether the routing number is a valid US ABA routing transit number
+func (r RoutingNumber) IsABA() bool {
+	return r.aba
+}
+
+// Equals compares two RoutingNumber objects for equality
+func (r RoutingNumber) Equals(other RoutingNumber) bool {
+	return r.value == other.value
+}
+
+// String returns a string representation of the routing number
+func (r RoutingNumber) String() string {
+	return r.value
+}
+
+// MarshalJSON encodes the routing number as a JSON string
+func (r RoutingNumber) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, r.value), nil
+}
+
+// UnmarshalJSON decodes the routing number from a JSON string, with validation
+func (r *RoutingNumber) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid routing number JSON")
+	}
+
+	parsed, err := NewRoutingNumber(raw)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// NormalizeRoutingNumber removes spaces and dashes from a routing number
+func NormalizeRoutingNumber(routingNumber string) (string, error) {
+	normalized := stripSeparators(routingNumber)
+
+	if err := IsValidRoutingNumber(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// IsValidRoutingNumber validates the characters and length of a generic routing number
+func IsValidRoutingNumber(routingNumber string) error {
+	if routingNumber == "" {
+		return ErrEmptyRoutingNumber
+	}
+
+	for i := 0; i < len(routingNumber); i++ {
+		if routingNumber[i] < '0' || routingNumber[i] > '9' {
+			return ErrInvalidRoutingNumberChars
+		}
+	}
+
+	length := len(routingNumber)
+	if length < MinRoutingNumberLength || length > MaxRoutingNumberLength {
+		return ErrInvalidRoutingNumberLength
+	}
+
+	return nil
+}
+
+// IsValidABARoutingNumber validates a US ABA routing transit number using the 3-7-1
+// weighted check digit
+func IsValidABARoutingNumber(routingNumber string) error {
+	if err := IsValidRoutingNumber(routingNumber); err != nil {
+		return err
+	}
+
+	if len(routingNumber) != ABARoutingNumberLength {
+		return ErrInvalidABARoutingNumber
+	}
+
+	if !isABAPrefix(parseDigits(routingNumber[:2])) {
+		return ErrInvalidABARoutingNumber
+	}
+
+	if weightedSum(routingNumber, 3, 7, 1, 3, 7, 1, 3, 7, 1)%10 != 0 {
+		return ErrInvalidABARoutingNumber
+	}
+
+	return nil
+}
+
+// isABAPrefix reports whether the first two digits belong to a Federal Reserve routing
+// symbol range: 00-12 (banks), 21-32 (thrifts), 61-72 (electronic) or 80 (traveler's checks)
+func isABAPrefix(prefix int) bool {
+	return prefix <= 12 || (prefix >= 21 && prefix <= 32) ||
+		(prefix >= 61 && prefix <= 72) || prefix == 80
+}
@@ -0,0 +1,154 @@
+package finance
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BankAccountTestSuite struct {
+	suite.Suite
+}
+
+func TestBankAccountSuite(t *testing.T) {
+	suite.Run(t, new(BankAccountTestSuite))
+}
+
+func (s *BankAccountTestSuite) TestItCanBuildNewAccountNumberWithValidValues() {
+	testCases := []struct {
+		name           string
+		input          string
+		expected       string
+		expectedMasked string
+	}{
+		{"US account", "000123456789", "000123456789", "••••••••6789"},
+		{"With spaces and dashes", " 1234-5678 90 ", "1234567890", "••••••7890"},
+		{"Alphanumeric lowercase", "ab12cd34", "AB12CD34", "••••CD34"},
+		{"Minimum length", "1234", "1234", "1234"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				accountNumber, err := NewAccountNumber(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, accountNumber.Value())
+				s.Equal(tc.expectedMasked, accountNumber.Masked())
+				s.Equal(tc.expectedMasked, accountNumber.String())
+				s.Equal(tc.expectedMasked, accountNumber.LogValue().String())
+			},
+		)
+	}
+}
+
+func (s *BankAccountTestSuite) TestItFailsToBuildNewAccountNumberFromInvalidValues() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Empty", "  ", ErrEmptyAccountNumber},
+		{"Too short", "123", ErrInvalidAccountNumberLength},
+		{"Too long", strings.Repeat("1", MaxAccountNumberLength+1), ErrInvalidAccountNumberLength},
+		{"Invalid characters", "1234/5678", ErrInvalidAccountNumberChars},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewAccountNumber(tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *BankAccountTestSuite) TestAccountNumberJSONIsMasked() {
+	accountNumber, _ := NewAccountNumber("000123456789")
+
+	encoded, err := json.Marshal(accountNumber)
+	s.NoError(err)
+	s.Equal(`"••••••••6789"`, string(encoded))
+
+	var decoded AccountNumber
+	s.NoError(json.Unmarshal([]byte(`"000123456789"`), &decoded))
+	s.True(accountNumber.Equals(decoded))
+	s.Equal("6789", decoded.LastFour())
+
+	s.ErrorIs(json.Unmarshal(encoded, &decoded), ErrInvalidAccountNumberChars)
+}
+
+func (s *BankAccountTestSuite) TestItCanBuildRoutingNumbers() {
+	testCases := []struct {
+		name        string
+		input       string
+		expected    string
+		expectedABA bool
+	}{
+		{"ABA routing number", "011000015", "011000015", true},
+		{"ABA routing number with dashes", "1210-0035-8", "121000358", true},
+		{"UK sort code", "20-00-00", "200000", false},
+		{"Nine digits with bad check digit", "011000016", "011000016", false},
+		{"Nine digits with reserved prefix", "500000000", "500000000", false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				routingNumber, err := NewRoutingNumber(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, routingNumber.Value())
+				s.Equal(tc.expectedABA, routingNumber.IsABA())
+
+				_, err = NewABARoutingNumber(tc.input)
+				if tc.expectedABA {
+					s.NoError(err)
+				} else {
+					s.ErrorIs(err, ErrInvalidABARoutingNumber)
+				}
+			},
+		)
+	}
+}
+
+func (s *BankAccountTestSuite) TestItFailsToBuildRoutingNumberFromInvalidValues() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Empty", "", ErrEmptyRoutingNumber},
+		{"Letters", "12A456789", ErrInvalidRoutingNumberChars},
+		{"Too short", "123", ErrInvalidRoutingNumberLength},
+		{"Too long", strings.Repeat("1", MaxRoutingNumberLength+1), ErrInvalidRoutingNumberLength},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewRoutingNumber(tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *BankAccountTestSuite) TestRoutingNumberJSON() {
+	routingNumber, _ := NewABARoutingNumber("011000015")
+
+	encoded, err := json.Marshal(routingNumber)
+	s.NoError(err)
+	s.Equal(`"011000015"`, string(encoded))
+
+	var decoded RoutingNumber
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(routingNumber.Equals(decoded))
+	s.True(decoded.IsABA())
+}
+
+func (s *BankAccountTestSuite) TestReconstitute() {
+	s.Equal("000123456789", ReconstituteAccountNumber("000123456789").Value())
+	s.True(ReconstituteRoutingNumber("011000015").IsABA())
+}
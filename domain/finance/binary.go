@@ -0,0 +1,413 @@
+package finance
+
+import (
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+// Amounts are encoded as exact decimal strings, so no precision is lost in a cache.
+
+// MarshalBinary encodes the currency code
+func (c Currency) MarshalBinary() ([]byte, error) {
+	return []byte(c.value), nil
+}
+
+// UnmarshalBinary decodes the currency code, with validation
+func (c *Currency) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewCurrency(string(data))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// MarshalBinary encodes the money amount and currency
+func (m Money) MarshalBinary() ([]byte, error) {
+	if m.currency.value == "" {
+		return nil, nil
+	}
+
+	return domain.MarshalBinaryFields(m.amount.String(), m.currency.value), nil
+}
+
+// UnmarshalBinary decodes the money, with validation
+func (m *Money) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 2)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewMoneyFromString(fields[0], fields[1])
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// MarshalBinary encodes the currency code and amount of every currency held in the bag
+func (b MoneyBag) MarshalBinary() ([]byte, error) {
+	if len(b.amounts) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]string, 0, 2*len(b.amounts))
+	for _, currency := range b.Currencies() {
+		fields = append(fields, currency.value, b.amounts[currency.value].String())
+	}
+	return domain.MarshalBinaryFields(fields...), nil
+}
+
+// UnmarshalBinary decodes the bag, with validation
+func (b *MoneyBag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFields(data)
+	if err != nil {
+		return err
+	}
+
+	if len(fields)%2 != 0 {
+		return domain.ErrInvalidBinary
+	}
+
+	amounts := make([]Money, 0, len(fields)/2)
+	for i := 0; i < len(fields); i += 2 {
+		money, err := NewMoneyFromString(fields[i+1], fields[i])
+		if err != nil {
+			return err
+		}
+		amounts = append(amounts, money)
+	}
+
+	parsed, err := NewMoneyBag(amounts...)
+	if err != nil {
+		return err
+	}
+
+	*b = parsed
+	return nil
+}
+
+// MarshalBinary encodes the number of decimal places and the rounding mode
+func (r Rounding) MarshalBinary() ([]byte, error) {
+	return domain.MarshalBinaryFields(
+		strconv.FormatInt(int64(r.places), 10),
+		strconv.Itoa(int(r.mode)),
+	), nil
+}
+
+// UnmarshalBinary decodes the rounding policy, with validation
+func (r *Rounding) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 2)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := parseRounding(fields[0], fields[1])
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// MarshalBinary encodes the unit price amount, currency and rounding policy
+func (p UnitPrice) MarshalBinary() ([]byte, error) {
+	if p.price.currency.value == "" {
+		return nil, nil
+	}
+
+	return domain.MarshalBinaryFields(
+		p.price.amount.String(),
+		p.price.currency.value,
+		strconv.FormatInt(int64(p.rounding.places), 10),
+		strconv.Itoa(int(p.rounding.mode)),
+	), nil
+}
+
+// UnmarshalBinary decodes the unit price, with validation
+func (p *UnitPrice) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 4)
+	if err != nil {
+		return err
+	}
+
+	price, err := NewMoneyFromString(fields[0], fields[1])
+	if err != nil {
+		return err
+	}
+
+	rounding, err := parseRounding(fields[2], fields[3])
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewUnitPrice(price, rounding)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// MarshalBinary encodes the currency with the net and tax amounts; the gross amount is
+// derived again when decoding
+func (l LineTotal) MarshalBinary() ([]byte, error) {
+	if l.net.currency.value == "" {
+		return nil, nil
+	}
+
+	return domain.MarshalBinaryFields(
+		l.net.currency.value,
+		l.net.amount.String(),
+		l.tax.amount.String(),
+	), nil
+}
+
+// UnmarshalBinary decodes the line total, with validation
+func (l *LineTotal) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 3)
+	if err != nil {
+		return err
+	}
+
+	net, err := NewMoneyFromString(fields[1], fields[0])
+	if err != nil {
+		return err
+	}
+
+	tax, err := NewMoneyFromString(fields[2], fields[0])
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewLineTotal(net, tax)
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+	return nil
+}
+
+// MarshalBinary encodes the annual percentage as a decimal string
+func (r InterestRate) MarshalBinary() ([]byte, error) {
+	return []byte(r.annualPercent.String()), nil
+}
+
+// UnmarshalBinary decodes the interest rate, with validation
+func (r *InterestRate) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewInterestRateFromString(string(data))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// MarshalBinary encodes the VAT number as its canonical string
+func (v VATNumber) MarshalBinary() ([]byte, error) {
+	return []byte(v.value), nil
+}
+
+// UnmarshalBinary decodes the VAT number, with validation
+func (v *VATNumber) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewVATNumber(string(data))
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+	return nil
+}
+
+// MarshalBinary encodes the ISIN as its canonical string
+func (i ISIN) MarshalBinary() ([]byte, error) {
+	return []byte(i.value), nil
+}
+
+// UnmarshalBinary decodes the ISIN, with validation
+func (i *ISIN) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewISIN(string(data))
+	if err != nil {
+		return err
+	}
+
+	*i = parsed
+	return nil
+}
+
+// MarshalBinary encodes the CUSIP as its canonical string
+func (c CUSIP) MarshalBinary() ([]byte, error) {
+	return []byte(c.value), nil
+}
+
+// UnmarshalBinary decodes the CUSIP, with validation
+func (c *CUSIP) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewCUSIP(string(data))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// MarshalBinary encodes the full, unmasked account number. Unlike MarshalJSON it is meant for
+// trusted storage, so only cache it where the raw value may live.
+func (a AccountNumber) MarshalBinary() ([]byte, error) {
+	return []byte(a.value), nil
+}
+
+// UnmarshalBinary decodes the account number, with validation
+func (a *AccountNumber) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewAccountNumber(string(data))
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
+
+// MarshalBinary encodes the routing number as its canonical string
+func (r RoutingNumber) MarshalBinary() ([]byte, error) {
+	return []byte(r.value), nil
+}
+
+// UnmarshalBinary decodes the routing number, with validation
+func (r *RoutingNumber) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewRoutingNumber(string(data))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// parseRounding builds a Rounding policy from its binary field values
+func parseRounding(places, mode string) (Rounding, error) {
+	parsedPlaces, err := strconv.ParseInt(places, 10, 32)
+	if err != nil {
+		return Rounding{}, domain.ErrInvalidBinary
+	}
+
+	parsedMode, err := strconv.Atoi(mode)
+	if err != nil {
+		return Rounding{}, domain.ErrInvalidBinary
+	}
+
+	return NewRounding(int32(parsedPlaces), RoundingMode(parsedMode))
+}
+
+// MarshalBinary encodes the country and the full, unmasked tax identification number. Unlike
+// MarshalJSON it is meant for trusted storage, so only cache it where the raw value may live.
+func (t TIN) MarshalBinary() ([]byte, error) {
+	if t == (TIN{}) {
+		return nil, nil
+	}
+
+	return domain.MarshalBinaryFields(t.country.Value(), t.value), nil
+}
+
+// UnmarshalBinary decodes the tax identification number, with validation
+func (t *TIN) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 2)
+	if err != nil {
+		return err
+	}
+
+	country, err := geography.NewCountryCode(fields[0])
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewTIN(country, fields[1])
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// MarshalBinary encodes the full, unmasked card token. Unlike MarshalJSON it is meant for
+// trusted storage, so only cache it where the raw value may live.
+func (t CardToken) MarshalBinary() ([]byte, error) {
+	return []byte(t.value), nil
+}
+
+// UnmarshalBinary decodes the card token, with validation
+func (t *CardToken) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewCardToken(string(data))
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
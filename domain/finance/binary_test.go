@@ -0,0 +1,126 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type BinaryTestSuite struct {
+	suite.Suite
+}
+
+func TestBinarySuite(t *testing.T) {
+	suite.Run(t, new(BinaryTestSuite))
+}
+
+type cachedInvoice struct {
+	Currency      Currency
+	Total         Money
+	Balances      MoneyBag
+	Rounding      Rounding
+	UnitPrice     UnitPrice
+	LineTotal     LineTotal
+	InterestRate  InterestRate
+	VATNumber     VATNumber
+	ISIN          ISIN
+	CUSIP         CUSIP
+	AccountNumber AccountNumber
+	RoutingNumber RoutingNumber
+	TIN           TIN
+	CardToken     CardToken
+	Discount      Money
+}
+
+func (s *BinaryTestSuite) TestItCanRoundTripValueObjectsThroughGob() {
+	usd, _ := NewCurrency("USD")
+	total, _ := NewMoneyFromString("1234.5678", "USD")
+	euros, _ := NewMoneyFromString("5", "EUR")
+	balances, err := NewMoneyBag(total, euros)
+	s.Require().NoError(err)
+	rounding, _ := NewRounding(2, RoundHalfEven)
+	unitPrice, err := NewUnitPrice(total, rounding)
+	s.Require().NoError(err)
+	lineTotal, err := unitPrice.LineTotal(decimal.NewFromInt(3), decimal.NewFromInt(19))
+	s.Require().NoError(err)
+	interestRate, _ := NewInterestRateFromString("4.25")
+	vatNumber, _ := NewVATNumber("DE136695976")
+	isin, _ := NewISIN("US0378331005")
+	cusip, _ := NewCUSIP("037833100")
+	accountNumber, _ := NewAccountNumber("123456789")
+	routingNumber, _ := NewRoutingNumber("011000015")
+	tin, _ := NewTIN(geography.ReconstituteCountryCode("US"), "12-3456789")
+	cardToken, _ := NewCardToken("pm_1NqXyZ2eZvKYlo2C4242")
+
+	original := cachedInvoice{
+		Currency:      usd,
+		Total:         total,
+		Balances:      balances,
+		Rounding:      rounding,
+		UnitPrice:     unitPrice,
+		LineTotal:     lineTotal,
+		InterestRate:  interestRate,
+		VATNumber:     vatNumber,
+		ISIN:          isin,
+		CUSIP:         cusip,
+		AccountNumber: accountNumber,
+		RoutingNumber: routingNumber,
+		TIN:           tin,
+		CardToken:     cardToken,
+	}
+
+	var buffer bytes.Buffer
+	s.Require().NoError(gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded cachedInvoice
+	s.Require().NoError(gob.NewDecoder(&buffer).Decode(&decoded))
+
+	s.True(original.Currency.Equals(decoded.Currency))
+	s.True(original.Total.Equals(decoded.Total))
+	s.Equal("1234.5678", decoded.Total.Amount().String())
+	s.True(original.Balances.Equals(decoded.Balances))
+	s.Equal(original.Rounding, decoded.Rounding)
+	s.True(original.UnitPrice.Equals(decoded.UnitPrice))
+	s.True(original.LineTotal.Equals(decoded.LineTotal))
+	s.True(original.InterestRate.Equals(decoded.InterestRate))
+	s.True(original.VATNumber.Equals(decoded.VATNumber))
+	s.True(original.ISIN.Equals(decoded.ISIN))
+	s.True(original.CUSIP.Equals(decoded.CUSIP))
+	s.Equal("123456789", decoded.AccountNumber.Value())
+	s.True(decoded.RoutingNumber.IsABA())
+	s.Equal("123456789", decoded.TIN.Value())
+	s.Equal("pm_1NqXyZ2eZvKYlo2C4242", decoded.CardToken.Value())
+	s.Equal(CardTokenProviderStripe, decoded.CardToken.Provider())
+	s.Equal(Money{}, decoded.Discount)
+}
+
+func (s *BinaryTestSuite) TestItValidatesWhenUnmarshalingBinary() {
+	var money Money
+	s.ErrorIs(money.UnmarshalBinary(domain.MarshalBinaryFields("-1", "USD")), ErrNegativeAmount)
+	s.ErrorIs(money.UnmarshalBinary(domain.MarshalBinaryFields("1")), domain.ErrInvalidBinary)
+
+	var bag MoneyBag
+	s.ErrorIs(bag.UnmarshalBinary(domain.MarshalBinaryFields("USD")), domain.ErrInvalidBinary)
+	s.ErrorIs(bag.UnmarshalBinary(domain.MarshalBinaryFields("US", "1")), ErrInvalidCurrency)
+
+	var rounding Rounding
+	s.ErrorIs(rounding.UnmarshalBinary(domain.MarshalBinaryFields("2", "9")), ErrInvalidRoundingMode)
+	s.ErrorIs(rounding.UnmarshalBinary(domain.MarshalBinaryFields("x", "0")), domain.ErrInvalidBinary)
+
+	var lineTotal LineTotal
+	s.ErrorIs(
+		lineTotal.UnmarshalBinary(domain.MarshalBinaryFields("USD", "10", "-1")),
+		ErrNegativeAmount,
+	)
+
+	var interestRate InterestRate
+	s.ErrorIs(interestRate.UnmarshalBinary([]byte("-1")), ErrNegativeInterestRate)
+
+	var isin ISIN
+	s.ErrorIs(isin.UnmarshalBinary([]byte("US0378331006")), ErrInvalidISINChecksum)
+}
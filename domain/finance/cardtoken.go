@@ -0,0 +1,209 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	MinCardTokenLength = 8
+	MaxCardTokenLength = 255
+	// CardTokenVisibleChars is the number of trailing characters left visible when masking
+	CardTokenVisibleChars = 4
+)
+
+var (
+	ErrEmptyCardToken = domain.NewLocalizedError(
+		"finance.card_token.empty", nil,
+		"card token cannot be empty",
+	)
+	ErrInvalidCardTokenChars = domain.NewLocalizedError(
+		"finance.card_token.invalid_chars", nil,
+		"card token may only contain letters, digits, underscores, hyphens and colons",
+	)
+	ErrInvalidCardTokenLength = domain.NewLocalizedError(
+		"finance.card_token.invalid_length",
+		domain.MessageParams{"min": MinCardTokenLength, "max": MaxCardTokenLength},
+		"card token must be between %d and %d characters long",
+		MinCardTokenLength,
+		MaxCardTokenLength,
+	)
+	ErrCardTokenIsCardNumber = domain.NewLocalizedError(
+		"finance.card_token.card_number", nil,
+		"card token must not be a card number",
+	)
+)
+
+// CardTokenProvider names the payment service provider that issued a card token
+type CardTokenProvider string
+
+const (
+	CardTokenProviderUnknown CardTokenProvider = ""
+	CardTokenProviderStripe  CardTokenProvider = "stripe"
+	CardTokenProviderSquare  CardTokenProvider = "square"
+)
+
+var (
+	cardTokenPrefixesMu sync.RWMutex
+	cardTokenPrefixes   = map[string]CardTokenProvider{
+		"tok_":  CardTokenProviderStripe,
+		"pm_":   CardTokenProviderStripe,
+		"card_": CardTokenProviderStripe,
+		"src_":  CardTokenProviderStripe,
+		"cnon:": CardTokenProviderSquare,
+		"ccof:": CardTokenProviderSquare,
+	}
+)
+
+// RegisterCardTokenPrefix registers (or replaces) the provider detected for tokens starting
+// with the prefix. The longest registered prefix wins. It is safe to call concurrently with
+// NewCardToken.
+func RegisterCardTokenPrefix(prefix string, provider CardTokenProvider) {
+	cardTokenPrefixesMu.Lock()
+	defer cardTokenPrefixesMu.Unlock()
+	cardTokenPrefixes[prefix] = provider
+}
+
+// CardToken is a token issued by a payment service provider in place of a card number, such
+// as a Stripe PaymentMethod ID or a Square card-on-file ID, so domains that never see the
+// card number still get a validated value. String, LogValue and MarshalJSON hide all but the
+// provider prefix and the last four characters; use Value to charge the card.
+type CardToken struct {
+	value    string
+	provider CardTokenProvider
+	prefix   string
+}
+
+// NewCardToken creates a new instance of CardToken with validation, detecting the provider
+// from the token prefix
+func NewCardToken(value string) (CardToken, error) {
+	value = strings.TrimSpace(value)
+	if err := IsValidCardToken(value); err != nil {
+		return CardToken{}, err
+	}
+
+	return ReconstituteCardToken(value), nil
+}
+
+// ReconstituteCardToken creates a new CardToken instance without validation, detecting the
+// provider from the token prefix
+func ReconstituteCardToken(value string) CardToken {
+	prefix, provider := DetectCardTokenProvider(value)
+	return CardToken{
+		value:    value,
+		provider: provider,
+		prefix:   prefix,
+	}
+}
+
+// Value returns the full card token
+func (t CardToken) Value() string {
+	return t.value
+}
+
+// Provider returns the provider that issued the token, or CardTokenProviderUnknown
+func (t CardToken) Provider() CardTokenProvider {
+	return t.provider
+}
+
+// Masked returns the token with all but its provider prefix and the last
+// CardTokenVisibleChars characters replaced by bullets, e.g., "pm_••••••••••••••4242"
+func (t CardToken) Masked() string {
+	body := t.value[len(t.prefix):]
+	visible := min(CardTokenVisibleChars, len(body)/2)
+	return t.prefix + strings.Repeat("•", len(body)-visible) + body[len(body)-visible:]
+}
+
+// Equals compares two CardToken objects for equality
+func (t CardToken) Equals(other CardToken) bool {
+	return t.value == other.value
+}
+
+// String returns the masked card token
+func (t CardToken) String() string {
+	return t.Masked()
+}
+
+// LogValue implements slog.LogValuer so that loggers record the masked card token
+func (t CardToken) LogValue() slog.Value {
+	return slog.StringValue(t.Masked())
+}
+
+// Sensitive marks the card token as a secret for domain.Sensitive
+func (t CardToken) Sensitive() {}
+
+// MarshalJSON encodes the masked card token as a JSON string
+func (t CardToken) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Masked())
+}
+
+// UnmarshalJSON decodes a full card token from a JSON string, with validation
+func (t *CardToken) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid card token JSON")
+	}
+
+	parsed, err := NewCardToken(raw)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// IsValidCardToken validates the characters and length of a card token and rejects card
+// numbers passed where a token is expected
+func IsValidCardToken(token string) error {
+	if token == "" {
+		return ErrEmptyCardToken
+	}
+
+	digitsOnly := true
+	for i := 0; i < len(token); i++ {
+		c := token[i]
+		isDigit := c >= '0' && c <= '9'
+		isLetter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if !isDigit && !isLetter && c != '_' && c != '-' && c != ':' {
+			return ErrInvalidCardTokenChars
+		}
+		digitsOnly = digitsOnly && isDigit
+	}
+
+	length := len(token)
+	if length < MinCardTokenLength || length > MaxCardTokenLength {
+		return ErrInvalidCardTokenLength
+	}
+
+	if digitsOnly && length >= 12 && length <= 19 && isLuhnValid(token) {
+		return ErrCardTokenIsCardNumber
+	}
+
+	return nil
+}
+
+// DetectCardTokenProvider returns the longest registered prefix the token starts with and the
+// provider it belongs to, or empty values when no prefix matches
+func DetectCardTokenProvider(token string) (string, CardTokenProvider) {
+	cardTokenPrefixesMu.RLock()
+	defer cardTokenPrefixesMu.RUnlock()
+
+	var prefix string
+	for candidate := range cardTokenPrefixes {
+		if len(candidate) > len(prefix) && strings.HasPrefix(token, candidate) {
+			prefix = candidate
+		}
+	}
+
+	return prefix, cardTokenPrefixes[prefix]
+}
@@ -0,0 +1,145 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CardTokenTestSuite struct {
+	suite.Suite
+}
+
+func TestCardTokenSuite(t *testing.T) {
+	suite.Run(t, new(CardTokenTestSuite))
+}
+
+func (s *CardTokenTestSuite) TestItCanBuildValidCardTokens() {
+	testCases := []struct {
+		name     string
+		value    string
+		expected string
+		provider CardTokenProvider
+	}{
+		{
+			"Stripe payment method", "pm_1NqXyZ2eZvKYlo2C4242", "pm_1NqXyZ2eZvKYlo2C4242",
+			CardTokenProviderStripe,
+		},
+		{"Stripe token", " tok_visa_4242 ", "tok_visa_4242", CardTokenProviderStripe},
+		{"Square nonce", "cnon:card-nonce-ok", "cnon:card-nonce-ok", CardTokenProviderSquare},
+		{
+			"Square card on file", "ccof:GaJGNaZa8x4OgDJn4GB", "ccof:GaJGNaZa8x4OgDJn4GB",
+			CardTokenProviderSquare,
+		},
+		{"Unprefixed token", "8b4kq2x9m7", "8b4kq2x9m7", CardTokenProviderUnknown},
+		{"Digits failing Luhn", "4242424242424241", "4242424242424241", CardTokenProviderUnknown},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				token, err := NewCardToken(tc.value)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, token.Value())
+				s.Equal(tc.provider, token.Provider())
+			},
+		)
+	}
+}
+
+func (s *CardTokenTestSuite) TestItFailsToBuildInvalidCardTokens() {
+	testCases := []struct {
+		name     string
+		value    string
+		expected error
+	}{
+		{"Empty", "  ", ErrEmptyCardToken},
+		{"Invalid characters", "tok_visa 4242", ErrInvalidCardTokenChars},
+		{"Non-ASCII characters", "tok_visä_4242", ErrInvalidCardTokenChars},
+		{"Too short", "pm_4242", ErrInvalidCardTokenLength},
+		{"Too long", "tok_" + strings.Repeat("a", MaxCardTokenLength), ErrInvalidCardTokenLength},
+		{"Card number", "4242424242424242", ErrCardTokenIsCardNumber},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewCardToken(tc.value)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *CardTokenTestSuite) TestItCanRegisterProviderPrefixes() {
+	RegisterCardTokenPrefix("pm_test_", "stripe-test")
+	defer func() {
+		cardTokenPrefixesMu.Lock()
+		delete(cardTokenPrefixes, "pm_test_")
+		cardTokenPrefixesMu.Unlock()
+	}()
+
+	token, err := NewCardToken("pm_test_1NqXyZ2e4242")
+	s.Require().NoError(err)
+	s.Equal(CardTokenProvider("stripe-test"), token.Provider())
+	s.Equal("pm_test_••••••••4242", token.Masked())
+}
+
+func (s *CardTokenTestSuite) TestItMasksAllButThePrefixAndLastFourCharacters() {
+	token, _ := NewCardToken("pm_1NqXyZ2eZvKYlo2C4242")
+	s.Equal("pm_••••••••••••••••4242", token.Masked())
+	s.Equal(token.Masked(), token.String())
+
+	unprefixed, _ := NewCardToken("8b4kq2x9m7")
+	s.Equal("••••••x9m7", unprefixed.Masked())
+
+	short, _ := NewCardToken("pm_12345")
+	s.Equal("pm_•••45", short.Masked())
+}
+
+func (s *CardTokenTestSuite) TestItLogsTheMaskedToken() {
+	token, _ := NewCardToken("pm_1NqXyZ2eZvKYlo2C4242")
+
+	var buffer bytes.Buffer
+	slog.New(slog.NewTextHandler(&buffer, nil)).Info("charge", "card", token)
+	s.Contains(buffer.String(), "card=pm_••••••••••••••••4242")
+	s.NotContains(buffer.String(), token.Value())
+}
+
+func (s *CardTokenTestSuite) TestItCanCompareCardTokens() {
+	token, _ := NewCardToken("pm_1NqXyZ2eZvKYlo2C4242")
+	same, _ := NewCardToken(" pm_1NqXyZ2eZvKYlo2C4242")
+	other, _ := NewCardToken("pm_1NqXyZ2eZvKYlo2C4343")
+
+	s.True(token.Equals(same))
+	s.False(token.Equals(other))
+	s.Equal(token, ReconstituteCardToken("pm_1NqXyZ2eZvKYlo2C4242"))
+}
+
+func (s *CardTokenTestSuite) TestItMasksTheValueWhenMarshalingJSON() {
+	token, _ := NewCardToken("pm_1NqXyZ2eZvKYlo2C4242")
+
+	data, err := json.Marshal(token)
+	s.Require().NoError(err)
+	s.JSONEq(`"pm_••••••••••••••••4242"`, string(data))
+
+	data, err = json.Marshal(ReconstituteCardToken("tok_abcdefgh12\x01\""))
+	s.Require().NoError(err)
+	s.Equal(`"tok_••••••••12\u0001\""`, string(data))
+}
+
+func (s *CardTokenTestSuite) TestItCanUnmarshalJSON() {
+	var token CardToken
+	s.Require().NoError(json.Unmarshal([]byte(`"pm_1NqXyZ2eZvKYlo2C4242"`), &token))
+	s.Equal("pm_1NqXyZ2eZvKYlo2C4242", token.Value())
+
+	s.Require().NoError(json.Unmarshal([]byte("null"), &token))
+	s.Equal("pm_1NqXyZ2eZvKYlo2C4242", token.Value())
+
+	s.ErrorIs(json.Unmarshal([]byte(`"4242424242424242"`), &token), ErrCardTokenIsCardNumber)
+	s.Error(json.Unmarshal([]byte(`42`), &token))
+}
@@ -0,0 +1,15 @@
+package finance
+
+import "github.com/golibry/go-common-domain/domain/geography"
+
+// DefaultCurrencyFor returns the currency a checkout in the given country should default to:
+// the first of the country's currencies accepted by CurrencyValidators. It reports false for
+// countries without a currency (e.g., Antarctica) or when every one of them is rejected.
+func DefaultCurrencyFor(country geography.CountryCode) (Currency, bool) {
+	for _, code := range country.Currencies() {
+		if currency, err := NewCurrency(code); err == nil {
+			return currency, true
+		}
+	}
+	return Currency{}, false
+}
@@ -0,0 +1,58 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+)
+
+type CountryCurrencyTestSuite struct {
+	suite.Suite
+}
+
+func TestCountryCurrencySuite(t *testing.T) {
+	suite.Run(t, new(CountryCurrencyTestSuite))
+}
+
+func (s *CountryCurrencyTestSuite) TestItCanDefaultTheCurrencyOfACountry() {
+	testCases := []struct {
+		country  string
+		expected string
+	}{
+		{"RO", "RON"},
+		{"FR", "EUR"},
+		{"GB", "GBP"},
+		{"JP", "JPY"},
+		{"EC", "USD"},
+		{"PA", "PAB"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.country, func() {
+			currency, ok := DefaultCurrencyFor(geography.ReconstituteCountryCode(tc.country))
+			s.Require().True(ok)
+			s.Equal(tc.expected, currency.Value())
+		})
+	}
+}
+
+func (s *CountryCurrencyTestSuite) TestItHasNoDefaultCurrencyForCountriesWithoutOne() {
+	_, ok := DefaultCurrencyFor(geography.ReconstituteCountryCode("AQ"))
+	s.False(ok)
+
+	_, ok = DefaultCurrencyFor(geography.CountryCode{})
+	s.False(ok)
+}
+
+func (s *CountryCurrencyTestSuite) TestItSkipsCurrenciesRejectedByValidators() {
+	unregister := CurrencyValidators.Register(AllowCurrencies("USD", "EUR"))
+	defer unregister()
+
+	currency, ok := DefaultCurrencyFor(geography.ReconstituteCountryCode("PA"))
+	s.Require().True(ok)
+	s.Equal("USD", currency.Value())
+
+	_, ok = DefaultCurrencyFor(geography.ReconstituteCountryCode("RO"))
+	s.False(ok)
+}
@@ -8,11 +8,27 @@ import (
 )
 
 var (
-	ErrEmptyCurrency   = domain.NewError("currency cannot be empty")
-	ErrInvalidCurrency = domain.NewError("currency must be exactly 3 letters")
+	ErrEmptyCurrency = domain.NewLocalizedError(
+		"finance.currency.empty", nil,
+		"currency cannot be empty",
+	)
+	ErrInvalidCurrency = domain.NewLocalizedError(
+		"finance.currency.invalid", nil,
+		"currency must be exactly 3 letters",
+	)
+	ErrCurrencyNotAllowed = domain.NewLocalizedError(
+		"finance.currency.not_allowed", nil,
+		"currency is not allowed",
+	)
 )
 
-var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+// CurrencyPattern is the regular expression an ISO 4217 currency code must match
+const CurrencyPattern = `^[A-Z]{3}$`
+
+var currencyRegex = regexp.MustCompile(CurrencyPattern)
+
+// CurrencyValidators holds extra rules run by NewCurrency after its own validation
+var CurrencyValidators domain.Validators[Currency]
 
 type Currency struct {
 	value string
@@ -25,9 +41,14 @@ func NewCurrency(value string) (Currency, error) {
 		return Currency{}, err
 	}
 
-	return Currency{
+	currency := Currency{
 		value: normalized,
-	}, nil
+	}
+	if err := CurrencyValidators.Validate(currency); err != nil {
+		return Currency{}, err
+	}
+
+	return currency, nil
 }
 
 // ReconstituteCurrency creates a new Currency instance without validation or normalization
@@ -37,6 +58,22 @@ func ReconstituteCurrency(value string) Currency {
 	}
 }
 
+// AllowCurrencies returns a rule, for CurrencyValidators, accepting only the given codes
+// (e.g., the currencies a tenant is set up to trade in)
+func AllowCurrencies(codes ...string) domain.Validator[Currency] {
+	allowed := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		allowed[strings.ToUpper(strings.TrimSpace(code))] = true
+	}
+
+	return func(currency Currency) error {
+		if !allowed[currency.value] {
+			return ErrCurrencyNotAllowed
+		}
+		return nil
+	}
+}
+
 // Value returns the currency value
 func (c Currency) Value() string {
 	return c.value
@@ -47,6 +84,20 @@ func (c Currency) Equals(other Currency) bool {
 	return c.value == other.value
 }
 
+// MinorUnits returns the number of decimal places of the currency: the exponent registered
+// in the active CurrencyRegistry, the ISO 4217 minor unit, or DefaultMinorUnits
+func (c Currency) MinorUnits() int32 {
+	if minorUnits, ok := registeredMinorUnits(c.value); ok {
+		return minorUnits
+	}
+
+	if minorUnits, ok := isoMinorUnits[c.value]; ok {
+		return minorUnits
+	}
+
+	return DefaultMinorUnits
+}
+
 // String returns a string representation of the currency
 func (c Currency) String() string {
 	return c.value
@@ -64,13 +115,17 @@ func NormalizeCurrency(currency string) (string, error) {
 	return normalized, nil
 }
 
-// IsValidCurrency validates a currency (must be exactly 3 uppercase letters)
+// IsValidCurrency validates a currency (must be exactly 3 uppercase letters, or a code
+// registered in the active CurrencyRegistry)
 func IsValidCurrency(currency string) error {
 	if currency == "" {
 		return ErrEmptyCurrency
 	}
 
 	if !currencyRegex.MatchString(currency) {
+		if _, ok := registeredMinorUnits(currency); ok {
+			return nil
+		}
 		return ErrInvalidCurrency
 	}
 
@@ -182,3 +182,17 @@ func (s *CurrencyTestSuite) TestReconstitute() {
 	s.Equal("USD", currency.Value())
 	s.Equal("USD", currency.String())
 }
+
+func (s *CurrencyTestSuite) TestItRunsRegisteredValidators() {
+	unregister := CurrencyValidators.Register(AllowCurrencies("eur", "RON"))
+	defer unregister()
+
+	_, err := NewCurrency("EUR")
+	s.NoError(err)
+
+	_, err = NewCurrency("usd")
+	s.ErrorIs(err, ErrCurrencyNotAllowed)
+
+	_, err = NewMoneyFromString("10", "USD")
+	s.ErrorIs(err, ErrCurrencyNotAllowed)
+}
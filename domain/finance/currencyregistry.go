@@ -0,0 +1,127 @@
+package finance
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// DefaultMinorUnits is the number of decimal places of currencies without a specific entry
+const DefaultMinorUnits = 2
+
+// MaxMinorUnits is the largest minor-unit exponent accepted by CurrencyRegistry.Register
+// (ETH uses 18)
+const MaxMinorUnits = 18
+
+var (
+	ErrInvalidRegisteredCurrency = domain.NewError(
+		"registered currency code must be 2 to 10 uppercase letters or digits",
+	)
+	ErrInvalidMinorUnits = domain.NewError(
+		"currency minor units must be between 0 and %d",
+		MaxMinorUnits,
+	)
+)
+
+var registeredCurrencyRegex = regexp.MustCompile(`^[A-Z0-9]{2,10}$`)
+
+// isoMinorUnits lists the ISO 4217 currencies whose minor unit differs from two decimals
+var isoMinorUnits = map[string]int32{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0,
+	"XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// CurrencyRegistry holds non-ISO currencies, such as crypto-currencies, together with their
+// minor-unit exponents. Registered codes are only accepted by NewCurrency once the registry
+// is activated with UseCurrencyRegistry, so ISO-strict applications are unaffected.
+type CurrencyRegistry struct {
+	mu         sync.RWMutex
+	currencies map[string]int32
+}
+
+// NewCurrencyRegistry creates a new empty CurrencyRegistry
+func NewCurrencyRegistry() *CurrencyRegistry {
+	return &CurrencyRegistry{
+		currencies: make(map[string]int32),
+	}
+}
+
+// Register adds or replaces a currency code with the given number of minor units
+// (e.g., "BTC" with 8 or "USDT" with 6)
+func (r *CurrencyRegistry) Register(code string, minorUnits int32) error {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !registeredCurrencyRegex.MatchString(code) {
+		return ErrInvalidRegisteredCurrency
+	}
+
+	if minorUnits < 0 || minorUnits > MaxMinorUnits {
+		return ErrInvalidMinorUnits
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.currencies[code] = minorUnits
+	return nil
+}
+
+// MinorUnits returns the minor-unit exponent of a registered currency
+func (r *CurrencyRegistry) MinorUnits(code string) (int32, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	minorUnits, ok := r.currencies[code]
+	return minorUnits, ok
+}
+
+// Has reports whether the currency code is registered
+func (r *CurrencyRegistry) Has(code string) bool {
+	_, ok := r.MinorUnits(code)
+	return ok
+}
+
+// Codes returns the registered currency codes in sorted order
+func (r *CurrencyRegistry) Codes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codes := make([]string, 0, len(r.currencies))
+	for code := range r.currencies {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+var (
+	activeRegistryMu sync.RWMutex
+	activeRegistry   *CurrencyRegistry
+)
+
+// UseCurrencyRegistry activates the registry consulted by NewCurrency and
+// Currency.MinorUnits. Passing nil restores ISO-only behavior.
+func UseCurrencyRegistry(registry *CurrencyRegistry) {
+	activeRegistryMu.Lock()
+	defer activeRegistryMu.Unlock()
+	activeRegistry = registry
+}
+
+// ActiveCurrencyRegistry returns the activated registry, or nil if none is active
+func ActiveCurrencyRegistry() *CurrencyRegistry {
+	activeRegistryMu.RLock()
+	defer activeRegistryMu.RUnlock()
+	return activeRegistry
+}
+
+// registeredMinorUnits looks the code up in the active registry, if any
+func registeredMinorUnits(code string) (int32, bool) {
+	registry := ActiveCurrencyRegistry()
+	if registry == nil {
+		return 0, false
+	}
+	return registry.MinorUnits(code)
+}
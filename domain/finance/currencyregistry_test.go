@@ -0,0 +1,102 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CurrencyRegistryTestSuite struct {
+	suite.Suite
+}
+
+func TestCurrencyRegistrySuite(t *testing.T) {
+	suite.Run(t, new(CurrencyRegistryTestSuite))
+}
+
+func (s *CurrencyRegistryTestSuite) TearDownTest() {
+	UseCurrencyRegistry(nil)
+}
+
+func (s *CurrencyRegistryTestSuite) newRegistry() *CurrencyRegistry {
+	registry := NewCurrencyRegistry()
+	s.Require().NoError(registry.Register("BTC", 8))
+	s.Require().NoError(registry.Register(" eth ", 18))
+	s.Require().NoError(registry.Register("USDT", 6))
+	return registry
+}
+
+func (s *CurrencyRegistryTestSuite) TestItCanRegisterCurrencies() {
+	registry := s.newRegistry()
+
+	minorUnits, ok := registry.MinorUnits("ETH")
+	s.True(ok)
+	s.Equal(int32(18), minorUnits)
+	s.True(registry.Has("USDT"))
+	s.False(registry.Has("DOGE"))
+	s.Equal([]string{"BTC", "ETH", "USDT"}, registry.Codes())
+}
+
+func (s *CurrencyRegistryTestSuite) TestItFailsToRegisterInvalidCurrencies() {
+	registry := NewCurrencyRegistry()
+
+	s.ErrorIs(registry.Register("X", 2), ErrInvalidRegisteredCurrency)
+	s.ErrorIs(registry.Register("TOOLONGCODE1", 2), ErrInvalidRegisteredCurrency)
+	s.ErrorIs(registry.Register("US-T", 2), ErrInvalidRegisteredCurrency)
+	s.ErrorIs(registry.Register("BTC", -1), ErrInvalidMinorUnits)
+	s.ErrorIs(registry.Register("BTC", MaxMinorUnits+1), ErrInvalidMinorUnits)
+}
+
+func (s *CurrencyRegistryTestSuite) TestRegisteredCurrenciesRequireOptIn() {
+	registry := s.newRegistry()
+
+	_, err := NewCurrency("USDT")
+	s.ErrorIs(err, ErrInvalidCurrency)
+
+	UseCurrencyRegistry(registry)
+	s.Same(registry, ActiveCurrencyRegistry())
+
+	usdt, err := NewCurrency("usdt")
+	s.NoError(err)
+	s.Equal("USDT", usdt.Value())
+
+	UseCurrencyRegistry(nil)
+	_, err = NewCurrency("USDT")
+	s.ErrorIs(err, ErrInvalidCurrency)
+}
+
+func (s *CurrencyRegistryTestSuite) TestMinorUnits() {
+	testCases := []struct {
+		name     string
+		code     string
+		useReg   bool
+		expected int32
+	}{
+		{"ISO default", "USD", false, 2},
+		{"ISO zero decimals", "JPY", false, 0},
+		{"ISO three decimals", "BHD", false, 3},
+		{"Unregistered BTC uses default", "BTC", false, 2},
+		{"Registered BTC", "BTC", true, 8},
+		{"Registered ETH", "ETH", true, 18},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				if tc.useReg {
+					UseCurrencyRegistry(s.newRegistry())
+					defer UseCurrencyRegistry(nil)
+				}
+				s.Equal(tc.expected, ReconstituteCurrency(tc.code).MinorUnits())
+			},
+		)
+	}
+}
+
+func (s *CurrencyRegistryTestSuite) TestMoneyRoundingUsesRegisteredMinorUnits() {
+	UseCurrencyRegistry(s.newRegistry())
+
+	money, err := NewMoneyFromString("0.123456789", "BTC")
+	s.NoError(err)
+	s.Equal("0.12345679", money.Round(RoundHalfUp).Amount().String())
+}
@@ -0,0 +1,235 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+)
+
+// InverseRatePrecision is the number of decimal places kept when inverting an exchange rate
+const InverseRatePrecision = 12
+
+var (
+	ErrSameCurrencyPair    = domain.NewError("currency pair needs two different currencies")
+	ErrInvalidCurrencyPair = domain.NewError(
+		"currency pair must be two currency codes separated by a slash, e.g., \"EUR/USD\"",
+	)
+	ErrNonPositiveExchangeRate = domain.NewError("exchange rate must be positive")
+	ErrMissingExchangeRateTime = domain.NewError("exchange rate needs the time it was quoted at")
+	ErrExchangeRateCurrency    = domain.NewError(
+		"money must be in the base currency of the exchange rate",
+	)
+)
+
+// CurrencyPair is a base and a quote currency, written "EUR/USD": one unit of the base
+// currency is worth the rate in the quote currency
+type CurrencyPair struct {
+	base  Currency
+	quote Currency
+}
+
+// NewCurrencyPair creates a new instance of CurrencyPair with validation
+func NewCurrencyPair(base, quote Currency) (CurrencyPair, error) {
+	if err := IsValidCurrency(base.value); err != nil {
+		return CurrencyPair{}, err
+	}
+	if err := IsValidCurrency(quote.value); err != nil {
+		return CurrencyPair{}, err
+	}
+	if base.Equals(quote) {
+		return CurrencyPair{}, ErrSameCurrencyPair
+	}
+
+	return CurrencyPair{
+		base:  base,
+		quote: quote,
+	}, nil
+}
+
+// ParseCurrencyPair parses a currency pair written "EUR/USD"
+func ParseCurrencyPair(value string) (CurrencyPair, error) {
+	baseCode, quoteCode, found := strings.Cut(strings.TrimSpace(value), "/")
+	if !found {
+		return CurrencyPair{}, ErrInvalidCurrencyPair
+	}
+
+	base, err := NewCurrency(baseCode)
+	if err != nil {
+		return CurrencyPair{}, err
+	}
+	quote, err := NewCurrency(quoteCode)
+	if err != nil {
+		return CurrencyPair{}, err
+	}
+
+	return NewCurrencyPair(base, quote)
+}
+
+// ReconstituteCurrencyPair creates a new CurrencyPair instance without validation
+func ReconstituteCurrencyPair(base, quote Currency) CurrencyPair {
+	return CurrencyPair{
+		base:  base,
+		quote: quote,
+	}
+}
+
+// Base returns the currency being priced
+func (p CurrencyPair) Base() Currency {
+	return p.base
+}
+
+// Quote returns the currency the price is given in
+func (p CurrencyPair) Quote() Currency {
+	return p.quote
+}
+
+// Inverse returns the pair with the base and quote currencies swapped
+func (p CurrencyPair) Inverse() CurrencyPair {
+	return CurrencyPair{
+		base:  p.quote,
+		quote: p.base,
+	}
+}
+
+// Equals compares two CurrencyPair objects for equality
+func (p CurrencyPair) Equals(other CurrencyPair) bool {
+	return p.base.Equals(other.base) && p.quote.Equals(other.quote)
+}
+
+// String returns the pair written "EUR/USD"
+func (p CurrencyPair) String() string {
+	return p.base.String() + "/" + p.quote.String()
+}
+
+// ExchangeRate is the price of one unit of the base currency of a pair in its quote
+// currency, as quoted at a point in time
+type ExchangeRate struct {
+	pair CurrencyPair
+	rate decimal.Decimal
+	at   time.Time
+}
+
+// NewExchangeRate creates a new instance of ExchangeRate with validation
+func NewExchangeRate(
+	pair CurrencyPair,
+	rate decimal.Decimal,
+	at time.Time,
+) (ExchangeRate, error) {
+	if pair.base.Equals(pair.quote) {
+		return ExchangeRate{}, ErrSameCurrencyPair
+	}
+	if !rate.IsPositive() {
+		return ExchangeRate{}, ErrNonPositiveExchangeRate
+	}
+	if at.IsZero() {
+		return ExchangeRate{}, ErrMissingExchangeRateTime
+	}
+
+	return ExchangeRate{
+		pair: pair,
+		rate: rate,
+		at:   at,
+	}, nil
+}
+
+// ReconstituteExchangeRate creates a new ExchangeRate instance without validation
+func ReconstituteExchangeRate(
+	pair CurrencyPair,
+	rate decimal.Decimal,
+	at time.Time,
+) ExchangeRate {
+	return ExchangeRate{
+		pair: pair,
+		rate: rate,
+		at:   at,
+	}
+}
+
+// Pair returns the currency pair
+func (r ExchangeRate) Pair() CurrencyPair {
+	return r.pair
+}
+
+// Rate returns the price of one unit of the base currency in the quote currency
+func (r ExchangeRate) Rate() decimal.Decimal {
+	return r.rate
+}
+
+// At returns the time the rate was quoted at
+func (r ExchangeRate) At() time.Time {
+	return r.at
+}
+
+// Inverse returns the rate of the inverse pair, rounded to InverseRatePrecision decimals
+func (r ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{
+		pair: r.pair.Inverse(),
+		rate: decimal.NewFromInt(1).DivRound(r.rate, InverseRatePrecision),
+		at:   r.at,
+	}
+}
+
+// Convert returns the money, which must be in the base currency, converted to the quote
+// currency. The amount is exact; use Money.Round to round it to the quote currency's minor
+// units.
+func (r ExchangeRate) Convert(money Money) (Money, error) {
+	if !money.currency.Equals(r.pair.base) {
+		return Money{}, ErrExchangeRateCurrency.WithField("currency", money.currency.String())
+	}
+
+	return Money{
+		amount:   money.amount.Mul(r.rate),
+		currency: r.pair.quote,
+	}, nil
+}
+
+// Equals compares two ExchangeRate objects for equality
+func (r ExchangeRate) Equals(other ExchangeRate) bool {
+	return r.pair.Equals(other.pair) && r.rate.Equal(other.rate) && r.at.Equal(other.at)
+}
+
+// String returns a string representation of the rate, e.g., "EUR/USD 1.085"
+func (r ExchangeRate) String() string {
+	return r.pair.String() + " " + r.rate.String()
+}
+
+// exchangeRateJSON is the JSON representation of ExchangeRate
+type exchangeRateJSON struct {
+	Pair string          `json:"pair"`
+	Rate decimal.Decimal `json:"rate"`
+	At   time.Time       `json:"at"`
+}
+
+// MarshalJSON encodes the rate with its pair, exact decimal rate and quote time
+func (r ExchangeRate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(exchangeRateJSON{Pair: r.pair.String(), Rate: r.rate, At: r.at})
+}
+
+// UnmarshalJSON decodes the rate, with validation
+func (r *ExchangeRate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw exchangeRateJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid exchange rate JSON")
+	}
+
+	pair, err := ParseCurrencyPair(raw.Pair)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewExchangeRate(pair, raw.Rate, raw.At)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
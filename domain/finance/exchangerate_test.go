@@ -0,0 +1,105 @@
+package finance
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExchangeRateTestSuite struct {
+	suite.Suite
+	eurUSD CurrencyPair
+	at     time.Time
+}
+
+func TestExchangeRateSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRateTestSuite))
+}
+
+func (s *ExchangeRateTestSuite) SetupTest() {
+	pair, err := ParseCurrencyPair("EUR/USD")
+	s.Require().NoError(err)
+	s.eurUSD = pair
+	s.at = time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC)
+}
+
+func (s *ExchangeRateTestSuite) TestItCanParseCurrencyPairs() {
+	s.Equal("EUR", s.eurUSD.Base().Value())
+	s.Equal("USD", s.eurUSD.Quote().Value())
+	s.Equal("EUR/USD", s.eurUSD.String())
+	s.Equal("USD/EUR", s.eurUSD.Inverse().String())
+
+	pair, err := ParseCurrencyPair(" eur/usd ")
+	s.Require().NoError(err)
+	s.True(s.eurUSD.Equals(pair))
+	s.False(s.eurUSD.Equals(pair.Inverse()))
+}
+
+func (s *ExchangeRateTestSuite) TestItFailsWithInvalidCurrencyPairs() {
+	_, err := ParseCurrencyPair("EURUSD")
+	s.True(errors.Is(err, ErrInvalidCurrencyPair))
+
+	_, err = ParseCurrencyPair("EUR/EUR")
+	s.True(errors.Is(err, ErrSameCurrencyPair))
+
+	_, err = ParseCurrencyPair("EUR/US")
+	s.True(errors.Is(err, ErrInvalidCurrency))
+
+	_, err = NewCurrencyPair(Currency{}, s.eurUSD.Quote())
+	s.Error(err)
+}
+
+func (s *ExchangeRateTestSuite) TestItCanConvertMoney() {
+	rate, err := NewExchangeRate(s.eurUSD, decimal.RequireFromString("1.085"), s.at)
+	s.Require().NoError(err)
+
+	price, _ := NewMoneyFromString("19.99", "EUR")
+	converted, err := rate.Convert(price)
+	s.Require().NoError(err)
+	s.Equal("21.68915 USD", converted.String())
+	s.Equal("21.69 USD", converted.Round(RoundHalfUp).String())
+
+	dollars, _ := NewMoneyFromString("1", "USD")
+	_, err = rate.Convert(dollars)
+	s.True(errors.Is(err, ErrExchangeRateCurrency))
+}
+
+func (s *ExchangeRateTestSuite) TestItCanInvertRates() {
+	rate, _ := NewExchangeRate(s.eurUSD, decimal.RequireFromString("1.25"), s.at)
+
+	inverse := rate.Inverse()
+	s.Equal("USD/EUR 0.8", inverse.String())
+	s.True(s.at.Equal(inverse.At()))
+}
+
+func (s *ExchangeRateTestSuite) TestItFailsWithInvalidRates() {
+	_, err := NewExchangeRate(s.eurUSD, decimal.Zero, s.at)
+	s.True(errors.Is(err, ErrNonPositiveExchangeRate))
+
+	_, err = NewExchangeRate(s.eurUSD, decimal.NewFromInt(1), time.Time{})
+	s.True(errors.Is(err, ErrMissingExchangeRateTime))
+
+	_, err = NewExchangeRate(CurrencyPair{}, decimal.NewFromInt(1), s.at)
+	s.True(errors.Is(err, ErrSameCurrencyPair))
+}
+
+func (s *ExchangeRateTestSuite) TestItCanRoundTripThroughJSON() {
+	rate, _ := NewExchangeRate(s.eurUSD, decimal.RequireFromString("1.085"), s.at)
+
+	data, err := json.Marshal(rate)
+	s.Require().NoError(err)
+	s.JSONEq(`{"pair":"EUR/USD","rate":"1.085","at":"2024-03-01T16:00:00Z"}`, string(data))
+
+	var decoded ExchangeRate
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(rate.Equals(decoded))
+
+	negative := `{"pair":"EUR/USD","rate":"-1","at":"2024-03-01T16:00:00Z"}`
+	err = json.Unmarshal([]byte(negative), &decoded)
+	s.True(errors.Is(err, ErrNonPositiveExchangeRate))
+	s.Error(json.Unmarshal([]byte(`{"pair":"EUR-USD"}`), &decoded))
+}
@@ -0,0 +1,225 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+)
+
+// MaxInterestRatePercent is the highest annual percentage rate accepted by NewInterestRate
+const MaxInterestRatePercent = 1000
+
+// interestPrecision is the number of decimal places kept when deriving periodic rates
+const interestPrecision = 16
+
+var (
+	ErrNegativeInterestRate = domain.NewLocalizedError(
+		"finance.interest_rate.negative", nil,
+		"interest rate cannot be negative",
+	)
+	ErrInterestRateTooHigh = domain.NewLocalizedError(
+		"finance.interest_rate.too_high", domain.MessageParams{"max": MaxInterestRatePercent},
+		"interest rate cannot exceed %d%% per year",
+		MaxInterestRatePercent,
+	)
+	ErrInvalidCompoundingPeriod = domain.NewError("unknown compounding period")
+	ErrNegativeInterestTerm     = domain.NewError("interest term cannot be negative")
+)
+
+var hundred = decimal.NewFromInt(100)
+
+// CompoundingPeriod is the number of times per year interest is applied
+type CompoundingPeriod int
+
+const (
+	CompoundAnnually CompoundingPeriod = 1
+	CompoundMonthly  CompoundingPeriod = 12
+	CompoundDaily    CompoundingPeriod = 365
+)
+
+// IsValid reports whether the compounding period is one of the known periods
+func (p CompoundingPeriod) IsValid() bool {
+	return p == CompoundAnnually || p == CompoundMonthly || p == CompoundDaily
+}
+
+// InterestRate represents a nominal annual percentage rate (APR), e.g., 5.25 for 5.25%
+type InterestRate struct {
+	annualPercent decimal.Decimal
+}
+
+// NewInterestRate creates a new InterestRate from an annual percentage, with validation
+func NewInterestRate(annualPercent decimal.Decimal) (InterestRate, error) {
+	if err := IsValidInterestRate(annualPercent); err != nil {
+		return InterestRate{}, err
+	}
+
+	return InterestRate{
+		annualPercent: annualPercent,
+	}, nil
+}
+
+// NewInterestRateFromString creates a new InterestRate from an annual percentage string
+func NewInterestRateFromString(annualPercent string) (InterestRate, error) {
+	value, err := decimal.NewFromString(annualPercent)
+	if err != nil {
+		return InterestRate{}, domain.NewErrorWithWrap(err, "invalid interest rate format")
+	}
+
+	return NewInterestRate(value)
+}
+
+// NewInterestRateFromPeriodic creates a new InterestRate from the percentage charged per
+// period, e.g., 1.5% per month becomes an 18% APR
+func NewInterestRateFromPeriodic(
+	periodPercent decimal.Decimal,
+	period CompoundingPeriod,
+) (InterestRate, error) {
+	if !period.IsValid() {
+		return InterestRate{}, ErrInvalidCompoundingPeriod
+	}
+
+	return NewInterestRate(periodPercent.Mul(decimal.NewFromInt(int64(period))))
+}
+
+// ReconstituteInterestRate creates a new InterestRate instance without validation
+func ReconstituteInterestRate(annualPercent decimal.Decimal) InterestRate {
+	return InterestRate{
+		annualPercent: annualPercent,
+	}
+}
+
+// AnnualPercent returns the nominal annual rate as a percentage
+func (r InterestRate) AnnualPercent() decimal.Decimal {
+	return r.annualPercent
+}
+
+// PeriodicPercent returns the percentage charged per period, e.g., the monthly rate
+func (r InterestRate) PeriodicPercent(period CompoundingPeriod) (decimal.Decimal, error) {
+	if !period.IsValid() {
+		return decimal.Zero, ErrInvalidCompoundingPeriod
+	}
+
+	return r.annualPercent.DivRound(decimal.NewFromInt(int64(period)), interestPrecision), nil
+}
+
+// EffectiveAnnualPercent returns the effective annual rate (APY) as a percentage when
+// interest is compounded with the given period
+func (r InterestRate) EffectiveAnnualPercent(period CompoundingPeriod) (decimal.Decimal, error) {
+	factor, err := r.growthFactor(period, int32(period))
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return factor.Sub(decimal.NewFromInt(1)).Mul(hundred).Round(interestPrecision - 2), nil
+}
+
+// SimpleInterest returns the interest earned on the principal over the given number of
+// years without compounding, rounded with the given policy
+func (r InterestRate) SimpleInterest(
+	principal Money,
+	years decimal.Decimal,
+	rounding Rounding,
+) (Money, error) {
+	if years.IsNegative() {
+		return Money{}, ErrNegativeInterestTerm
+	}
+
+	interest := principal.amount.Mul(r.annualPercent).Mul(years).Div(hundred)
+	return Money{
+		amount:   rounding.Apply(interest),
+		currency: principal.currency,
+	}, nil
+}
+
+// CompoundInterest returns the interest earned on the principal over the given number of
+// compounding periods, rounded with the given policy
+func (r InterestRate) CompoundInterest(
+	principal Money,
+	period CompoundingPeriod,
+	periods int32,
+	rounding Rounding,
+) (Money, error) {
+	if periods < 0 {
+		return Money{}, ErrNegativeInterestTerm
+	}
+
+	factor, err := r.growthFactor(period, periods)
+	if err != nil {
+		return Money{}, err
+	}
+
+	interest := principal.amount.Mul(factor).Sub(principal.amount)
+	return Money{
+		amount:   rounding.Apply(interest),
+		currency: principal.currency,
+	}, nil
+}
+
+// growthFactor returns (1 + periodic rate)^periods
+func (r InterestRate) growthFactor(
+	period CompoundingPeriod,
+	periods int32,
+) (decimal.Decimal, error) {
+	periodPercent, err := r.PeriodicPercent(period)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	base := decimal.NewFromInt(1).Add(periodPercent.Div(hundred))
+	factor, err := base.PowWithPrecision(decimal.NewFromInt32(periods), interestPrecision)
+	if err != nil {
+		return decimal.Zero, domain.NewInternalErrorWithWrap(err, "failed to compound interest")
+	}
+
+	return factor, nil
+}
+
+// Equals compares two InterestRate objects for equality
+func (r InterestRate) Equals(other InterestRate) bool {
+	return r.annualPercent.Equal(other.annualPercent)
+}
+
+// String returns a string representation of the interest rate, e.g., "5.25%"
+func (r InterestRate) String() string {
+	return r.annualPercent.String() + "%"
+}
+
+// MarshalJSON encodes the interest rate as a decimal string holding the annual percentage
+func (r InterestRate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.annualPercent.String())
+}
+
+// UnmarshalJSON decodes the interest rate from a decimal string or number, with validation
+func (r *InterestRate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var value decimal.Decimal
+	if err := value.UnmarshalJSON(data); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid interest rate JSON")
+	}
+
+	parsed, err := NewInterestRate(value)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// IsValidInterestRate validates an annual percentage rate
+func IsValidInterestRate(annualPercent decimal.Decimal) error {
+	if annualPercent.IsNegative() {
+		return ErrNegativeInterestRate
+	}
+
+	if annualPercent.GreaterThan(decimal.NewFromInt(MaxInterestRatePercent)) {
+		return ErrInterestRateTooHigh
+	}
+
+	return nil
+}
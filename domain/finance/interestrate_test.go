@@ -0,0 +1,142 @@
+package finance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type InterestRateTestSuite struct {
+	suite.Suite
+	rounding Rounding
+}
+
+func TestInterestRateSuite(t *testing.T) {
+	suite.Run(t, new(InterestRateTestSuite))
+}
+
+func (s *InterestRateTestSuite) SetupTest() {
+	s.rounding, _ = NewRounding(2, RoundHalfUp)
+}
+
+func (s *InterestRateTestSuite) TestItCanBuildNewInterestRate() {
+	testCases := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError error
+	}{
+		{"Typical APR", "5.25", "5.25%", nil},
+		{"Zero rate", "0", "0%", nil},
+		{"Upper bound", "1000", "1000%", nil},
+		{"Negative rate", "-0.5", "", ErrNegativeInterestRate},
+		{"Above upper bound", "1000.01", "", ErrInterestRateTooHigh},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				rate, err := NewInterestRateFromString(tc.input)
+				if tc.expectedError != nil {
+					s.ErrorIs(err, tc.expectedError)
+					return
+				}
+				s.NoError(err)
+				s.Equal(tc.expected, rate.String())
+			},
+		)
+	}
+
+	_, err := NewInterestRateFromString("five")
+	s.Error(err)
+}
+
+func (s *InterestRateTestSuite) TestItCanConvertBetweenPeriods() {
+	rate, _ := NewInterestRateFromString("12")
+
+	monthly, err := rate.PeriodicPercent(CompoundMonthly)
+	s.NoError(err)
+	s.Equal("1", monthly.String())
+
+	daily, err := rate.PeriodicPercent(CompoundDaily)
+	s.NoError(err)
+	s.Equal("0.0328767123287671", daily.String())
+
+	fromMonthly, err := NewInterestRateFromPeriodic(decimal.RequireFromString("1.5"), CompoundMonthly)
+	s.NoError(err)
+	s.Equal("18%", fromMonthly.String())
+
+	_, err = rate.PeriodicPercent(CompoundingPeriod(7))
+	s.ErrorIs(err, ErrInvalidCompoundingPeriod)
+
+	_, err = NewInterestRateFromPeriodic(decimal.NewFromInt(1), CompoundingPeriod(0))
+	s.ErrorIs(err, ErrInvalidCompoundingPeriod)
+}
+
+func (s *InterestRateTestSuite) TestEffectiveAnnualPercent() {
+	rate, _ := NewInterestRateFromString("12")
+
+	annually, err := rate.EffectiveAnnualPercent(CompoundAnnually)
+	s.NoError(err)
+	s.Equal("12", annually.String())
+
+	monthly, err := rate.EffectiveAnnualPercent(CompoundMonthly)
+	s.NoError(err)
+	s.Equal("12.6825", monthly.Round(4).String())
+}
+
+func (s *InterestRateTestSuite) TestItCanApplyInterestToMoney() {
+	principal, _ := NewMoneyFromString("1000", "USD")
+
+	simpleRate, _ := NewInterestRateFromString("5")
+	simple, err := simpleRate.SimpleInterest(principal, decimal.NewFromInt(2), s.rounding)
+	s.NoError(err)
+	s.Equal("100 USD", simple.String())
+
+	halfYear, err := simpleRate.SimpleInterest(principal, decimal.RequireFromString("0.5"), s.rounding)
+	s.NoError(err)
+	s.Equal("25 USD", halfYear.String())
+
+	compoundRate, _ := NewInterestRateFromString("12")
+	compound, err := compoundRate.CompoundInterest(principal, CompoundMonthly, 12, s.rounding)
+	s.NoError(err)
+	s.Equal("126.83 USD", compound.String())
+
+	none, err := compoundRate.CompoundInterest(principal, CompoundMonthly, 0, s.rounding)
+	s.NoError(err)
+	s.True(none.Amount().IsZero())
+
+	_, err = compoundRate.CompoundInterest(principal, CompoundMonthly, -1, s.rounding)
+	s.ErrorIs(err, ErrNegativeInterestTerm)
+
+	_, err = simpleRate.SimpleInterest(principal, decimal.NewFromInt(-1), s.rounding)
+	s.ErrorIs(err, ErrNegativeInterestTerm)
+}
+
+func (s *InterestRateTestSuite) TestItCanMarshalAndUnmarshalJSON() {
+	rate, _ := NewInterestRateFromString("5.25")
+
+	encoded, err := json.Marshal(rate)
+	s.NoError(err)
+	s.Equal(`"5.25"`, string(encoded))
+
+	var decoded InterestRate
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(rate.Equals(decoded))
+
+	s.NoError(json.Unmarshal([]byte(`7.5`), &decoded))
+	s.Equal("7.5%", decoded.String())
+
+	s.ErrorIs(json.Unmarshal([]byte(`"-1"`), &decoded), ErrNegativeInterestRate)
+}
+
+func (s *InterestRateTestSuite) TestEquals() {
+	rate1, _ := NewInterestRateFromString("5.0")
+	rate2 := ReconstituteInterestRate(decimal.NewFromInt(5))
+	rate3, _ := NewInterestRateFromString("5.1")
+
+	s.True(rate1.Equals(rate2))
+	s.False(rate1.Equals(rate3))
+}
@@ -0,0 +1,367 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	// DefaultInvoiceNumberPatternText is the pattern used until SetDefaultInvoiceNumberPattern
+	// is called, e.g., "INV-2024-000042"
+	DefaultInvoiceNumberPatternText = "INV-{YYYY}-{SEQ:6}"
+	// MaxInvoiceSequenceWidth is the widest zero-padded sequence a pattern may declare
+	MaxInvoiceSequenceWidth = 18
+)
+
+var (
+	ErrInvalidInvoiceNumber = domain.NewLocalizedError(
+		"finance.invoice_number.invalid", nil,
+		"invoice number does not match the invoice numbering pattern",
+	)
+	ErrInvalidInvoiceNumberPattern = domain.NewError(
+		"invoice number pattern needs exactly one {SEQ} or {SEQ:width} placeholder, " +
+			"with a width between 1 and 18",
+	)
+	ErrInvalidInvoiceSequence  = domain.NewError("invoice sequence must be positive")
+	ErrInvoiceSequenceOverflow = domain.NewError(
+		"invoice sequence has more digits than the pattern allows",
+	)
+	ErrInvoicePeriodBeforePrevious = domain.NewError(
+		"invoice cannot be issued in a period before the previous invoice",
+	)
+)
+
+// invoicePlaceholder matches the placeholders of an invoice number pattern
+var invoicePlaceholder = regexp.MustCompile(`\{(YYYY|YY|MM|SEQ(?::(\d+))?)\}`)
+
+// invoicePartKind is the kind of a part of an invoice number pattern
+type invoicePartKind int
+
+const (
+	invoicePartLiteral invoicePartKind = iota
+	invoicePartYear
+	invoicePartShortYear
+	invoicePartMonth
+	invoicePartSequence
+)
+
+// invoicePart is a literal or a placeholder of an invoice number pattern
+type invoicePart struct {
+	kind  invoicePartKind
+	text  string
+	width int
+}
+
+// InvoiceNumberPattern describes how invoice numbers are built from the issue date and a
+// sequence. Patterns mix literal text with the placeholders {YYYY} (year), {YY} (two-digit
+// year), {MM} (month) and exactly one {SEQ} (sequence) or {SEQ:width} (zero-padded sequence),
+// e.g., "INV-{YYYY}-{SEQ:6}" or "{YY}{MM}/{SEQ}".
+type InvoiceNumberPattern struct {
+	text    string
+	parts   []invoicePart
+	matcher *regexp.Regexp
+}
+
+// NewInvoiceNumberPattern compiles an invoice number pattern
+func NewInvoiceNumberPattern(pattern string) (InvoiceNumberPattern, error) {
+	var parts []invoicePart
+	var expression strings.Builder
+	expression.WriteString("^")
+	sequences := 0
+	last := 0
+	for _, match := range invoicePlaceholder.FindAllStringSubmatchIndex(pattern, -1) {
+		if literal := pattern[last:match[0]]; literal != "" {
+			parts = append(parts, invoicePart{kind: invoicePartLiteral, text: literal})
+			expression.WriteString(regexp.QuoteMeta(literal))
+		}
+		last = match[1]
+
+		placeholder := pattern[match[2]:match[3]]
+		switch {
+		case placeholder == "YYYY":
+			parts = append(parts, invoicePart{kind: invoicePartYear})
+			expression.WriteString(`(\d{4})`)
+		case placeholder == "YY":
+			parts = append(parts, invoicePart{kind: invoicePartShortYear})
+			expression.WriteString(`(\d{2})`)
+		case placeholder == "MM":
+			parts = append(parts, invoicePart{kind: invoicePartMonth})
+			expression.WriteString(`(0[1-9]|1[0-2])`)
+		default:
+			sequences++
+			width := 0
+			if match[4] >= 0 {
+				width, _ = strconv.Atoi(pattern[match[4]:match[5]])
+				if width < 1 || width > MaxInvoiceSequenceWidth {
+					return InvoiceNumberPattern{}, ErrInvalidInvoiceNumberPattern
+				}
+			}
+			parts = append(parts, invoicePart{kind: invoicePartSequence, width: width})
+			if width > 0 {
+				expression.WriteString(`(\d{` + strconv.Itoa(width) + `})`)
+			} else {
+				expression.WriteString(`([1-9]\d*)`)
+			}
+		}
+	}
+	if literal := pattern[last:]; literal != "" {
+		parts = append(parts, invoicePart{kind: invoicePartLiteral, text: literal})
+		expression.WriteString(regexp.QuoteMeta(literal))
+	}
+	expression.WriteString("$")
+
+	if sequences != 1 {
+		return InvoiceNumberPattern{}, ErrInvalidInvoiceNumberPattern
+	}
+
+	return InvoiceNumberPattern{
+		text:    pattern,
+		parts:   parts,
+		matcher: regexp.MustCompile(expression.String()),
+	}, nil
+}
+
+// Format returns the invoice number issued on the date with the sequence. It fails with
+// ErrInvalidInvoiceSequence for a zero sequence and with ErrInvoiceSequenceOverflow when the
+// sequence does not fit the declared width.
+func (p InvoiceNumberPattern) Format(
+	issuedOn time.Time,
+	sequence uint64,
+) (InvoiceNumber, error) {
+	if sequence == 0 {
+		return InvoiceNumber{}, ErrInvalidInvoiceSequence
+	}
+
+	var value strings.Builder
+	for _, part := range p.parts {
+		switch part.kind {
+		case invoicePartLiteral:
+			value.WriteString(part.text)
+		case invoicePartYear:
+			fmt.Fprintf(&value, "%04d", issuedOn.Year())
+		case invoicePartShortYear:
+			fmt.Fprintf(&value, "%02d", issuedOn.Year()%100)
+		case invoicePartMonth:
+			fmt.Fprintf(&value, "%02d", int(issuedOn.Month()))
+		case invoicePartSequence:
+			digits := strconv.FormatUint(sequence, 10)
+			if part.width > 0 && len(digits) > part.width {
+				return InvoiceNumber{}, ErrInvoiceSequenceOverflow.WithField("sequence", sequence)
+			}
+			value.WriteString(strings.Repeat("0", max(part.width-len(digits), 0)) + digits)
+		}
+	}
+
+	return InvoiceNumber{
+		value:    value.String(),
+		year:     p.year(issuedOn.Year()),
+		month:    p.month(issuedOn.Month()),
+		sequence: sequence,
+	}, nil
+}
+
+// Next returns the invoice number following previous, issued on the date. The sequence
+// restarts at 1 when the year changes, for patterns with a year, or when the month changes,
+// for patterns with a month; otherwise it continues without gaps.
+func (p InvoiceNumberPattern) Next(
+	previous InvoiceNumber,
+	issuedOn time.Time,
+) (InvoiceNumber, error) {
+	year, month := p.year(issuedOn.Year()), p.month(issuedOn.Month())
+	switch {
+	case year < previous.year || (year == previous.year && month < previous.month):
+		return InvoiceNumber{}, ErrInvoicePeriodBeforePrevious
+	case year == previous.year && month == previous.month:
+		return p.Format(issuedOn, previous.sequence+1)
+	default:
+		return p.Format(issuedOn, 1)
+	}
+}
+
+// Parse splits an invoice number into its components. It fails with ErrInvalidInvoiceNumber
+// when the value does not match the pattern.
+func (p InvoiceNumberPattern) Parse(value string) (InvoiceNumber, error) {
+	value = strings.TrimSpace(value)
+	if p.matcher == nil {
+		return InvoiceNumber{}, ErrInvalidInvoiceNumberPattern
+	}
+
+	groups := p.matcher.FindStringSubmatch(value)
+	if groups == nil {
+		return InvoiceNumber{}, ErrInvalidInvoiceNumber
+	}
+
+	number := InvoiceNumber{value: value}
+	group := 1
+	for _, part := range p.parts {
+		if part.kind == invoicePartLiteral {
+			continue
+		}
+
+		digits := groups[group]
+		group++
+		parsed, err := strconv.ParseUint(digits, 10, 64)
+		if err != nil {
+			return InvoiceNumber{}, ErrInvalidInvoiceNumber
+		}
+		switch part.kind {
+		case invoicePartYear:
+			number.year = int(parsed)
+		case invoicePartShortYear:
+			number.year = 2000 + int(parsed)
+		case invoicePartMonth:
+			number.month = time.Month(parsed)
+		case invoicePartSequence:
+			number.sequence = parsed
+		}
+	}
+	if number.sequence == 0 {
+		return InvoiceNumber{}, ErrInvalidInvoiceNumber
+	}
+
+	return number, nil
+}
+
+// String returns the pattern text, e.g., "INV-{YYYY}-{SEQ:6}"
+func (p InvoiceNumberPattern) String() string {
+	return p.text
+}
+
+// year returns the year recorded in numbers of the pattern, or zero if it has no year
+func (p InvoiceNumberPattern) year(year int) int {
+	if !p.has(invoicePartYear) && !p.has(invoicePartShortYear) {
+		return 0
+	}
+	return year
+}
+
+// month returns the month recorded in numbers of the pattern, or zero if it has no month
+func (p InvoiceNumberPattern) month(month time.Month) time.Month {
+	if !p.has(invoicePartMonth) {
+		return 0
+	}
+	return month
+}
+
+// has reports whether the pattern has a part of the kind
+func (p InvoiceNumberPattern) has(kind invoicePartKind) bool {
+	for _, part := range p.parts {
+		if part.kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+var defaultInvoiceNumberPattern atomic.Pointer[InvoiceNumberPattern]
+
+func init() {
+	pattern, err := NewInvoiceNumberPattern(DefaultInvoiceNumberPatternText)
+	if err != nil {
+		panic(err)
+	}
+	defaultInvoiceNumberPattern.Store(&pattern)
+}
+
+// SetDefaultInvoiceNumberPattern sets the pattern used by NewInvoiceNumber and by the JSON
+// decoding of InvoiceNumber
+func SetDefaultInvoiceNumberPattern(pattern InvoiceNumberPattern) {
+	defaultInvoiceNumberPattern.Store(&pattern)
+}
+
+// DefaultInvoiceNumberPattern returns the pattern used by NewInvoiceNumber
+func DefaultInvoiceNumberPattern() InvoiceNumberPattern {
+	return *defaultInvoiceNumberPattern.Load()
+}
+
+// InvoiceNumber is the number of an invoice, together with the year, month and sequence it
+// was built from. The year and month are zero when the pattern does not include them.
+type InvoiceNumber struct {
+	value    string
+	year     int
+	month    time.Month
+	sequence uint64
+}
+
+// NewInvoiceNumber parses an invoice number with the default pattern
+func NewInvoiceNumber(value string) (InvoiceNumber, error) {
+	return DefaultInvoiceNumberPattern().Parse(value)
+}
+
+// ReconstituteInvoiceNumber creates a new InvoiceNumber instance without validation
+func ReconstituteInvoiceNumber(
+	value string,
+	year int,
+	month time.Month,
+	sequence uint64,
+) InvoiceNumber {
+	return InvoiceNumber{
+		value:    value,
+		year:     year,
+		month:    month,
+		sequence: sequence,
+	}
+}
+
+// Value returns the invoice number, e.g., "INV-2024-000042"
+func (n InvoiceNumber) Value() string {
+	return n.value
+}
+
+// Year returns the year of the invoice number, or zero
+func (n InvoiceNumber) Year() int {
+	return n.year
+}
+
+// Month returns the month of the invoice number, or zero
+func (n InvoiceNumber) Month() time.Month {
+	return n.month
+}
+
+// Sequence returns the sequence of the invoice number
+func (n InvoiceNumber) Sequence() uint64 {
+	return n.sequence
+}
+
+// Equals compares two InvoiceNumber objects for equality
+func (n InvoiceNumber) Equals(other InvoiceNumber) bool {
+	return n.value == other.value
+}
+
+// String returns the invoice number
+func (n InvoiceNumber) String() string {
+	return n.value
+}
+
+// MarshalJSON encodes the invoice number as a JSON string
+func (n InvoiceNumber) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON decodes the invoice number from a JSON string with the default pattern
+func (n *InvoiceNumber) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid invoice number JSON")
+	}
+
+	parsed, err := NewInvoiceNumber(raw)
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
@@ -0,0 +1,232 @@
+package finance
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type InvoiceNumberTestSuite struct {
+	suite.Suite
+}
+
+func TestInvoiceNumberSuite(t *testing.T) {
+	suite.Run(t, new(InvoiceNumberTestSuite))
+}
+
+func (s *InvoiceNumberTestSuite) pattern(text string) InvoiceNumberPattern {
+	pattern, err := NewInvoiceNumberPattern(text)
+	s.Require().NoError(err)
+	return pattern
+}
+
+func (s *InvoiceNumberTestSuite) day(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *InvoiceNumberTestSuite) TestItFormatsZeroPaddedSequences() {
+	pattern := s.pattern("INV-{YYYY}-{SEQ:6}")
+
+	number, err := pattern.Format(s.day(2024, time.March, 5), 42)
+	s.Require().NoError(err)
+	s.Equal("INV-2024-000042", number.Value())
+	s.Equal("INV-2024-000042", number.String())
+	s.Equal(2024, number.Year())
+	s.Equal(time.Month(0), number.Month())
+	s.Equal(uint64(42), number.Sequence())
+	s.Equal("INV-{YYYY}-{SEQ:6}", pattern.String())
+}
+
+func (s *InvoiceNumberTestSuite) TestItFormatsAllPlaceholders() {
+	number, err := s.pattern("{YY}{MM}/{SEQ}").Format(s.day(2025, time.November, 30), 1234567)
+	s.Require().NoError(err)
+	s.Equal("2511/1234567", number.Value())
+	s.Equal(2025, number.Year())
+	s.Equal(time.November, number.Month())
+}
+
+func (s *InvoiceNumberTestSuite) TestItFailsToFormatInvalidSequences() {
+	pattern := s.pattern("INV-{SEQ:3}")
+
+	_, err := pattern.Format(s.day(2024, time.March, 5), 0)
+	s.ErrorIs(err, ErrInvalidInvoiceSequence)
+
+	_, err = pattern.Format(s.day(2024, time.March, 5), 1000)
+	s.ErrorIs(err, ErrInvoiceSequenceOverflow)
+
+	number, err := pattern.Format(s.day(2024, time.March, 5), 999)
+	s.Require().NoError(err)
+	s.Equal("INV-999", number.Value())
+}
+
+func (s *InvoiceNumberTestSuite) TestItFailsToCompileInvalidPatterns() {
+	for _, text := range []string{
+		"",
+		"INV-{YYYY}",
+		"{SEQ}-{SEQ:4}",
+		"INV-{SEQ:0}",
+		"INV-{SEQ:19}",
+	} {
+		_, err := NewInvoiceNumberPattern(text)
+		s.ErrorIs(err, ErrInvalidInvoiceNumberPattern, text)
+	}
+}
+
+func (s *InvoiceNumberTestSuite) TestItParsesNumbersIntoComponents() {
+	pattern := s.pattern("F.{YYYY}.{MM}-{SEQ:4}")
+
+	number, err := pattern.Parse(" F.2024.07-0105 ")
+	s.Require().NoError(err)
+	s.Equal("F.2024.07-0105", number.Value())
+	s.Equal(2024, number.Year())
+	s.Equal(time.July, number.Month())
+	s.Equal(uint64(105), number.Sequence())
+
+	formatted, err := pattern.Format(s.day(2024, time.July, 1), 105)
+	s.Require().NoError(err)
+	s.True(formatted.Equals(number))
+}
+
+func (s *InvoiceNumberTestSuite) TestItFailsToParseNumbersNotMatchingThePattern() {
+	pattern := s.pattern("F.{YYYY}.{MM}-{SEQ:4}")
+
+	for _, value := range []string{
+		"",
+		"F.2024.07-105",
+		"F.2024.13-0105",
+		"Fx2024.07-0105",
+		"F.2024.07-0000",
+		"F.2024.07-01050",
+	} {
+		_, err := pattern.Parse(value)
+		s.ErrorIs(err, ErrInvalidInvoiceNumber, value)
+	}
+
+	_, err := s.pattern("{SEQ}").Parse("007")
+	s.ErrorIs(err, ErrInvalidInvoiceNumber)
+}
+
+func (s *InvoiceNumberTestSuite) TestItContinuesTheSequenceWithinAPeriod() {
+	pattern := s.pattern("INV-{YYYY}-{SEQ:6}")
+	previous, err := pattern.Parse("INV-2024-000041")
+	s.Require().NoError(err)
+
+	next, err := pattern.Next(previous, s.day(2024, time.December, 31))
+	s.Require().NoError(err)
+	s.Equal("INV-2024-000042", next.Value())
+}
+
+func (s *InvoiceNumberTestSuite) TestItRestartsTheSequenceInANewPeriod() {
+	yearly := s.pattern("INV-{YYYY}-{SEQ:6}")
+	previous, err := yearly.Parse("INV-2024-000041")
+	s.Require().NoError(err)
+
+	next, err := yearly.Next(previous, s.day(2025, time.January, 1))
+	s.Require().NoError(err)
+	s.Equal("INV-2025-000001", next.Value())
+
+	monthly := s.pattern("{YYYY}{MM}-{SEQ}")
+	previous, err = monthly.Parse("202401-17")
+	s.Require().NoError(err)
+
+	next, err = monthly.Next(previous, s.day(2024, time.February, 1))
+	s.Require().NoError(err)
+	s.Equal("202402-1", next.Value())
+}
+
+func (s *InvoiceNumberTestSuite) TestItNeverRestartsPatternsWithoutAPeriod() {
+	pattern := s.pattern("INV{SEQ:5}")
+	previous, err := pattern.Parse("INV00009")
+	s.Require().NoError(err)
+
+	next, err := pattern.Next(previous, s.day(2030, time.June, 1))
+	s.Require().NoError(err)
+	s.Equal("INV00010", next.Value())
+}
+
+func (s *InvoiceNumberTestSuite) TestItFailsToIssueNumbersInAnEarlierPeriod() {
+	pattern := s.pattern("INV-{YYYY}-{SEQ:6}")
+	previous, err := pattern.Parse("INV-2024-000041")
+	s.Require().NoError(err)
+
+	_, err = pattern.Next(previous, s.day(2023, time.December, 31))
+	s.ErrorIs(err, ErrInvoicePeriodBeforePrevious)
+}
+
+func (s *InvoiceNumberTestSuite) TestItFailsToIssueNumbersPastTheSequenceWidth() {
+	pattern := s.pattern("INV-{SEQ:2}")
+	previous, err := pattern.Parse("INV-99")
+	s.Require().NoError(err)
+
+	_, err = pattern.Next(previous, s.day(2024, time.March, 5))
+	s.ErrorIs(err, ErrInvoiceSequenceOverflow)
+}
+
+func (s *InvoiceNumberTestSuite) TestItParsesWithTheDefaultPattern() {
+	number, err := NewInvoiceNumber("INV-2024-000042")
+	s.Require().NoError(err)
+	s.Equal(uint64(42), number.Sequence())
+
+	_, err = NewInvoiceNumber("2024/42")
+	s.ErrorIs(err, ErrInvalidInvoiceNumber)
+	s.Equal(DefaultInvoiceNumberPatternText, DefaultInvoiceNumberPattern().String())
+}
+
+func (s *InvoiceNumberTestSuite) TestItCanChangeTheDefaultPattern() {
+	previous := DefaultInvoiceNumberPattern()
+	defer SetDefaultInvoiceNumberPattern(previous)
+
+	SetDefaultInvoiceNumberPattern(s.pattern("{YYYY}/{SEQ}"))
+	number, err := NewInvoiceNumber("2024/42")
+	s.Require().NoError(err)
+	s.Equal(2024, number.Year())
+	s.Equal(uint64(42), number.Sequence())
+}
+
+func (s *InvoiceNumberTestSuite) TestItCanBeReconstituted() {
+	number := ReconstituteInvoiceNumber("INV-2024-000042", 2024, 0, 42)
+	parsed, err := NewInvoiceNumber("INV-2024-000042")
+	s.Require().NoError(err)
+	s.Equal(parsed, number)
+}
+
+func (s *InvoiceNumberTestSuite) TestItCanBeMarshaledToAndFromJSON() {
+	number, err := NewInvoiceNumber("INV-2024-000042")
+	s.Require().NoError(err)
+
+	data, err := json.Marshal(number)
+	s.Require().NoError(err)
+	s.JSONEq(`"INV-2024-000042"`, string(data))
+
+	var decoded InvoiceNumber
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(number, decoded)
+
+	s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+	s.Equal(number, decoded)
+}
+
+func (s *InvoiceNumberTestSuite) TestItMarshalsJSONWithJSONEscaping() {
+	number := ReconstituteInvoiceNumber("FACT\x01/é\"2024", 2024, 0, 1)
+
+	data, err := json.Marshal(number)
+	s.Require().NoError(err)
+	s.Equal(`"FACT\u0001/é\"2024"`, string(data))
+
+	var raw string
+	s.Require().NoError(json.Unmarshal(data, &raw))
+	s.Equal(number.Value(), raw)
+}
+
+func (s *InvoiceNumberTestSuite) TestItFailsToUnmarshalInvalidJSON() {
+	var decoded InvoiceNumber
+	s.ErrorIs(json.Unmarshal([]byte(`"INV-24-42"`), &decoded), ErrInvalidInvoiceNumber)
+
+	err := json.Unmarshal([]byte(`42`), &decoded)
+	s.Error(err)
+	var typeErr *json.UnmarshalTypeError
+	s.True(errors.As(err, &typeErr))
+}
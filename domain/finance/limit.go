@@ -0,0 +1,158 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrLimitExceeded         = domain.NewInvariantError("amount exceeds the remaining limit")
+	ErrLimitCurrencyMismatch = domain.NewError(
+		"limit cap and consumed amounts must use the same currency",
+	)
+	ErrLimitConsumedAboveCap = domain.NewError("limit consumed amount cannot exceed its cap")
+)
+
+// Limit is a spending cap and the amount consumed against it, such as a card spend control or
+// a monthly budget. It is immutable: Consume and Reset return a new limit.
+type Limit struct {
+	maximum  Money
+	consumed Money
+}
+
+// NewLimit creates a new Limit with nothing consumed
+func NewLimit(maximum Money) (Limit, error) {
+	return NewLimitWithConsumed(maximum, ReconstituteMoney(decimal.Zero, maximum.currency))
+}
+
+// NewLimitWithConsumed creates a new Limit with an amount already consumed, e.g., when loading
+// it from storage, with validation
+func NewLimitWithConsumed(maximum, consumed Money) (Limit, error) {
+	if err := IsValidCurrency(maximum.currency.value); err != nil {
+		return Limit{}, err
+	}
+	if err := IsValidMoneyAmount(maximum.amount); err != nil {
+		return Limit{}, err
+	}
+	if err := IsValidMoneyAmount(consumed.amount); err != nil {
+		return Limit{}, err
+	}
+
+	if !maximum.currency.Equals(consumed.currency) {
+		return Limit{}, ErrLimitCurrencyMismatch
+	}
+	if consumed.amount.GreaterThan(maximum.amount) {
+		return Limit{}, ErrLimitConsumedAboveCap
+	}
+
+	return Limit{
+		maximum:  maximum,
+		consumed: consumed,
+	}, nil
+}
+
+// ReconstituteLimit creates a new Limit instance without validation
+func ReconstituteLimit(maximum, consumed Money) Limit {
+	return Limit{
+		maximum:  maximum,
+		consumed: consumed,
+	}
+}
+
+// Cap returns the maximum amount that can be consumed
+func (l Limit) Cap() Money {
+	return l.maximum
+}
+
+// Consumed returns the amount consumed so far
+func (l Limit) Consumed() Money {
+	return l.consumed
+}
+
+// Remaining returns the amount that can still be consumed
+func (l Limit) Remaining() Money {
+	return ReconstituteMoney(l.maximum.amount.Sub(l.consumed.amount), l.maximum.currency)
+}
+
+// IsExhausted reports whether nothing remains to be consumed
+func (l Limit) IsExhausted() bool {
+	return !l.consumed.amount.LessThan(l.maximum.amount)
+}
+
+// Consume returns a new limit with the amount consumed; its Remaining is what is left. It
+// fails with ErrLimitExceeded, carrying the "remaining" field, when the amount is more than
+// what remains, and leaves the limit unchanged.
+func (l Limit) Consume(amount Money) (Limit, error) {
+	if !l.maximum.currency.Equals(amount.currency) {
+		return Limit{}, domain.NewErrorWithWrap(
+			ErrLimitCurrencyMismatch,
+			"cannot consume %s from a limit in %s",
+			amount.currency.String(),
+			l.maximum.currency.String(),
+		)
+	}
+
+	remaining := l.Remaining()
+	if amount.amount.GreaterThan(remaining.amount) {
+		return Limit{}, ErrLimitExceeded.WithField("remaining", remaining.String())
+	}
+
+	return Limit{
+		maximum:  l.maximum,
+		consumed: ReconstituteMoney(l.consumed.amount.Add(amount.amount), l.consumed.currency),
+	}, nil
+}
+
+// Reset returns a new limit with the same cap and nothing consumed, e.g., at the start of a
+// new budget period
+func (l Limit) Reset() Limit {
+	return Limit{
+		maximum:  l.maximum,
+		consumed: ReconstituteMoney(decimal.Zero, l.maximum.currency),
+	}
+}
+
+// Equals compares two Limit objects for equality
+func (l Limit) Equals(other Limit) bool {
+	return l.maximum.Equals(other.maximum) && l.consumed.Equals(other.consumed)
+}
+
+// String returns a string representation of the limit, e.g., "25 EUR of 100 EUR"
+func (l Limit) String() string {
+	return fmt.Sprintf("%s of %s", l.consumed, l.maximum)
+}
+
+// limitJSON is the JSON representation of Limit
+type limitJSON struct {
+	Cap      Money `json:"cap"`
+	Consumed Money `json:"consumed"`
+}
+
+// MarshalJSON encodes the limit as its cap and consumed amounts
+func (l Limit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(limitJSON{Cap: l.maximum, Consumed: l.consumed})
+}
+
+// UnmarshalJSON decodes the limit from its cap and consumed amounts, with validation
+func (l *Limit) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw limitJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid limit JSON")
+	}
+
+	parsed, err := NewLimitWithConsumed(raw.Cap, raw.Consumed)
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+	return nil
+}
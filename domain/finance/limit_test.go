@@ -0,0 +1,103 @@
+package finance
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type LimitTestSuite struct {
+	suite.Suite
+}
+
+func TestLimitSuite(t *testing.T) {
+	suite.Run(t, new(LimitTestSuite))
+}
+
+func (s *LimitTestSuite) money(amount, currency string) Money {
+	money, err := NewMoneyFromString(amount, currency)
+	s.Require().NoError(err)
+	return money
+}
+
+func (s *LimitTestSuite) TestItCanConsumeUpToTheCap() {
+	limit, err := NewLimit(s.money("100", "EUR"))
+	s.Require().NoError(err)
+	s.True(s.money("0", "EUR").Equals(limit.Consumed()))
+
+	limit, err = limit.Consume(s.money("60.50", "EUR"))
+	s.Require().NoError(err)
+	s.True(s.money("39.50", "EUR").Equals(limit.Remaining()))
+	s.False(limit.IsExhausted())
+
+	limit, err = limit.Consume(s.money("39.50", "EUR"))
+	s.Require().NoError(err)
+	s.True(s.money("0", "EUR").Equals(limit.Remaining()))
+	s.True(limit.IsExhausted())
+	s.Equal("100 EUR of 100 EUR", limit.String())
+}
+
+func (s *LimitTestSuite) TestItFailsWhenConsumingMoreThanRemains() {
+	limit, _ := NewLimitWithConsumed(s.money("100", "EUR"), s.money("90", "EUR"))
+
+	_, err := limit.Consume(s.money("10.01", "EUR"))
+	s.True(errors.Is(err, ErrLimitExceeded))
+
+	var domainErr *domain.Error
+	s.Require().True(errors.As(err, &domainErr))
+	s.Equal(domain.CategoryInvariant, domainErr.Category())
+	s.Equal("10 EUR", domainErr.Fields()["remaining"])
+
+	s.True(s.money("90", "EUR").Equals(limit.Consumed()))
+}
+
+func (s *LimitTestSuite) TestItFailsWhenConsumingAnotherCurrency() {
+	limit, _ := NewLimit(s.money("100", "EUR"))
+
+	_, err := limit.Consume(s.money("1", "USD"))
+	s.True(errors.Is(err, ErrLimitCurrencyMismatch))
+}
+
+func (s *LimitTestSuite) TestItCanBeReset() {
+	limit, _ := NewLimitWithConsumed(s.money("100", "EUR"), s.money("100", "EUR"))
+
+	reset := limit.Reset()
+	s.True(s.money("100", "EUR").Equals(reset.Remaining()))
+	s.True(limit.Cap().Equals(reset.Cap()))
+	s.True(limit.IsExhausted())
+}
+
+func (s *LimitTestSuite) TestItValidatesConsumedAmounts() {
+	_, err := NewLimitWithConsumed(s.money("100", "EUR"), s.money("100.01", "EUR"))
+	s.True(errors.Is(err, ErrLimitConsumedAboveCap))
+
+	_, err = NewLimitWithConsumed(s.money("100", "EUR"), s.money("1", "USD"))
+	s.True(errors.Is(err, ErrLimitCurrencyMismatch))
+
+	_, err = NewLimit(Money{})
+	s.Error(err)
+}
+
+func (s *LimitTestSuite) TestItCanRoundTripThroughJSON() {
+	limit, _ := NewLimitWithConsumed(s.money("100", "EUR"), s.money("25.5", "EUR"))
+
+	data, err := json.Marshal(limit)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{"cap":{"amount":"100","currency":"EUR"},"consumed":{"amount":"25.5","currency":"EUR"}}`,
+		string(data),
+	)
+
+	var decoded Limit
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(limit.Equals(decoded))
+
+	overspent := `{"cap":{"amount":"1","currency":"EUR"},` +
+		`"consumed":{"amount":"2","currency":"EUR"}}`
+	err = json.Unmarshal([]byte(overspent), &decoded)
+	s.True(errors.Is(err, ErrLimitConsumedAboveCap))
+	s.Error(json.Unmarshal([]byte(`[]`), &decoded))
+}
@@ -2,13 +2,24 @@ package finance
 
 import (
 	"fmt"
+	"math/big"
+	"strings"
 
 	"github.com/golibry/go-common-domain/domain"
 	"github.com/shopspring/decimal"
 )
 
 var (
-	ErrNegativeAmount = domain.NewError("money amount cannot be negative")
+	ErrNegativeAmount = domain.NewLocalizedError(
+		"finance.money.negative_amount", nil,
+		"money amount cannot be negative",
+	)
+	ErrInexactAmount  = domain.NewError("money amount cannot be represented exactly as a decimal")
+	ErrDivisionByZero = domain.NewError("cannot divide by zero")
+	ErrInvalidMoney   = domain.NewLocalizedError(
+		"finance.money.invalid", nil,
+		"money must be an amount followed by a currency code, e.g., \"10.50 USD\"",
+	)
 )
 
 type Money struct {
@@ -43,6 +54,33 @@ func NewMoneyFromString(amountStr, currencyStr string) (Money, error) {
 	return NewMoney(amount, currency)
 }
 
+// ParseMoney parses money from its string form, an amount followed by a currency code
+// (e.g., "10.50 USD"), as returned by String
+func ParseMoney(value string) (Money, error) {
+	parts := strings.Fields(value)
+	if len(parts) != 2 {
+		return Money{}, ErrInvalidMoney
+	}
+
+	return NewMoneyFromString(parts[0], parts[1])
+}
+
+// NewMoneyFromBigRat creates a new instance of Money from an arbitrary-precision rational amount.
+// The rational must have a finite decimal expansion (e.g., 1/8 but not 1/3); otherwise
+// ErrInexactAmount is returned instead of silently rounding.
+func NewMoneyFromBigRat(amount *big.Rat, currency Currency) (Money, error) {
+	if amount == nil {
+		return Money{}, ErrInexactAmount
+	}
+
+	places, ok := exactDecimalPlaces(amount.Denom())
+	if !ok {
+		return Money{}, ErrInexactAmount
+	}
+
+	return NewMoney(decimal.NewFromBigRat(amount, places), currency)
+}
+
 // ReconstituteMoney creates a new Money instance without validation
 func ReconstituteMoney(amount decimal.Decimal, currency Currency) Money {
 	return Money{
@@ -71,6 +109,20 @@ func (m Money) String() string {
 	return fmt.Sprintf("%s %s", m.amount.String(), m.currency.String())
 }
 
+// Round returns the money rounded to the minor units of its currency (e.g., cents for USD,
+// satoshis for a registered BTC)
+func (m Money) Round(mode RoundingMode) Money {
+	return Money{
+		amount:   Rounding{places: m.currency.MinorUnits(), mode: mode}.Apply(m.amount),
+		currency: m.currency,
+	}
+}
+
+// ToBigRat returns the money amount as an arbitrary-precision rational number
+func (m Money) ToBigRat() *big.Rat {
+	return m.amount.Rat()
+}
+
 // Add adds another Money object to this one (must have the same currency)
 func (m Money) Add(other Money) (Money, error) {
 	if !m.currency.Equals(other.currency) {
@@ -125,7 +177,7 @@ func (m Money) Multiply(factor decimal.Decimal) (Money, error) {
 // Divide divides the money amount by a divisor
 func (m Money) Divide(divisor decimal.Decimal) (Money, error) {
 	if divisor.IsZero() {
-		return Money{}, domain.NewError("cannot divide by zero")
+		return Money{}, ErrDivisionByZero
 	}
 
 	newAmount := m.amount.Div(divisor)
@@ -139,6 +191,30 @@ func (m Money) Divide(divisor decimal.Decimal) (Money, error) {
 	}, nil
 }
 
+// DivideExact divides the money amount by a divisor without silent rounding.
+// The quotient is truncated to the given number of decimal places and the remainder is
+// returned alongside it, so that quotient*divisor + remainder equals the original amount.
+func (m Money) DivideExact(divisor decimal.Decimal, places int32) (Money, Money, error) {
+	if divisor.IsZero() {
+		return Money{}, Money{}, ErrDivisionByZero
+	}
+
+	quotient, remainder := m.amount.QuoRem(divisor, places)
+	if quotient.IsNegative() || remainder.IsNegative() {
+		return Money{}, Money{}, ErrNegativeAmount
+	}
+
+	quotientMoney := Money{
+		amount:   quotient,
+		currency: m.currency,
+	}
+	remainderMoney := Money{
+		amount:   remainder,
+		currency: m.currency,
+	}
+	return quotientMoney, remainderMoney, nil
+}
+
 // IsValidMoneyAmount validates a money amount (must not be negative)
 func IsValidMoneyAmount(amount decimal.Decimal) error {
 	if amount.IsNegative() {
@@ -146,3 +222,34 @@ func IsValidMoneyAmount(amount decimal.Decimal) error {
 	}
 	return nil
 }
+
+// exactDecimalPlaces reports how many decimal places are needed to represent a fraction with
+// the given (reduced) denominator exactly. Only denominators of the form 2^a * 5^b qualify.
+func exactDecimalPlaces(denominator *big.Int) (int32, bool) {
+	rest := new(big.Int).Set(denominator)
+	one := big.NewInt(1)
+	mod := new(big.Int)
+
+	countFactor := func(factor int64) int32 {
+		divisor := big.NewInt(factor)
+		var count int32
+		for rest.Cmp(one) > 0 {
+			quotient := new(big.Int)
+			quotient.QuoRem(rest, divisor, mod)
+			if mod.Sign() != 0 {
+				break
+			}
+			rest = quotient
+			count++
+		}
+		return count
+	}
+
+	twos := countFactor(2)
+	fives := countFactor(5)
+	if rest.Cmp(one) != 0 {
+		return 0, false
+	}
+
+	return max(twos, fives), true
+}
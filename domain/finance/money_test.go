@@ -2,6 +2,7 @@ package finance
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -183,7 +184,7 @@ func (s *MoneyTestSuite) TestMoneyArithmetic() {
 	s.Run(
 		"division by zero fails", func() {
 			_, err := money1.Divide(decimal.Zero)
-			s.Error(err)
+			s.ErrorIs(err, ErrDivisionByZero)
 		},
 	)
 
@@ -196,6 +197,61 @@ func (s *MoneyTestSuite) TestMoneyArithmetic() {
 	)
 }
 
+func (s *MoneyTestSuite) TestItCanDivideExactlyWithRemainder() {
+	usd, _ := NewCurrency("USD")
+	money, _ := NewMoneyFromString("100", "USD")
+
+	quotient, remainder, err := money.DivideExact(decimal.NewFromInt(3), 2)
+	s.NoError(err)
+	s.Equal("33.33", quotient.Amount().String())
+	s.Equal("0.01", remainder.Amount().String())
+	s.True(quotient.Currency().Equals(usd))
+	s.True(remainder.Currency().Equals(usd))
+	s.True(
+		quotient.Amount().Mul(decimal.NewFromInt(3)).Add(remainder.Amount()).
+			Equal(money.Amount()),
+	)
+
+	_, _, err = money.DivideExact(decimal.Zero, 2)
+	s.ErrorIs(err, ErrDivisionByZero)
+
+	_, _, err = money.DivideExact(decimal.NewFromInt(-3), 2)
+	s.ErrorIs(err, ErrNegativeAmount)
+}
+
+func (s *MoneyTestSuite) TestItCanConvertToAndFromBigRat() {
+	usd, _ := NewCurrency("USD")
+
+	testCases := []struct {
+		name          string
+		rat           *big.Rat
+		expected      string
+		expectedError error
+	}{
+		{name: "whole amount", rat: big.NewRat(42, 1), expected: "42"},
+		{name: "terminating fraction", rat: big.NewRat(1, 8), expected: "0.125"},
+		{name: "mixed factors", rat: big.NewRat(7, 40), expected: "0.175"},
+		{name: "repeating fraction", rat: big.NewRat(1, 3), expectedError: ErrInexactAmount},
+		{name: "negative amount", rat: big.NewRat(-1, 2), expectedError: ErrNegativeAmount},
+		{name: "nil amount", rat: nil, expectedError: ErrInexactAmount},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				money, err := NewMoneyFromBigRat(tc.rat, usd)
+				if tc.expectedError != nil {
+					s.ErrorIs(err, tc.expectedError)
+					return
+				}
+				s.NoError(err)
+				s.Equal(tc.expected, money.Amount().String())
+				s.Equal(0, money.ToBigRat().Cmp(tc.rat))
+			},
+		)
+	}
+}
+
 func (s *MoneyTestSuite) TestEquals() {
 	usd, _ := NewCurrency("USD")
 	eur, _ := NewCurrency("EUR")
@@ -224,3 +280,48 @@ func (s *MoneyTestSuite) TestReconstitute() {
 	s.Equal("100.5", money.Amount().String())
 	s.Equal("USD", money.Currency().String())
 }
+
+func (s *MoneyTestSuite) TestItCanRoundToCurrencyMinorUnits() {
+	testCases := []struct {
+		name     string
+		amount   string
+		currency string
+		mode     RoundingMode
+		expected string
+	}{
+		{"Two decimals half up", "10.125", "USD", RoundHalfUp, "10.13"},
+		{"Two decimals half even", "10.125", "USD", RoundHalfEven, "10.12"},
+		{"Zero decimals", "1500.5", "JPY", RoundHalfUp, "1501"},
+		{"Three decimals", "1.23456", "KWD", RoundDown, "1.234"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				money, err := NewMoneyFromString(tc.amount, tc.currency)
+				s.NoError(err)
+				rounded := money.Round(tc.mode)
+				s.Equal(tc.expected, rounded.Amount().String())
+				s.Equal(tc.currency, rounded.Currency().Value())
+			},
+		)
+	}
+}
+
+func (s *MoneyTestSuite) TestItCanParseMoneyFromItsStringForm() {
+	money, err := ParseMoney(" 10.50   usd ")
+	s.NoError(err)
+	s.Equal("10.5 USD", money.String())
+
+	roundTrip, err := ParseMoney(money.String())
+	s.NoError(err)
+	s.True(money.Equals(roundTrip))
+
+	for _, input := range []string{"", "10.50", "USD 10.50 extra", "ten USD"} {
+		_, err := ParseMoney(input)
+		s.Error(err, input)
+	}
+
+	_, err = ParseMoney("10.50")
+	s.ErrorIs(err, ErrInvalidMoney)
+}
@@ -0,0 +1,182 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+)
+
+var ErrInsufficientMoneyInBag = domain.NewInvariantError(
+	"money bag holds less than the amount subtracted",
+)
+
+// MoneyBag holds amounts in several currencies, such as a wallet or a cart mixing
+// currencies. It is immutable: Add and Subtract return a new bag. Currencies whose amount
+// drops to zero are removed, so two bags are equal when they hold the same non-zero amounts.
+type MoneyBag struct {
+	amounts map[string]decimal.Decimal
+}
+
+// NewMoneyBag creates a new MoneyBag holding the sum of the given amounts per currency
+func NewMoneyBag(amounts ...Money) (MoneyBag, error) {
+	bag := MoneyBag{}
+	for _, money := range amounts {
+		if err := IsValidMoneyAmount(money.amount); err != nil {
+			return MoneyBag{}, err
+		}
+		if err := IsValidCurrency(money.currency.value); err != nil {
+			return MoneyBag{}, err
+		}
+		bag = bag.Add(money)
+	}
+	return bag, nil
+}
+
+// Add returns a new bag with the money added to the amount held in its currency
+func (b MoneyBag) Add(money Money) MoneyBag {
+	amounts := maps.Clone(b.amounts)
+	if amounts == nil {
+		amounts = make(map[string]decimal.Decimal, 1)
+	}
+
+	code := money.currency.Value()
+	total := amounts[code].Add(money.amount)
+	if total.IsZero() {
+		delete(amounts, code)
+	} else {
+		amounts[code] = total
+	}
+
+	return MoneyBag{amounts: amounts}
+}
+
+// Subtract returns a new bag with the money subtracted from the amount held in its currency.
+// It fails with ErrInsufficientMoneyInBag if the bag holds less than the amount.
+func (b MoneyBag) Subtract(money Money) (MoneyBag, error) {
+	code := money.currency.Value()
+	remaining := b.amounts[code].Sub(money.amount)
+	if remaining.IsNegative() {
+		return MoneyBag{}, domain.NewErrorWithWrap(
+			ErrInsufficientMoneyInBag,
+			"cannot subtract %s",
+			money.String(),
+		)
+	}
+
+	amounts := maps.Clone(b.amounts)
+	if remaining.IsZero() {
+		delete(amounts, code)
+	} else {
+		amounts[code] = remaining
+	}
+
+	return MoneyBag{amounts: amounts}, nil
+}
+
+// Get returns the amount held in the currency, which is zero if the currency is absent
+func (b MoneyBag) Get(currency Currency) Money {
+	return Money{
+		amount:   b.amounts[currency.Value()],
+		currency: currency,
+	}
+}
+
+// Has reports whether the bag holds a non-zero amount in the currency
+func (b MoneyBag) Has(currency Currency) bool {
+	_, ok := b.amounts[currency.Value()]
+	return ok
+}
+
+// Currencies returns the currencies held in the bag, sorted by code
+func (b MoneyBag) Currencies() []Currency {
+	codes := slices.Sorted(maps.Keys(b.amounts))
+	currencies := make([]Currency, 0, len(codes))
+	for _, code := range codes {
+		currencies = append(currencies, ReconstituteCurrency(code))
+	}
+	return currencies
+}
+
+// All returns an iterator over the amounts held in the bag, sorted by currency code
+func (b MoneyBag) All() iter.Seq[Money] {
+	return func(yield func(Money) bool) {
+		for _, currency := range b.Currencies() {
+			if !yield(b.Get(currency)) {
+				return
+			}
+		}
+	}
+}
+
+// Monies returns the amounts held in the bag, sorted by currency code
+func (b MoneyBag) Monies() []Money {
+	return slices.Collect(b.All())
+}
+
+// Len returns the number of currencies held in the bag
+func (b MoneyBag) Len() int {
+	return len(b.amounts)
+}
+
+// IsEmpty reports whether the bag holds no money
+func (b MoneyBag) IsEmpty() bool {
+	return len(b.amounts) == 0
+}
+
+// Equals compares two MoneyBag objects for equality
+func (b MoneyBag) Equals(other MoneyBag) bool {
+	return maps.EqualFunc(b.amounts, other.amounts, decimal.Decimal.Equal)
+}
+
+// String returns the amounts held in the bag, sorted by currency code, e.g., "10.5 EUR, 3 USD"
+func (b MoneyBag) String() string {
+	parts := make([]string, 0, len(b.amounts))
+	for money := range b.All() {
+		parts = append(parts, money.String())
+	}
+	return strings.Join(parts, ", ")
+}
+
+// MarshalJSON encodes the bag as a JSON object of decimal strings keyed by currency code
+func (b MoneyBag) MarshalJSON() ([]byte, error) {
+	encoded := make(map[string]string, len(b.amounts))
+	for code, amount := range b.amounts {
+		encoded[code] = amount.String()
+	}
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes the bag from a JSON object of amounts keyed by currency code
+func (b *MoneyBag) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw map[string]decimal.Decimal
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid money bag JSON")
+	}
+
+	amounts := make([]Money, 0, len(raw))
+	for code, amount := range raw {
+		currency, err := NewCurrency(code)
+		if err != nil {
+			return err
+		}
+		amounts = append(amounts, Money{amount: amount, currency: currency})
+	}
+
+	parsed, err := NewMoneyBag(amounts...)
+	if err != nil {
+		return err
+	}
+
+	*b = parsed
+	return nil
+}
@@ -0,0 +1,106 @@
+package finance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type MoneyBagTestSuite struct {
+	suite.Suite
+}
+
+func TestMoneyBagSuite(t *testing.T) {
+	suite.Run(t, new(MoneyBagTestSuite))
+}
+
+func (s *MoneyBagTestSuite) money(amount, currency string) Money {
+	money, err := NewMoneyFromString(amount, currency)
+	s.Require().NoError(err)
+	return money
+}
+
+func (s *MoneyBagTestSuite) TestItCanBuildNewMoneyBag() {
+	bag, err := NewMoneyBag(
+		s.money("10.50", "USD"),
+		s.money("5", "EUR"),
+		s.money("2.25", "USD"),
+	)
+
+	s.NoError(err)
+	s.Equal(2, bag.Len())
+	s.Equal("12.75 USD", bag.Get(ReconstituteCurrency("USD")).String())
+	s.Equal("5 EUR", bag.Get(ReconstituteCurrency("EUR")).String())
+	s.True(bag.Get(ReconstituteCurrency("GBP")).Amount().IsZero())
+	s.False(bag.Has(ReconstituteCurrency("GBP")))
+
+	_, err = NewMoneyBag(ReconstituteMoney(decimal.NewFromInt(-1), ReconstituteCurrency("USD")))
+	s.ErrorIs(err, ErrNegativeAmount)
+
+	_, err = NewMoneyBag(ReconstituteMoney(decimal.NewFromInt(1), ReconstituteCurrency("usd")))
+	s.ErrorIs(err, ErrInvalidCurrency)
+}
+
+func (s *MoneyBagTestSuite) TestItCanAddAndSubtractMoney() {
+	empty := MoneyBag{}
+	s.True(empty.IsEmpty())
+
+	bag := empty.Add(s.money("10", "EUR")).Add(s.money("3", "USD"))
+	s.True(empty.IsEmpty(), "Add must not modify the original bag")
+	s.Equal(2, bag.Len())
+
+	bag, err := bag.Subtract(s.money("4", "EUR"))
+	s.NoError(err)
+	s.Equal("6 EUR", bag.Get(ReconstituteCurrency("EUR")).String())
+
+	bag, err = bag.Subtract(s.money("3", "USD"))
+	s.NoError(err)
+	s.False(bag.Has(ReconstituteCurrency("USD")), "zero amounts are removed")
+
+	_, err = bag.Subtract(s.money("7", "EUR"))
+	s.ErrorIs(err, ErrInsufficientMoneyInBag)
+
+	_, err = bag.Subtract(s.money("1", "GBP"))
+	s.ErrorIs(err, ErrInsufficientMoneyInBag)
+}
+
+func (s *MoneyBagTestSuite) TestIterationIsSortedByCurrency() {
+	bag, _ := NewMoneyBag(s.money("1", "USD"), s.money("2", "CHF"), s.money("3", "EUR"))
+
+	var codes []string
+	for money := range bag.All() {
+		codes = append(codes, money.Currency().Value())
+	}
+
+	s.Equal([]string{"CHF", "EUR", "USD"}, codes)
+	s.Len(bag.Monies(), 3)
+	s.Equal("CHF", bag.Currencies()[0].Value())
+	s.Equal("2 CHF, 3 EUR, 1 USD", bag.String())
+}
+
+func (s *MoneyBagTestSuite) TestEquals() {
+	bag1, _ := NewMoneyBag(s.money("1.50", "USD"), s.money("2", "EUR"))
+	bag2, _ := NewMoneyBag(s.money("2.00", "EUR"), s.money("1.5", "USD"))
+	bag3, _ := NewMoneyBag(s.money("1.50", "USD"))
+
+	s.True(bag1.Equals(bag2))
+	s.False(bag1.Equals(bag3))
+}
+
+func (s *MoneyBagTestSuite) TestItCanMarshalAndUnmarshalJSON() {
+	bag, _ := NewMoneyBag(s.money("10.50", "USD"), s.money("5", "EUR"))
+
+	encoded, err := json.Marshal(bag)
+	s.NoError(err)
+	s.JSONEq(`{"EUR":"5","USD":"10.5"}`, string(encoded))
+
+	var decoded MoneyBag
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(bag.Equals(decoded))
+
+	s.ErrorIs(json.Unmarshal([]byte(`{"EURO":"5"}`), &decoded), ErrInvalidCurrency)
+	s.ErrorIs(json.Unmarshal([]byte(`{"EUR":"-5"}`), &decoded), ErrNegativeAmount)
+	s.Error(json.Unmarshal([]byte(`{"EUR":"five"}`), &decoded))
+}
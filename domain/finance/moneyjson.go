@@ -0,0 +1,150 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+)
+
+// MoneyJSONFormat selects the wire shape used when encoding Money as JSON
+type MoneyJSONFormat int32
+
+const (
+	// MoneyJSONString encodes the amount as a decimal string:
+	// {"amount":"10.5","currency":"USD"}
+	MoneyJSONString MoneyJSONFormat = iota
+	// MoneyJSONNumber encodes the amount as a JSON number: {"amount":10.5,"currency":"USD"}
+	MoneyJSONNumber
+	// MoneyJSONMinorUnits encodes the amount as an integer count of the currency's minor
+	// units: {"minorUnits":1050,"currency":"USD"}
+	MoneyJSONMinorUnits
+)
+
+var (
+	ErrInvalidMoneyJSONFormat = domain.NewError("unknown money JSON format")
+	ErrInvalidMoneyJSON       = domain.NewError(
+		"money JSON must hold a currency and exactly one of amount or minorUnits",
+	)
+	ErrConflictingMoneyJSON = domain.NewError(
+		"money JSON cannot hold both amount and minorUnits",
+	)
+)
+
+type moneyJSON struct {
+	Amount     json.RawMessage `json:"amount,omitempty"`
+	MinorUnits json.RawMessage `json:"minorUnits,omitempty"`
+	Currency   string          `json:"currency"`
+}
+
+// MarshalJSON encodes the money with MoneyJSONString. Use MarshalJSONWith or WithJSONFormat
+// for the other formats.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return m.MarshalJSONWith(MoneyJSONString)
+}
+
+// WithJSONFormat wraps the money so that it encodes as JSON in the given format, e.g., in a
+// response struct field
+func (m Money) WithJSONFormat(format MoneyJSONFormat) FormattedMoney {
+	return FormattedMoney{
+		money:  m,
+		format: format,
+	}
+}
+
+// MarshalJSONWith encodes the money using the given format. MoneyJSONMinorUnits fails with
+// ErrInexactAmount when the amount has more decimals than the currency's minor units.
+func (m Money) MarshalJSONWith(format MoneyJSONFormat) ([]byte, error) {
+	encoded := moneyJSON{Currency: m.currency.Value()}
+
+	switch format {
+	case MoneyJSONString:
+		encoded.Amount, _ = json.Marshal(m.amount.String())
+	case MoneyJSONNumber:
+		encoded.Amount = json.RawMessage(m.amount.String())
+	case MoneyJSONMinorUnits:
+		minorUnits := m.amount.Shift(m.currency.MinorUnits())
+		if !minorUnits.IsInteger() {
+			return nil, ErrInexactAmount
+		}
+		encoded.MinorUnits = json.RawMessage(minorUnits.String())
+	default:
+		return nil, ErrInvalidMoneyJSONFormat
+	}
+
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes the money from any MoneyJSONFormat, with validation
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw moneyJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid money JSON")
+	}
+
+	if raw.Amount != nil && raw.MinorUnits != nil {
+		return ErrConflictingMoneyJSON
+	}
+	if raw.Amount == nil && raw.MinorUnits == nil {
+		return ErrInvalidMoneyJSON
+	}
+
+	currency, err := NewCurrency(raw.Currency)
+	if err != nil {
+		return err
+	}
+
+	var amount decimal.Decimal
+	if raw.Amount != nil {
+		if err = amount.UnmarshalJSON(raw.Amount); err != nil {
+			return domain.NewErrorWithWrap(err, "invalid money amount")
+		}
+	} else {
+		var minorUnits decimal.Decimal
+		if err = minorUnits.UnmarshalJSON(raw.MinorUnits); err != nil || !minorUnits.IsInteger() {
+			return domain.NewErrorWithWrap(ErrInvalidMoneyJSON, "invalid money minor units")
+		}
+		amount = minorUnits.Shift(-currency.MinorUnits())
+	}
+
+	parsed, err := NewMoney(amount, currency)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// FormattedMoney is Money bound to a MoneyJSONFormat, so each payload picks its wire format
+// without affecting how other code encodes Money. Decoding accepts every format and keeps the
+// format already set.
+type FormattedMoney struct {
+	money  Money
+	format MoneyJSONFormat
+}
+
+// Money returns the wrapped money
+func (f FormattedMoney) Money() Money {
+	return f.money
+}
+
+// Format returns the JSON format the money is encoded with
+func (f FormattedMoney) Format() MoneyJSONFormat {
+	return f.format
+}
+
+// MarshalJSON encodes the money in the wrapper's format
+func (f FormattedMoney) MarshalJSON() ([]byte, error) {
+	return f.money.MarshalJSONWith(f.format)
+}
+
+// UnmarshalJSON decodes the money from any MoneyJSONFormat, with validation
+func (f *FormattedMoney) UnmarshalJSON(data []byte) error {
+	return f.money.UnmarshalJSON(data)
+}
@@ -0,0 +1,139 @@
+package finance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MoneyJSONTestSuite struct {
+	suite.Suite
+}
+
+func TestMoneyJSONSuite(t *testing.T) {
+	suite.Run(t, new(MoneyJSONTestSuite))
+}
+
+func (s *MoneyJSONTestSuite) TestItCanMarshalMoneyInEveryFormat() {
+	testCases := []struct {
+		name     string
+		amount   string
+		currency string
+		format   MoneyJSONFormat
+		expected string
+	}{
+		{"String", "10.50", "USD", MoneyJSONString, `{"amount":"10.5","currency":"USD"}`},
+		{"Number", "10.50", "USD", MoneyJSONNumber, `{"amount":10.5,"currency":"USD"}`},
+		{"Minor units", "10.50", "USD", MoneyJSONMinorUnits, `{"minorUnits":1050,"currency":"USD"}`},
+		{
+			"Minor units without decimals", "1500", "JPY", MoneyJSONMinorUnits,
+			`{"minorUnits":1500,"currency":"JPY"}`,
+		},
+		{
+			"Minor units with three decimals", "1.5", "KWD", MoneyJSONMinorUnits,
+			`{"minorUnits":1500,"currency":"KWD"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				money, err := NewMoneyFromString(tc.amount, tc.currency)
+				s.Require().NoError(err)
+
+				encoded, err := money.MarshalJSONWith(tc.format)
+				s.NoError(err)
+				s.JSONEq(tc.expected, string(encoded))
+
+				var decoded Money
+				s.NoError(json.Unmarshal(encoded, &decoded))
+				s.True(money.Equals(decoded))
+			},
+		)
+	}
+}
+
+func (s *MoneyJSONTestSuite) TestMarshalJSONUsesTheStringFormat() {
+	money, _ := NewMoneyFromString("10.5", "USD")
+
+	encoded, err := json.Marshal(money)
+	s.NoError(err)
+	s.JSONEq(`{"amount":"10.5","currency":"USD"}`, string(encoded))
+}
+
+func (s *MoneyJSONTestSuite) TestItCanBindAFormatToMoney() {
+	money, _ := NewMoneyFromString("10.5", "USD")
+	payload := struct {
+		Price  FormattedMoney `json:"price"`
+		Refund Money          `json:"refund"`
+	}{
+		Price:  money.WithJSONFormat(MoneyJSONMinorUnits),
+		Refund: money,
+	}
+	s.Equal(MoneyJSONMinorUnits, payload.Price.Format())
+	s.True(money.Equals(payload.Price.Money()))
+
+	encoded, err := json.Marshal(payload)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{"price":{"minorUnits":1050,"currency":"USD"},`+
+			`"refund":{"amount":"10.5","currency":"USD"}}`,
+		string(encoded),
+	)
+
+	decoded := struct {
+		Price FormattedMoney `json:"price"`
+	}{Price: Money{}.WithJSONFormat(MoneyJSONNumber)}
+	s.Require().NoError(json.Unmarshal(encoded, &decoded))
+	s.True(money.Equals(decoded.Price.Money()))
+	s.Equal(MoneyJSONNumber, decoded.Price.Format())
+
+	_, err = json.Marshal(money.WithJSONFormat(MoneyJSONFormat(9)))
+	s.ErrorIs(err, ErrInvalidMoneyJSONFormat)
+}
+
+func (s *MoneyJSONTestSuite) TestItFailsToMarshalInexactMinorUnits() {
+	money, _ := NewMoneyFromString("10.505", "USD")
+
+	_, err := money.MarshalJSONWith(MoneyJSONMinorUnits)
+	s.ErrorIs(err, ErrInexactAmount)
+
+	_, err = money.MarshalJSONWith(MoneyJSONFormat(9))
+	s.ErrorIs(err, ErrInvalidMoneyJSONFormat)
+}
+
+func (s *MoneyJSONTestSuite) TestItFailsToUnmarshalInvalidMoney() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Missing amount", `{"currency":"USD"}`, ErrInvalidMoneyJSON},
+		{
+			"Both amount and minor units",
+			`{"amount":1,"minorUnits":100,"currency":"USD"}`,
+			ErrConflictingMoneyJSON,
+		},
+		{
+			"Both amount and null minor units",
+			`{"amount":"1","minorUnits":null,"currency":"USD"}`,
+			ErrConflictingMoneyJSON,
+		},
+		{"Fractional minor units", `{"minorUnits":10.5,"currency":"USD"}`, ErrInvalidMoneyJSON},
+		{"Invalid currency", `{"amount":"1","currency":"US"}`, ErrInvalidCurrency},
+		{"Negative amount", `{"amount":"-1","currency":"USD"}`, ErrNegativeAmount},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				var decoded Money
+				s.ErrorIs(json.Unmarshal([]byte(tc.input), &decoded), tc.expectedError)
+			},
+		)
+	}
+
+	var decoded Money
+	s.Error(json.Unmarshal([]byte(`{"amount":"ten","currency":"USD"}`), &decoded))
+}
@@ -0,0 +1,119 @@
+package finance
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrExchangeRateNotFound = domain.NewNotFoundError("no exchange rate is known for the pair")
+	ErrStaleExchangeRate    = domain.NewError("latest exchange rate for the pair is too old")
+)
+
+// RateProvider supplies exchange rates, e.g., from an FX API, a database or a RateTable
+type RateProvider interface {
+	// Rate returns the latest rate of the pair quoted at or before at
+	Rate(ctx context.Context, pair CurrencyPair, at time.Time) (ExchangeRate, error)
+}
+
+// RateTableOption configures a RateTable
+type RateTableOption func(*RateTable)
+
+// WithMaxRateAge makes a RateTable fail with ErrStaleExchangeRate when the latest rate was
+// quoted more than maxAge before the requested time. Rates never go stale by default.
+func WithMaxRateAge(maxAge time.Duration) RateTableOption {
+	return func(t *RateTable) {
+		t.maxAge = maxAge
+	}
+}
+
+// RateTable is an in-memory RateProvider holding the history of each pair, for tests and for
+// domains that load daily reference rates up front. Pairs only known the other way round are
+// answered with the inverse rate. It is safe for concurrent use.
+type RateTable struct {
+	mu     sync.RWMutex
+	rates  map[CurrencyPair][]ExchangeRate
+	maxAge time.Duration
+}
+
+// NewRateTable creates an empty RateTable
+func NewRateTable(opts ...RateTableOption) *RateTable {
+	table := &RateTable{
+		rates: make(map[CurrencyPair][]ExchangeRate),
+	}
+	for _, opt := range opts {
+		opt(table)
+	}
+	return table
+}
+
+// Add records the rates, keeping the history of each pair ordered by quote time. A rate
+// quoted at the same time as a recorded one replaces it.
+func (t *RateTable) Add(rates ...ExchangeRate) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, rate := range rates {
+		history := t.rates[rate.pair]
+		index, found := slices.BinarySearchFunc(history, rate.at, compareRateTime)
+		if found {
+			history[index] = rate
+		} else {
+			history = slices.Insert(history, index, rate)
+		}
+		t.rates[rate.pair] = history
+	}
+}
+
+// Rate returns the latest rate of the pair quoted at or before at, falling back to the inverse
+// of the latest rate of the inverse pair. It fails with ErrExchangeRateNotFound when no rate
+// is known and with ErrStaleExchangeRate when the rate is older than the maximum age.
+func (t *RateTable) Rate(
+	ctx context.Context,
+	pair CurrencyPair,
+	at time.Time,
+) (ExchangeRate, error) {
+	if err := ctx.Err(); err != nil {
+		return ExchangeRate{}, err
+	}
+
+	t.mu.RLock()
+	rate, found := latestRate(t.rates[pair], at)
+	if !found {
+		if inverse, inverseFound := latestRate(t.rates[pair.Inverse()], at); inverseFound {
+			rate, found = inverse.Inverse(), true
+		}
+	}
+	t.mu.RUnlock()
+
+	if !found {
+		return ExchangeRate{}, ErrExchangeRateNotFound.WithField("pair", pair.String())
+	}
+	if t.maxAge > 0 && at.Sub(rate.at) > t.maxAge {
+		return ExchangeRate{}, ErrStaleExchangeRate.
+			WithField("pair", pair.String()).
+			WithField("quotedAt", rate.at)
+	}
+
+	return rate, nil
+}
+
+// latestRate returns the last rate of the ordered history quoted at or before at
+func latestRate(history []ExchangeRate, at time.Time) (ExchangeRate, bool) {
+	index, found := slices.BinarySearchFunc(history, at, compareRateTime)
+	if found {
+		return history[index], true
+	}
+	if index == 0 {
+		return ExchangeRate{}, false
+	}
+	return history[index-1], true
+}
+
+// compareRateTime orders rates by quote time, for binary searches
+func compareRateTime(rate ExchangeRate, at time.Time) int {
+	return rate.at.Compare(at)
+}
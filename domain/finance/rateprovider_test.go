@@ -0,0 +1,113 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateTableTestSuite struct {
+	suite.Suite
+	eurUSD CurrencyPair
+	day    time.Time
+}
+
+func TestRateTableSuite(t *testing.T) {
+	suite.Run(t, new(RateTableTestSuite))
+}
+
+func (s *RateTableTestSuite) SetupTest() {
+	s.eurUSD, _ = ParseCurrencyPair("EUR/USD")
+	s.day = time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC)
+}
+
+func (s *RateTableTestSuite) rate(pair CurrencyPair, value string, at time.Time) ExchangeRate {
+	rate, err := NewExchangeRate(pair, decimal.RequireFromString(value), at)
+	s.Require().NoError(err)
+	return rate
+}
+
+func (s *RateTableTestSuite) TestItReturnsTheLatestRateAtTheRequestedTime() {
+	var provider RateProvider = s.newTable()
+	ctx := context.Background()
+
+	rate, err := provider.Rate(ctx, s.eurUSD, s.day.Add(time.Hour))
+	s.Require().NoError(err)
+	s.Equal("EUR/USD 1.08", rate.String())
+
+	rate, err = provider.Rate(ctx, s.eurUSD, s.day.AddDate(0, 0, 1))
+	s.Require().NoError(err)
+	s.Equal("EUR/USD 1.09", rate.String())
+
+	rate, err = provider.Rate(ctx, s.eurUSD, s.day.AddDate(0, 0, -1))
+	s.Require().NoError(err)
+	s.Equal("EUR/USD 1.07", rate.String())
+
+	_, err = provider.Rate(ctx, s.eurUSD, s.day.AddDate(0, 0, -2))
+	s.True(errors.Is(err, ErrExchangeRateNotFound))
+}
+
+func (s *RateTableTestSuite) TestItAnswersInversePairs() {
+	table := s.newTable()
+
+	rate, err := table.Rate(context.Background(), s.eurUSD.Inverse(), s.day)
+	s.Require().NoError(err)
+	s.Equal("USD/EUR", rate.Pair().String())
+	s.True(decimal.RequireFromString("0.925925925926").Equal(rate.Rate()))
+}
+
+func (s *RateTableTestSuite) TestItReplacesRatesQuotedAtTheSameTime() {
+	table := s.newTable()
+	table.Add(s.rate(s.eurUSD, "1.081", s.day))
+
+	rate, err := table.Rate(context.Background(), s.eurUSD, s.day)
+	s.Require().NoError(err)
+	s.Equal("EUR/USD 1.081", rate.String())
+}
+
+func (s *RateTableTestSuite) TestItRejectsStaleRates() {
+	table := NewRateTable(WithMaxRateAge(24 * time.Hour))
+	table.Add(s.rate(s.eurUSD, "1.08", s.day))
+
+	_, err := table.Rate(context.Background(), s.eurUSD, s.day.Add(24*time.Hour))
+	s.NoError(err)
+
+	_, err = table.Rate(context.Background(), s.eurUSD, s.day.Add(25*time.Hour))
+	s.True(errors.Is(err, ErrStaleExchangeRate))
+}
+
+func (s *RateTableTestSuite) TestItFailsForUnknownPairs() {
+	gbpJPY, _ := ParseCurrencyPair("GBP/JPY")
+
+	_, err := s.newTable().Rate(context.Background(), gbpJPY, s.day)
+	s.True(errors.Is(err, ErrExchangeRateNotFound))
+
+	var domainErr *domain.Error
+	s.Require().True(errors.As(err, &domainErr))
+	s.Equal(domain.CategoryNotFound, domainErr.Category())
+	s.Equal("GBP/JPY", domainErr.Fields()["pair"])
+}
+
+func (s *RateTableTestSuite) TestItStopsOnCanceledContexts() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.newTable().Rate(ctx, s.eurUSD, s.day)
+	s.ErrorIs(err, context.Canceled)
+}
+
+// newTable returns a table with EUR/USD rates on three consecutive days, added out of order
+func (s *RateTableTestSuite) newTable() *RateTable {
+	table := NewRateTable()
+	table.Add(
+		s.rate(s.eurUSD, "1.09", s.day.AddDate(0, 0, 1)),
+		s.rate(s.eurUSD, "1.07", s.day.AddDate(0, 0, -1)),
+		s.rate(s.eurUSD, "1.08", s.day),
+	)
+	return table
+}
@@ -0,0 +1,289 @@
+package finance
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+var (
+	ErrEmptyISIN = domain.NewLocalizedError(
+		"finance.isin.empty", nil,
+		"ISIN cannot be empty",
+	)
+	ErrInvalidISINFormat = domain.NewLocalizedError(
+		"finance.isin.invalid_format", nil,
+		"ISIN must be 2 letters, 9 letters or digits and a check digit",
+	)
+	ErrInvalidISINChecksum = domain.NewLocalizedError(
+		"finance.isin.invalid_checksum", nil,
+		"ISIN has an invalid check digit",
+	)
+
+	ErrEmptyCUSIP = domain.NewLocalizedError(
+		"finance.cusip.empty", nil,
+		"CUSIP cannot be empty",
+	)
+	ErrInvalidCUSIPFormat = domain.NewLocalizedError(
+		"finance.cusip.invalid_format", nil,
+		"CUSIP must be 8 letters, digits or *@# characters and a check digit",
+	)
+	ErrInvalidCUSIPChecksum = domain.NewLocalizedError(
+		"finance.cusip.invalid_checksum", nil,
+		"CUSIP has an invalid check digit",
+	)
+)
+
+// Regular expressions a normalized ISIN and CUSIP must match, before their check digit is
+// verified
+const (
+	ISINPattern  = `^[A-Z]{2}[A-Z0-9]{9}[0-9]$`
+	CUSIPPattern = `^[A-Z0-9*@#]{8}[0-9]$`
+)
+
+var (
+	isinRegex  = regexp.MustCompile(ISINPattern)
+	cusipRegex = regexp.MustCompile(CUSIPPattern)
+)
+
+// ISINValidators holds extra rules run by NewISIN after its own validation
+var ISINValidators domain.Validators[ISIN]
+
+// ISIN represents an International Securities Identification Number (ISO 6166)
+type ISIN struct {
+	value string
+}
+
+// NewISIN creates a new instance of ISIN with validation and normalization
+func NewISIN(value string) (ISIN, error) {
+	normalized, err := NormalizeISIN(value)
+	if err != nil {
+		return ISIN{}, err
+	}
+
+	isin := ISIN{
+		value: normalized,
+	}
+	if err := ISINValidators.Validate(isin); err != nil {
+		return ISIN{}, err
+	}
+
+	return isin, nil
+}
+
+// ReconstituteISIN creates a new ISIN instance without validation or normalization
+func ReconstituteISIN(value string) ISIN {
+	return ISIN{
+		value: value,
+	}
+}
+
+// Value returns the ISIN value
+func (i ISIN) Value() string {
+	return i.value
+}
+
+// CountryCode returns the issuing country prefix. Note that some prefixes, such as "XS" for
+// international securities, are not ISO 3166 country codes.
+func (i ISIN) CountryCode() geography.CountryCode {
+	if len(i.value) < 2 {
+		return geography.CountryCode{}
+	}
+	return geography.ReconstituteCountryCode(i.value[:2])
+}
+
+// NSIN returns the 9-character national securities identifying number
+func (i ISIN) NSIN() string {
+	if len(i.value) < 11 {
+		return ""
+	}
+	return i.value[2:11]
+}
+
+// CUSIP returns the embedded CUSIP of a US or Canadian ISIN
+func (i ISIN) CUSIP() (CUSIP, bool) {
+	country := i.CountryCode().Value()
+	if country != "US" && country != "CA" {
+		return CUSIP{}, false
+	}
+
+	cusip, err := NewCUSIP(i.NSIN())
+	if err != nil {
+		return CUSIP{}, false
+	}
+	return cusip, true
+}
+
+// Equals compares two ISIN objects for equality
+func (i ISIN) Equals(other ISIN) bool {
+	return i.value == other.value
+}
+
+// String returns a string representation of the ISIN
+func (i ISIN) String() string {
+	return i.value
+}
+
+// NormalizeISIN normalizes an ISIN by removing spaces and dashes and converting to uppercase
+func NormalizeISIN(isin string) (string, error) {
+	normalized := stripSeparators(isin)
+
+	if err := IsValidISIN(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// IsValidISIN validates the format and check digit of a normalized ISIN
+func IsValidISIN(isin string) error {
+	if isin == "" {
+		return ErrEmptyISIN
+	}
+
+	if !isinRegex.MatchString(isin) {
+		return ErrInvalidISINFormat
+	}
+
+	// Letters expand to two digits (A=10 ... Z=35) before the Luhn check
+	var digits strings.Builder
+	for _, r := range isin {
+		digits.WriteString(securityCharValue(r))
+	}
+
+	if !isLuhnValid(digits.String()) {
+		return ErrInvalidISINChecksum
+	}
+
+	return nil
+}
+
+// CUSIPValidators holds extra rules run by NewCUSIP after its own validation
+var CUSIPValidators domain.Validators[CUSIP]
+
+// CUSIP represents a North American Committee on Uniform Securities Identification
+// Procedures number
+type CUSIP struct {
+	value string
+}
+
+// NewCUSIP creates a new instance of CUSIP with validation and normalization
+func NewCUSIP(value string) (CUSIP, error) {
+	normalized, err := NormalizeCUSIP(value)
+	if err != nil {
+		return CUSIP{}, err
+	}
+
+	cusip := CUSIP{
+		value: normalized,
+	}
+	if err := CUSIPValidators.Validate(cusip); err != nil {
+		return CUSIP{}, err
+	}
+
+	return cusip, nil
+}
+
+// ReconstituteCUSIP creates a new CUSIP instance without validation or normalization
+func ReconstituteCUSIP(value string) CUSIP {
+	return CUSIP{
+		value: value,
+	}
+}
+
+// Value returns the CUSIP value
+func (c CUSIP) Value() string {
+	return c.value
+}
+
+// Issuer returns the 6-character issuer code
+func (c CUSIP) Issuer() string {
+	if len(c.value) < 6 {
+		return ""
+	}
+	return c.value[:6]
+}
+
+// Equals compares two CUSIP objects for equality
+func (c CUSIP) Equals(other CUSIP) bool {
+	return c.value == other.value
+}
+
+// String returns a string representation of the CUSIP
+func (c CUSIP) String() string {
+	return c.value
+}
+
+// NormalizeCUSIP normalizes a CUSIP by removing spaces and dashes and converting to uppercase
+func NormalizeCUSIP(cusip string) (string, error) {
+	normalized := stripSeparators(cusip)
+
+	if err := IsValidCUSIP(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// IsValidCUSIP validates the format and check digit of a normalized CUSIP
+func IsValidCUSIP(cusip string) error {
+	if cusip == "" {
+		return ErrEmptyCUSIP
+	}
+
+	if !cusipRegex.MatchString(cusip) {
+		return ErrInvalidCUSIPFormat
+	}
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		var value int
+		switch c := cusip[i]; {
+		case c >= '0' && c <= '9':
+			value = int(c - '0')
+		case c >= 'A' && c <= 'Z':
+			value = int(c-'A') + 10
+		case c == '*':
+			value = 36
+		case c == '@':
+			value = 37
+		case c == '#':
+			value = 38
+		}
+
+		if i%2 == 1 {
+			value *= 2
+		}
+		sum += value/10 + value%10
+	}
+
+	if (10-sum%10)%10 != digitAt(cusip, 8) {
+		return ErrInvalidCUSIPChecksum
+	}
+
+	return nil
+}
+
+// stripSeparators removes spaces and dashes and converts letters to uppercase
+func stripSeparators(value string) string {
+	return strings.Map(
+		func(r rune) rune {
+			if r == ' ' || r == '-' {
+				return -1
+			}
+			return r
+		},
+		strings.ToUpper(strings.TrimSpace(value)),
+	)
+}
+
+// securityCharValue returns the decimal representation of an ISIN character
+func securityCharValue(r rune) string {
+	if r >= 'A' && r <= 'Z' {
+		value := int(r-'A') + 10
+		return string([]byte{byte('0' + value/10), byte('0' + value%10)})
+	}
+	return string(r)
+}
@@ -0,0 +1,136 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SecurityIdentifierTestSuite struct {
+	suite.Suite
+}
+
+func TestSecurityIdentifierSuite(t *testing.T) {
+	suite.Run(t, new(SecurityIdentifierTestSuite))
+}
+
+func (s *SecurityIdentifierTestSuite) TestItCanBuildNewISINWithValidValues() {
+	testCases := []struct {
+		name            string
+		input           string
+		expected        string
+		expectedCountry string
+	}{
+		{"Apple", "US0378331005", "US0378331005", "US"},
+		{"Lowercase with spaces", " us 0378331005 ", "US0378331005", "US"},
+		{"United Kingdom", "GB0002634946", "GB0002634946", "GB"},
+		{"Letters in NSIN", "DE000BAY0017", "DE000BAY0017", "DE"},
+		{"International", "XS2021471433", "XS2021471433", "XS"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				isin, err := NewISIN(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, isin.Value())
+				s.Equal(tc.expected, isin.String())
+				s.Equal(tc.expectedCountry, isin.CountryCode().Value())
+			},
+		)
+	}
+}
+
+func (s *SecurityIdentifierTestSuite) TestItFailsToBuildNewISINFromInvalidValues() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Empty", "  ", ErrEmptyISIN},
+		{"Too short", "US037833100", ErrInvalidISINFormat},
+		{"Digits in country prefix", "U10378331005", ErrInvalidISINFormat},
+		{"Letter as check digit", "US037833100A", ErrInvalidISINFormat},
+		{"Invalid check digit", "US0378331006", ErrInvalidISINChecksum},
+		{"Transposed characters", "US0373831005", ErrInvalidISINChecksum},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewISIN(tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *SecurityIdentifierTestSuite) TestISINExposesNSINAndCUSIP() {
+	apple, _ := NewISIN("US0378331005")
+	s.Equal("037833100", apple.NSIN())
+
+	cusip, ok := apple.CUSIP()
+	s.True(ok)
+	s.Equal("037833100", cusip.Value())
+
+	british, _ := NewISIN("GB0002634946")
+	_, ok = british.CUSIP()
+	s.False(ok)
+}
+
+func (s *SecurityIdentifierTestSuite) TestItCanBuildNewCUSIPWithValidValues() {
+	testCases := []struct {
+		name           string
+		input          string
+		expected       string
+		expectedIssuer string
+	}{
+		{"Apple", "037833100", "037833100", "037833"},
+		{"Microsoft lowercase", "594918104", "594918104", "594918"},
+		{"Letters", "38259p508", "38259P508", "38259P"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				cusip, err := NewCUSIP(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, cusip.Value())
+				s.Equal(tc.expectedIssuer, cusip.Issuer())
+			},
+		)
+	}
+}
+
+func (s *SecurityIdentifierTestSuite) TestItFailsToBuildNewCUSIPFromInvalidValues() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Empty", "", ErrEmptyCUSIP},
+		{"Too long", "0378331000", ErrInvalidCUSIPFormat},
+		{"Invalid character", "03783310!", ErrInvalidCUSIPFormat},
+		{"Invalid check digit", "037833101", ErrInvalidCUSIPChecksum},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewCUSIP(tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *SecurityIdentifierTestSuite) TestEquals() {
+	isin1, _ := NewISIN("US0378331005")
+	isin2 := ReconstituteISIN("US0378331005")
+	s.True(isin1.Equals(isin2))
+	s.False(isin1.Equals(ReconstituteISIN("GB0002634946")))
+
+	cusip1, _ := NewCUSIP("037833100")
+	s.True(cusip1.Equals(ReconstituteCUSIP("037833100")))
+	s.False(cusip1.Equals(ReconstituteCUSIP("594918104")))
+}
@@ -0,0 +1,241 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidEntryDirection = domain.NewError("unknown entry direction")
+	ErrNoEntries             = domain.NewError("at least one entry is needed to sum entries")
+	ErrEntryCurrencyMismatch = domain.NewError("entries must use the same currency")
+	ErrUnbalancedEntries     = domain.NewInvariantError(
+		"debits and credits must balance in every currency",
+	)
+)
+
+// EntryDirection is the side of a ledger account an entry is posted to
+type EntryDirection int
+
+const (
+	// Debit increases asset and expense accounts and decreases the others
+	Debit EntryDirection = iota
+	// Credit increases liability, equity and income accounts and decreases the others
+	Credit
+)
+
+var entryDirectionNames = [...]string{
+	Debit:  "debit",
+	Credit: "credit",
+}
+
+// ParseEntryDirection parses an entry direction name: "debit" or "credit"
+func ParseEntryDirection(name string) (EntryDirection, error) {
+	for direction, directionName := range entryDirectionNames {
+		if directionName == name {
+			return EntryDirection(direction), nil
+		}
+	}
+	return 0, ErrInvalidEntryDirection
+}
+
+// Opposite returns the other direction
+func (d EntryDirection) Opposite() EntryDirection {
+	if d == Debit {
+		return Credit
+	}
+	return Debit
+}
+
+// String returns the name of the entry direction, e.g., "debit"
+func (d EntryDirection) String() string {
+	if d < Debit || d > Credit {
+		return fmt.Sprintf("EntryDirection(%d)", int(d))
+	}
+	return entryDirectionNames[d]
+}
+
+// SignedEntry is an amount posted as a debit or a credit, the building block of double-entry
+// bookkeeping. Its amount is never negative: the direction carries the sign, with debits
+// counted as positive and credits as negative.
+type SignedEntry struct {
+	direction EntryDirection
+	amount    Money
+}
+
+// NewSignedEntry creates a new instance of SignedEntry with validation
+func NewSignedEntry(direction EntryDirection, amount Money) (SignedEntry, error) {
+	if direction != Debit && direction != Credit {
+		return SignedEntry{}, ErrInvalidEntryDirection
+	}
+	if err := IsValidCurrency(amount.currency.value); err != nil {
+		return SignedEntry{}, err
+	}
+	if err := IsValidMoneyAmount(amount.amount); err != nil {
+		return SignedEntry{}, err
+	}
+
+	return SignedEntry{
+		direction: direction,
+		amount:    amount,
+	}, nil
+}
+
+// NewDebit creates a new debit entry with validation
+func NewDebit(amount Money) (SignedEntry, error) {
+	return NewSignedEntry(Debit, amount)
+}
+
+// NewCredit creates a new credit entry with validation
+func NewCredit(amount Money) (SignedEntry, error) {
+	return NewSignedEntry(Credit, amount)
+}
+
+// ReconstituteSignedEntry creates a new SignedEntry instance without validation
+func ReconstituteSignedEntry(direction EntryDirection, amount Money) SignedEntry {
+	return SignedEntry{
+		direction: direction,
+		amount:    amount,
+	}
+}
+
+// Direction returns whether the entry is a debit or a credit
+func (e SignedEntry) Direction() EntryDirection {
+	return e.direction
+}
+
+// Amount returns the unsigned amount of the entry
+func (e SignedEntry) Amount() Money {
+	return e.amount
+}
+
+// IsDebit reports whether the entry is a debit
+func (e SignedEntry) IsDebit() bool {
+	return e.direction == Debit
+}
+
+// IsCredit reports whether the entry is a credit
+func (e SignedEntry) IsCredit() bool {
+	return e.direction == Credit
+}
+
+// Signed returns the amount, positive for a debit and negative for a credit
+func (e SignedEntry) Signed() decimal.Decimal {
+	if e.direction == Credit {
+		return e.amount.amount.Neg()
+	}
+	return e.amount.amount
+}
+
+// Invert returns the entry with the same amount in the opposite direction, e.g., to reverse
+// a posting
+func (e SignedEntry) Invert() SignedEntry {
+	return SignedEntry{
+		direction: e.direction.Opposite(),
+		amount:    e.amount,
+	}
+}
+
+// Equals compares two SignedEntry objects for equality
+func (e SignedEntry) Equals(other SignedEntry) bool {
+	return e.direction == other.direction && e.amount.Equals(other.amount)
+}
+
+// String returns a string representation of the entry, e.g., "debit 100 EUR"
+func (e SignedEntry) String() string {
+	return e.direction.String() + " " + e.amount.String()
+}
+
+// signedEntryJSON is the JSON representation of SignedEntry
+type signedEntryJSON struct {
+	Direction string `json:"direction"`
+	Amount    Money  `json:"amount"`
+}
+
+// MarshalJSON encodes the entry as its direction name and amount
+func (e SignedEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(signedEntryJSON{Direction: e.direction.String(), Amount: e.amount})
+}
+
+// UnmarshalJSON decodes the entry from its direction name and amount, with validation
+func (e *SignedEntry) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw signedEntryJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid signed entry JSON")
+	}
+
+	direction, err := ParseEntryDirection(raw.Direction)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewSignedEntry(direction, raw.Amount)
+	if err != nil {
+		return err
+	}
+
+	*e = parsed
+	return nil
+}
+
+// SumEntries returns the net of entries in a single currency: a debit when debits exceed
+// credits and a credit otherwise. Entries that cancel out sum to a zero debit. It fails with
+// ErrNoEntries when there are no entries and with ErrEntryCurrencyMismatch when they use
+// different currencies.
+func SumEntries(entries ...SignedEntry) (SignedEntry, error) {
+	if len(entries) == 0 {
+		return SignedEntry{}, ErrNoEntries
+	}
+
+	currency := entries[0].amount.currency
+	net := decimal.Zero
+	for _, entry := range entries {
+		if !entry.amount.currency.Equals(currency) {
+			return SignedEntry{}, domain.NewErrorWithWrap(
+				ErrEntryCurrencyMismatch,
+				"cannot sum %s with %s",
+				entry.amount.currency.String(),
+				currency.String(),
+			)
+		}
+		net = net.Add(entry.Signed())
+	}
+
+	if net.IsNegative() {
+		return SignedEntry{direction: Credit, amount: ReconstituteMoney(net.Neg(), currency)}, nil
+	}
+	return SignedEntry{direction: Debit, amount: ReconstituteMoney(net, currency)}, nil
+}
+
+// EnsureBalanced checks that the debits equal the credits in every currency of the entries,
+// as every double-entry transaction must. It fails with ErrUnbalancedEntries, carrying the
+// "currency" and the net "difference" (positive when debits exceed credits), otherwise.
+func EnsureBalanced(entries ...SignedEntry) error {
+	nets := make(map[Currency]decimal.Decimal)
+	var currencies []Currency
+	for _, entry := range entries {
+		currency := entry.amount.currency
+		if _, seen := nets[currency]; !seen {
+			currencies = append(currencies, currency)
+		}
+		nets[currency] = nets[currency].Add(entry.Signed())
+	}
+
+	for _, currency := range currencies {
+		if net := nets[currency]; !net.IsZero() {
+			return ErrUnbalancedEntries.
+				WithField("currency", currency.String()).
+				WithField("difference", net.String())
+		}
+	}
+
+	return nil
+}
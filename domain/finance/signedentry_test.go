@@ -0,0 +1,160 @@
+package finance
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignedEntryTestSuite struct {
+	suite.Suite
+}
+
+func TestSignedEntrySuite(t *testing.T) {
+	suite.Run(t, new(SignedEntryTestSuite))
+}
+
+func (s *SignedEntryTestSuite) money(amount, currency string) Money {
+	money, err := NewMoneyFromString(amount, currency)
+	s.Require().NoError(err)
+	return money
+}
+
+func (s *SignedEntryTestSuite) debit(amount, currency string) SignedEntry {
+	entry, err := NewDebit(s.money(amount, currency))
+	s.Require().NoError(err)
+	return entry
+}
+
+func (s *SignedEntryTestSuite) credit(amount, currency string) SignedEntry {
+	entry, err := NewCredit(s.money(amount, currency))
+	s.Require().NoError(err)
+	return entry
+}
+
+func (s *SignedEntryTestSuite) TestItCanBuildDebitsAndCredits() {
+	debit := s.debit("100", "EUR")
+	s.True(debit.IsDebit())
+	s.False(debit.IsCredit())
+	s.Equal(Debit, debit.Direction())
+	s.True(s.money("100", "EUR").Equals(debit.Amount()))
+	s.Equal("100", debit.Signed().String())
+	s.Equal("debit 100 EUR", debit.String())
+
+	credit := s.credit("25.50", "EUR")
+	s.True(credit.IsCredit())
+	s.Equal("-25.5", credit.Signed().String())
+	s.Equal("credit 25.5 EUR", credit.String())
+}
+
+func (s *SignedEntryTestSuite) TestItFailsToBuildInvalidEntries() {
+	_, err := NewSignedEntry(EntryDirection(7), s.money("1", "EUR"))
+	s.ErrorIs(err, ErrInvalidEntryDirection)
+
+	_, err = NewDebit(ReconstituteMoney(decimal.NewFromInt(-1), ReconstituteCurrency("EUR")))
+	s.ErrorIs(err, ErrNegativeAmount)
+
+	_, err = NewCredit(ReconstituteMoney(decimal.NewFromInt(1), ReconstituteCurrency("EURO")))
+	s.ErrorIs(err, ErrInvalidCurrency)
+}
+
+func (s *SignedEntryTestSuite) TestItCanBeInverted() {
+	debit := s.debit("100", "EUR")
+
+	inverted := debit.Invert()
+	s.True(inverted.IsCredit())
+	s.True(debit.Amount().Equals(inverted.Amount()))
+	s.True(debit.Equals(inverted.Invert()))
+	s.False(debit.Equals(inverted))
+}
+
+func (s *SignedEntryTestSuite) TestItCanBeReconstituted() {
+	s.Equal(s.credit("5", "USD"), ReconstituteSignedEntry(Credit, s.money("5", "USD")))
+}
+
+func (s *SignedEntryTestSuite) TestItParsesEntryDirections() {
+	direction, err := ParseEntryDirection("credit")
+	s.Require().NoError(err)
+	s.Equal(Credit, direction)
+	s.Equal(Debit, direction.Opposite())
+
+	_, err = ParseEntryDirection("Debit")
+	s.ErrorIs(err, ErrInvalidEntryDirection)
+	s.Equal("EntryDirection(7)", EntryDirection(7).String())
+}
+
+func (s *SignedEntryTestSuite) TestItSumsEntriesToTheirNet() {
+	net, err := SumEntries(s.debit("100", "EUR"), s.credit("30", "EUR"), s.debit("5", "EUR"))
+	s.Require().NoError(err)
+	s.Equal("debit 75 EUR", net.String())
+
+	net, err = SumEntries(s.debit("10", "EUR"), s.credit("30.25", "EUR"))
+	s.Require().NoError(err)
+	s.Equal("credit 20.25 EUR", net.String())
+
+	net, err = SumEntries(s.debit("10", "EUR"), s.credit("10", "EUR"))
+	s.Require().NoError(err)
+	s.True(net.IsDebit())
+	s.True(net.Amount().Amount().IsZero())
+}
+
+func (s *SignedEntryTestSuite) TestItFailsToSumInvalidEntries() {
+	_, err := SumEntries()
+	s.ErrorIs(err, ErrNoEntries)
+
+	_, err = SumEntries(s.debit("10", "EUR"), s.credit("10", "USD"))
+	s.ErrorIs(err, ErrEntryCurrencyMismatch)
+}
+
+func (s *SignedEntryTestSuite) TestItChecksThatEntriesBalance() {
+	s.NoError(EnsureBalanced())
+	s.NoError(
+		EnsureBalanced(
+			s.debit("100", "EUR"),
+			s.credit("80", "EUR"),
+			s.credit("20", "EUR"),
+			s.debit("5", "USD"),
+			s.credit("5", "USD"),
+		),
+	)
+
+	err := EnsureBalanced(s.debit("100", "EUR"), s.credit("100", "EUR"), s.credit("7", "USD"))
+	s.ErrorIs(err, ErrUnbalancedEntries)
+
+	var domainErr *domain.Error
+	s.Require().True(errors.As(err, &domainErr))
+	s.Equal(domain.CategoryInvariant, domainErr.Category())
+	s.Equal("USD", domainErr.Fields()["currency"])
+	s.Equal("-7", domainErr.Fields()["difference"])
+}
+
+func (s *SignedEntryTestSuite) TestItCanBeMarshaledToAndFromJSON() {
+	entry := s.credit("19.99", "EUR")
+
+	data, err := json.Marshal(entry)
+	s.Require().NoError(err)
+	s.JSONEq(`{"direction":"credit","amount":{"amount":"19.99","currency":"EUR"}}`, string(data))
+
+	var decoded SignedEntry
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(entry.Equals(decoded))
+
+	s.Require().NoError(json.Unmarshal([]byte("null"), &decoded))
+	s.True(entry.Equals(decoded))
+}
+
+func (s *SignedEntryTestSuite) TestItFailsToUnmarshalInvalidJSON() {
+	var decoded SignedEntry
+	s.ErrorIs(
+		json.Unmarshal(
+			[]byte(`{"direction":"sideways","amount":{"amount":"1","currency":"EUR"}}`),
+			&decoded,
+		),
+		ErrInvalidEntryDirection,
+	)
+	s.Error(json.Unmarshal([]byte(`[]`), &decoded))
+}
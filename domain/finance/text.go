@@ -0,0 +1,155 @@
+package finance
+
+// Text decoding is meant for CSV imports and HTML forms. Money reads from its string form
+// (e.g., "10.50 USD") and amounts never pass through floating point. Empty text is treated as
+// a missing value and leaves the receiver unchanged.
+
+// UnmarshalText parses the currency code from text, with validation
+func (c *Currency) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewCurrency(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// UnmarshalText parses the money from text, with validation
+func (m *Money) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := ParseMoney(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// UnmarshalText parses the interest rate from text, with validation
+func (r *InterestRate) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewInterestRateFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// UnmarshalText parses the rounding mode from text, with validation
+func (m *RoundingMode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := ParseRoundingMode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// UnmarshalText parses the entry direction from text, with validation
+func (d *EntryDirection) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := ParseEntryDirection(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// UnmarshalText parses the VAT number from text, with validation
+func (v *VATNumber) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewVATNumber(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+	return nil
+}
+
+// UnmarshalText parses the ISIN from text, with validation
+func (i *ISIN) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewISIN(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = parsed
+	return nil
+}
+
+// UnmarshalText parses the CUSIP from text, with validation
+func (c *CUSIP) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewCUSIP(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// UnmarshalText parses the account number from text, with validation
+func (a *AccountNumber) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewAccountNumber(string(text))
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
+
+// UnmarshalText parses the routing number from text, with validation
+func (r *RoutingNumber) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewRoutingNumber(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
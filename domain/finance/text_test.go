@@ -0,0 +1,60 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TextTestSuite struct {
+	suite.Suite
+}
+
+func TestTextSuite(t *testing.T) {
+	suite.Run(t, new(TextTestSuite))
+}
+
+func (s *TextTestSuite) TestItCanParseFinanceValuesFromText() {
+	var money Money
+	s.Require().NoError(money.UnmarshalText([]byte("10.50 USD")))
+	s.Equal("10.5 USD", money.String())
+
+	var currency Currency
+	s.Require().NoError(currency.UnmarshalText([]byte("eur")))
+	s.Equal("EUR", currency.Value())
+
+	var rate InterestRate
+	s.Require().NoError(rate.UnmarshalText([]byte("4.25")))
+	s.Equal("4.25", rate.AnnualPercent().String())
+
+	var mode RoundingMode
+	s.Require().NoError(mode.UnmarshalText([]byte("half-even")))
+	s.Equal(RoundHalfEven, mode)
+
+	var direction EntryDirection
+	s.Require().NoError(direction.UnmarshalText([]byte("credit")))
+	s.Equal(Credit, direction)
+
+	var isin ISIN
+	s.Require().NoError(isin.UnmarshalText([]byte("US0378331005")))
+	s.Equal("US0378331005", isin.Value())
+}
+
+func (s *TextTestSuite) TestItValidatesText() {
+	var money Money
+	s.ErrorIs(money.UnmarshalText([]byte("10.50")), ErrInvalidMoney)
+	s.ErrorIs(money.UnmarshalText([]byte("-1 USD")), ErrNegativeAmount)
+
+	var mode RoundingMode
+	s.ErrorIs(mode.UnmarshalText([]byte("sideways")), ErrInvalidRoundingMode)
+
+	var direction EntryDirection
+	s.ErrorIs(direction.UnmarshalText([]byte("sideways")), ErrInvalidEntryDirection)
+}
+
+func (s *TextTestSuite) TestItLeavesTheValueUnchangedForEmptyText() {
+	money, _ := NewMoneyFromString("5", "USD")
+
+	s.NoError(money.UnmarshalText(nil))
+	s.Equal("5 USD", money.String())
+}
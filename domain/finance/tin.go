@@ -0,0 +1,321 @@
+package finance
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+// TINVisibleChars is the number of trailing characters left visible when masking
+const TINVisibleChars = 4
+
+var (
+	ErrEmptyTIN = domain.NewLocalizedError(
+		"finance.tin.empty", nil,
+		"tax identification number cannot be empty",
+	)
+	ErrInvalidTIN = domain.NewLocalizedError(
+		"finance.tin.invalid_format", nil,
+		"tax identification number has invalid format",
+	)
+	ErrInvalidTINChecksum = domain.NewLocalizedError(
+		"finance.tin.invalid_checksum", nil,
+		"tax identification number has an invalid check digit",
+	)
+	ErrUnsupportedTINCountry = domain.NewLocalizedError(
+		"finance.tin.unsupported_country", nil,
+		"tax identification number country is not supported",
+	)
+)
+
+// TINValidator validates a normalized tax identification number (uppercase, without spaces,
+// hyphens, dots or slashes) for a single country
+type TINValidator func(normalized string) error
+
+var (
+	tinValidatorsMu sync.RWMutex
+	tinValidators   = map[string]TINValidator{
+		"US": IsValidUSTIN,
+		"GB": IsValidUKUTR,
+		"DE": IsValidGermanSteuernummer,
+	}
+)
+
+// RegisterTINValidator registers (or replaces) the validator used for a country.
+// It is safe to call concurrently with NewTIN.
+func RegisterTINValidator(country geography.CountryCode, validator TINValidator) {
+	tinValidatorsMu.Lock()
+	defer tinValidatorsMu.Unlock()
+	tinValidators[country.Value()] = validator
+}
+
+// TIN is a tax identification number collected for payouts and KYC checks, such as a US EIN
+// or a UK UTR. Unlike a VATNumber it identifies the taxpayer for income tax, so it is
+// sensitive: String, LogValue and MarshalJSON only reveal the last four characters; use Value
+// to persist it.
+type TIN struct {
+	country geography.CountryCode
+	value   string
+}
+
+// NewTIN creates a new instance of TIN with validation and normalization, using the
+// validator registered for the country. US numbers given with hyphens must also be valid
+// for the layout they use: an EIN for "12-3456789" and an SSN or ITIN for "123-45-6789".
+func NewTIN(country geography.CountryCode, value string) (TIN, error) {
+	normalized, err := NormalizeTIN(value)
+	if err != nil {
+		return TIN{}, err
+	}
+
+	if err := IsValidTIN(country, normalized); err != nil {
+		return TIN{}, err
+	}
+
+	if country.Value() == "US" {
+		if err := isValidUSTINLayout(strings.TrimSpace(value), normalized); err != nil {
+			return TIN{}, err
+		}
+	}
+
+	return TIN{
+		country: country,
+		value:   normalized,
+	}, nil
+}
+
+// ReconstituteTIN creates a new TIN instance without validation or normalization
+func ReconstituteTIN(country geography.CountryCode, value string) TIN {
+	return TIN{
+		country: country,
+		value:   value,
+	}
+}
+
+// Country returns the issuing country
+func (t TIN) Country() geography.CountryCode {
+	return t.country
+}
+
+// Value returns the full, unmasked tax identification number
+func (t TIN) Value() string {
+	return t.value
+}
+
+// Masked returns the tax identification number with all but the last TINVisibleChars
+// characters replaced by bullets, e.g., "•••••6789"
+func (t TIN) Masked() string {
+	runes := []rune(t.value)
+	visible := min(TINVisibleChars, len(runes)/2)
+	return strings.Repeat("•", len(runes)-visible) + string(runes[len(runes)-visible:])
+}
+
+// Equals compares two TIN objects for equality
+func (t TIN) Equals(other TIN) bool {
+	return t.country.Equals(other.country) && t.value == other.value
+}
+
+// String returns a masked string representation of the tax identification number
+func (t TIN) String() string {
+	return t.Masked()
+}
+
+// LogValue implements slog.LogValuer so that loggers record the masked number
+func (t TIN) LogValue() slog.Value {
+	return slog.StringValue(t.Masked())
+}
+
+// Sensitive marks the tax identification number as personal data for domain.Sensitive
+func (t TIN) Sensitive() {}
+
+// tinJSON is the JSON representation of TIN
+type tinJSON struct {
+	Country string `json:"country"`
+	Value   string `json:"value"`
+}
+
+// MarshalJSON encodes the tax identification number with a masked value, so it never leaves
+// the domain in full through JSON
+func (t TIN) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tinJSON{Country: t.country.Value(), Value: t.Masked()})
+}
+
+// UnmarshalJSON decodes and validates a tax identification number given with its full value
+func (t *TIN) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw tinJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid tax identification number JSON")
+	}
+
+	country, err := geography.NewCountryCode(raw.Country)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewTIN(country, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// NormalizeTIN normalizes a tax identification number by converting to uppercase and removing
+// spaces, hyphens, dots and slashes
+func NormalizeTIN(value string) (string, error) {
+	normalized := strings.Map(
+		func(r rune) rune {
+			if unicode.IsSpace(r) || r == '-' || r == '.' || r == '/' {
+				return -1
+			}
+			return unicode.ToUpper(r)
+		},
+		value,
+	)
+	if normalized == "" {
+		return "", ErrEmptyTIN
+	}
+
+	return normalized, nil
+}
+
+// IsValidTIN validates a normalized tax identification number with the validator registered
+// for the country
+func IsValidTIN(country geography.CountryCode, normalized string) error {
+	if normalized == "" {
+		return ErrEmptyTIN
+	}
+
+	tinValidatorsMu.RLock()
+	validator, ok := tinValidators[country.Value()]
+	tinValidatorsMu.RUnlock()
+	if !ok {
+		return ErrUnsupportedTINCountry
+	}
+
+	return validator(normalized)
+}
+
+var (
+	nineDigitsPattern         = regexp.MustCompile(`^\d{9}$`)
+	einLayoutPattern          = regexp.MustCompile(`^\d{2}-\d{7}$`)
+	ssnLayoutPattern          = regexp.MustCompile(`^\d{3}-\d{2}-\d{4}$`)
+	ukUTRPattern              = regexp.MustCompile(`^\d{10}$`)
+	germanSteuernummerPattern = regexp.MustCompile(`^(\d{10,11}|\d{13})$`)
+)
+
+// unassignedEINPrefixes lists the two-digit EIN prefixes the IRS has never assigned
+var unassignedEINPrefixes = []string{
+	"00", "07", "08", "09", "17", "18", "19", "28", "29", "49", "69", "70", "78", "79", "89",
+	"96", "97",
+}
+
+// utrWeights are the weights HMRC applies to the last nine digits of a UTR
+var utrWeights = [...]int{6, 7, 8, 9, 10, 5, 4, 3, 2}
+
+// utrCheckDigits maps the weighted sum of a UTR, modulo 11, to its first digit
+const utrCheckDigits = "21987654321"
+
+// IsValidUSTIN validates a US taxpayer identification number: either a valid EIN or a valid
+// SSN or ITIN. Nine digits alone do not tell these apart, so NewTIN also checks the layout of
+// hyphenated input.
+func IsValidUSTIN(normalized string) error {
+	if IsValidEIN(normalized) == nil || IsValidSSN(normalized) == nil {
+		return nil
+	}
+
+	return ErrInvalidTIN
+}
+
+// IsValidEIN validates a US Employer Identification Number ("12-3456789", normalized): nine
+// digits with a prefix the IRS assigns
+func IsValidEIN(normalized string) error {
+	if !nineDigitsPattern.MatchString(normalized) ||
+		slices.Contains(unassignedEINPrefixes, normalized[:2]) {
+		return ErrInvalidTIN
+	}
+
+	return nil
+}
+
+// IsValidSSN validates a number in US Social Security Number format ("123-45-6789",
+// normalized). Areas 000 and 666, group 00 and serial 0000 are never issued. Area 9xx is
+// reserved for Individual Taxpayer Identification Numbers, which are accepted with one of
+// the groups the IRS issues: 50-65, 70-88, 90-92 and 94-99.
+func IsValidSSN(normalized string) error {
+	if !nineDigitsPattern.MatchString(normalized) {
+		return ErrInvalidTIN
+	}
+
+	area, group, serial := normalized[:3], normalized[3:5], normalized[5:]
+	if area == "000" || area == "666" || group == "00" || serial == "0000" {
+		return ErrInvalidTIN
+	}
+	if area[0] == '9' && !isITINGroup(group) {
+		return ErrInvalidTIN
+	}
+
+	return nil
+}
+
+// isITINGroup reports whether the two-digit group is one issued for ITINs
+func isITINGroup(group string) bool {
+	return group >= "50" && group <= "65" ||
+		group >= "70" && group <= "88" ||
+		group >= "90" && group <= "92" ||
+		group >= "94" && group <= "99"
+}
+
+// isValidUSTINLayout checks a hyphenated US number against the type its layout denotes.
+// Other layouts were already validated by IsValidUSTIN.
+func isValidUSTINLayout(value, normalized string) error {
+	switch {
+	case einLayoutPattern.MatchString(value):
+		return IsValidEIN(normalized)
+	case ssnLayoutPattern.MatchString(value):
+		return IsValidSSN(normalized)
+	default:
+		return nil
+	}
+}
+
+// IsValidUKUTR validates a UK Unique Taxpayer Reference: ten digits, optionally followed by
+// the "K" some HMRC forms add, whose first digit is the check digit of the other nine
+func IsValidUKUTR(normalized string) error {
+	normalized = strings.TrimSuffix(normalized, "K")
+	if !ukUTRPattern.MatchString(normalized) {
+		return ErrInvalidTIN
+	}
+
+	sum := 0
+	for i, weight := range utrWeights {
+		sum += int(normalized[i+1]-'0') * weight
+	}
+	if utrCheckDigits[sum%11] != normalized[0] {
+		return ErrInvalidTINChecksum
+	}
+
+	return nil
+}
+
+// IsValidGermanSteuernummer validates a German tax number: the 10 or 11 digit format of the
+// issuing state ("12/345/67890") or the 13 digit nationwide ELSTER format
+func IsValidGermanSteuernummer(normalized string) error {
+	if !germanSteuernummerPattern.MatchString(normalized) {
+		return ErrInvalidTIN
+	}
+
+	return nil
+}
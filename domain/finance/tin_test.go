@@ -0,0 +1,208 @@
+package finance
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+)
+
+type TINTestSuite struct {
+	suite.Suite
+	us geography.CountryCode
+	gb geography.CountryCode
+	de geography.CountryCode
+}
+
+func TestTINSuite(t *testing.T) {
+	suite.Run(t, new(TINTestSuite))
+}
+
+func (s *TINTestSuite) SetupTest() {
+	s.us = geography.ReconstituteCountryCode("US")
+	s.gb = geography.ReconstituteCountryCode("GB")
+	s.de = geography.ReconstituteCountryCode("DE")
+}
+
+func (s *TINTestSuite) TestItCanCreateValidTINs() {
+	testCases := []struct {
+		name     string
+		country  geography.CountryCode
+		value    string
+		expected string
+	}{
+		{"US EIN", s.us, "12-3456789", "123456789"},
+		{"US SSN format", s.us, "078-05-1120", "078051120"},
+		{"US ITIN", s.us, "912-70-1234", "912701234"},
+		{"UK UTR", s.gb, "19558 39661", "1955839661"},
+		{"UK UTR with K", s.gb, "2234567890k", "2234567890K"},
+		{"DE state format", s.de, "12/345/67890", "1234567890"},
+		{"DE ELSTER format", s.de, "2893081508152", "2893081508152"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				tin, err := NewTIN(tc.country, tc.value)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, tin.Value())
+				s.True(tc.country.Equals(tin.Country()))
+			},
+		)
+	}
+}
+
+func (s *TINTestSuite) TestItFailsWithInvalidTINs() {
+	jp := geography.ReconstituteCountryCode("JP")
+	testCases := []struct {
+		name    string
+		country geography.CountryCode
+		value   string
+		err     error
+	}{
+		{"empty", s.us, " - ", ErrEmptyTIN},
+		{"US too short", s.us, "12-345678", ErrInvalidTIN},
+		{"US letters", s.us, "12-345678A", ErrInvalidTIN},
+		{"US unassigned prefix in SSN area 000", s.us, "000-12-3456", ErrInvalidTIN},
+		{"US unassigned prefix with serial 0000", s.us, "07-1230000", ErrInvalidTIN},
+		{"UK too long", s.gb, "12345678901", ErrInvalidTIN},
+		{"UK wrong check digit", s.gb, "1234567890", ErrInvalidTINChecksum},
+		{"DE twelve digits", s.de, "123456789012", ErrInvalidTIN},
+		{"unsupported country", jp, "123", ErrUnsupportedTINCountry},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewTIN(tc.country, tc.value)
+				s.True(errors.Is(err, tc.err), "got %v", err)
+			},
+		)
+	}
+}
+
+func (s *TINTestSuite) TestItValidatesUSTaxpayerIdentificationNumbers() {
+	testCases := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"EIN", "12-3456789", true},
+		{"EIN with an assigned 9x prefix", "98-7654321", true},
+		{"EIN with unassigned prefix 07", "07-1234567", false},
+		{"EIN with unassigned prefix 96", "96-1234567", false},
+		{"SSN", "078-05-1120", true},
+		{"SSN in area 000", "000-12-3456", false},
+		{"SSN in area 666", "666-12-3456", false},
+		{"SSN in area 9xx outside the ITIN groups", "912-34-5678", false},
+		{"SSN with group 00", "123-00-4567", false},
+		{"SSN with serial 0000", "123-45-0000", false},
+		{"ITIN", "912-70-1234", true},
+		{"ITIN in group 93", "912-93-1234", false},
+		{"Unformatted EIN", "123456789", true},
+		{"Unformatted SSN in area 000", "000123456", false},
+		{"Unformatted number in area 970 outside the ITIN groups", "970001234", false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewTIN(s.us, tc.value)
+				if tc.valid {
+					s.NoError(err)
+					return
+				}
+				s.ErrorIs(err, ErrInvalidTIN)
+			},
+		)
+	}
+}
+
+func (s *TINTestSuite) TestItValidatesUKUTRCheckDigits() {
+	testCases := []struct {
+		name  string
+		value string
+		err   error
+	}{
+		{"Valid", "1955839661", nil},
+		{"Valid with K", "1955839661K", nil},
+		{"Valid with remainder 0", "2234567890", nil},
+		{"Wrong check digit", "2955839661", ErrInvalidTINChecksum},
+		{"Transposed digits", "1955836961", ErrInvalidTINChecksum},
+		{"Nine digits", "195583966", ErrInvalidTIN},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				err := IsValidUKUTR(tc.value)
+				if tc.err == nil {
+					s.NoError(err)
+					return
+				}
+				s.ErrorIs(err, tc.err)
+			},
+		)
+	}
+}
+
+func (s *TINTestSuite) TestItCanRegisterValidators() {
+	fr := geography.ReconstituteCountryCode("FR")
+	RegisterTINValidator(fr, func(normalized string) error {
+		if len(normalized) != 13 {
+			return ErrInvalidTIN
+		}
+		return nil
+	})
+	defer func() {
+		tinValidatorsMu.Lock()
+		delete(tinValidators, "FR")
+		tinValidatorsMu.Unlock()
+	}()
+
+	_, err := NewTIN(fr, "30 23 217 600 053")
+	s.NoError(err)
+}
+
+func (s *TINTestSuite) TestItMasksTheValue() {
+	tin, _ := NewTIN(s.us, "12-3456789")
+
+	s.Equal("•••••6789", tin.Masked())
+	s.Equal("•••••6789", tin.String())
+	s.Equal(slog.StringValue("•••••6789"), tin.LogValue())
+
+	s.Equal("••••ßé12", ReconstituteTIN(s.de, "ÄÖÜßßé12").Masked())
+}
+
+func (s *TINTestSuite) TestItMasksTheValueWhenMarshalingJSON() {
+	tin, _ := NewTIN(s.us, "12-3456789")
+
+	data, err := json.Marshal(tin)
+	s.Require().NoError(err)
+	s.JSONEq(`{"country":"US","value":"•••••6789"}`, string(data))
+}
+
+func (s *TINTestSuite) TestItCanUnmarshalJSON() {
+	var tin TIN
+	s.Require().NoError(json.Unmarshal([]byte(`{"country":"DE","value":"12/345/67890"}`), &tin))
+	s.Equal("1234567890", tin.Value())
+
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &tin))
+	s.Equal("1234567890", tin.Value())
+
+	err := json.Unmarshal([]byte(`{"country":"DE","value":"123"}`), &tin)
+	s.True(errors.Is(err, ErrInvalidTIN))
+	s.Error(json.Unmarshal([]byte(`{"country":"D","value":"1234567890"}`), &tin))
+	s.Error(json.Unmarshal([]byte(`"1234567890"`), &tin))
+}
+
+func (s *TINTestSuite) TestItCanCompareTINs() {
+	tin := ReconstituteTIN(s.gb, "1234567890")
+
+	s.True(tin.Equals(ReconstituteTIN(s.gb, "1234567890")))
+	s.False(tin.Equals(ReconstituteTIN(s.de, "1234567890")))
+	s.False(tin.Equals(ReconstituteTIN(s.gb, "1234567891")))
+}
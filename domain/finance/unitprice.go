@@ -0,0 +1,248 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrNegativeQuantity          = domain.NewError("quantity cannot be negative")
+	ErrNegativeTaxRate           = domain.NewError("tax rate cannot be negative")
+	ErrNegativeTaxAmount         = domain.NewError("line total tax amount cannot be negative")
+	ErrInvalidRoundingMode       = domain.NewError("unknown rounding mode")
+	ErrNegativeRoundingPlaces    = domain.NewError("rounding places cannot be negative")
+	ErrLineTotalCurrencyMismatch = domain.NewError(
+		"line total net and tax amounts must use the same currency",
+	)
+)
+
+// RoundingMode selects how amounts are rounded to a number of decimal places
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds halves away from zero (commercial rounding)
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds halves to the nearest even digit (banker's rounding)
+	RoundHalfEven
+	// RoundDown truncates towards zero
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+)
+
+var roundingModeNames = [...]string{
+	RoundHalfUp:   "half-up",
+	RoundHalfEven: "half-even",
+	RoundDown:     "down",
+	RoundUp:       "up",
+}
+
+// ParseRoundingMode parses a rounding mode name: "half-up", "half-even", "down" or "up"
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	for mode, modeName := range roundingModeNames {
+		if modeName == name {
+			return RoundingMode(mode), nil
+		}
+	}
+	return 0, ErrInvalidRoundingMode
+}
+
+// String returns the name of the rounding mode, e.g., "half-even"
+func (m RoundingMode) String() string {
+	if m < RoundHalfUp || m > RoundUp {
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+	return roundingModeNames[m]
+}
+
+// Rounding is an explicit rounding policy: a number of decimal places and a mode
+type Rounding struct {
+	places int32
+	mode   RoundingMode
+}
+
+// NewRounding creates a new Rounding policy with validation
+func NewRounding(places int32, mode RoundingMode) (Rounding, error) {
+	if places < 0 {
+		return Rounding{}, ErrNegativeRoundingPlaces
+	}
+
+	if mode < RoundHalfUp || mode > RoundUp {
+		return Rounding{}, ErrInvalidRoundingMode
+	}
+
+	return Rounding{
+		places: places,
+		mode:   mode,
+	}, nil
+}
+
+// Places returns the number of decimal places kept
+func (r Rounding) Places() int32 {
+	return r.places
+}
+
+// Mode returns the rounding mode
+func (r Rounding) Mode() RoundingMode {
+	return r.mode
+}
+
+// Apply rounds the amount according to the policy
+func (r Rounding) Apply(amount decimal.Decimal) decimal.Decimal {
+	switch r.mode {
+	case RoundHalfEven:
+		return amount.RoundBank(r.places)
+	case RoundDown:
+		return amount.RoundDown(r.places)
+	case RoundUp:
+		return amount.RoundUp(r.places)
+	default:
+		return amount.Round(r.places)
+	}
+}
+
+// UnitPrice is the price of a single unit together with the rounding applied to totals
+type UnitPrice struct {
+	price    Money
+	rounding Rounding
+}
+
+// NewUnitPrice creates a new UnitPrice; the rounding policy is required so that every
+// total computed from it is rounded explicitly
+func NewUnitPrice(price Money, rounding Rounding) (UnitPrice, error) {
+	if err := IsValidMoneyAmount(price.amount); err != nil {
+		return UnitPrice{}, err
+	}
+
+	if _, err := NewRounding(rounding.places, rounding.mode); err != nil {
+		return UnitPrice{}, err
+	}
+
+	return UnitPrice{
+		price:    price,
+		rounding: rounding,
+	}, nil
+}
+
+// ReconstituteUnitPrice creates a new UnitPrice instance without validation
+func ReconstituteUnitPrice(price Money, rounding Rounding) UnitPrice {
+	return UnitPrice{
+		price:    price,
+		rounding: rounding,
+	}
+}
+
+// Price returns the price of a single unit
+func (p UnitPrice) Price() Money {
+	return p.price
+}
+
+// Rounding returns the rounding policy applied to totals
+func (p UnitPrice) Rounding() Rounding {
+	return p.rounding
+}
+
+// Total returns the rounded price of the given quantity, which may be fractional
+// (e.g., 1.5 kg)
+func (p UnitPrice) Total(quantity decimal.Decimal) (Money, error) {
+	if quantity.IsNegative() {
+		return Money{}, ErrNegativeQuantity
+	}
+
+	return Money{
+		amount:   p.rounding.Apply(p.price.amount.Mul(quantity)),
+		currency: p.price.currency,
+	}, nil
+}
+
+// LineTotal returns the net, tax and gross totals of an order line. The tax rate is a
+// fraction (e.g., 0.19 for 19%) and the tax is rounded with the same policy as the net total.
+func (p UnitPrice) LineTotal(quantity, taxRate decimal.Decimal) (LineTotal, error) {
+	if taxRate.IsNegative() {
+		return LineTotal{}, ErrNegativeTaxRate
+	}
+
+	net, err := p.Total(quantity)
+	if err != nil {
+		return LineTotal{}, err
+	}
+
+	tax := Money{
+		amount:   p.rounding.Apply(net.amount.Mul(taxRate)),
+		currency: net.currency,
+	}
+
+	return NewLineTotal(net, tax)
+}
+
+// Equals compares two UnitPrice objects for equality
+func (p UnitPrice) Equals(other UnitPrice) bool {
+	return p.price.Equals(other.price) && p.rounding == other.rounding
+}
+
+// String returns a string representation of the unit price, e.g., "9.99 EUR/unit"
+func (p UnitPrice) String() string {
+	return p.price.String() + "/unit"
+}
+
+// LineTotal holds the net, tax and gross amounts of an order line
+type LineTotal struct {
+	net   Money
+	tax   Money
+	gross Money
+}
+
+// NewLineTotal creates a new LineTotal from net and tax amounts in the same currency. The tax
+// cannot be negative, so the gross amount is never below the net amount.
+func NewLineTotal(net, tax Money) (LineTotal, error) {
+	if tax.amount.IsNegative() {
+		return LineTotal{}, ErrNegativeTaxAmount.WithField("tax", tax.String())
+	}
+
+	gross, err := net.Add(tax)
+	if err != nil {
+		return LineTotal{}, domain.NewErrorWithWrap(ErrLineTotalCurrencyMismatch, "%v", err)
+	}
+
+	return LineTotal{
+		net:   net,
+		tax:   tax,
+		gross: gross,
+	}, nil
+}
+
+// ReconstituteLineTotal creates a new LineTotal instance without validation
+func ReconstituteLineTotal(net, tax, gross Money) LineTotal {
+	return LineTotal{
+		net:   net,
+		tax:   tax,
+		gross: gross,
+	}
+}
+
+// Net returns the amount before tax
+func (l LineTotal) Net() Money {
+	return l.net
+}
+
+// Tax returns the tax amount
+func (l LineTotal) Tax() Money {
+	return l.tax
+}
+
+// Gross returns the amount including tax
+func (l LineTotal) Gross() Money {
+	return l.gross
+}
+
+// Equals compares two LineTotal objects for equality
+func (l LineTotal) Equals(other LineTotal) bool {
+	return l.net.Equals(other.net) && l.tax.Equals(other.tax) && l.gross.Equals(other.gross)
+}
+
+// String returns a string representation of the line total
+func (l LineTotal) String() string {
+	return fmt.Sprintf("net %s + tax %s = %s", l.net, l.tax, l.gross)
+}
@@ -0,0 +1,157 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitPriceTestSuite struct {
+	suite.Suite
+}
+
+func TestUnitPriceSuite(t *testing.T) {
+	suite.Run(t, new(UnitPriceTestSuite))
+}
+
+func (s *UnitPriceTestSuite) unitPrice(amount string, mode RoundingMode) UnitPrice {
+	price, err := NewMoneyFromString(amount, "EUR")
+	s.Require().NoError(err)
+	rounding, err := NewRounding(2, mode)
+	s.Require().NoError(err)
+	unitPrice, err := NewUnitPrice(price, rounding)
+	s.Require().NoError(err)
+	return unitPrice
+}
+
+func (s *UnitPriceTestSuite) TestRoundingModes() {
+	testCases := []struct {
+		name     string
+		mode     RoundingMode
+		input    string
+		expected string
+	}{
+		{"Half up rounds half away from zero", RoundHalfUp, "2.345", "2.35"},
+		{"Half even rounds half to even", RoundHalfEven, "2.345", "2.34"},
+		{"Half even rounds above half up", RoundHalfEven, "2.3451", "2.35"},
+		{"Down truncates", RoundDown, "2.349", "2.34"},
+		{"Up rounds away from zero", RoundUp, "2.341", "2.35"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				rounding, err := NewRounding(2, tc.mode)
+				s.NoError(err)
+				s.Equal(tc.expected, rounding.Apply(decimal.RequireFromString(tc.input)).String())
+			},
+		)
+	}
+
+	_, err := NewRounding(-1, RoundHalfUp)
+	s.ErrorIs(err, ErrNegativeRoundingPlaces)
+
+	_, err = NewRounding(2, RoundingMode(42))
+	s.ErrorIs(err, ErrInvalidRoundingMode)
+}
+
+func (s *UnitPriceTestSuite) TestItCanComputeTotals() {
+	testCases := []struct {
+		name     string
+		price    string
+		mode     RoundingMode
+		quantity string
+		expected string
+	}{
+		{"Whole quantity", "9.99", RoundHalfUp, "3", "29.97"},
+		{"Fractional quantity rounded half up", "3.99", RoundHalfUp, "1.255", "5.01"},
+		{"Fractional quantity truncated", "3.99", RoundDown, "1.255", "5"},
+		{"Zero quantity", "3.99", RoundHalfUp, "0", "0"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				total, err := s.unitPrice(tc.price, tc.mode).Total(decimal.RequireFromString(tc.quantity))
+				s.NoError(err)
+				s.Equal(tc.expected, total.Amount().String())
+				s.Equal("EUR", total.Currency().Value())
+			},
+		)
+	}
+
+	_, err := s.unitPrice("1", RoundHalfUp).Total(decimal.NewFromInt(-1))
+	s.ErrorIs(err, ErrNegativeQuantity)
+}
+
+func (s *UnitPriceTestSuite) TestItCanComputeLineTotals() {
+	unitPrice := s.unitPrice("19.99", RoundHalfUp)
+
+	lineTotal, err := unitPrice.LineTotal(decimal.NewFromInt(3), decimal.RequireFromString("0.19"))
+	s.NoError(err)
+	s.Equal("59.97 EUR", lineTotal.Net().String())
+	s.Equal("11.39 EUR", lineTotal.Tax().String())
+	s.Equal("71.36 EUR", lineTotal.Gross().String())
+	s.Equal("net 59.97 EUR + tax 11.39 EUR = 71.36 EUR", lineTotal.String())
+
+	_, err = unitPrice.LineTotal(decimal.NewFromInt(1), decimal.RequireFromString("-0.1"))
+	s.ErrorIs(err, ErrNegativeTaxRate)
+}
+
+func (s *UnitPriceTestSuite) TestItFailsToBuildLineTotalInMixedCurrencies() {
+	net, _ := NewMoneyFromString("10", "EUR")
+	tax, _ := NewMoneyFromString("2", "USD")
+
+	_, err := NewLineTotal(net, tax)
+	s.ErrorIs(err, ErrLineTotalCurrencyMismatch)
+}
+
+func (s *UnitPriceTestSuite) TestItFailsToBuildLineTotalWithNegativeTax() {
+	net, _ := NewMoneyFromString("10", "EUR")
+	tax := ReconstituteMoney(decimal.RequireFromString("-0.01"), ReconstituteCurrency("EUR"))
+
+	_, err := NewLineTotal(net, tax)
+	s.ErrorIs(err, ErrNegativeTaxAmount)
+
+	zeroTax, _ := NewMoneyFromString("0", "EUR")
+	lineTotal, err := NewLineTotal(net, zeroTax)
+	s.Require().NoError(err)
+	s.True(net.Equals(lineTotal.Gross()))
+}
+
+func (s *UnitPriceTestSuite) TestItFailsToBuildUnitPriceFromInvalidValues() {
+	negative := ReconstituteMoney(decimal.NewFromInt(-1), ReconstituteCurrency("EUR"))
+	rounding, _ := NewRounding(2, RoundHalfUp)
+
+	_, err := NewUnitPrice(negative, rounding)
+	s.ErrorIs(err, ErrNegativeAmount)
+
+	price, _ := NewMoneyFromString("1", "EUR")
+	_, err = NewUnitPrice(price, Rounding{places: -2})
+	s.ErrorIs(err, ErrNegativeRoundingPlaces)
+}
+
+func (s *UnitPriceTestSuite) TestEqualsAndString() {
+	price1 := s.unitPrice("9.99", RoundHalfUp)
+	price2 := s.unitPrice("9.990", RoundHalfUp)
+	price3 := s.unitPrice("9.99", RoundHalfEven)
+
+	s.True(price1.Equals(price2))
+	s.False(price1.Equals(price3))
+	s.Equal("9.99 EUR/unit", price1.String())
+}
+
+func (s *UnitPriceTestSuite) TestItCanNameRoundingModes() {
+	for _, mode := range []RoundingMode{RoundHalfUp, RoundHalfEven, RoundDown, RoundUp} {
+		parsed, err := ParseRoundingMode(mode.String())
+		s.NoError(err)
+		s.Equal(mode, parsed)
+	}
+
+	s.Equal("half-even", RoundHalfEven.String())
+	s.Equal("RoundingMode(9)", RoundingMode(9).String())
+
+	_, err := ParseRoundingMode("sideways")
+	s.ErrorIs(err, ErrInvalidRoundingMode)
+}
@@ -0,0 +1,326 @@
+package finance
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+var (
+	ErrEmptyVATNumber = domain.NewLocalizedError(
+		"finance.vat_number.empty", nil,
+		"VAT number cannot be empty",
+	)
+	ErrUnsupportedVATCountry = domain.NewLocalizedError(
+		"finance.vat_number.unsupported_country", nil,
+		"VAT number has an unsupported country prefix",
+	)
+	ErrInvalidVATNumberFormat = domain.NewLocalizedError(
+		"finance.vat_number.invalid_format", nil,
+		"VAT number has invalid format for its country",
+	)
+	ErrInvalidVATNumberChecksum = domain.NewLocalizedError(
+		"finance.vat_number.invalid_checksum", nil,
+		"VAT number has an invalid check digit",
+	)
+)
+
+// vatRule describes the format and optional check-digit algorithm of a country's VAT numbers
+type vatRule struct {
+	country  string
+	pattern  *regexp.Regexp
+	checksum func(number string) bool
+}
+
+// vatRules is keyed by VAT prefix. Greece uses "EL" and Northern Ireland "XI", which is why
+// the prefix and the ISO country code may differ.
+var vatRules = map[string]vatRule{
+	"AT":  {"AT", regexp.MustCompile(`^U\d{8}$`), vatChecksumAT},
+	"BE":  {"BE", regexp.MustCompile(`^[01]\d{9}$`), vatChecksumBE},
+	"BG":  {"BG", regexp.MustCompile(`^\d{9,10}$`), nil},
+	"CY":  {"CY", regexp.MustCompile(`^\d{8}[A-Z]$`), nil},
+	"CZ":  {"CZ", regexp.MustCompile(`^\d{8,10}$`), nil},
+	"DE":  {"DE", regexp.MustCompile(`^\d{9}$`), vatChecksumMod1110},
+	"DK":  {"DK", regexp.MustCompile(`^\d{8}$`), vatChecksumDK},
+	"EE":  {"EE", regexp.MustCompile(`^\d{9}$`), nil},
+	"EL":  {"GR", regexp.MustCompile(`^\d{9}$`), nil},
+	"ES":  {"ES", regexp.MustCompile(`^[A-Z0-9]\d{7}[A-Z0-9]$`), nil},
+	"FI":  {"FI", regexp.MustCompile(`^\d{8}$`), vatChecksumFI},
+	"FR":  {"FR", regexp.MustCompile(`^[A-Z0-9]{2}\d{9}$`), vatChecksumFR},
+	"HR":  {"HR", regexp.MustCompile(`^\d{11}$`), vatChecksumMod1110},
+	"HU":  {"HU", regexp.MustCompile(`^\d{8}$`), nil},
+	"IE":  {"IE", regexp.MustCompile(`^(\d{7}[A-W][A-I]?|\d[A-Z+*]\d{5}[A-W])$`), nil},
+	"IT":  {"IT", regexp.MustCompile(`^\d{11}$`), isLuhnValid},
+	"LT":  {"LT", regexp.MustCompile(`^(\d{9}|\d{12})$`), nil},
+	"LU":  {"LU", regexp.MustCompile(`^\d{8}$`), vatChecksumLU},
+	"LV":  {"LV", regexp.MustCompile(`^\d{11}$`), nil},
+	"MT":  {"MT", regexp.MustCompile(`^\d{8}$`), nil},
+	"NL":  {"NL", regexp.MustCompile(`^\d{9}B\d{2}$`), nil},
+	"PL":  {"PL", regexp.MustCompile(`^\d{10}$`), vatChecksumPL},
+	"PT":  {"PT", regexp.MustCompile(`^\d{9}$`), vatChecksumPT},
+	"RO":  {"RO", regexp.MustCompile(`^[1-9]\d{1,9}$`), nil},
+	"SE":  {"SE", regexp.MustCompile(`^\d{10}01$`), nil},
+	"SI":  {"SI", regexp.MustCompile(`^\d{8}$`), nil},
+	"SK":  {"SK", regexp.MustCompile(`^\d{10}$`), vatChecksumSK},
+	"GB":  {"GB", regexp.MustCompile(`^(\d{9}|\d{12}|GD\d{3}|HA\d{3})$`), nil},
+	"XI":  {"GB", regexp.MustCompile(`^(\d{9}|\d{12}|GD\d{3}|HA\d{3})$`), nil},
+	"CHE": {"CH", regexp.MustCompile(`^\d{9}$`), vatChecksumCH},
+}
+
+// swissVATSuffixes are the language-specific suffixes allowed after a Swiss UID
+var swissVATSuffixes = []string{"MWST", "TVA", "IVA"}
+
+// VATNumberValidators holds extra rules run by NewVATNumber after its own validation
+var VATNumberValidators domain.Validators[VATNumber]
+
+// VATNumber represents a value-added tax identification number of an EU member state,
+// the United Kingdom or Switzerland, stored as prefix followed by the national number
+// (e.g., "DE136695976", "CHE116281710")
+type VATNumber struct {
+	value string
+}
+
+// NewVATNumber creates a new instance of VATNumber with validation and normalization
+func NewVATNumber(value string) (VATNumber, error) {
+	normalized, err := NormalizeVATNumber(value)
+	if err != nil {
+		return VATNumber{}, err
+	}
+
+	vatNumber := VATNumber{
+		value: normalized,
+	}
+	if err := VATNumberValidators.Validate(vatNumber); err != nil {
+		return VATNumber{}, err
+	}
+
+	return vatNumber, nil
+}
+
+// ReconstituteVATNumber creates a new VATNumber instance without validation or normalization
+func ReconstituteVATNumber(value string) VATNumber {
+	return VATNumber{
+		value: value,
+	}
+}
+
+// Value returns the VAT number value
+func (v VATNumber) Value() string {
+	return v.value
+}
+
+// Prefix returns the VAT prefix, e.g., "EL" for Greece or "CHE" for Switzerland
+func (v VATNumber) Prefix() string {
+	prefix, _ := splitVATNumber(v.value)
+	return prefix
+}
+
+// Number returns the national part of the VAT number, without the prefix
+func (v VATNumber) Number() string {
+	_, number := splitVATNumber(v.value)
+	return number
+}
+
+// CountryCode returns the ISO 3166-1 alpha-2 country of the VAT number, e.g., "GR" for "EL"
+func (v VATNumber) CountryCode() geography.CountryCode {
+	return geography.ReconstituteCountryCode(vatRules[v.Prefix()].country)
+}
+
+// Equals compares two VATNumber objects for equality
+func (v VATNumber) Equals(other VATNumber) bool {
+	return v.value == other.value
+}
+
+// String returns a string representation of the VAT number
+func (v VATNumber) String() string {
+	return v.value
+}
+
+// NormalizeVATNumber uppercases the VAT number and strips spaces, dots and dashes.
+// The optional Swiss MWST/TVA/IVA suffix is dropped.
+func NormalizeVATNumber(vatNumber string) (string, error) {
+	normalized := strings.Map(
+		func(r rune) rune {
+			switch r {
+			case ' ', '.', '-', '\t':
+				return -1
+			}
+			return r
+		},
+		strings.ToUpper(vatNumber),
+	)
+
+	if strings.HasPrefix(normalized, "CHE") {
+		for _, suffix := range swissVATSuffixes {
+			normalized = strings.TrimSuffix(normalized, suffix)
+		}
+	}
+
+	if err := IsValidVATNumber(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// IsValidVATNumber validates the prefix, the country-specific format and, where the
+// country publishes one, the check digit of a normalized VAT number
+func IsValidVATNumber(vatNumber string) error {
+	if vatNumber == "" {
+		return ErrEmptyVATNumber
+	}
+
+	prefix, number := splitVATNumber(vatNumber)
+	rule, ok := vatRules[prefix]
+	if !ok {
+		return ErrUnsupportedVATCountry
+	}
+
+	if !rule.pattern.MatchString(number) {
+		return ErrInvalidVATNumberFormat
+	}
+
+	if rule.checksum != nil && !rule.checksum(number) {
+		return ErrInvalidVATNumberChecksum
+	}
+
+	return nil
+}
+
+// splitVATNumber separates the prefix from the national number
+func splitVATNumber(vatNumber string) (string, string) {
+	if strings.HasPrefix(vatNumber, "CHE") {
+		return "CHE", vatNumber[3:]
+	}
+	if len(vatNumber) < 2 {
+		return vatNumber, ""
+	}
+	return vatNumber[:2], vatNumber[2:]
+}
+
+// digitAt returns the numeric value of the ASCII digit at position i
+func digitAt(number string, i int) int {
+	return int(number[i] - '0')
+}
+
+// weightedSum multiplies the leading digits of number by the given weights
+func weightedSum(number string, weights ...int) int {
+	sum := 0
+	for i, weight := range weights {
+		sum += digitAt(number, i) * weight
+	}
+	return sum
+}
+
+// mod11CheckDigit computes 11 - sum mod 11, mapping 11 to 0; 10 means no valid check digit
+func mod11CheckDigit(sum int) int {
+	check := 11 - sum%11
+	if check == 11 {
+		return 0
+	}
+	return check
+}
+
+func vatChecksumAT(number string) bool {
+	digits := number[1:]
+	sum := 0
+	for i := 0; i < 7; i++ {
+		product := digitAt(digits, i) * (1 + i%2)
+		sum += product/10 + product%10
+	}
+	return (10-(sum+4)%10)%10 == digitAt(digits, 7)
+}
+
+func vatChecksumBE(number string) bool {
+	base := parseDigits(number[:8])
+	return 97-base%97 == parseDigits(number[8:])
+}
+
+func vatChecksumDK(number string) bool {
+	return weightedSum(number, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+func vatChecksumFI(number string) bool {
+	check := mod11CheckDigit(weightedSum(number, 7, 9, 10, 5, 8, 4, 2))
+	return check != 10 && check == digitAt(number, 7)
+}
+
+// vatChecksumFR validates the numeric key; alphanumeric keys (new-style) are format-checked only
+func vatChecksumFR(number string) bool {
+	key := number[:2]
+	if key[0] < '0' || key[0] > '9' || key[1] < '0' || key[1] > '9' {
+		return true
+	}
+	siren := parseDigits(number[2:])
+	return (12+3*(siren%97))%97 == parseDigits(key)
+}
+
+// vatChecksumMod1110 implements ISO 7064 MOD 11,10 as used by Germany and Croatia
+func vatChecksumMod1110(number string) bool {
+	product := 10
+	for i := 0; i < len(number)-1; i++ {
+		sum := (digitAt(number, i) + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (2 * sum) % 11
+	}
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	return check == digitAt(number, len(number)-1)
+}
+
+// isLuhnValid reports whether a string of digits passes the Luhn (mod 10) check
+func isLuhnValid(number string) bool {
+	sum := 0
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := digitAt(number, i)
+		if (len(number)-i)%2 == 0 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+func vatChecksumLU(number string) bool {
+	return parseDigits(number[:6])%89 == parseDigits(number[6:])
+}
+
+func vatChecksumPL(number string) bool {
+	check := weightedSum(number, 6, 5, 7, 2, 3, 4, 5, 6, 7) % 11
+	return check != 10 && check == digitAt(number, 9)
+}
+
+func vatChecksumPT(number string) bool {
+	check := 11 - weightedSum(number, 9, 8, 7, 6, 5, 4, 3, 2)%11
+	if check >= 10 {
+		check = 0
+	}
+	return check == digitAt(number, 8)
+}
+
+func vatChecksumSK(number string) bool {
+	return parseDigits(number)%11 == 0
+}
+
+func vatChecksumCH(number string) bool {
+	check := mod11CheckDigit(weightedSum(number, 5, 4, 3, 2, 7, 6, 5, 4))
+	return check != 10 && check == digitAt(number, 8)
+}
+
+// parseDigits converts a string of ASCII digits to an integer
+func parseDigits(digits string) int {
+	value := 0
+	for i := 0; i < len(digits); i++ {
+		value = value*10 + digitAt(digits, i)
+	}
+	return value
+}
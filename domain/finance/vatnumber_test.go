@@ -0,0 +1,107 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type VATNumberTestSuite struct {
+	suite.Suite
+}
+
+func TestVATNumberSuite(t *testing.T) {
+	suite.Run(t, new(VATNumberTestSuite))
+}
+
+func (s *VATNumberTestSuite) TestItCanBuildNewVATNumberWithValidValues() {
+	testCases := []struct {
+		name            string
+		input           string
+		expected        string
+		expectedCountry string
+	}{
+		{"Austria", "ATU13585627", "ATU13585627", "AT"},
+		{"Belgium with dots", "BE 0411.905.847", "BE0411905847", "BE"},
+		{"Germany lowercase", "de136695976", "DE136695976", "DE"},
+		{"Denmark", "DK 13 58 56 28", "DK13585628", "DK"},
+		{"Finland", "FI20774740", "FI20774740", "FI"},
+		{"France numeric key", "FR40303265045", "FR40303265045", "FR"},
+		{"Croatia", "HR33392005961", "HR33392005961", "HR"},
+		{"Italy", "IT00743110157", "IT00743110157", "IT"},
+		{"Luxembourg", "LU15027442", "LU15027442", "LU"},
+		{"Poland with dashes", "PL856-734-62-15", "PL8567346215", "PL"},
+		{"Portugal", "PT501964843", "PT501964843", "PT"},
+		{"Slovakia", "SK2022749619", "SK2022749619", "SK"},
+		{"Greece uses EL prefix", "EL094259216", "EL094259216", "GR"},
+		{"Netherlands", "NL004495445B01", "NL004495445B01", "NL"},
+		{"Spain", "ESA28015865", "ESA28015865", "ES"},
+		{"Ireland", "IE6388047V", "IE6388047V", "IE"},
+		{"United Kingdom", "GB 980 7806 84", "GB980780684", "GB"},
+		{"Northern Ireland", "XI980780684", "XI980780684", "GB"},
+		{"Switzerland with suffix", "CHE-116.281.710 MWST", "CHE116281710", "CH"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				vatNumber, err := NewVATNumber(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, vatNumber.Value())
+				s.Equal(tc.expected, vatNumber.String())
+				s.Equal(tc.expectedCountry, vatNumber.CountryCode().Value())
+			},
+		)
+	}
+}
+
+func (s *VATNumberTestSuite) TestItFailsToBuildNewVATNumberFromInvalidValues() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{"Empty", "  ", ErrEmptyVATNumber},
+		{"Unknown prefix", "US123456789", ErrUnsupportedVATCountry},
+		{"Greece with GR prefix", "GR094259216", ErrUnsupportedVATCountry},
+		{"Single character", "D", ErrUnsupportedVATCountry},
+		{"Austria without U", "AT13585627", ErrInvalidVATNumberFormat},
+		{"Germany too short", "DE13669597", ErrInvalidVATNumberFormat},
+		{"Netherlands without B", "NL004495445001", ErrInvalidVATNumberFormat},
+		{"Austria bad check digit", "ATU13585626", ErrInvalidVATNumberChecksum},
+		{"Belgium bad check digits", "BE0411905848", ErrInvalidVATNumberChecksum},
+		{"Germany bad check digit", "DE136695977", ErrInvalidVATNumberChecksum},
+		{"France bad key", "FR41303265045", ErrInvalidVATNumberChecksum},
+		{"Italy bad check digit", "IT00743110158", ErrInvalidVATNumberChecksum},
+		{"Poland bad check digit", "PL8567346216", ErrInvalidVATNumberChecksum},
+		{"Switzerland bad check digit", "CHE116281711", ErrInvalidVATNumberChecksum},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewVATNumber(tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *VATNumberTestSuite) TestPrefixAndNumber() {
+	greek, _ := NewVATNumber("EL094259216")
+	s.Equal("EL", greek.Prefix())
+	s.Equal("094259216", greek.Number())
+
+	swiss, _ := NewVATNumber("CHE116281710")
+	s.Equal("CHE", swiss.Prefix())
+	s.Equal("116281710", swiss.Number())
+}
+
+func (s *VATNumberTestSuite) TestEqualsAndReconstitute() {
+	vatNumber1, _ := NewVATNumber("DE 136 695 976")
+	vatNumber2 := ReconstituteVATNumber("DE136695976")
+	vatNumber3 := ReconstituteVATNumber("ATU13585627")
+
+	s.True(vatNumber1.Equals(vatNumber2))
+	s.False(vatNumber1.Equals(vatNumber3))
+}
@@ -0,0 +1,445 @@
+package finance
+
+import (
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/shopspring/decimal"
+)
+
+// Amounts are encoded in YAML as decimal strings, so they never pass through floating point,
+// and zero values encode as null.
+
+// MarshalYAML encodes the currency code as a YAML string
+func (c Currency) MarshalYAML() (any, error) {
+	if c.value == "" {
+		return nil, nil
+	}
+
+	return c.value, nil
+}
+
+// UnmarshalYAML decodes the currency code from a YAML string, with validation
+func (c *Currency) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid currency code YAML")
+	}
+
+	parsed, err := NewCurrency(raw)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// moneyYAML is the YAML mapping representation of Money
+type moneyYAML struct {
+	Amount     string `yaml:"amount,omitempty"`
+	MinorUnits string `yaml:"minorUnits,omitempty"`
+	Currency   string `yaml:"currency"`
+}
+
+// MarshalYAML encodes the money as a YAML mapping with a decimal string amount,
+// e.g., {amount: "10.5", currency: USD}
+func (m Money) MarshalYAML() (any, error) {
+	if m.currency.value == "" {
+		return nil, nil
+	}
+
+	return moneyYAML{Amount: m.amount.String(), Currency: m.currency.value}, nil
+}
+
+// UnmarshalYAML decodes the money, with validation, from either a YAML string such as
+// "10.50 USD" or a mapping holding a currency and exactly one of amount or minorUnits
+func (m *Money) UnmarshalYAML(unmarshal func(any) error) error {
+	var text string
+	if err := unmarshal(&text); err == nil {
+		parsed, err := ParseMoney(text)
+		if err != nil {
+			return err
+		}
+
+		*m = parsed
+		return nil
+	}
+
+	var raw moneyYAML
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid money YAML")
+	}
+
+	if (raw.Amount == "") == (raw.MinorUnits == "") {
+		return ErrInvalidMoney
+	}
+
+	currency, err := NewCurrency(raw.Currency)
+	if err != nil {
+		return err
+	}
+
+	if raw.Amount == "" {
+		minorUnits, err := decimal.NewFromString(raw.MinorUnits)
+		if err != nil || !minorUnits.IsInteger() {
+			return domain.NewErrorWithWrap(ErrInvalidMoney, "invalid money minor units")
+		}
+		raw.Amount = minorUnits.Shift(-currency.MinorUnits()).String()
+	}
+
+	parsed, err := NewMoneyFromString(raw.Amount, currency.value)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// MarshalYAML encodes the bag as a YAML mapping of decimal strings keyed by currency code
+func (b MoneyBag) MarshalYAML() (any, error) {
+	encoded := make(map[string]string, len(b.amounts))
+	for code, amount := range b.amounts {
+		encoded[code] = amount.String()
+	}
+	return encoded, nil
+}
+
+// UnmarshalYAML decodes the bag from a YAML mapping of amounts keyed by currency code
+func (b *MoneyBag) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw map[string]string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid money bag YAML")
+	}
+
+	amounts := make([]Money, 0, len(raw))
+	for code, amount := range raw {
+		money, err := NewMoneyFromString(amount, code)
+		if err != nil {
+			return err
+		}
+		amounts = append(amounts, money)
+	}
+
+	parsed, err := NewMoneyBag(amounts...)
+	if err != nil {
+		return err
+	}
+
+	*b = parsed
+	return nil
+}
+
+// MarshalYAML encodes the interest rate as a decimal string holding the annual percentage
+func (r InterestRate) MarshalYAML() (any, error) {
+	return r.annualPercent.String(), nil
+}
+
+// UnmarshalYAML decodes the interest rate from a YAML number or string, with validation
+func (r *InterestRate) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid interest rate YAML")
+	}
+
+	parsed, err := NewInterestRateFromString(raw)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// MarshalYAML encodes the rounding mode by name, e.g., "half-even"
+func (m RoundingMode) MarshalYAML() (any, error) {
+	return m.String(), nil
+}
+
+// UnmarshalYAML decodes the rounding mode from its name
+func (m *RoundingMode) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid rounding mode YAML")
+	}
+
+	parsed, err := ParseRoundingMode(raw)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// roundingYAML is the YAML representation of Rounding
+type roundingYAML struct {
+	Places int32        `yaml:"places"`
+	Mode   RoundingMode `yaml:"mode"`
+}
+
+// MarshalYAML encodes the rounding policy as a YAML mapping, e.g., {places: 2, mode: half-up}
+func (r Rounding) MarshalYAML() (any, error) {
+	return roundingYAML{Places: r.places, Mode: r.mode}, nil
+}
+
+// UnmarshalYAML decodes the rounding policy from a YAML mapping, with validation
+func (r *Rounding) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw roundingYAML
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid rounding YAML")
+	}
+
+	parsed, err := NewRounding(raw.Places, raw.Mode)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// unitPriceYAML is the YAML representation of UnitPrice
+type unitPriceYAML struct {
+	Price    Money    `yaml:"price"`
+	Rounding Rounding `yaml:"rounding"`
+}
+
+// MarshalYAML encodes the unit price as a YAML mapping of its price and rounding policy
+func (p UnitPrice) MarshalYAML() (any, error) {
+	if p.price.currency.value == "" {
+		return nil, nil
+	}
+
+	return unitPriceYAML{Price: p.price, Rounding: p.rounding}, nil
+}
+
+// UnmarshalYAML decodes the unit price from a YAML mapping, with validation
+func (p *UnitPrice) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw unitPriceYAML
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid unit price YAML")
+	}
+
+	parsed, err := NewUnitPrice(raw.Price, raw.Rounding)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// lineTotalYAML is the YAML representation of LineTotal
+type lineTotalYAML struct {
+	Net   Money `yaml:"net"`
+	Tax   Money `yaml:"tax"`
+	Gross Money `yaml:"gross"`
+}
+
+// MarshalYAML encodes the line total as a YAML mapping of its net, tax and gross amounts
+func (l LineTotal) MarshalYAML() (any, error) {
+	if l.net.currency.value == "" {
+		return nil, nil
+	}
+
+	return lineTotalYAML{Net: l.net, Tax: l.tax, Gross: l.gross}, nil
+}
+
+// UnmarshalYAML decodes the line total from a YAML mapping, with validation. The gross amount
+// is always derived from the net and tax amounts.
+func (l *LineTotal) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw lineTotalYAML
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid line total YAML")
+	}
+
+	parsed, err := NewLineTotal(raw.Net, raw.Tax)
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+	return nil
+}
+
+// MarshalYAML encodes the VAT number as a YAML string
+func (v VATNumber) MarshalYAML() (any, error) {
+	if v.value == "" {
+		return nil, nil
+	}
+
+	return v.value, nil
+}
+
+// UnmarshalYAML decodes the VAT number from a YAML string, with validation
+func (v *VATNumber) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid VAT number YAML")
+	}
+
+	parsed, err := NewVATNumber(raw)
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+	return nil
+}
+
+// MarshalYAML encodes the ISIN as a YAML string
+func (i ISIN) MarshalYAML() (any, error) {
+	if i.value == "" {
+		return nil, nil
+	}
+
+	return i.value, nil
+}
+
+// UnmarshalYAML decodes the ISIN from a YAML string, with validation
+func (i *ISIN) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid ISIN YAML")
+	}
+
+	parsed, err := NewISIN(raw)
+	if err != nil {
+		return err
+	}
+
+	*i = parsed
+	return nil
+}
+
+// MarshalYAML encodes the CUSIP as a YAML string
+func (c CUSIP) MarshalYAML() (any, error) {
+	if c.value == "" {
+		return nil, nil
+	}
+
+	return c.value, nil
+}
+
+// UnmarshalYAML decodes the CUSIP from a YAML string, with validation
+func (c *CUSIP) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid CUSIP YAML")
+	}
+
+	parsed, err := NewCUSIP(raw)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// MarshalYAML encodes the account number as its masked string, like MarshalJSON
+func (a AccountNumber) MarshalYAML() (any, error) {
+	if a.value == "" {
+		return nil, nil
+	}
+
+	return a.Masked(), nil
+}
+
+// UnmarshalYAML decodes a full account number from a YAML string, with validation
+func (a *AccountNumber) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid account number YAML")
+	}
+
+	parsed, err := NewAccountNumber(raw)
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
+
+// MarshalYAML encodes the routing number as a YAML string
+func (r RoutingNumber) MarshalYAML() (any, error) {
+	if r.value == "" {
+		return nil, nil
+	}
+
+	return r.value, nil
+}
+
+// UnmarshalYAML decodes the routing number from a YAML string, with validation
+func (r *RoutingNumber) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid routing number YAML")
+	}
+
+	parsed, err := NewRoutingNumber(raw)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+	return nil
+}
+
+// tinYAML is the YAML representation of TIN
+type tinYAML struct {
+	Country geography.CountryCode `yaml:"country"`
+	Value   string                `yaml:"value"`
+}
+
+// MarshalYAML encodes the tax identification number with a masked value, like MarshalJSON
+func (t TIN) MarshalYAML() (any, error) {
+	if t == (TIN{}) {
+		return nil, nil
+	}
+
+	return tinYAML{Country: t.country, Value: t.Masked()}, nil
+}
+
+// UnmarshalYAML decodes and validates a tax identification number given with its full value
+func (t *TIN) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw tinYAML
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid tax identification number YAML")
+	}
+
+	parsed, err := NewTIN(raw.Country, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// MarshalYAML encodes the card token as its masked string, like MarshalJSON
+func (t CardToken) MarshalYAML() (any, error) {
+	if t.value == "" {
+		return nil, nil
+	}
+
+	return t.Masked(), nil
+}
+
+// UnmarshalYAML decodes a full card token from a YAML string, with validation
+func (t *CardToken) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid card token YAML")
+	}
+
+	parsed, err := NewCardToken(raw)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,180 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLTestSuite struct {
+	suite.Suite
+}
+
+func TestYAMLSuite(t *testing.T) {
+	suite.Run(t, new(YAMLTestSuite))
+}
+
+type billingConfig struct {
+	Currency      Currency      `yaml:"currency"`
+	DailyLimit    Money         `yaml:"dailyLimit"`
+	MonthlyLimit  Money         `yaml:"monthlyLimit"`
+	Fee           Money         `yaml:"fee"`
+	Reserves      MoneyBag      `yaml:"reserves"`
+	Rounding      Rounding      `yaml:"rounding"`
+	UnitPrice     UnitPrice     `yaml:"unitPrice"`
+	InterestRate  InterestRate  `yaml:"interestRate"`
+	VATNumber     VATNumber     `yaml:"vatNumber"`
+	ISIN          ISIN          `yaml:"isin"`
+	CUSIP         CUSIP         `yaml:"cusip"`
+	AccountNumber AccountNumber `yaml:"accountNumber"`
+	RoutingNumber RoutingNumber `yaml:"routingNumber"`
+}
+
+func (s *YAMLTestSuite) TestItCanBindValueObjectsFromYAML() {
+	document := `
+currency: eur
+dailyLimit: 1000.00 EUR
+monthlyLimit:
+  amount: 25000.50
+  currency: EUR
+fee:
+  minorUnits: 1050
+  currency: USD
+reserves:
+  EUR: "5"
+  USD: 10.5
+rounding:
+  places: 2
+  mode: half-even
+unitPrice:
+  price: 0.3333 EUR
+  rounding: {places: 2, mode: up}
+interestRate: 4.25
+vatNumber: DE136695976
+isin: US0378331005
+cusip: "037833100"
+accountNumber: 1234-5678-9
+routingNumber: "011000015"
+`
+
+	var config billingConfig
+	s.Require().NoError(yaml.Unmarshal([]byte(document), &config))
+
+	s.Equal("EUR", config.Currency.Value())
+	s.Equal("1000 EUR", config.DailyLimit.String())
+	s.Equal("25000.5 EUR", config.MonthlyLimit.String())
+	s.Equal("10.5 USD", config.Fee.String())
+	s.Equal("5 EUR, 10.5 USD", config.Reserves.String())
+	s.Equal(int32(2), config.Rounding.Places())
+	s.Equal(RoundHalfEven, config.Rounding.Mode())
+	s.Equal(RoundUp, config.UnitPrice.Rounding().Mode())
+	s.Equal("4.25", config.InterestRate.AnnualPercent().String())
+	s.Equal("DE136695976", config.VATNumber.Value())
+	s.Equal("US0378331005", config.ISIN.Value())
+	s.Equal("037833100", config.CUSIP.Value())
+	s.Equal("123456789", config.AccountNumber.Value())
+	s.True(config.RoutingNumber.IsABA())
+}
+
+func (s *YAMLTestSuite) TestItCanRoundTripValueObjectsThroughYAML() {
+	limit, _ := NewMoneyFromString("1000.50", "EUR")
+	rounding, _ := NewRounding(2, RoundDown)
+	unitPrice, _ := NewUnitPrice(limit, rounding)
+	reserves, _ := NewMoneyBag(limit)
+	original := billingConfig{
+		DailyLimit: limit,
+		Reserves:   reserves,
+		Rounding:   rounding,
+		UnitPrice:  unitPrice,
+	}
+
+	encoded, err := yaml.Marshal(original)
+	s.Require().NoError(err)
+	s.Contains(string(encoded), "dailyLimit:\n    amount: \"1000.5\"\n    currency: EUR\n")
+	s.Contains(string(encoded), "mode: down\n")
+
+	var decoded billingConfig
+	s.Require().NoError(yaml.Unmarshal(encoded, &decoded))
+	s.True(original.DailyLimit.Equals(decoded.DailyLimit))
+	s.True(original.Reserves.Equals(decoded.Reserves))
+	s.Equal(original.Rounding, decoded.Rounding)
+	s.True(original.UnitPrice.Equals(decoded.UnitPrice))
+}
+
+func (s *YAMLTestSuite) TestItCanRoundTripLineTotalsThroughYAML() {
+	net, _ := NewMoneyFromString("100", "EUR")
+	tax, _ := NewMoneyFromString("19", "EUR")
+	original, _ := NewLineTotal(net, tax)
+
+	encoded, err := yaml.Marshal(original)
+	s.Require().NoError(err)
+
+	var decoded LineTotal
+	s.Require().NoError(yaml.Unmarshal(encoded, &decoded))
+	s.True(original.Equals(decoded))
+	s.Equal("119 EUR", decoded.Gross().String())
+}
+
+func (s *YAMLTestSuite) TestItMasksAccountNumbersWhenMarshalingYAML() {
+	accountNumber, _ := NewAccountNumber("123456789")
+
+	encoded, err := yaml.Marshal(billingConfig{AccountNumber: accountNumber})
+	s.Require().NoError(err)
+	s.Contains(string(encoded), "accountNumber: •••••6789\n")
+}
+
+func (s *YAMLTestSuite) TestItMasksCardTokensWhenMarshalingYAML() {
+	token, _ := NewCardToken("cnon:card-nonce-ok")
+
+	encoded, err := yaml.Marshal(token)
+	s.Require().NoError(err)
+	s.Equal("cnon:•••••••••e-ok\n", string(encoded))
+
+	var decoded CardToken
+	s.Require().NoError(yaml.Unmarshal([]byte("cnon:card-nonce-ok"), &decoded))
+	s.True(token.Equals(decoded))
+}
+
+func (s *YAMLTestSuite) TestItMasksTINsWhenMarshalingYAML() {
+	tin, _ := NewTIN(geography.ReconstituteCountryCode("GB"), "1955839661")
+
+	encoded, err := yaml.Marshal(tin)
+	s.Require().NoError(err)
+	s.Equal("country: GB\nvalue: ••••••9661\n", string(encoded))
+
+	var decoded TIN
+	s.Require().NoError(yaml.Unmarshal([]byte("country: GB\nvalue: \"1955839661\"\n"), &decoded))
+	s.True(tin.Equals(decoded))
+}
+
+func (s *YAMLTestSuite) TestItValidatesWhenUnmarshalingYAML() {
+	testCases := []struct {
+		name     string
+		document string
+		expected error
+	}{
+		{"Invalid currency", "currency: EURO", ErrInvalidCurrency},
+		{"Money without currency", "dailyLimit: 1000", ErrInvalidMoney},
+		{"Negative money", "dailyLimit: -5 EUR", ErrNegativeAmount},
+		{
+			"Money with both amounts", "fee: {amount: 1, minorUnits: 100, currency: USD}",
+			ErrInvalidMoney,
+		},
+		{"Fractional minor units", "fee: {minorUnits: 10.5, currency: USD}", ErrInvalidMoney},
+		{"Unknown rounding mode", "rounding: {places: 2, mode: sideways}", ErrInvalidRoundingMode},
+		{"Negative rounding places", "rounding: {places: -1}", ErrNegativeRoundingPlaces},
+		{"Negative interest rate", "interestRate: -1", ErrNegativeInterestRate},
+		{"Invalid ISIN", "isin: US0378331006", ErrInvalidISINChecksum},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				var config billingConfig
+				s.ErrorIs(yaml.Unmarshal([]byte(tc.document), &config), tc.expected)
+			},
+		)
+	}
+}
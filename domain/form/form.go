@@ -0,0 +1,144 @@
+// Package form reads value objects from HTML form values and CSV records, and writes them back.
+//
+// Single-field value objects implement encoding.TextUnmarshaler and are read with Decode.
+// Multi-field value objects are spread over one key per part, nested under a prefix with a dot:
+//
+//	<input name="billing.firstName">
+//	<input name="billing.lastName">
+//
+//	name, err := form.DecodeFullName(request.PostForm, "billing")
+//
+// CSV rows go through the same helpers: FromCSV turns a record into url.Values keyed by the
+// header, and ToCSV writes url.Values back in header order. Missing or empty fields decode to
+// the zero value, so required fields are checked by the caller.
+package form
+
+import (
+	"encoding"
+	"net/url"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/person"
+)
+
+var ErrRecordLength = domain.NewError("CSV record must have one field per header column")
+
+// Key returns the key of a field nested under prefix, e.g., "billing.firstName"
+func Key(prefix, field string) string {
+	if prefix == "" {
+		return field
+	}
+	return prefix + "." + field
+}
+
+// Decode parses the value under key with the value object's UnmarshalText
+func Decode[T any, P interface {
+	*T
+	encoding.TextUnmarshaler
+}](values url.Values, key string) (T, error) {
+	var value T
+	if err := P(&value).UnmarshalText([]byte(values.Get(key))); err != nil {
+		return value, domain.NewErrorWithWrap(err, "invalid form field %q", key)
+	}
+	return value, nil
+}
+
+// EncodeFullName sets one key per part of the full name under prefix
+func EncodeFullName(values url.Values, prefix string, name person.FullName) {
+	values.Set(Key(prefix, "prefix"), name.Prefix())
+	values.Set(Key(prefix, "firstName"), name.FirstName())
+	values.Set(Key(prefix, "middleName"), name.MiddleName())
+	values.Set(Key(prefix, "lastName"), name.LastName())
+	values.Set(Key(prefix, "suffix"), name.Suffix())
+}
+
+// DecodeFullName reads the full name parts under prefix, validating every part. A form with
+// every part empty decodes to the zero value.
+func DecodeFullName(values url.Values, prefix string) (person.FullName, error) {
+	namePrefix := values.Get(Key(prefix, "prefix"))
+	firstName := values.Get(Key(prefix, "firstName"))
+	middleName := values.Get(Key(prefix, "middleName"))
+	lastName := values.Get(Key(prefix, "lastName"))
+	suffix := values.Get(Key(prefix, "suffix"))
+
+	if namePrefix+firstName+middleName+lastName+suffix == "" {
+		return person.FullName{}, nil
+	}
+
+	return person.NewFullNameWithAffixes(namePrefix, firstName, middleName, lastName, suffix)
+}
+
+// EncodeNationalID sets the country and the full (unmasked) value of the national ID under
+// prefix, so an edit form can be filled in again. Use Masked for display-only output.
+func EncodeNationalID(values url.Values, prefix string, id person.NationalID) {
+	values.Set(Key(prefix, "country"), id.Country().Value())
+	values.Set(Key(prefix, "value"), id.Value())
+}
+
+// DecodeNationalID reads the country and value of the national ID under prefix, with
+// validation. A form with both fields empty decodes to the zero value.
+func DecodeNationalID(values url.Values, prefix string) (person.NationalID, error) {
+	country := values.Get(Key(prefix, "country"))
+	value := values.Get(Key(prefix, "value"))
+
+	if country == "" && value == "" {
+		return person.NationalID{}, nil
+	}
+
+	countryCode, err := geography.NewCountryCode(country)
+	if err != nil {
+		return person.NationalID{}, err
+	}
+
+	return person.NewNationalID(countryCode, value)
+}
+
+// EncodeMoney sets the amount and the currency code of the money under prefix. The zero value
+// is written as empty fields.
+func EncodeMoney(values url.Values, prefix string, money finance.Money) {
+	if money.Equals(finance.Money{}) {
+		values.Set(Key(prefix, "amount"), "")
+		values.Set(Key(prefix, "currency"), "")
+		return
+	}
+
+	values.Set(Key(prefix, "amount"), money.Amount().String())
+	values.Set(Key(prefix, "currency"), money.Currency().Value())
+}
+
+// DecodeMoney reads the amount and the currency code of the money under prefix, with
+// validation. A form with both fields empty decodes to the zero value.
+func DecodeMoney(values url.Values, prefix string) (finance.Money, error) {
+	amount := values.Get(Key(prefix, "amount"))
+	currency := values.Get(Key(prefix, "currency"))
+
+	if amount == "" && currency == "" {
+		return finance.Money{}, nil
+	}
+
+	return finance.NewMoneyFromString(amount, currency)
+}
+
+// FromCSV returns the fields of a CSV record keyed by the matching header column
+func FromCSV(header, record []string) (url.Values, error) {
+	if len(header) != len(record) {
+		return nil, ErrRecordLength
+	}
+
+	values := make(url.Values, len(header))
+	for i, column := range header {
+		values.Set(column, record[i])
+	}
+	return values, nil
+}
+
+// ToCSV returns a CSV record holding the value of every header column, in header order
+func ToCSV(header []string, values url.Values) []string {
+	record := make([]string, len(header))
+	for i, column := range header {
+		record[i] = values.Get(column)
+	}
+	return record
+}
@@ -0,0 +1,127 @@
+package form
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type FormTestSuite struct {
+	suite.Suite
+}
+
+func TestFormSuite(t *testing.T) {
+	suite.Run(t, new(FormTestSuite))
+}
+
+func (s *FormTestSuite) TestItCanDecodeSingleFieldValueObjects() {
+	values := url.Values{"email": {"Jane@Example.com"}, "id": {"42"}}
+
+	email, err := Decode[web.Email](values, "email")
+	s.Require().NoError(err)
+	s.Equal("jane@example.com", email.Value())
+
+	id, err := Decode[identifier.IntIdentifier](values, "id")
+	s.Require().NoError(err)
+	s.Equal(uint64(42), id.Value())
+
+	missing, err := Decode[web.Email](values, "backupEmail")
+	s.NoError(err)
+	s.Equal(web.Email{}, missing)
+}
+
+func (s *FormTestSuite) TestItWrapsDecodeErrorsWithTheKey() {
+	_, err := Decode[web.Email](url.Values{"email": {"not-an-email"}}, "email")
+
+	s.ErrorIs(err, web.ErrMissingAtSymbol)
+	s.ErrorContains(err, `"email"`)
+}
+
+func (s *FormTestSuite) TestItCanRoundTripMultiFieldValueObjects() {
+	name, _ := person.NewFullNameWithAffixes("Dr.", "Jane", "", "Doe", "")
+	country, _ := geography.NewCountryCode("US")
+	nationalID, _ := person.NewNationalID(country, "123-45-6789")
+	total, _ := finance.NewMoneyFromString("19.99", "EUR")
+
+	values := url.Values{}
+	EncodeFullName(values, "billing", name)
+	EncodeNationalID(values, "billing.taxId", nationalID)
+	EncodeMoney(values, "", total)
+
+	s.Equal("Jane", values.Get("billing.firstName"))
+	s.Equal("US", values.Get("billing.taxId.country"))
+	s.Equal("19.99", values.Get("amount"))
+
+	decodedName, err := DecodeFullName(values, "billing")
+	s.Require().NoError(err)
+	s.True(name.Equals(decodedName))
+
+	decodedNationalID, err := DecodeNationalID(values, "billing.taxId")
+	s.Require().NoError(err)
+	s.True(nationalID.Equals(decodedNationalID))
+
+	decodedTotal, err := DecodeMoney(values, "")
+	s.Require().NoError(err)
+	s.True(total.Equals(decodedTotal))
+}
+
+func (s *FormTestSuite) TestItDecodesEmptyMultiFieldValueObjectsAsZero() {
+	values := url.Values{}
+	EncodeMoney(values, "discount", finance.Money{})
+
+	name, err := DecodeFullName(values, "billing")
+	s.NoError(err)
+	s.Equal(person.FullName{}, name)
+
+	money, err := DecodeMoney(values, "discount")
+	s.NoError(err)
+	s.True(money.Equals(finance.Money{}))
+}
+
+func (s *FormTestSuite) TestItValidatesMultiFieldValueObjects() {
+	_, err := DecodeMoney(url.Values{"amount": {"-5"}, "currency": {"USD"}}, "")
+	s.ErrorIs(err, finance.ErrNegativeAmount)
+
+	_, err = DecodeNationalID(url.Values{"value": {"123-45-6789"}}, "")
+	s.Error(err)
+}
+
+func (s *FormTestSuite) TestItCanReadAndWriteCSVRecords() {
+	header := []string{"email", "firstName", "lastName", "amount", "currency"}
+	input := "jane@example.com,Jane,Doe,10.50,USD\n"
+
+	record, err := csv.NewReader(strings.NewReader(input)).Read()
+	s.Require().NoError(err)
+
+	values, err := FromCSV(header, record)
+	s.Require().NoError(err)
+
+	name, err := DecodeFullName(values, "")
+	s.Require().NoError(err)
+	s.Equal("Jane Doe", name.String())
+
+	money, err := DecodeMoney(values, "")
+	s.Require().NoError(err)
+	s.Equal("10.5 USD", money.String())
+
+	var output bytes.Buffer
+	writer := csv.NewWriter(&output)
+	s.Require().NoError(writer.Write(ToCSV(header, values)))
+	writer.Flush()
+	s.Equal(input, output.String())
+}
+
+func (s *FormTestSuite) TestItRejectsRecordsWithTheWrongLength() {
+	_, err := FromCSV([]string{"email", "name"}, []string{"jane@example.com"})
+
+	s.ErrorIs(err, ErrRecordLength)
+}
@@ -0,0 +1,234 @@
+package geography
+
+import (
+	_ "embed"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyIATACode = domain.NewLocalizedError(
+		"geography.iata_code.empty", nil,
+		"IATA airport code cannot be empty",
+	)
+	ErrInvalidIATACode = domain.NewLocalizedError(
+		"geography.iata_code.invalid", nil,
+		"IATA airport code must be exactly 3 letters",
+	)
+	ErrEmptyICAOCode = domain.NewLocalizedError(
+		"geography.icao_code.empty", nil,
+		"ICAO airport code cannot be empty",
+	)
+	ErrInvalidICAOCode = domain.NewLocalizedError(
+		"geography.icao_code.invalid", nil,
+		"ICAO airport code must be a letter followed by 3 letters or digits",
+	)
+	ErrUnknownAirportCode = domain.NewLocalizedError(
+		"geography.airport_code.unknown", nil,
+		"airport code does not belong to a known airport",
+	)
+)
+
+var (
+	iataCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+	icaoCodeRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]{3}$`)
+)
+
+// embeddedAirports lists about 210 major commercial hubs as "IATA ICAO" lines. It is an
+// allowlist of busy airports, not a complete IATA or ICAO registry.
+//
+//go:embed data/airports.txt
+var embeddedAirports string
+
+var (
+	airportsMu sync.RWMutex
+	// iataToICAO and icaoToIATA hold the known airports, by either code
+	iataToICAO, icaoToIATA = parseAirports(embeddedAirports)
+)
+
+// RegisterAirport adds an airport to the known ones checked by WithMajorAirportsOnly, e.g., the
+// regional airports a travel or logistics application serves. It is safe for concurrent use.
+func RegisterAirport(iata IATACode, icao ICAOCode) {
+	airportsMu.Lock()
+	defer airportsMu.Unlock()
+	iataToICAO[iata.value] = icao.value
+	icaoToIATA[icao.value] = iata.value
+}
+
+// AirportCodeOption configures how airport codes are validated
+type AirportCodeOption func(*airportCodeOptions)
+
+type airportCodeOptions struct {
+	majorOnly bool
+}
+
+// WithMajorAirportsOnly restricts codes to an allowlist: the major hubs embedded in the package
+// and the airports added with RegisterAirport. Other well-formed codes, including those of
+// most regional airports, fail with ErrUnknownAirportCode, so register the airports the
+// application serves before using it.
+func WithMajorAirportsOnly() AirportCodeOption {
+	return func(o *airportCodeOptions) {
+		o.majorOnly = true
+	}
+}
+
+// IATACode is a 3-letter IATA airport code, such as "OTP" for Bucharest Henri Coandă, used on
+// tickets and baggage tags
+type IATACode struct {
+	value string
+}
+
+// NewIATACode creates a new instance of IATACode with validation and normalization
+func NewIATACode(value string, opts ...AirportCodeOption) (IATACode, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if err := isValidAirportCode(
+		normalized, iataCodeRegex, iataToICAO, ErrEmptyIATACode, ErrInvalidIATACode, opts,
+	); err != nil {
+		return IATACode{}, err
+	}
+
+	return IATACode{
+		value: normalized,
+	}, nil
+}
+
+// ReconstituteIATACode creates a new IATACode instance without validation
+func ReconstituteIATACode(value string) IATACode {
+	return IATACode{
+		value: value,
+	}
+}
+
+// Value returns the IATA code
+func (c IATACode) Value() string {
+	return c.value
+}
+
+// IsKnown reports whether the code belongs to a known airport
+func (c IATACode) IsKnown() bool {
+	_, known := c.ICAO()
+	return known
+}
+
+// ICAO returns the ICAO code of a known airport
+func (c IATACode) ICAO() (ICAOCode, bool) {
+	airportsMu.RLock()
+	defer airportsMu.RUnlock()
+	icao, known := iataToICAO[c.value]
+	return ICAOCode{value: icao}, known
+}
+
+// Equals compares two IATACode objects for equality
+func (c IATACode) Equals(other IATACode) bool {
+	return c.value == other.value
+}
+
+// String returns a string representation of the IATA code
+func (c IATACode) String() string {
+	return c.value
+}
+
+// ICAOCode is a 4-character ICAO airport code, such as "LROP" for Bucharest Henri Coandă,
+// used in flight plans. Its first letter identifies the region.
+type ICAOCode struct {
+	value string
+}
+
+// NewICAOCode creates a new instance of ICAOCode with validation and normalization
+func NewICAOCode(value string, opts ...AirportCodeOption) (ICAOCode, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if err := isValidAirportCode(
+		normalized, icaoCodeRegex, icaoToIATA, ErrEmptyICAOCode, ErrInvalidICAOCode, opts,
+	); err != nil {
+		return ICAOCode{}, err
+	}
+
+	return ICAOCode{
+		value: normalized,
+	}, nil
+}
+
+// ReconstituteICAOCode creates a new ICAOCode instance without validation
+func ReconstituteICAOCode(value string) ICAOCode {
+	return ICAOCode{
+		value: value,
+	}
+}
+
+// Value returns the ICAO code
+func (c ICAOCode) Value() string {
+	return c.value
+}
+
+// IsKnown reports whether the code belongs to a known airport
+func (c ICAOCode) IsKnown() bool {
+	_, known := c.IATA()
+	return known
+}
+
+// IATA returns the IATA code of a known airport
+func (c ICAOCode) IATA() (IATACode, bool) {
+	airportsMu.RLock()
+	defer airportsMu.RUnlock()
+	iata, known := icaoToIATA[c.value]
+	return IATACode{value: iata}, known
+}
+
+// Equals compares two ICAOCode objects for equality
+func (c ICAOCode) Equals(other ICAOCode) bool {
+	return c.value == other.value
+}
+
+// String returns a string representation of the ICAO code
+func (c ICAOCode) String() string {
+	return c.value
+}
+
+// isValidAirportCode validates a normalized code against its format and, in strict mode,
+// the known airports
+func isValidAirportCode(
+	code string,
+	format *regexp.Regexp,
+	known map[string]string,
+	errEmpty, errInvalid error,
+	opts []AirportCodeOption,
+) error {
+	var config airportCodeOptions
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if code == "" {
+		return errEmpty
+	}
+	if !format.MatchString(code) {
+		return errInvalid
+	}
+
+	if config.majorOnly {
+		airportsMu.RLock()
+		defer airportsMu.RUnlock()
+		if _, found := known[code]; !found {
+			return ErrUnknownAirportCode
+		}
+	}
+	return nil
+}
+
+// parseAirports reads "IATA ICAO" lines, skipping blank lines and '#' comments
+func parseAirports(data string) (map[string]string, map[string]string) {
+	iataToICAO := make(map[string]string)
+	icaoToIATA := make(map[string]string)
+	for line := range strings.Lines(data) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		iataToICAO[fields[0]] = fields[1]
+		icaoToIATA[fields[1]] = fields[0]
+	}
+	return iataToICAO, icaoToIATA
+}
@@ -0,0 +1,161 @@
+package geography
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AirportCodeTestSuite struct {
+	suite.Suite
+}
+
+func TestAirportCodeSuite(t *testing.T) {
+	suite.Run(t, new(AirportCodeTestSuite))
+}
+
+func (s *AirportCodeTestSuite) TestItCanBuildValidIATACodes() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"uppercase", "OTP", "OTP"},
+		{"lowercase", "jfk", "JFK"},
+		{"whitespace", "  lhr ", "LHR"},
+		{"unlisted airport", "XYZ", "XYZ"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			code, err := NewIATACode(tc.input)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, code.Value())
+			s.Equal(tc.expected, code.String())
+		})
+	}
+}
+
+func (s *AirportCodeTestSuite) TestItFailsToBuildInvalidIATACodes() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{"empty", "", ErrEmptyIATACode},
+		{"whitespace only", "   ", ErrEmptyIATACode},
+		{"too short", "OT", ErrInvalidIATACode},
+		{"too long", "LROP", ErrInvalidIATACode},
+		{"digit", "OT1", ErrInvalidIATACode},
+		{"non-ASCII letter", "ÖTP", ErrInvalidIATACode},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := NewIATACode(tc.input)
+			s.ErrorIs(err, tc.expected)
+		})
+	}
+}
+
+func (s *AirportCodeTestSuite) TestItCanBuildValidICAOCodes() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"uppercase", "LROP", "LROP"},
+		{"lowercase", "kjfk", "KJFK"},
+		{"with digits", "K1G4", "K1G4"},
+		{"whitespace", " egll ", "EGLL"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			code, err := NewICAOCode(tc.input)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, code.Value())
+		})
+	}
+}
+
+func (s *AirportCodeTestSuite) TestItFailsToBuildInvalidICAOCodes() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{"empty", "", ErrEmptyICAOCode},
+		{"too short", "OTP", ErrInvalidICAOCode},
+		{"too long", "LROPX", ErrInvalidICAOCode},
+		{"leading digit", "1ROP", ErrInvalidICAOCode},
+		{"punctuation", "LR-P", ErrInvalidICAOCode},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := NewICAOCode(tc.input)
+			s.ErrorIs(err, tc.expected)
+		})
+	}
+}
+
+func (s *AirportCodeTestSuite) TestItRejectsAirportsMissingFromTheAllowlist() {
+	iata, err := NewIATACode("otp", WithMajorAirportsOnly())
+	s.Require().NoError(err)
+	s.Equal("OTP", iata.Value())
+
+	icao, err := NewICAOCode("kjfk", WithMajorAirportsOnly())
+	s.Require().NoError(err)
+	s.Equal("KJFK", icao.Value())
+
+	_, err = NewIATACode("QQQ", WithMajorAirportsOnly())
+	s.ErrorIs(err, ErrUnknownAirportCode)
+	_, err = NewICAOCode("QQQQ", WithMajorAirportsOnly())
+	s.ErrorIs(err, ErrUnknownAirportCode)
+
+	_, err = NewIATACode("Q1", WithMajorAirportsOnly())
+	s.ErrorIs(err, ErrInvalidIATACode)
+}
+
+func (s *AirportCodeTestSuite) TestItCanTranslateBetweenIATAAndICAO() {
+	icao, known := ReconstituteIATACode("OTP").ICAO()
+	s.True(known)
+	s.Equal("LROP", icao.Value())
+
+	iata, known := ReconstituteICAOCode("KJFK").IATA()
+	s.True(known)
+	s.Equal("JFK", iata.Value())
+
+	_, known = ReconstituteIATACode("QQQ").ICAO()
+	s.False(known)
+	s.False(ReconstituteICAOCode("QQQQ").IsKnown())
+	s.True(ReconstituteIATACode("JFK").IsKnown())
+}
+
+func (s *AirportCodeTestSuite) TestItCanRegisterAdditionalAirports() {
+	_, err := NewIATACode("ZZX", WithMajorAirportsOnly())
+	s.Require().ErrorIs(err, ErrUnknownAirportCode)
+
+	RegisterAirport(ReconstituteIATACode("ZZX"), ReconstituteICAOCode("ZZZX"))
+
+	iata, err := NewIATACode("zzx", WithMajorAirportsOnly())
+	s.Require().NoError(err)
+	icao, known := iata.ICAO()
+	s.True(known)
+	s.Equal("ZZZX", icao.Value())
+	s.True(ReconstituteICAOCode("ZZZX").IsKnown())
+}
+
+func (s *AirportCodeTestSuite) TestItCanCheckEquality() {
+	s.True(ReconstituteIATACode("OTP").Equals(ReconstituteIATACode("OTP")))
+	s.False(ReconstituteIATACode("OTP").Equals(ReconstituteIATACode("CLJ")))
+	s.True(ReconstituteICAOCode("LROP").Equals(ReconstituteICAOCode("LROP")))
+	s.False(ReconstituteICAOCode("LROP").Equals(ReconstituteICAOCode("LRCL")))
+}
+
+func (s *AirportCodeTestSuite) TestItSkipsCommentsWhenParsingTheAirportList() {
+	iataToICAO, icaoToIATA := parseAirports("# header\n\nOTP LROP\nmalformed\n")
+	s.Equal(map[string]string{"OTP": "LROP"}, iataToICAO)
+	s.Equal(map[string]string{"LROP": "OTP"}, icaoToIATA)
+}
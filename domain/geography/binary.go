@@ -0,0 +1,23 @@
+package geography
+
+// MarshalBinary encodes the country code as its canonical string, so it can be cached or
+// sent over gob-based RPC
+func (c CountryCode) MarshalBinary() ([]byte, error) {
+	return []byte(c.value), nil
+}
+
+// UnmarshalBinary decodes the country code, with validation. Empty data leaves the value
+// unchanged, mirroring how JSON decoders treat null.
+func (c *CountryCode) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewCountryCode(string(data))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,35 @@
+package geography
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BinaryTestSuite struct {
+	suite.Suite
+}
+
+func TestBinarySuite(t *testing.T) {
+	suite.Run(t, new(BinaryTestSuite))
+}
+
+func (s *BinaryTestSuite) TestItCanRoundTripCountryCodesThroughGob() {
+	original, _ := NewCountryCode("ro")
+
+	var buffer bytes.Buffer
+	s.Require().NoError(gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded CountryCode
+	s.Require().NoError(gob.NewDecoder(&buffer).Decode(&decoded))
+	s.True(original.Equals(decoded))
+}
+
+func (s *BinaryTestSuite) TestItValidatesWhenUnmarshalingBinary() {
+	var code CountryCode
+	s.ErrorIs(code.UnmarshalBinary([]byte("ROU")), ErrInvalidCountryCode)
+	s.NoError(code.UnmarshalBinary(nil))
+	s.Equal(CountryCode{}, code)
+}
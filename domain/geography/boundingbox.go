@@ -0,0 +1,177 @@
+package geography
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrInvalidBoundingBox = domain.NewLocalizedError(
+		"geography.bounding_box.invalid", nil,
+		"bounding box south edge cannot be north of its north edge",
+	)
+	ErrNegativeBoundingBoxDistance = domain.NewError("bounding box distance cannot be negative")
+)
+
+// BoundingBox is the area between two parallels and two meridians, such as a map viewport.
+// Its western edge may be east of its eastern edge, for boxes crossing the antimeridian, e.g.,
+// Fiji from 177°E to 178°W.
+type BoundingBox struct {
+	southWest Coordinates
+	northEast Coordinates
+}
+
+// NewBoundingBox creates a new instance of BoundingBox from its south-west and north-east
+// corners, with validation
+func NewBoundingBox(southWest, northEast Coordinates) (BoundingBox, error) {
+	if southWest.latitude > northEast.latitude {
+		return BoundingBox{}, ErrInvalidBoundingBox
+	}
+
+	return BoundingBox{
+		southWest: southWest,
+		northEast: northEast,
+	}, nil
+}
+
+// ReconstituteBoundingBox creates a new BoundingBox instance without validation
+func ReconstituteBoundingBox(southWest, northEast Coordinates) BoundingBox {
+	return BoundingBox{
+		southWest: southWest,
+		northEast: northEast,
+	}
+}
+
+// SouthWest returns the south-west corner
+func (b BoundingBox) SouthWest() Coordinates {
+	return b.southWest
+}
+
+// NorthEast returns the north-east corner
+func (b BoundingBox) NorthEast() Coordinates {
+	return b.northEast
+}
+
+// CrossesAntimeridian reports whether the box spans the 180th meridian
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.southWest.longitude > b.northEast.longitude
+}
+
+// Contains reports whether the point lies inside the box or on its edges
+func (b BoundingBox) Contains(point Coordinates) bool {
+	if point.latitude < b.southWest.latitude || point.latitude > b.northEast.latitude {
+		return false
+	}
+
+	west, east := b.southWest.longitude, b.northEast.longitude
+	if b.CrossesAntimeridian() {
+		return point.longitude >= west || point.longitude <= east
+	}
+	return point.longitude >= west && point.longitude <= east
+}
+
+// Center returns the point halfway between the edges, on the correct side of the
+// antimeridian for boxes crossing it
+func (b BoundingBox) Center() Coordinates {
+	latitude := (b.southWest.latitude + b.northEast.latitude) / 2
+	longitude := b.southWest.longitude + b.longitudeSpan()/2
+	if longitude > 180 {
+		longitude -= 360
+	}
+	return Coordinates{latitude: latitude, longitude: longitude}
+}
+
+// Expand returns the box grown by at least the distance in meters on every side, e.g., to
+// search around a viewport. Latitudes stop at the poles, and boxes spanning every longitude,
+// or reaching a pole, cover -180 to 180.
+func (b BoundingBox) Expand(meters float64) (BoundingBox, error) {
+	if meters < 0 || math.IsNaN(meters) {
+		return BoundingBox{}, ErrNegativeBoundingBoxDistance
+	}
+
+	delta := meters / EarthRadiusMeters * 180 / math.Pi
+	south := max(b.southWest.latitude-delta, -90)
+	north := min(b.northEast.latitude+delta, 90)
+
+	// Meridians converge towards the poles, so the widest longitude delta is needed at the
+	// latitude of the expanded box closest to a pole
+	longitudeDelta := delta / math.Cos(radians(max(math.Abs(south), math.Abs(north))))
+	if south == -90 || north == 90 || b.longitudeSpan()+2*longitudeDelta >= 360 {
+		return BoundingBox{
+			southWest: Coordinates{latitude: south, longitude: -180},
+			northEast: Coordinates{latitude: north, longitude: 180},
+		}, nil
+	}
+
+	west := wrapLongitude(b.southWest.longitude - longitudeDelta)
+	east := wrapLongitude(b.northEast.longitude + longitudeDelta)
+	return BoundingBox{
+		southWest: Coordinates{latitude: south, longitude: west},
+		northEast: Coordinates{latitude: north, longitude: east},
+	}, nil
+}
+
+// Equals compares two BoundingBox objects for equality
+func (b BoundingBox) Equals(other BoundingBox) bool {
+	return b.southWest.Equals(other.southWest) && b.northEast.Equals(other.northEast)
+}
+
+// String returns the corners as "south-west latitude,longitude;north-east latitude,longitude"
+func (b BoundingBox) String() string {
+	return b.southWest.String() + ";" + b.northEast.String()
+}
+
+// boundingBoxJSON is the JSON representation of BoundingBox
+type boundingBoxJSON struct {
+	SouthWest Coordinates `json:"southWest"`
+	NorthEast Coordinates `json:"northEast"`
+}
+
+// MarshalJSON encodes the box as its south-west and north-east corners
+func (b BoundingBox) MarshalJSON() ([]byte, error) {
+	return json.Marshal(boundingBoxJSON{SouthWest: b.southWest, NorthEast: b.northEast})
+}
+
+// UnmarshalJSON decodes and validates the box
+func (b *BoundingBox) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw boundingBoxJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid bounding box JSON")
+	}
+
+	parsed, err := NewBoundingBox(raw.SouthWest, raw.NorthEast)
+	if err != nil {
+		return err
+	}
+
+	*b = parsed
+	return nil
+}
+
+// longitudeSpan returns the degrees of longitude the box covers, from west to east
+func (b BoundingBox) longitudeSpan() float64 {
+	span := b.northEast.longitude - b.southWest.longitude
+	if span < 0 {
+		span += 360
+	}
+	return span
+}
+
+// wrapLongitude brings a longitude back within -180 and 180
+func wrapLongitude(longitude float64) float64 {
+	switch {
+	case longitude > 180:
+		return longitude - 360
+	case longitude < -180:
+		return longitude + 360
+	default:
+		return longitude
+	}
+}
@@ -0,0 +1,163 @@
+package geography
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BoundingBoxTestSuite struct {
+	suite.Suite
+}
+
+func TestBoundingBoxSuite(t *testing.T) {
+	suite.Run(t, new(BoundingBoxTestSuite))
+}
+
+func (s *BoundingBoxTestSuite) box(south, west, north, east float64) BoundingBox {
+	southWest, err := NewCoordinates(south, west)
+	s.Require().NoError(err)
+	northEast, err := NewCoordinates(north, east)
+	s.Require().NoError(err)
+	box, err := NewBoundingBox(southWest, northEast)
+	s.Require().NoError(err)
+	return box
+}
+
+func (s *BoundingBoxTestSuite) point(latitude, longitude float64) Coordinates {
+	point, err := NewCoordinates(latitude, longitude)
+	s.Require().NoError(err)
+	return point
+}
+
+func (s *BoundingBoxTestSuite) TestItCanBuildNewBoundingBox() {
+	romania := s.box(43.6, 20.2, 48.3, 29.7)
+
+	s.Equal(43.6, romania.SouthWest().Latitude())
+	s.Equal(29.7, romania.NorthEast().Longitude())
+	s.False(romania.CrossesAntimeridian())
+	s.Equal("43.6,20.2;48.3,29.7", romania.String())
+
+	fiji := s.box(-21, 177, -12, -178)
+	s.True(fiji.CrossesAntimeridian())
+}
+
+func (s *BoundingBoxTestSuite) TestItFailsToBuildBoundingBoxWithSouthAboveNorth() {
+	_, err := NewBoundingBox(s.point(48.3, 20.2), s.point(43.6, 29.7))
+	s.ErrorIs(err, ErrInvalidBoundingBox)
+}
+
+func (s *BoundingBoxTestSuite) TestItChecksWhetherItContainsPoints() {
+	romania := s.box(43.6, 20.2, 48.3, 29.7)
+	s.True(romania.Contains(s.point(44.4268, 26.1025)))
+	s.True(romania.Contains(s.point(43.6, 20.2)))
+	s.False(romania.Contains(s.point(48.8566, 2.3522)))
+	s.False(romania.Contains(s.point(44, 30)))
+
+	fiji := s.box(-21, 177, -12, -178)
+	s.True(fiji.Contains(s.point(-18, 178.4)))
+	s.True(fiji.Contains(s.point(-16, -179.9)))
+	s.True(fiji.Contains(s.point(-16, 180)))
+	s.False(fiji.Contains(s.point(-16, 0)))
+	s.False(fiji.Contains(s.point(-25, 178)))
+}
+
+func (s *BoundingBoxTestSuite) TestItComputesItsCenter() {
+	center := s.box(40, 20, 50, 30).Center()
+	s.Equal(45.0, center.Latitude())
+	s.Equal(25.0, center.Longitude())
+
+	center = s.box(-20, 170, -10, -160).Center()
+	s.Equal(-15.0, center.Latitude())
+	s.Equal(-175.0, center.Longitude())
+
+	center = s.box(-20, 176, -10, -174).Center()
+	s.InDelta(-179.0, center.Longitude(), 1e-9)
+}
+
+func (s *BoundingBoxTestSuite) TestItCanExpandByADistance() {
+	box := s.box(0, 0, 0, 0)
+	expanded, err := box.Expand(111_195)
+	s.Require().NoError(err)
+	s.InDelta(-1.0, expanded.SouthWest().Latitude(), 1e-3)
+	s.InDelta(1.0, expanded.NorthEast().Latitude(), 1e-3)
+	s.InDelta(-1.0, expanded.SouthWest().Longitude(), 1e-3)
+	s.InDelta(1.0, expanded.NorthEast().Longitude(), 1e-3)
+
+	s.InDelta(111_195, s.point(0, 0).DistanceTo(s.point(expanded.NorthEast().Latitude(), 0)), 1)
+	s.True(expanded.Contains(s.point(0.5, -0.5)))
+
+	unchanged, err := box.Expand(0)
+	s.Require().NoError(err)
+	s.True(box.Equals(unchanged))
+}
+
+func (s *BoundingBoxTestSuite) TestItWidensLongitudesTowardsThePoles() {
+	expanded, err := s.box(60, 10, 60, 10).Expand(111_195)
+	s.Require().NoError(err)
+
+	// At 61° a degree of longitude is about half as long as at the equator
+	s.Greater(10-expanded.SouthWest().Longitude(), 2.0)
+	s.Less(10-expanded.SouthWest().Longitude(), 2.2)
+}
+
+func (s *BoundingBoxTestSuite) TestItWrapsExpandedBoxesAroundTheAntimeridian() {
+	expanded, err := s.box(0, 179.5, 1, 179.9).Expand(111_195)
+	s.Require().NoError(err)
+
+	s.True(expanded.CrossesAntimeridian())
+	s.InDelta(-179.1, expanded.NorthEast().Longitude(), 1e-2)
+	s.True(expanded.Contains(s.point(0.5, -179.5)))
+}
+
+func (s *BoundingBoxTestSuite) TestItCoversEveryLongitudeWhenReachingAPole() {
+	expanded, err := s.box(89, 10, 89.5, 20).Expand(200_000)
+	s.Require().NoError(err)
+
+	s.Equal(90.0, expanded.NorthEast().Latitude())
+	s.Equal(-180.0, expanded.SouthWest().Longitude())
+	s.Equal(180.0, expanded.NorthEast().Longitude())
+	s.True(expanded.Contains(s.point(89.9, -100)))
+
+	world, err := s.box(-10, -170, 10, 170).Expand(5_000_000)
+	s.Require().NoError(err)
+	s.Equal(-180.0, world.SouthWest().Longitude())
+	s.Equal(180.0, world.NorthEast().Longitude())
+}
+
+func (s *BoundingBoxTestSuite) TestItFailsToExpandByANegativeDistance() {
+	_, err := s.box(0, 0, 1, 1).Expand(-1)
+	s.ErrorIs(err, ErrNegativeBoundingBoxDistance)
+}
+
+func (s *BoundingBoxTestSuite) TestItCanRoundTripThroughJSON() {
+	box := s.box(-21, 177, -12, -178)
+
+	data, err := json.Marshal(box)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{"southWest":{"latitude":-21,"longitude":177},`+
+			`"northEast":{"latitude":-12,"longitude":-178}}`,
+		string(data),
+	)
+
+	var decoded BoundingBox
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(box.Equals(decoded))
+
+	s.NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(box.Equals(decoded))
+	s.ErrorIs(
+		json.Unmarshal(
+			[]byte(`{"southWest":{"latitude":10,"longitude":0},`+
+				`"northEast":{"latitude":0,"longitude":0}}`),
+			&decoded,
+		),
+		ErrInvalidBoundingBox,
+	)
+	s.ErrorIs(
+		json.Unmarshal([]byte(`{"southWest":{"latitude":-95,"longitude":0}}`), &decoded),
+		ErrInvalidLatitude,
+	)
+}
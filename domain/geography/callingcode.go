@@ -0,0 +1,118 @@
+package geography
+
+import "strings"
+
+// countryCallingCodes maps countries to their ITU-T E.164 calling codes. Bouvet Island,
+// Heard Island, the French Southern Territories and the US Minor Outlying Islands have none.
+var countryCallingCodes = map[string]string{
+	"AD": "376", "AE": "971", "AF": "93", "AG": "1", "AI": "1", "AL": "355", "AM": "374",
+	"AO": "244", "AQ": "672", "AR": "54", "AS": "1", "AT": "43", "AU": "61", "AW": "297",
+	"AX": "358", "AZ": "994", "BA": "387", "BB": "1", "BD": "880", "BE": "32", "BF": "226",
+	"BG": "359", "BH": "973", "BI": "257", "BJ": "229", "BL": "590", "BM": "1", "BN": "673",
+	"BO": "591", "BQ": "599", "BR": "55", "BS": "1", "BT": "975", "BW": "267", "BY": "375",
+	"BZ": "501", "CA": "1", "CC": "61", "CD": "243", "CF": "236", "CG": "242", "CH": "41",
+	"CI": "225", "CK": "682", "CL": "56", "CM": "237", "CN": "86", "CO": "57", "CR": "506",
+	"CU": "53", "CV": "238", "CW": "599", "CX": "61", "CY": "357", "CZ": "420", "DE": "49",
+	"DJ": "253", "DK": "45", "DM": "1", "DO": "1", "DZ": "213", "EC": "593", "EE": "372",
+	"EG": "20", "EH": "212", "ER": "291", "ES": "34", "ET": "251", "FI": "358", "FJ": "679",
+	"FK": "500", "FM": "691", "FO": "298", "FR": "33", "GA": "241", "GB": "44", "GD": "1",
+	"GE": "995", "GF": "594", "GG": "44", "GH": "233", "GI": "350", "GL": "299", "GM": "220",
+	"GN": "224", "GP": "590", "GQ": "240", "GR": "30", "GS": "500", "GT": "502", "GU": "1",
+	"GW": "245", "GY": "592", "HK": "852", "HN": "504", "HR": "385", "HT": "509", "HU": "36",
+	"ID": "62", "IE": "353", "IL": "972", "IM": "44", "IN": "91", "IO": "246", "IQ": "964",
+	"IR": "98", "IS": "354", "IT": "39", "JE": "44", "JM": "1", "JO": "962", "JP": "81",
+	"KE": "254", "KG": "996", "KH": "855", "KI": "686", "KM": "269", "KN": "1", "KP": "850",
+	"KR": "82", "KW": "965", "KY": "1", "KZ": "7", "LA": "856", "LB": "961", "LC": "1",
+	"LI": "423", "LK": "94", "LR": "231", "LS": "266", "LT": "370", "LU": "352", "LV": "371",
+	"LY": "218", "MA": "212", "MC": "377", "MD": "373", "ME": "382", "MF": "590", "MG": "261",
+	"MH": "692", "MK": "389", "ML": "223", "MM": "95", "MN": "976", "MO": "853", "MP": "1",
+	"MQ": "596", "MR": "222", "MS": "1", "MT": "356", "MU": "230", "MV": "960", "MW": "265",
+	"MX": "52", "MY": "60", "MZ": "258", "NA": "264", "NC": "687", "NE": "227", "NF": "672",
+	"NG": "234", "NI": "505", "NL": "31", "NO": "47", "NP": "977", "NR": "674", "NU": "683",
+	"NZ": "64", "OM": "968", "PA": "507", "PE": "51", "PF": "689", "PG": "675", "PH": "63",
+	"PK": "92", "PL": "48", "PM": "508", "PN": "64", "PR": "1", "PS": "970", "PT": "351",
+	"PW": "680", "PY": "595", "QA": "974", "RE": "262", "RO": "40", "RS": "381", "RU": "7",
+	"RW": "250", "SA": "966", "SB": "677", "SC": "248", "SD": "249", "SE": "46", "SG": "65",
+	"SH": "290", "SI": "386", "SJ": "47", "SK": "421", "SL": "232", "SM": "378", "SN": "221",
+	"SO": "252", "SR": "597", "SS": "211", "ST": "239", "SV": "503", "SX": "1", "SY": "963",
+	"SZ": "268", "TC": "1", "TD": "235", "TG": "228", "TH": "66", "TJ": "992", "TK": "690",
+	"TL": "670", "TM": "993", "TN": "216", "TO": "676", "TR": "90", "TT": "1", "TV": "688",
+	"TW": "886", "TZ": "255", "UA": "380", "UG": "256", "US": "1", "UY": "598", "UZ": "998",
+	"VA": "39", "VC": "1", "VE": "58", "VG": "1", "VI": "1", "VN": "84", "VU": "678",
+	"WF": "681", "WS": "685", "YE": "967", "YT": "262", "ZA": "27", "ZM": "260", "ZW": "263",
+}
+
+// callingCodeOwners maps calling codes shared by several countries to the country numbers
+// belong to when no longer prefix in callingNumberPrefixes tells them apart
+var callingCodeOwners = map[string]string{
+	"1": "US", "7": "RU", "39": "IT", "44": "GB", "47": "NO", "61": "AU", "64": "NZ",
+	"212": "MA", "262": "RE", "358": "FI", "500": "FK", "590": "GP", "599": "CW", "672": "NF",
+}
+
+// callingNumberPrefixes maps the leading digits of international numbers to the countries
+// sharing a calling code they identify: North American area codes outside the United States
+// and the Kazakh ranges of +7
+var callingNumberPrefixes = map[string]string{
+	"76": "KZ", "77": "KZ",
+
+	"1204": "CA", "1226": "CA", "1236": "CA", "1249": "CA", "1250": "CA", "1257": "CA",
+	"1263": "CA", "1289": "CA", "1306": "CA", "1343": "CA", "1354": "CA", "1365": "CA",
+	"1367": "CA", "1368": "CA", "1382": "CA", "1403": "CA", "1416": "CA", "1418": "CA",
+	"1428": "CA", "1431": "CA", "1437": "CA", "1438": "CA", "1450": "CA", "1460": "CA",
+	"1468": "CA", "1474": "CA", "1506": "CA", "1514": "CA", "1519": "CA", "1548": "CA",
+	"1579": "CA", "1581": "CA", "1584": "CA", "1587": "CA", "1604": "CA", "1613": "CA",
+	"1639": "CA", "1647": "CA", "1672": "CA", "1683": "CA", "1705": "CA", "1709": "CA",
+	"1742": "CA", "1753": "CA", "1778": "CA", "1780": "CA", "1782": "CA", "1807": "CA",
+	"1819": "CA", "1825": "CA", "1867": "CA", "1873": "CA", "1879": "CA", "1902": "CA",
+	"1905": "CA", "1942": "CA",
+
+	"1242": "BS", "1246": "BB", "1264": "AI", "1268": "AG", "1284": "VG", "1340": "VI",
+	"1345": "KY", "1441": "BM", "1473": "GD", "1649": "TC", "1658": "JM", "1664": "MS",
+	"1670": "MP", "1671": "GU", "1684": "AS", "1721": "SX", "1758": "LC", "1767": "DM",
+	"1784": "VC", "1787": "PR", "1809": "DO", "1829": "DO", "1849": "DO", "1868": "TT",
+	"1869": "KN", "1876": "JM", "1939": "PR",
+}
+
+// maxCallingNumberPrefixLength is the length of the longest key of callingNumberPrefixes
+const maxCallingNumberPrefixLength = 4
+
+// callingNumberCountries maps calling codes and the prefixes of callingNumberPrefixes to
+// the country numbers starting with them belong to
+var callingNumberCountries = func() map[string]string {
+	countries := make(map[string]string, len(countryCallingCodes))
+	for country, code := range countryCallingCodes {
+		if owner, shared := callingCodeOwners[code]; shared {
+			country = owner
+		}
+		countries[code] = country
+	}
+	for prefix, country := range callingNumberPrefixes {
+		countries[prefix] = country
+	}
+	return countries
+}()
+
+// CallingCode returns the international calling code of the country with its '+', e.g.,
+// "+40" for RO, to pre-fill dial code pickers. North American countries return "+1", their
+// area codes being part of the national number.
+func CallingCode(country CountryCode) (string, bool) {
+	code, found := countryCallingCodes[country.value]
+	if !found {
+		return "", false
+	}
+	return "+" + code, true
+}
+
+// CountryForInternationalNumber returns the country an international number in E.164 form,
+// such as "+40721234567", belongs to, by its calling code. Countries sharing a calling code
+// are told apart by area code for North America and by range for Kazakhstan; otherwise the
+// number is attributed to the main country of the code, e.g., GB for Jersey's +44.
+func CountryForInternationalNumber(number string) (CountryCode, bool) {
+	digits := strings.TrimPrefix(number, "+")
+	for length := min(maxCallingNumberPrefixLength, len(digits)); length > 0; length-- {
+		if country, found := callingNumberCountries[digits[:length]]; found {
+			return CountryCode{value: country}, true
+		}
+	}
+	return CountryCode{}, false
+}
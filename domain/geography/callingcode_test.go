@@ -0,0 +1,95 @@
+package geography
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CallingCodeTestSuite struct {
+	suite.Suite
+}
+
+func TestCallingCodeSuite(t *testing.T) {
+	suite.Run(t, new(CallingCodeTestSuite))
+}
+
+func (s *CallingCodeTestSuite) TestItReturnsTheCallingCodeOfCountries() {
+	testCases := []struct {
+		country  string
+		expected string
+		found    bool
+	}{
+		{"RO", "+40", true},
+		{"US", "+1", true},
+		{"JM", "+1", true},
+		{"KZ", "+7", true},
+		{"JE", "+44", true},
+		{"HM", "", false},
+		{"XX", "", false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.country, func() {
+				country, err := NewCountryCode(tc.country)
+				s.Require().NoError(err)
+
+				code, found := CallingCode(country)
+				s.Equal(tc.found, found)
+				s.Equal(tc.expected, code)
+			},
+		)
+	}
+}
+
+func (s *CallingCodeTestSuite) TestItInfersTheCountryOfInternationalNumbers() {
+	testCases := []struct {
+		number   string
+		expected string
+	}{
+		{"+40721234567", "RO"},
+		{"40721234567", "RO"},
+		{"+12125550100", "US"},
+		{"+14165550100", "CA"},
+		{"+18765550100", "JM"},
+		{"+17875550100", "PR"},
+		{"+74951234567", "RU"},
+		{"+77011234567", "KZ"},
+		{"+441534123456", "GB"},
+		{"+3726123456", "EE"},
+		{"+35312345678", "IE"},
+		{"+2626912345", "RE"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.number, func() {
+				country, found := CountryForInternationalNumber(tc.number)
+				s.True(found)
+				s.Equal(tc.expected, country.Value())
+			},
+		)
+	}
+
+	for _, number := range []string{"", "+", "+0123456", "+8001234"} {
+		_, found := CountryForInternationalNumber(number)
+		s.False(found, number)
+	}
+}
+
+func (s *CallingCodeTestSuite) TestItResolvesEverySharedCallingCode() {
+	for country, code := range countryCallingCodes {
+		owner, found := CountryForInternationalNumber(code)
+		s.True(found, country)
+
+		s.NotEqual(
+			ContinentUnknown, owner.Continent(),
+			"calling code %s of %s resolves to an unknown country", code, country,
+		)
+	}
+
+	for prefix, country := range callingNumberPrefixes {
+		s.Equal(countryCallingCodes[country], prefix[:len(countryCallingCodes[country])])
+	}
+}
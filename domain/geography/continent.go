@@ -0,0 +1,93 @@
+package geography
+
+// Continent is one of the seven continents, with the Americas split into North America,
+// including Central America and the Caribbean, and South America
+type Continent int
+
+const (
+	ContinentUnknown Continent = iota
+	Africa
+	Antarctica
+	Asia
+	Europe
+	NorthAmerica
+	Oceania
+	SouthAmerica
+)
+
+// String returns the name of the continent
+func (c Continent) String() string {
+	switch c {
+	case Africa:
+		return "Africa"
+	case Antarctica:
+		return "Antarctica"
+	case Asia:
+		return "Asia"
+	case Europe:
+		return "Europe"
+	case NorthAmerica:
+		return "North America"
+	case Oceania:
+		return "Oceania"
+	case SouthAmerica:
+		return "South America"
+	default:
+		return "Unknown"
+	}
+}
+
+// continentCountries lists the ISO 3166-1 countries and territories of each continent.
+// Transcontinental countries belong to the continent of their capital, so Russia is in
+// Europe while Turkey, Cyprus and the Caucasus are in Asia.
+var continentCountries = map[Continent][]string{
+	Africa: {
+		"AO", "BF", "BI", "BJ", "BW", "CD", "CF", "CG", "CI", "CM", "CV", "DJ", "DZ", "EG",
+		"EH", "ER", "ET", "GA", "GH", "GM", "GN", "GQ", "GW", "KE", "KM", "LR", "LS", "LY",
+		"MA", "MG", "ML", "MR", "MU", "MW", "MZ", "NA", "NE", "NG", "RE", "RW", "SC", "SD",
+		"SH", "SL", "SN", "SO", "SS", "ST", "SZ", "TD", "TG", "TN", "TZ", "UG", "YT", "ZA",
+		"ZM", "ZW",
+	},
+	Antarctica: {"AQ", "BV", "GS", "HM", "TF"},
+	Asia: {
+		"AE", "AF", "AM", "AZ", "BD", "BH", "BN", "BT", "CC", "CN", "CX", "CY", "GE", "HK",
+		"ID", "IL", "IN", "IO", "IQ", "IR", "JO", "JP", "KG", "KH", "KP", "KR", "KW", "KZ",
+		"LA", "LB", "LK", "MM", "MN", "MO", "MV", "MY", "NP", "OM", "PH", "PK", "PS", "QA",
+		"SA", "SG", "SY", "TH", "TJ", "TL", "TM", "TR", "TW", "UZ", "VN", "YE",
+	},
+	Europe: {
+		"AD", "AL", "AT", "AX", "BA", "BE", "BG", "BY", "CH", "CZ", "DE", "DK", "EE", "ES",
+		"FI", "FO", "FR", "GB", "GG", "GI", "GR", "HR", "HU", "IE", "IM", "IS", "IT", "JE",
+		"LI", "LT", "LU", "LV", "MC", "MD", "ME", "MK", "MT", "NL", "NO", "PL", "PT", "RO",
+		"RS", "RU", "SE", "SI", "SJ", "SK", "SM", "UA", "VA",
+	},
+	NorthAmerica: {
+		"AG", "AI", "AW", "BB", "BL", "BM", "BQ", "BS", "BZ", "CA", "CR", "CU", "CW", "DM",
+		"DO", "GD", "GL", "GP", "GT", "HN", "HT", "JM", "KN", "KY", "LC", "MF", "MQ", "MS",
+		"MX", "NI", "PA", "PM", "PR", "SV", "SX", "TC", "TT", "US", "VC", "VG", "VI",
+	},
+	Oceania: {
+		"AS", "AU", "CK", "FJ", "FM", "GU", "KI", "MH", "MP", "NC", "NF", "NR", "NU", "NZ",
+		"PF", "PG", "PN", "PW", "SB", "TK", "TO", "TV", "UM", "VU", "WF", "WS",
+	},
+	SouthAmerica: {
+		"AR", "BO", "BR", "CL", "CO", "EC", "FK", "GF", "GY", "PE", "PY", "SR", "UY", "VE",
+	},
+}
+
+// countryContinents maps each country code to its continent
+var countryContinents = func() map[string]Continent {
+	continents := make(map[string]Continent)
+	for continent, countries := range continentCountries {
+		for _, country := range countries {
+			continents[country] = continent
+		}
+	}
+	return continents
+}()
+
+// Continent returns the continent of the country, or ContinentUnknown for codes outside
+// ISO 3166-1
+func (c CountryCode) Continent() Continent {
+	return countryContinents[c.value]
+}
@@ -0,0 +1,59 @@
+package geography
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ContinentTestSuite struct {
+	suite.Suite
+}
+
+func TestContinentSuite(t *testing.T) {
+	suite.Run(t, new(ContinentTestSuite))
+}
+
+func (s *ContinentTestSuite) TestItReturnsTheContinentOfCountries() {
+	testCases := []struct {
+		country  string
+		expected Continent
+	}{
+		{"RO", Europe},
+		{"RU", Europe},
+		{"TR", Asia},
+		{"JP", Asia},
+		{"EG", Africa},
+		{"US", NorthAmerica},
+		{"PA", NorthAmerica},
+		{"BR", SouthAmerica},
+		{"AU", Oceania},
+		{"AQ", Antarctica},
+		{"XX", ContinentUnknown},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.country, func() {
+				country, err := NewCountryCode(tc.country)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, country.Continent())
+			},
+		)
+	}
+}
+
+func (s *ContinentTestSuite) TestItCoversEveryCountryOnce() {
+	total := 0
+	for _, countries := range continentCountries {
+		total += len(countries)
+	}
+	s.Equal(249, total)
+	s.Len(countryContinents, 249)
+}
+
+func (s *ContinentTestSuite) TestItNamesContinents() {
+	s.Equal("North America", NorthAmerica.String())
+	s.Equal("Europe", Europe.String())
+	s.Equal("Unknown", ContinentUnknown.String())
+}
@@ -0,0 +1,130 @@
+package geography
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// EarthRadiusMeters is the mean radius of the Earth used for distances, in meters
+const EarthRadiusMeters = 6371008.8
+
+var (
+	ErrInvalidLatitude = domain.NewLocalizedError(
+		"geography.coordinates.invalid_latitude", nil,
+		"latitude must be between -90 and 90 degrees",
+	)
+	ErrInvalidLongitude = domain.NewLocalizedError(
+		"geography.coordinates.invalid_longitude", nil,
+		"longitude must be between -180 and 180 degrees",
+	)
+)
+
+// Coordinates is a point on Earth in decimal degrees (WGS 84), such as 44.4268, 26.1025 for
+// Bucharest
+type Coordinates struct {
+	latitude  float64
+	longitude float64
+}
+
+// NewCoordinates creates a new instance of Coordinates with validation
+func NewCoordinates(latitude, longitude float64) (Coordinates, error) {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return Coordinates{}, ErrInvalidLatitude
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return Coordinates{}, ErrInvalidLongitude
+	}
+
+	return Coordinates{
+		latitude:  latitude,
+		longitude: longitude,
+	}, nil
+}
+
+// NewCoordinatesFrom creates a new instance of Coordinates from a validated latitude and
+// longitude
+func NewCoordinatesFrom(latitude Latitude, longitude Longitude) Coordinates {
+	return Coordinates{
+		latitude:  latitude.degrees,
+		longitude: longitude.degrees,
+	}
+}
+
+// ReconstituteCoordinates creates a new Coordinates instance without validation
+func ReconstituteCoordinates(latitude, longitude float64) Coordinates {
+	return Coordinates{
+		latitude:  latitude,
+		longitude: longitude,
+	}
+}
+
+// Latitude returns the latitude in decimal degrees, positive north of the equator
+func (c Coordinates) Latitude() float64 {
+	return c.latitude
+}
+
+// Longitude returns the longitude in decimal degrees, positive east of Greenwich
+func (c Coordinates) Longitude() float64 {
+	return c.longitude
+}
+
+// DistanceTo returns the great-circle distance to other in meters, with the haversine formula
+func (c Coordinates) DistanceTo(other Coordinates) float64 {
+	lat1, lat2 := radians(c.latitude), radians(other.latitude)
+	deltaLat := lat2 - lat1
+	deltaLon := radians(other.longitude - c.longitude)
+
+	h := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+	return 2 * EarthRadiusMeters * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+// Equals compares two Coordinates objects for equality
+func (c Coordinates) Equals(other Coordinates) bool {
+	return c.latitude == other.latitude && c.longitude == other.longitude
+}
+
+// String returns the coordinates as "latitude,longitude", e.g., "44.4268,26.1025"
+func (c Coordinates) String() string {
+	return strconv.FormatFloat(c.latitude, 'f', -1, 64) + "," +
+		strconv.FormatFloat(c.longitude, 'f', -1, 64)
+}
+
+// coordinatesJSON is the JSON representation of Coordinates
+type coordinatesJSON struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// MarshalJSON encodes the coordinates as their latitude and longitude
+func (c Coordinates) MarshalJSON() ([]byte, error) {
+	return json.Marshal(coordinatesJSON{Latitude: c.latitude, Longitude: c.longitude})
+}
+
+// UnmarshalJSON decodes and validates the coordinates
+func (c *Coordinates) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw coordinatesJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid coordinates JSON")
+	}
+
+	parsed, err := NewCoordinates(raw.Latitude, raw.Longitude)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,97 @@
+package geography
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CoordinatesTestSuite struct {
+	suite.Suite
+}
+
+func TestCoordinatesSuite(t *testing.T) {
+	suite.Run(t, new(CoordinatesTestSuite))
+}
+
+func (s *CoordinatesTestSuite) TestItCanBuildNewCoordinatesWithValidValues() {
+	coordinates, err := NewCoordinates(44.4268, 26.1025)
+	s.Require().NoError(err)
+	s.Equal(44.4268, coordinates.Latitude())
+	s.Equal(26.1025, coordinates.Longitude())
+	s.Equal("44.4268,26.1025", coordinates.String())
+
+	for _, corner := range [][2]float64{{90, 180}, {-90, -180}, {0, 0}} {
+		_, err := NewCoordinates(corner[0], corner[1])
+		s.NoError(err)
+	}
+}
+
+func (s *CoordinatesTestSuite) TestItFailsToBuildNewCoordinatesWithInvalidValues() {
+	testCases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		expected  error
+	}{
+		{"latitude above 90", 90.1, 0, ErrInvalidLatitude},
+		{"latitude below -90", -91, 0, ErrInvalidLatitude},
+		{"latitude NaN", math.NaN(), 0, ErrInvalidLatitude},
+		{"longitude above 180", 0, 180.5, ErrInvalidLongitude},
+		{"longitude below -180", 0, -181, ErrInvalidLongitude},
+		{"longitude infinite", 0, math.Inf(1), ErrInvalidLongitude},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewCoordinates(tc.latitude, tc.longitude)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *CoordinatesTestSuite) TestItComputesDistances() {
+	paris, _ := NewCoordinates(48.8566, 2.3522)
+	london, _ := NewCoordinates(51.5074, -0.1278)
+
+	s.InDelta(343_500, paris.DistanceTo(london), 1_000)
+	s.InDelta(paris.DistanceTo(london), london.DistanceTo(paris), 1e-6)
+	s.Zero(paris.DistanceTo(paris))
+
+	west, _ := NewCoordinates(0, 179.5)
+	east, _ := NewCoordinates(0, -179.5)
+	s.InDelta(111_195, west.DistanceTo(east), 100)
+}
+
+func (s *CoordinatesTestSuite) TestItCanCompareForEquality() {
+	first, _ := NewCoordinates(44.4268, 26.1025)
+	second := ReconstituteCoordinates(44.4268, 26.1025)
+	third, _ := NewCoordinates(26.1025, 44.4268)
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *CoordinatesTestSuite) TestItCanRoundTripThroughJSON() {
+	coordinates, _ := NewCoordinates(44.4268, 26.1025)
+
+	data, err := json.Marshal(coordinates)
+	s.Require().NoError(err)
+	s.JSONEq(`{"latitude":44.4268,"longitude":26.1025}`, string(data))
+
+	var decoded Coordinates
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(coordinates.Equals(decoded))
+
+	s.NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(coordinates.Equals(decoded))
+	s.ErrorIs(
+		json.Unmarshal([]byte(`{"latitude":100,"longitude":0}`), &decoded),
+		ErrInvalidLatitude,
+	)
+	s.Error(json.Unmarshal([]byte(`"44,26"`), &decoded))
+}
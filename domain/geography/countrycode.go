@@ -8,11 +8,23 @@ import (
 )
 
 var (
-	ErrEmptyCountryCode   = domain.NewError("country code cannot be empty")
-	ErrInvalidCountryCode = domain.NewError("country code must be exactly 2 letters")
+	ErrEmptyCountryCode = domain.NewLocalizedError(
+		"geography.country_code.empty", nil,
+		"country code cannot be empty",
+	)
+	ErrInvalidCountryCode = domain.NewLocalizedError(
+		"geography.country_code.invalid", nil,
+		"country code must be exactly 2 letters",
+	)
 )
 
-var countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+// CountryCodePattern is the regular expression a normalized country code must match
+const CountryCodePattern = `^[A-Z]{2}$`
+
+var countryCodeRegex = regexp.MustCompile(CountryCodePattern)
+
+// CountryCodeValidators holds extra rules run by NewCountryCode after its own validation
+var CountryCodeValidators domain.Validators[CountryCode]
 
 type CountryCode struct {
 	value string
@@ -25,9 +37,14 @@ func NewCountryCode(value string) (CountryCode, error) {
 		return CountryCode{}, err
 	}
 
-	return CountryCode{
+	countryCode := CountryCode{
 		value: normalized,
-	}, nil
+	}
+	if err := CountryCodeValidators.Validate(countryCode); err != nil {
+		return CountryCode{}, err
+	}
+
+	return countryCode, nil
 }
 
 // ReconstituteCountryCode creates a new CountryCode instance without validation or normalization
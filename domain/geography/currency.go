@@ -0,0 +1,36 @@
+package geography
+
+import (
+	_ "embed"
+	"slices"
+	"strings"
+)
+
+// embeddedCountryCurrencies lists the ISO 4217 currencies in use in each country, default first
+//
+//go:embed data/currencies.txt
+var embeddedCountryCurrencies string
+
+// countryCurrencies maps countries to their currencies. Antarctica has no entry.
+var countryCurrencies = parseCountryCurrencies(embeddedCountryCurrencies)
+
+// Currencies returns the ISO 4217 codes of the currencies in use in the country, the default
+// (legal tender issued or adopted by the country) first. It is empty for Antarctica and
+// unknown countries. Use finance.DefaultCurrencyFor for a finance.Currency.
+func (c CountryCode) Currencies() []string {
+	return slices.Clone(countryCurrencies[c.value])
+}
+
+// parseCountryCurrencies reads "COUNTRY CURRENCY..." lines, skipping blank lines and
+// '#' comments
+func parseCountryCurrencies(data string) map[string][]string {
+	currencies := make(map[string][]string)
+	for line := range strings.Lines(data) {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		currencies[fields[0]] = fields[1:]
+	}
+	return currencies
+}
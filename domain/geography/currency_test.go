@@ -0,0 +1,58 @@
+package geography
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CurrencyTestSuite struct {
+	suite.Suite
+}
+
+func TestCurrencySuite(t *testing.T) {
+	suite.Run(t, new(CurrencyTestSuite))
+}
+
+func (s *CurrencyTestSuite) TestItCanLookUpTheCurrenciesOfACountry() {
+	testCases := []struct {
+		country  string
+		expected []string
+	}{
+		{"RO", []string{"RON"}},
+		{"DE", []string{"EUR"}},
+		{"US", []string{"USD"}},
+		{"CI", []string{"XOF"}},
+		{"PA", []string{"PAB", "USD"}},
+		{"NA", []string{"NAD", "ZAR"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.country, func() {
+			s.Equal(tc.expected, ReconstituteCountryCode(tc.country).Currencies())
+		})
+	}
+}
+
+func (s *CurrencyTestSuite) TestItHasNoCurrenciesForAntarcticaAndUnknownCountries() {
+	s.Empty(ReconstituteCountryCode("AQ").Currencies())
+	s.Empty(ReconstituteCountryCode("XX").Currencies())
+	s.Empty(CountryCode{}.Currencies())
+}
+
+func (s *CurrencyTestSuite) TestItCoversEveryCountryWithACurrency() {
+	for _, countries := range continentCountries {
+		for _, country := range countries {
+			if country == "AQ" {
+				continue
+			}
+			s.NotEmpty(ReconstituteCountryCode(country).Currencies(), country)
+		}
+	}
+}
+
+func (s *CurrencyTestSuite) TestItReturnsACopyOfTheCurrencies() {
+	currencies := ReconstituteCountryCode("PA").Currencies()
+	currencies[0] = "XXX"
+	s.Equal("PAB", ReconstituteCountryCode("PA").Currencies()[0])
+}
@@ -0,0 +1,121 @@
+package geography
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// dmsMarkers lists the symbols accepted after degrees, minutes and seconds, including the
+// typographic primes and the look-alikes keyboards produce
+var dmsMarkers = [3][]string{
+	{"°", "º", "˚"},
+	{"'", "′", "’", "´"},
+	{`"`, "″", "”", "''", "′′"},
+}
+
+// parseDegrees parses decimal degrees or degrees, minutes and seconds, such as "-48.8566",
+// "48.8566N", "48°51'24\"N" or "N 48 51 24", where positive and negative are the hemisphere
+// letters of the axis. It returns false when the value does not parse.
+func parseDegrees(value string, positive, negative byte) (float64, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	sign, hemisphere := 1.0, false
+	for _, edge := range []int{len(value) - 1, 0} {
+		switch value[edge] &^ 0x20 {
+		case positive:
+			hemisphere = true
+		case negative:
+			sign, hemisphere = -1, true
+		default:
+			continue
+		}
+		value = strings.TrimSpace(value[:edge] + value[edge+1:])
+		break
+	}
+
+	if value != "" && (value[0] == '-' || value[0] == '+') {
+		if hemisphere {
+			return 0, false
+		}
+		if value[0] == '-' {
+			sign = -1
+		}
+		value = value[1:]
+	}
+
+	var parts [3]float64
+	count := 0
+	for value != "" && count < 3 {
+		end := strings.IndexFunc(
+			value, func(r rune) bool {
+				return (r < '0' || r > '9') && r != '.'
+			},
+		)
+		if end < 0 {
+			end = len(value)
+		}
+		number, err := strconv.ParseFloat(value[:end], 64)
+		if err != nil || value[:end] == "" || strings.HasPrefix(value[:end], ".") {
+			return 0, false
+		}
+		// Only the last part may have a fraction, as in 48°51.4'
+		if count > 0 && parts[count-1] != math.Trunc(parts[count-1]) {
+			return 0, false
+		}
+		parts[count] = number
+
+		value = strings.TrimLeft(value[end:], " ")
+		if marker, unit := cutDMSMarker(value); unit >= 0 {
+			if unit != count {
+				return 0, false
+			}
+			value = strings.TrimLeft(value[len(marker):], " ")
+		}
+		count++
+	}
+
+	if value != "" || count == 0 || parts[1] >= 60 || parts[2] >= 60 {
+		return 0, false
+	}
+
+	return sign * (parts[0] + parts[1]/60 + parts[2]/3600), true
+}
+
+// cutDMSMarker returns the marker at the start of the value and its unit, 0 for degrees, 1
+// for minutes and 2 for seconds, or -1 when there is none. Seconds are matched first, so two
+// apostrophes read as seconds rather than minutes.
+func cutDMSMarker(value string) (string, int) {
+	for unit := 2; unit >= 0; unit-- {
+		for _, marker := range dmsMarkers[unit] {
+			if strings.HasPrefix(value, marker) {
+				return marker, unit
+			}
+		}
+	}
+	return "", -1
+}
+
+// formatDMS formats decimal degrees as degrees, minutes and seconds rounded to hundredths of
+// a second, followed by the hemisphere letter, e.g., 48°51'24"N
+func formatDMS(degrees float64, positive, negative byte) string {
+	hemisphere := positive
+	if degrees < 0 {
+		hemisphere = negative
+	}
+
+	centiseconds := int64(math.Round(math.Abs(degrees) * 360000))
+	whole := centiseconds / 360000
+	minutes := centiseconds % 360000 / 6000
+	seconds := float64(centiseconds%6000) / 100
+
+	var formatted strings.Builder
+	formatted.WriteString(strconv.FormatInt(whole, 10) + "°")
+	formatted.WriteString(strconv.FormatInt(minutes, 10) + "'")
+	formatted.WriteString(strconv.FormatFloat(seconds, 'f', -1, 64) + `"`)
+	formatted.WriteByte(hemisphere)
+	return formatted.String()
+}
@@ -0,0 +1,95 @@
+package geography
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var ErrInvalidCoordinateFormat = domain.NewLocalizedError(
+	"geography.coordinates.invalid_format", nil,
+	"coordinate must be decimal degrees or degrees, minutes and seconds",
+)
+
+// Latitude is the angle north (positive) or south (negative) of the equator, in decimal
+// degrees from -90 to 90
+type Latitude struct {
+	degrees float64
+}
+
+// NewLatitude creates a new instance of Latitude with validation
+func NewLatitude(degrees float64) (Latitude, error) {
+	if math.IsNaN(degrees) || degrees < -90 || degrees > 90 {
+		return Latitude{}, ErrInvalidLatitude
+	}
+
+	return Latitude{
+		degrees: degrees,
+	}, nil
+}
+
+// ParseLatitude creates a new instance of Latitude from decimal degrees, such as "48.8566"
+// or "48.8566 N", or from degrees, minutes and seconds, such as 48°51'24"N or "48 51 24 N".
+// A sign and a hemisphere letter cannot be combined.
+func ParseLatitude(value string) (Latitude, error) {
+	degrees, ok := parseDegrees(value, 'N', 'S')
+	if !ok {
+		return Latitude{}, ErrInvalidCoordinateFormat
+	}
+	return NewLatitude(degrees)
+}
+
+// ReconstituteLatitude creates a new Latitude instance without validation
+func ReconstituteLatitude(degrees float64) Latitude {
+	return Latitude{
+		degrees: degrees,
+	}
+}
+
+// Degrees returns the latitude in decimal degrees
+func (l Latitude) Degrees() float64 {
+	return l.degrees
+}
+
+// DMS returns the latitude in degrees, minutes and seconds, e.g., 48°51'23.76"N
+func (l Latitude) DMS() string {
+	return formatDMS(l.degrees, 'N', 'S')
+}
+
+// Equals compares two Latitude objects for equality
+func (l Latitude) Equals(other Latitude) bool {
+	return l.degrees == other.degrees
+}
+
+// String returns the latitude in decimal degrees
+func (l Latitude) String() string {
+	return strconv.FormatFloat(l.degrees, 'f', -1, 64)
+}
+
+// MarshalJSON encodes the latitude as a JSON number of decimal degrees
+func (l Latitude) MarshalJSON() ([]byte, error) {
+	return strconv.AppendFloat(nil, l.degrees, 'f', -1, 64), nil
+}
+
+// UnmarshalJSON decodes the latitude from a JSON number of decimal degrees or a JSON string
+// accepted by ParseLatitude
+func (l *Latitude) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	raw, err := unquoteCoordinateJSON(data)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ParseLatitude(raw)
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+	return nil
+}
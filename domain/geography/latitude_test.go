@@ -0,0 +1,146 @@
+package geography
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LatitudeTestSuite struct {
+	suite.Suite
+}
+
+func TestLatitudeSuite(t *testing.T) {
+	suite.Run(t, new(LatitudeTestSuite))
+}
+
+func (s *LatitudeTestSuite) TestItCanBuildNewLatitude() {
+	latitude, err := NewLatitude(48.8566)
+	s.Require().NoError(err)
+	s.Equal(48.8566, latitude.Degrees())
+	s.Equal("48.8566", latitude.String())
+
+	_, err = NewLatitude(90.5)
+	s.ErrorIs(err, ErrInvalidLatitude)
+	_, err = NewLatitude(math.NaN())
+	s.ErrorIs(err, ErrInvalidLatitude)
+}
+
+func (s *LatitudeTestSuite) TestItCanParseLatitudes() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{name: "decimal", input: "48.8566", expected: 48.8566},
+		{name: "negative decimal", input: "-33.8688", expected: -33.8688},
+		{name: "explicit plus sign", input: "+12.5", expected: 12.5},
+		{name: "decimal with hemisphere", input: "33.8688 S", expected: -33.8688},
+		{name: "degrees with symbol", input: "45°N", expected: 45},
+		{name: "degrees, minutes, seconds", input: `48°51'24"N`, expected: 48.8566666},
+		{name: "spaces between parts", input: `48° 51' 24.5" N`, expected: 48.8568055},
+		{name: "typographic primes", input: "33°52′7.7″S", expected: -33.8688055},
+		{name: "double single quote", input: "48°51'24''N", expected: 48.8566666},
+		{name: "leading hemisphere", input: "S 33 52 7.7", expected: -33.8688055},
+		{name: "lower case hemisphere", input: "10.5s", expected: -10.5},
+		{name: "decimal minutes", input: "48°51.4'N", expected: 48.8566666},
+		{name: "pole", input: "90 S", expected: -90},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				latitude, err := ParseLatitude(tc.input)
+				s.Require().NoError(err)
+				s.InDelta(tc.expected, latitude.Degrees(), 1e-6)
+			},
+		)
+	}
+}
+
+func (s *LatitudeTestSuite) TestItFailsToParseInvalidLatitudes() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{name: "empty", input: " ", expected: ErrInvalidCoordinateFormat},
+		{name: "hemisphere only", input: "N", expected: ErrInvalidCoordinateFormat},
+		{name: "longitude hemisphere", input: "48.85 E", expected: ErrInvalidCoordinateFormat},
+		{name: "sign and hemisphere", input: "-48.85 N", expected: ErrInvalidCoordinateFormat},
+		{name: "minutes of 60", input: "48°60'N", expected: ErrInvalidCoordinateFormat},
+		{name: "seconds of 60", input: `48°51'60"N`, expected: ErrInvalidCoordinateFormat},
+		{name: "fraction before minutes", input: "48.5°30'N", expected: ErrInvalidCoordinateFormat},
+		{name: "markers out of order", input: `48°24"N`, expected: ErrInvalidCoordinateFormat},
+		{name: "too many parts", input: "48 51 24 10", expected: ErrInvalidCoordinateFormat},
+		{name: "exponent", input: "4.8e1", expected: ErrInvalidCoordinateFormat},
+		{name: "text", input: "north", expected: ErrInvalidCoordinateFormat},
+		{name: "out of range", input: "91°N", expected: ErrInvalidLatitude},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := ParseLatitude(tc.input)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *LatitudeTestSuite) TestItFormatsDegreesMinutesAndSeconds() {
+	testCases := []struct {
+		degrees  float64
+		expected string
+	}{
+		{48.8566, `48°51'23.76"N`},
+		{-33.8688, `33°52'7.68"S`},
+		{0, `0°0'0"N`},
+		{45.99999999, `46°0'0"N`},
+	}
+
+	for _, tc := range testCases {
+		latitude, err := NewLatitude(tc.degrees)
+		s.Require().NoError(err)
+		s.Equal(tc.expected, latitude.DMS())
+
+		parsed, err := ParseLatitude(latitude.DMS())
+		s.Require().NoError(err)
+		s.InDelta(tc.degrees, parsed.Degrees(), 1e-5)
+	}
+}
+
+func (s *LatitudeTestSuite) TestItCanCompareForEquality() {
+	first, _ := NewLatitude(48.8566)
+	second, _ := ParseLatitude("48.8566N")
+	third, _ := NewLatitude(-48.8566)
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+	s.True(first.Equals(ReconstituteLatitude(48.8566)))
+}
+
+func (s *LatitudeTestSuite) TestItCanRoundTripThroughJSON() {
+	latitude, _ := NewLatitude(-33.8688)
+
+	data, err := json.Marshal(latitude)
+	s.Require().NoError(err)
+	s.Equal(`-33.8688`, string(data))
+
+	var decoded Latitude
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(latitude.Equals(decoded))
+
+	s.Require().NoError(json.Unmarshal([]byte(`"45°30'N"`), &decoded))
+	s.Equal(45.5, decoded.Degrees())
+	s.Require().NoError(json.Unmarshal([]byte(`1e1`), &decoded))
+	s.Equal(10.0, decoded.Degrees())
+
+	s.NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.Equal(10.0, decoded.Degrees())
+	s.ErrorIs(json.Unmarshal([]byte(`95`), &decoded), ErrInvalidLatitude)
+	s.ErrorIs(json.Unmarshal([]byte(`true`), &decoded), ErrInvalidCoordinateFormat)
+	s.Error(json.Unmarshal([]byte(`{`), &decoded))
+}
@@ -0,0 +1,108 @@
+package geography
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// Longitude is the angle east (positive) or west (negative) of the Greenwich meridian, in
+// decimal degrees from -180 to 180
+type Longitude struct {
+	degrees float64
+}
+
+// NewLongitude creates a new instance of Longitude with validation
+func NewLongitude(degrees float64) (Longitude, error) {
+	if math.IsNaN(degrees) || degrees < -180 || degrees > 180 {
+		return Longitude{}, ErrInvalidLongitude
+	}
+
+	return Longitude{
+		degrees: degrees,
+	}, nil
+}
+
+// ParseLongitude creates a new instance of Longitude from decimal degrees, such as "2.3522"
+// or "2.3522 E", or from degrees, minutes and seconds, such as 2°21'7.92"E or "W 0 7 40".
+// A sign and a hemisphere letter cannot be combined.
+func ParseLongitude(value string) (Longitude, error) {
+	degrees, ok := parseDegrees(value, 'E', 'W')
+	if !ok {
+		return Longitude{}, ErrInvalidCoordinateFormat
+	}
+	return NewLongitude(degrees)
+}
+
+// ReconstituteLongitude creates a new Longitude instance without validation
+func ReconstituteLongitude(degrees float64) Longitude {
+	return Longitude{
+		degrees: degrees,
+	}
+}
+
+// Degrees returns the longitude in decimal degrees
+func (l Longitude) Degrees() float64 {
+	return l.degrees
+}
+
+// DMS returns the longitude in degrees, minutes and seconds, e.g., 2°21'7.92"E
+func (l Longitude) DMS() string {
+	return formatDMS(l.degrees, 'E', 'W')
+}
+
+// Equals compares two Longitude objects for equality
+func (l Longitude) Equals(other Longitude) bool {
+	return l.degrees == other.degrees
+}
+
+// String returns the longitude in decimal degrees
+func (l Longitude) String() string {
+	return strconv.FormatFloat(l.degrees, 'f', -1, 64)
+}
+
+// MarshalJSON encodes the longitude as a JSON number of decimal degrees
+func (l Longitude) MarshalJSON() ([]byte, error) {
+	return strconv.AppendFloat(nil, l.degrees, 'f', -1, 64), nil
+}
+
+// UnmarshalJSON decodes the longitude from a JSON number of decimal degrees or a JSON string
+// accepted by ParseLongitude
+func (l *Longitude) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	raw, err := unquoteCoordinateJSON(data)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ParseLongitude(raw)
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+	return nil
+}
+
+// unquoteCoordinateJSON returns the text of a JSON number or string
+func unquoteCoordinateJSON(data []byte) (string, error) {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return "", domain.NewErrorWithWrap(err, "invalid coordinate JSON")
+	}
+
+	switch value := raw.(type) {
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case string:
+		return value, nil
+	default:
+		return "", ErrInvalidCoordinateFormat
+	}
+}
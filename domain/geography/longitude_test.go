@@ -0,0 +1,100 @@
+package geography
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LongitudeTestSuite struct {
+	suite.Suite
+}
+
+func TestLongitudeSuite(t *testing.T) {
+	suite.Run(t, new(LongitudeTestSuite))
+}
+
+func (s *LongitudeTestSuite) TestItCanBuildNewLongitude() {
+	longitude, err := NewLongitude(-179.99)
+	s.Require().NoError(err)
+	s.Equal(-179.99, longitude.Degrees())
+	s.Equal("-179.99", longitude.String())
+
+	_, err = NewLongitude(180.01)
+	s.ErrorIs(err, ErrInvalidLongitude)
+}
+
+func (s *LongitudeTestSuite) TestItCanParseLongitudes() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{name: "decimal", input: "2.3522", expected: 2.3522},
+		{name: "decimal with hemisphere", input: "0.1278W", expected: -0.1278},
+		{name: "degrees, minutes, seconds", input: `2°21'7.92"E`, expected: 2.3522},
+		{name: "leading hemisphere", input: "W 0 7 40.08", expected: -0.1278},
+		{name: "antimeridian", input: "180°W", expected: -180},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				longitude, err := ParseLongitude(tc.input)
+				s.Require().NoError(err)
+				s.InDelta(tc.expected, longitude.Degrees(), 1e-6)
+			},
+		)
+	}
+}
+
+func (s *LongitudeTestSuite) TestItFailsToParseInvalidLongitudes() {
+	_, err := ParseLongitude("2.35 N")
+	s.ErrorIs(err, ErrInvalidCoordinateFormat)
+
+	_, err = ParseLongitude("181 E")
+	s.ErrorIs(err, ErrInvalidLongitude)
+}
+
+func (s *LongitudeTestSuite) TestItFormatsDegreesMinutesAndSeconds() {
+	longitude, _ := NewLongitude(2.3522)
+	s.Equal(`2°21'7.92"E`, longitude.DMS())
+
+	longitude, _ = NewLongitude(-0.1278)
+	s.Equal(`0°7'40.08"W`, longitude.DMS())
+}
+
+func (s *LongitudeTestSuite) TestItCanBuildCoordinates() {
+	latitude, _ := ParseLatitude(`48°51'24"N`)
+	longitude, _ := ParseLongitude(`2°21'7.92"E`)
+
+	coordinates := NewCoordinatesFrom(latitude, longitude)
+	s.Equal(latitude.Degrees(), coordinates.Latitude())
+	s.Equal(longitude.Degrees(), coordinates.Longitude())
+}
+
+func (s *LongitudeTestSuite) TestItCanCompareForEquality() {
+	first, _ := NewLongitude(2.3522)
+	second, _ := ParseLongitude("2.3522 E")
+	third, _ := ParseLongitude("2.3522 W")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *LongitudeTestSuite) TestItCanRoundTripThroughJSON() {
+	longitude, _ := NewLongitude(2.3522)
+
+	data, err := json.Marshal(longitude)
+	s.Require().NoError(err)
+	s.Equal(`2.3522`, string(data))
+
+	var decoded Longitude
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(longitude.Equals(decoded))
+
+	s.Require().NoError(json.Unmarshal([]byte(`"0°7'40.08\"W"`), &decoded))
+	s.InDelta(-0.1278, decoded.Degrees(), 1e-9)
+	s.ErrorIs(json.Unmarshal([]byte(`"2.35 N"`), &decoded), ErrInvalidCoordinateFormat)
+}
@@ -0,0 +1,117 @@
+package geography
+
+import (
+	"slices"
+	"sync"
+)
+
+// RegionGroup names a group of countries sharing rules, such as a customs union or a tax
+// area, so rules keyed on it need no country lists of their own
+type RegionGroup string
+
+const (
+	// RegionGroupEU is the European Union
+	RegionGroupEU RegionGroup = "EU"
+	// RegionGroupEEA is the European Economic Area: the European Union, Iceland,
+	// Liechtenstein and Norway
+	RegionGroupEEA RegionGroup = "EEA"
+	// RegionGroupSchengen is the Schengen Area of open internal borders
+	RegionGroupSchengen RegionGroup = "Schengen"
+)
+
+var (
+	regionGroupsMu sync.RWMutex
+	// regionGroups holds the sorted countries of each group, as of 2025
+	regionGroups = map[RegionGroup][]string{
+		RegionGroupEU: {
+			"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+			"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+		},
+		RegionGroupEEA: {
+			"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+			"IE", "IS", "IT", "LI", "LT", "LU", "LV", "MT", "NL", "NO", "PL", "PT", "RO", "SE",
+			"SI", "SK",
+		},
+		// Every EU member but Cyprus and Ireland, and the EFTA states
+		RegionGroupSchengen: {
+			"AT", "BE", "BG", "CH", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+			"IS", "IT", "LI", "LT", "LU", "LV", "MT", "NL", "NO", "PL", "PT", "RO", "SE", "SI",
+			"SK",
+		},
+	}
+)
+
+// RegisterRegionGroup registers (or replaces) the countries of a group, e.g., a shipping zone
+// or an update after a country joins the EU. It is safe to call concurrently with lookups.
+func RegisterRegionGroup(group RegionGroup, countries ...CountryCode) {
+	values := make([]string, len(countries))
+	for i, country := range countries {
+		values[i] = country.Value()
+	}
+
+	regionGroupsMu.Lock()
+	defer regionGroupsMu.Unlock()
+	regionGroups[group] = sortedCountries(values)
+}
+
+// Contains reports whether the country belongs to the group
+func (g RegionGroup) Contains(country CountryCode) bool {
+	regionGroupsMu.RLock()
+	defer regionGroupsMu.RUnlock()
+	_, found := slices.BinarySearch(regionGroups[g], country.value)
+	return found
+}
+
+// Countries returns the countries of the group in alphabetical order, or none for a group
+// that is not registered
+func (g RegionGroup) Countries() []CountryCode {
+	regionGroupsMu.RLock()
+	defer regionGroupsMu.RUnlock()
+
+	countries := make([]CountryCode, len(regionGroups[g]))
+	for i, value := range regionGroups[g] {
+		countries[i] = CountryCode{value: value}
+	}
+	return countries
+}
+
+// String returns the name of the group
+func (g RegionGroup) String() string {
+	return string(g)
+}
+
+// RegionGroups returns the groups the country belongs to, in alphabetical order
+func (c CountryCode) RegionGroups() []RegionGroup {
+	regionGroupsMu.RLock()
+	defer regionGroupsMu.RUnlock()
+
+	var groups []RegionGroup
+	for group, countries := range regionGroups {
+		if _, found := slices.BinarySearch(countries, c.value); found {
+			groups = append(groups, group)
+		}
+	}
+	slices.Sort(groups)
+	return groups
+}
+
+// IsEU reports whether the country is a member state of the European Union
+func (c CountryCode) IsEU() bool {
+	return RegionGroupEU.Contains(c)
+}
+
+// IsEEA reports whether the country belongs to the European Economic Area
+func (c CountryCode) IsEEA() bool {
+	return RegionGroupEEA.Contains(c)
+}
+
+// IsSchengen reports whether the country belongs to the Schengen Area
+func (c CountryCode) IsSchengen() bool {
+	return RegionGroupSchengen.Contains(c)
+}
+
+// sortedCountries sorts the country codes and drops duplicates
+func sortedCountries(countries []string) []string {
+	slices.Sort(countries)
+	return slices.Compact(countries)
+}
@@ -0,0 +1,92 @@
+package geography
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RegionGroupTestSuite struct {
+	suite.Suite
+}
+
+func TestRegionGroupSuite(t *testing.T) {
+	suite.Run(t, new(RegionGroupTestSuite))
+}
+
+func (s *RegionGroupTestSuite) country(value string) CountryCode {
+	country, err := NewCountryCode(value)
+	s.Require().NoError(err)
+	return country
+}
+
+func (s *RegionGroupTestSuite) TestItChecksEuropeanMemberships() {
+	testCases := []struct {
+		country  string
+		eu       bool
+		eea      bool
+		schengen bool
+	}{
+		{"RO", true, true, true},
+		{"IE", true, true, false},
+		{"CY", true, true, false},
+		{"NO", false, true, true},
+		{"CH", false, false, true},
+		{"GB", false, false, false},
+		{"US", false, false, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.country, func() {
+				country := s.country(tc.country)
+				s.Equal(tc.eu, country.IsEU())
+				s.Equal(tc.eea, country.IsEEA())
+				s.Equal(tc.schengen, country.IsSchengen())
+			},
+		)
+	}
+}
+
+func (s *RegionGroupTestSuite) TestItListsTheCountriesOfGroups() {
+	s.Len(RegionGroupEU.Countries(), 27)
+	s.Len(RegionGroupEEA.Countries(), 30)
+	s.Len(RegionGroupSchengen.Countries(), 29)
+	s.Equal("AT", RegionGroupEU.Countries()[0].Value())
+	s.Empty(RegionGroup("unknown").Countries())
+
+	for _, country := range RegionGroupEU.Countries() {
+		s.True(country.IsEEA(), country.Value())
+	}
+}
+
+func (s *RegionGroupTestSuite) TestItListsTheGroupsOfCountries() {
+	s.Equal(
+		[]RegionGroup{RegionGroupEEA, RegionGroupEU, RegionGroupSchengen},
+		s.country("DE").RegionGroups(),
+	)
+	s.Equal([]RegionGroup{RegionGroupSchengen}, s.country("CH").RegionGroups())
+	s.Empty(s.country("JP").RegionGroups())
+}
+
+func (s *RegionGroupTestSuite) TestItCanRegisterRegionGroups() {
+	nordics := RegionGroup("Nordics")
+	s.T().Cleanup(
+		func() {
+			regionGroupsMu.Lock()
+			defer regionGroupsMu.Unlock()
+			delete(regionGroups, nordics)
+		},
+	)
+
+	RegisterRegionGroup(nordics, s.country("SE"), s.country("DK"), s.country("NO"), s.country("SE"))
+
+	s.True(nordics.Contains(s.country("NO")))
+	s.False(nordics.Contains(s.country("DE")))
+	s.Equal(
+		[]CountryCode{s.country("DK"), s.country("NO"), s.country("SE")},
+		nordics.Countries(),
+	)
+	s.Contains(s.country("DK").RegionGroups(), nordics)
+	s.Equal("Nordics", nordics.String())
+}
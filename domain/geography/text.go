@@ -0,0 +1,48 @@
+package geography
+
+// Empty text is treated as a missing value and leaves the country code unchanged.
+
+// UnmarshalText parses the country code from text, with validation
+func (c *CountryCode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewCountryCode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// UnmarshalText parses the IATA code from text, with format validation only
+func (c *IATACode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewIATACode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// UnmarshalText parses the ICAO code from text, with format validation only
+func (c *ICAOCode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewICAOCode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
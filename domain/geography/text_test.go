@@ -0,0 +1,46 @@
+package geography
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TextTestSuite struct {
+	suite.Suite
+}
+
+func TestTextSuite(t *testing.T) {
+	suite.Run(t, new(TextTestSuite))
+}
+
+func (s *TextTestSuite) TestItCanParseCountryCodesFromText() {
+	var country CountryCode
+	s.Require().NoError(country.UnmarshalText([]byte("ro")))
+	s.Equal("RO", country.Value())
+
+	s.ErrorIs(country.UnmarshalText([]byte("XYZ")), ErrInvalidCountryCode)
+	s.NoError(country.UnmarshalText(nil))
+	s.Equal("RO", country.Value())
+}
+
+func (s *TextTestSuite) TestItCanParseAirportCodesFromText() {
+	var iata IATACode
+	s.Require().NoError(iata.UnmarshalText([]byte("otp")))
+	s.Equal("OTP", iata.Value())
+	s.ErrorIs(iata.UnmarshalText([]byte("OT1")), ErrInvalidIATACode)
+
+	var icao ICAOCode
+	s.Require().NoError(icao.UnmarshalText([]byte("lrop")))
+	s.Equal("LROP", icao.Value())
+	s.ErrorIs(icao.UnmarshalText([]byte("1ROP")), ErrInvalidICAOCode)
+	s.NoError(icao.UnmarshalText(nil))
+	s.Equal("LROP", icao.Value())
+}
+
+func (s *TextTestSuite) TestItCanBeUsedAsAJSONMapKey() {
+	var shares map[CountryCode]int
+	s.Require().NoError(json.Unmarshal([]byte(`{"fr": 3}`), &shares))
+	s.Equal(3, shares[ReconstituteCountryCode("FR")])
+}
@@ -0,0 +1,29 @@
+package geography
+
+import "github.com/golibry/go-common-domain/domain"
+
+// MarshalYAML encodes the country code as a YAML string, so configuration files can bind it
+// directly
+func (c CountryCode) MarshalYAML() (any, error) {
+	if c.value == "" {
+		return nil, nil
+	}
+
+	return c.value, nil
+}
+
+// UnmarshalYAML decodes the country code from a YAML string, with validation
+func (c *CountryCode) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid country code YAML")
+	}
+
+	parsed, err := NewCountryCode(raw)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
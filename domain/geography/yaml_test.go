@@ -0,0 +1,39 @@
+package geography
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLTestSuite struct {
+	suite.Suite
+}
+
+func TestYAMLSuite(t *testing.T) {
+	suite.Run(t, new(YAMLTestSuite))
+}
+
+type marketConfig struct {
+	Markets []CountryCode `yaml:"markets"`
+}
+
+func (s *YAMLTestSuite) TestItCanBindCountryCodesFromYAML() {
+	var config marketConfig
+	s.Require().NoError(yaml.Unmarshal([]byte("markets: [ro, DE]"), &config))
+
+	s.Require().Len(config.Markets, 2)
+	s.Equal("RO", config.Markets[0].Value())
+	s.Equal("DE", config.Markets[1].Value())
+
+	encoded, err := yaml.Marshal(config)
+	s.Require().NoError(err)
+	s.Equal("markets:\n    - RO\n    - DE\n", string(encoded))
+}
+
+func (s *YAMLTestSuite) TestItValidatesWhenUnmarshalingYAML() {
+	var config marketConfig
+	s.ErrorIs(yaml.Unmarshal([]byte("markets: [ROU]"), &config), ErrInvalidCountryCode)
+	s.Error(yaml.Unmarshal([]byte("markets: [{code: RO}]"), &config))
+}
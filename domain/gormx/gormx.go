@@ -0,0 +1,216 @@
+// Package gormx lets GORM models hold value objects directly, instead of mirroring them in
+// string fields and converting by hand in every repository.
+//
+// Wrap each value object field in a Column:
+//
+//	type Customer struct {
+//		ID      gormx.Column[identifier.IntIdentifier] `gorm:"primaryKey"`
+//		Email   gormx.Column[web.Email]                `gorm:"uniqueIndex"`
+//		Country gormx.Column[geography.CountryCode]
+//		Balance gormx.Column[finance.Money]
+//	}
+//
+// Column implements sql.Scanner, driver.Valuer and GORM's GormDataTypeInterface, so AutoMigrate
+// creates a matching column and queries bind it like any other field. Integer identifiers are
+// stored as integers, value objects with a text form as strings and the others, such as
+// person.FullName, as their binary encoding. Zero values are stored as NULL, and NULL scans
+// to the zero value. Every value is validated when scanned.
+//
+// Models that keep the value object types themselves can use the serializer this package
+// registers with GORM instead:
+//
+//	type Customer struct {
+//		ID    uint
+//		Email web.Email       `gorm:"serializer:valueobject"`
+//		Name  person.FullName `gorm:"serializer:valueobject;type:bytes"`
+//	}
+//
+// GORM gives serialized fields a string column, so fields stored as integers or bytes need an
+// explicit type tag.
+package gormx
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// GORM data types reported by Column.GormDataType, matching GORM's schema.DataType values
+const (
+	DataTypeUint   = "uint"
+	DataTypeString = "string"
+	DataTypeBytes  = "bytes"
+)
+
+var (
+	ErrUnsupportedColumnScan = domain.NewInternalError(
+		"unsupported source type for value object column",
+	)
+	ErrUnsupportedColumnType = domain.NewInternalError(
+		"value object has neither a text nor a binary encoding",
+	)
+	ErrIdentifierOutOfRange = domain.NewError("identifier does not fit in a SQL bigint")
+)
+
+// integerValue is implemented by integer identifiers, such as identifier.IntIdentifier
+type integerValue interface {
+	Value() uint64
+}
+
+// Column holds a value object in a GORM model field
+type Column[T any] struct {
+	value T
+}
+
+// NewColumn wraps the value for a GORM model field
+func NewColumn[T any](value T) Column[T] {
+	return Column[T]{
+		value: value,
+	}
+}
+
+// Get returns the wrapped value object
+func (c Column[T]) Get() T {
+	return c.value
+}
+
+// IsZero reports whether the wrapped value is the zero value, stored as NULL
+func (c Column[T]) IsZero() bool {
+	return reflect.ValueOf(&c.value).Elem().IsZero()
+}
+
+// Equals compares the wrapped values with their Equals method, or with == for values
+// without one
+func (c Column[T]) Equals(other Column[T]) bool {
+	if equatable, ok := any(c.value).(domain.Equatable[T]); ok {
+		return equatable.Equals(other.value)
+	}
+	return reflect.DeepEqual(c.value, other.value)
+}
+
+// String returns the string representation of the wrapped value
+func (c Column[T]) String() string {
+	return fmt.Sprint(c.value)
+}
+
+// GormDataType returns the GORM data type used by AutoMigrate for the column
+func (c Column[T]) GormDataType() string {
+	return dataTypeOf[T]()
+}
+
+// Value implements driver.Valuer, returning nil for the zero value
+func (c Column[T]) Value() (driver.Value, error) {
+	return columnValue(c.value)
+}
+
+// Scan implements sql.Scanner, validating the value. NULL scans to the zero value.
+func (c *Column[T]) Scan(src any) error {
+	if src == nil {
+		*c = Column[T]{}
+		return nil
+	}
+
+	var value T
+	if err := scanColumn(&value, src); err != nil {
+		return err
+	}
+
+	*c = NewColumn(value)
+	return nil
+}
+
+// columnValue returns the database value of a value object, nil for the zero value
+func columnValue(value any) (driver.Value, error) {
+	if reflect.ValueOf(value).IsZero() {
+		return nil, nil
+	}
+
+	switch dataTypeFor(reflect.TypeOf(value)) {
+	case DataTypeUint:
+		id := value.(integerValue).Value()
+		if id > math.MaxInt64 {
+			return nil, ErrIdentifierOutOfRange
+		}
+		return int64(id), nil
+	case DataTypeString:
+		return textOf(value)
+	case DataTypeBytes:
+		return value.(encoding.BinaryMarshaler).MarshalBinary()
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedColumnType, value)
+	}
+}
+
+// scanColumn parses a non-NULL database value into the value object target points to, with
+// validation
+func scanColumn(target any, src any) error {
+	var data []byte
+	switch src := src.(type) {
+	case string:
+		data = []byte(src)
+	case []byte:
+		data = src
+	case int64:
+		data = strconv.AppendInt(nil, src, 10)
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedColumnScan, src)
+	}
+
+	switch unmarshaler := target.(type) {
+	case encoding.TextUnmarshaler:
+		return unmarshaler.UnmarshalText(data)
+	case encoding.BinaryUnmarshaler:
+		return unmarshaler.UnmarshalBinary(data)
+	default:
+		return fmt.Errorf("%w: %v", ErrUnsupportedColumnType, reflect.TypeOf(target).Elem())
+	}
+}
+
+// dataTypeOf picks the column type of T, see dataTypeFor
+func dataTypeOf[T any]() string {
+	return dataTypeFor(reflect.TypeFor[T]())
+}
+
+// dataTypeFor picks the column type of a value object type: integers for integer
+// identifiers, strings for values with a text form and bytes for values with a binary
+// form only
+func dataTypeFor(t reflect.Type) string {
+	value := reflect.Zero(t).Interface()
+	target := reflect.New(t).Interface()
+	_, isInteger := value.(integerValue)
+	_, hasText := target.(encoding.TextUnmarshaler)
+	_, hasBinary := target.(encoding.BinaryUnmarshaler)
+	_, marshalsBinary := value.(encoding.BinaryMarshaler)
+
+	switch {
+	case isInteger && hasText:
+		return DataTypeUint
+	case hasText:
+		return DataTypeString
+	case hasBinary && marshalsBinary:
+		return DataTypeBytes
+	default:
+		return ""
+	}
+}
+
+// textOf returns the text form parsed back by UnmarshalText: MarshalText when defined, then
+// Value() string, then String
+func textOf(value any) (string, error) {
+	switch value := value.(type) {
+	case encoding.TextMarshaler:
+		text, err := value.MarshalText()
+		return string(text), err
+	case interface{ Value() string }:
+		return value.Value(), nil
+	case fmt.Stringer:
+		return value.String(), nil
+	default:
+		return "", fmt.Errorf("%w: %T", ErrUnsupportedColumnType, value)
+	}
+}
@@ -0,0 +1,112 @@
+package gormx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	_ sql.Scanner                  = (*Column[web.Email])(nil)
+	_ driver.Valuer                = Column[web.Email]{}
+	_ schema.GormDataTypeInterface = Column[web.Email]{}
+	_ schema.SerializerInterface   = Serializer{}
+)
+
+type GormxTestSuite struct {
+	suite.Suite
+}
+
+func TestGormxSuite(t *testing.T) {
+	suite.Run(t, new(GormxTestSuite))
+}
+
+func (s *GormxTestSuite) TestItReportsColumnDataTypes() {
+	s.Equal(DataTypeUint, Column[identifier.IntIdentifier]{}.GormDataType())
+	s.Equal(DataTypeString, Column[web.Email]{}.GormDataType())
+	s.Equal(DataTypeString, Column[finance.Money]{}.GormDataType())
+	s.Equal(DataTypeBytes, Column[person.FullName]{}.GormDataType())
+	s.Empty(Column[struct{ Name string }]{}.GormDataType())
+}
+
+func (s *GormxTestSuite) TestItCanRoundTripStringColumns() {
+	email, err := web.NewEmail("jane@example.com")
+	s.Require().NoError(err)
+
+	value, err := NewColumn(email).Value()
+	s.Require().NoError(err)
+	s.Equal("jane@example.com", value)
+
+	var scanned Column[web.Email]
+	s.Require().NoError(scanned.Scan([]byte("Jane@Example.com")))
+	s.True(NewColumn(email).Equals(scanned))
+	s.Equal("jane@example.com", scanned.String())
+}
+
+func (s *GormxTestSuite) TestItCanRoundTripCompositeColumns() {
+	price, err := finance.NewMoneyFromString("12.50", "EUR")
+	s.Require().NoError(err)
+	value, err := NewColumn(price).Value()
+	s.Require().NoError(err)
+
+	var scannedPrice Column[finance.Money]
+	s.Require().NoError(scannedPrice.Scan(value))
+	s.True(price.Equals(scannedPrice.Get()))
+
+	name, err := person.NewFullName("Jane", "", "Doe")
+	s.Require().NoError(err)
+	value, err = NewColumn(name).Value()
+	s.Require().NoError(err)
+	s.IsType([]byte{}, value)
+
+	var scannedName Column[person.FullName]
+	s.Require().NoError(scannedName.Scan(value))
+	s.True(name.Equals(scannedName.Get()))
+}
+
+func (s *GormxTestSuite) TestItStoresIntegerIdentifiersAsIntegers() {
+	id, err := identifier.NewIntIdentifier(42)
+	s.Require().NoError(err)
+
+	value, err := NewColumn(id).Value()
+	s.Require().NoError(err)
+	s.Equal(int64(42), value)
+
+	var scanned Column[identifier.IntIdentifier]
+	s.Require().NoError(scanned.Scan(int64(42)))
+	s.Equal(uint64(42), scanned.Get().Value())
+
+	_, err = NewColumn(identifier.ReconstituteIntIdentifier(math.MaxUint64)).Value()
+	s.ErrorIs(err, ErrIdentifierOutOfRange)
+}
+
+func (s *GormxTestSuite) TestItMapsZeroValuesToNull() {
+	value, err := Column[web.Email]{}.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	scanned := NewColumn(geography.ReconstituteCountryCode("RO"))
+	s.Require().NoError(scanned.Scan(nil))
+	s.True(scanned.IsZero())
+}
+
+func (s *GormxTestSuite) TestItValidatesWhenScanning() {
+	var country Column[geography.CountryCode]
+	s.ErrorIs(country.Scan("XYZ"), geography.ErrInvalidCountryCode)
+
+	s.ErrorIs(country.Scan(3.14), ErrUnsupportedColumnScan)
+
+	var unsupported Column[struct{ Name string }]
+	s.ErrorIs(unsupported.Scan("x"), ErrUnsupportedColumnType)
+	_, err := NewColumn(struct{ Name string }{"x"}).Value()
+	s.ErrorIs(err, ErrUnsupportedColumnType)
+}
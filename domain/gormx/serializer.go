@@ -0,0 +1,64 @@
+package gormx
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is the name the value object serializer is registered under, for use in
+// the gorm:"serializer:valueobject" tag
+const SerializerName = "valueobject"
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// Serializer is a GORM serializer storing value object fields the same way Column does.
+// Pointer fields are supported, with nil stored as NULL.
+type Serializer struct{}
+
+// Scan implements schema.SerializerInterface, validating the value. NULL scans to the zero
+// value, or nil for pointer fields.
+func (Serializer) Scan(
+	ctx context.Context,
+	field *schema.Field,
+	dst reflect.Value,
+	dbValue any,
+) error {
+	value := reflect.New(field.FieldType).Elem()
+	if dbValue != nil {
+		target := value
+		if field.FieldType.Kind() == reflect.Pointer {
+			target.Set(reflect.New(field.FieldType.Elem()))
+			target = target.Elem()
+		}
+
+		if err := scanColumn(target.Addr().Interface(), dbValue); err != nil {
+			return err
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(value)
+	return nil
+}
+
+// Value implements schema.SerializerValuerInterface, returning nil for zero values and nil
+// pointers
+func (Serializer) Value(
+	_ context.Context,
+	_ *schema.Field,
+	_ reflect.Value,
+	fieldValue any,
+) (any, error) {
+	value := reflect.ValueOf(fieldValue)
+	if !value.IsValid() || value.Kind() == reflect.Pointer && value.IsNil() {
+		return nil, nil
+	}
+	if value.Kind() == reflect.Pointer {
+		fieldValue = value.Elem().Interface()
+	}
+
+	return columnValue(fieldValue)
+}
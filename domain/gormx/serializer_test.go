@@ -0,0 +1,126 @@
+package gormx
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm/schema"
+)
+
+type serializedCustomer struct {
+	ID      uint
+	Email   web.Email              `gorm:"serializer:valueobject"`
+	Country *geography.CountryCode `gorm:"serializer:valueobject"`
+	Name    person.FullName        `gorm:"serializer:valueobject;type:bytes"`
+}
+
+type columnCustomer struct {
+	ID      Column[identifier.IntIdentifier] `gorm:"primaryKey"`
+	Email   Column[web.Email]
+	Balance Column[finance.Money]
+	Name    Column[person.FullName]
+}
+
+type SerializerTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestSerializerSuite(t *testing.T) {
+	suite.Run(t, &SerializerTestSuite{ctx: context.Background()})
+}
+
+func (s *SerializerTestSuite) parse(model any) *schema.Schema {
+	parsed, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+	s.Require().NoError(err)
+	return parsed
+}
+
+func (s *SerializerTestSuite) TestItIsRegisteredWithGorm() {
+	serializer, ok := schema.GetSerializer(SerializerName)
+	s.Require().True(ok)
+	s.IsType(Serializer{}, serializer)
+
+	parsed := s.parse(&serializedCustomer{})
+	s.IsType(Serializer{}, parsed.LookUpField("Email").Serializer)
+	s.Equal(schema.String, parsed.LookUpField("Email").DataType)
+	s.Equal(schema.DataType("bytes"), parsed.LookUpField("Name").DataType)
+}
+
+func (s *SerializerTestSuite) TestGormMigratesColumnsWithMatchingTypes() {
+	parsed := s.parse(&columnCustomer{})
+	s.Equal(schema.Uint, parsed.LookUpField("ID").DataType)
+	s.Equal(schema.String, parsed.LookUpField("Email").DataType)
+	s.Equal(schema.String, parsed.LookUpField("Balance").DataType)
+	s.Equal(schema.Bytes, parsed.LookUpField("Name").DataType)
+}
+
+func (s *SerializerTestSuite) TestItCanRoundTripValueObjectFields() {
+	email, err := web.NewEmail("jane@example.com")
+	s.Require().NoError(err)
+	country, err := geography.NewCountryCode("RO")
+	s.Require().NoError(err)
+	name, err := person.NewFullName("Jane", "", "Doe")
+	s.Require().NoError(err)
+
+	parsed := s.parse(&serializedCustomer{})
+	customer := serializedCustomer{Email: email, Country: &country, Name: name}
+	model := reflect.ValueOf(&customer).Elem()
+
+	var scanned serializedCustomer
+	for _, fieldName := range []string{"Email", "Country", "Name"} {
+		field := parsed.LookUpField(fieldName)
+		fieldValue, _ := field.ValueOf(s.ctx, model)
+		value, err := fieldValue.(driver.Valuer).Value()
+		s.Require().NoError(err)
+		s.Require().NoError(
+			field.Serializer.Scan(s.ctx, field, reflect.ValueOf(&scanned).Elem(), value),
+		)
+	}
+
+	s.True(email.Equals(scanned.Email))
+	s.Require().NotNil(scanned.Country)
+	s.True(country.Equals(*scanned.Country))
+	s.True(name.Equals(scanned.Name))
+}
+
+func (s *SerializerTestSuite) TestItMapsZeroValuesAndNilPointersToNull() {
+	parsed := s.parse(&serializedCustomer{})
+	var customer serializedCustomer
+	model := reflect.ValueOf(&customer).Elem()
+
+	for _, fieldName := range []string{"Email", "Country", "Name"} {
+		field := parsed.LookUpField(fieldName)
+		fieldValue, _ := field.ValueOf(s.ctx, model)
+		value, err := fieldValue.(driver.Valuer).Value()
+		s.Require().NoError(err, fieldName)
+		s.Nil(value, fieldName)
+	}
+
+	country := geography.ReconstituteCountryCode("RO")
+	customer.Country = &country
+	field := parsed.LookUpField("Country")
+	s.Require().NoError(field.Serializer.Scan(s.ctx, field, model, nil))
+	s.Nil(customer.Country)
+}
+
+func (s *SerializerTestSuite) TestItValidatesWhenScanning() {
+	parsed := s.parse(&serializedCustomer{})
+	var customer serializedCustomer
+	model := reflect.ValueOf(&customer).Elem()
+
+	field := parsed.LookUpField("Country")
+	s.ErrorIs(field.Serializer.Scan(s.ctx, field, model, "XYZ"), geography.ErrInvalidCountryCode)
+
+	field = parsed.LookUpField("Email")
+	s.ErrorIs(field.Serializer.Scan(s.ctx, field, model, 3.14), ErrUnsupportedColumnScan)
+}
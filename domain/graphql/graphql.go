@@ -0,0 +1,269 @@
+// Package graphql provides gqlgen marshalers for the value objects, so GraphQL schemas can
+// declare them as custom scalars backed by the validated types.
+//
+// Declare the scalar in the schema and map it to this package in gqlgen.yml; gqlgen finds the
+// Marshal<Name> and Unmarshal<Name> functions by the model name:
+//
+//	scalar Email
+//
+//	models:
+//	  Email:
+//	    model: github.com/golibry/go-common-domain/domain/graphql.Email
+//
+// Every scalar is serialized as a string. Identifiers follow the GraphQL ID convention (a
+// string on output, a string or an integer on input) because GraphQL Int is only 32 bits wide.
+// Money is serialized as its string form, e.g., "10.5 USD". Zero values are serialized as null,
+// except for InterestRate where 0% is meaningful, and every input is validated. Secrets
+// (passwords, tokens) have no scalar on purpose.
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+)
+
+var (
+	ErrNotAString     = domain.NewError("scalar input must be a string")
+	ErrInvalidIDInput = domain.NewError("ID input must be a string or an integer")
+)
+
+// MarshalEmail serializes the email as a string
+func MarshalEmail(email web.Email) gqlgen.Marshaler {
+	return marshalString(email.Value())
+}
+
+// UnmarshalEmail parses and validates an email input
+func UnmarshalEmail(v any) (web.Email, error) {
+	return unmarshalString(v, web.NewEmail)
+}
+
+// MarshalURL serializes the URL as a string
+func MarshalURL(url web.URL) gqlgen.Marshaler {
+	return marshalString(url.Value())
+}
+
+// UnmarshalURL parses and validates a URL input
+func UnmarshalURL(v any) (web.URL, error) {
+	return unmarshalString(v, web.NewURL)
+}
+
+// MarshalDomainName serializes the domain name as a string
+func MarshalDomainName(domainName web.DomainName) gqlgen.Marshaler {
+	return marshalString(domainName.Value())
+}
+
+// UnmarshalDomainName parses and validates a domain name input
+func UnmarshalDomainName(v any) (web.DomainName, error) {
+	return unmarshalString(v, web.NewDomainName)
+}
+
+// MarshalIPAddress serializes the IP address as a string
+func MarshalIPAddress(ip web.IPAddress) gqlgen.Marshaler {
+	return marshalString(ip.Value())
+}
+
+// UnmarshalIPAddress parses and validates an IP address input
+func UnmarshalIPAddress(v any) (web.IPAddress, error) {
+	return unmarshalString(v, web.NewIPAddress)
+}
+
+// MarshalCountryCode serializes the country code as a string
+func MarshalCountryCode(country geography.CountryCode) gqlgen.Marshaler {
+	return marshalString(country.Value())
+}
+
+// UnmarshalCountryCode parses and validates a country code input
+func UnmarshalCountryCode(v any) (geography.CountryCode, error) {
+	return unmarshalString(v, geography.NewCountryCode)
+}
+
+// MarshalPhoneNumber serializes the phone number in its string form, including the extension
+func MarshalPhoneNumber(phone contact.PhoneNumber) gqlgen.Marshaler {
+	if phone == (contact.PhoneNumber{}) {
+		return gqlgen.Null
+	}
+	return gqlgen.MarshalString(phone.String())
+}
+
+// UnmarshalPhoneNumber parses and validates a phone number input
+func UnmarshalPhoneNumber(v any) (contact.PhoneNumber, error) {
+	return unmarshalString(v, contact.NewPhoneNumber)
+}
+
+// MarshalUsername serializes the username as a string
+func MarshalUsername(username auth.Username) gqlgen.Marshaler {
+	return marshalString(username.Value())
+}
+
+// UnmarshalUsername parses and validates a username input
+func UnmarshalUsername(v any) (auth.Username, error) {
+	return unmarshalString(v, auth.NewUsername)
+}
+
+// MarshalIntIdentifier serializes the identifier as an ID string
+func MarshalIntIdentifier(id identifier.IntIdentifier) gqlgen.Marshaler {
+	if id.Value() == 0 {
+		return gqlgen.Null
+	}
+	return gqlgen.MarshalString(id.String())
+}
+
+// UnmarshalIntIdentifier parses and validates an ID input given as a string or an integer
+func UnmarshalIntIdentifier(v any) (identifier.IntIdentifier, error) {
+	return unmarshalID(v, identifier.NewIntIdentifierFromString)
+}
+
+// MarshalSnowflake serializes the snowflake as an ID string
+func MarshalSnowflake(snowflake identifier.Snowflake) gqlgen.Marshaler {
+	if snowflake.Value() == 0 {
+		return gqlgen.Null
+	}
+	return gqlgen.MarshalString(snowflake.String())
+}
+
+// UnmarshalSnowflake parses and validates an ID input given as a string or an integer
+func UnmarshalSnowflake(v any) (identifier.Snowflake, error) {
+	return unmarshalID(v, identifier.NewSnowflakeFromString)
+}
+
+// MarshalUUID serializes the UUID in its canonical string form
+func MarshalUUID(uuid identifier.UUID) gqlgen.Marshaler {
+	if uuid == (identifier.UUID{}) {
+		return gqlgen.Null
+	}
+	return gqlgen.MarshalString(uuid.String())
+}
+
+// UnmarshalUUID parses and validates a UUID input
+func UnmarshalUUID(v any) (identifier.UUID, error) {
+	return unmarshalString(v, identifier.NewUUIDFromString)
+}
+
+// MarshalULID serializes the ULID in its canonical string form
+func MarshalULID(ulid identifier.ULID) gqlgen.Marshaler {
+	if ulid == (identifier.ULID{}) {
+		return gqlgen.Null
+	}
+	return gqlgen.MarshalString(ulid.String())
+}
+
+// UnmarshalULID parses and validates a ULID input
+func UnmarshalULID(v any) (identifier.ULID, error) {
+	return unmarshalString(v, identifier.NewULIDFromString)
+}
+
+// MarshalComposite serializes the composite identifier in its string form
+func MarshalComposite(composite identifier.Composite) gqlgen.Marshaler {
+	return marshalString(composite.String())
+}
+
+// UnmarshalComposite parses and validates a composite identifier input
+func UnmarshalComposite(v any) (identifier.Composite, error) {
+	return unmarshalString(v, identifier.NewCompositeFromString)
+}
+
+// MarshalCurrency serializes the currency as its code
+func MarshalCurrency(currency finance.Currency) gqlgen.Marshaler {
+	return marshalString(currency.Value())
+}
+
+// UnmarshalCurrency parses and validates a currency code input
+func UnmarshalCurrency(v any) (finance.Currency, error) {
+	return unmarshalString(v, finance.NewCurrency)
+}
+
+// MarshalMoney serializes the money as an amount followed by a currency code, e.g., "10.5 USD"
+func MarshalMoney(money finance.Money) gqlgen.Marshaler {
+	if money.Equals(finance.Money{}) {
+		return gqlgen.Null
+	}
+	return gqlgen.MarshalString(money.String())
+}
+
+// UnmarshalMoney parses and validates a money input such as "10.50 USD"
+func UnmarshalMoney(v any) (finance.Money, error) {
+	return unmarshalString(v, finance.ParseMoney)
+}
+
+// MarshalInterestRate serializes the annual percentage as a decimal string (0% is not null)
+func MarshalInterestRate(rate finance.InterestRate) gqlgen.Marshaler {
+	return gqlgen.MarshalString(rate.AnnualPercent().String())
+}
+
+// UnmarshalInterestRate parses and validates an annual percentage input given as a string
+func UnmarshalInterestRate(v any) (finance.InterestRate, error) {
+	return unmarshalString(v, finance.NewInterestRateFromString)
+}
+
+// MarshalVATNumber serializes the VAT number as a string
+func MarshalVATNumber(vat finance.VATNumber) gqlgen.Marshaler {
+	return marshalString(vat.Value())
+}
+
+// UnmarshalVATNumber parses and validates a VAT number input
+func UnmarshalVATNumber(v any) (finance.VATNumber, error) {
+	return unmarshalString(v, finance.NewVATNumber)
+}
+
+// MarshalISIN serializes the ISIN as a string
+func MarshalISIN(isin finance.ISIN) gqlgen.Marshaler {
+	return marshalString(isin.Value())
+}
+
+// UnmarshalISIN parses and validates an ISIN input
+func UnmarshalISIN(v any) (finance.ISIN, error) {
+	return unmarshalString(v, finance.NewISIN)
+}
+
+// MarshalCUSIP serializes the CUSIP as a string
+func MarshalCUSIP(cusip finance.CUSIP) gqlgen.Marshaler {
+	return marshalString(cusip.Value())
+}
+
+// UnmarshalCUSIP parses and validates a CUSIP input
+func UnmarshalCUSIP(v any) (finance.CUSIP, error) {
+	return unmarshalString(v, finance.NewCUSIP)
+}
+
+// marshalString serializes a string value, or null for the zero value
+func marshalString(value string) gqlgen.Marshaler {
+	if value == "" {
+		return gqlgen.Null
+	}
+	return gqlgen.MarshalString(value)
+}
+
+// unmarshalString validates a string input with the value object's constructor
+func unmarshalString[T any](v any, parse func(string) (T, error)) (T, error) {
+	value, ok := v.(string)
+	if !ok {
+		var zero T
+		return zero, ErrNotAString
+	}
+	return parse(value)
+}
+
+// unmarshalID validates an ID input, which GraphQL allows as either a string or an integer.
+// Numeric variables arrive as json.Number and inline literals as int64.
+func unmarshalID[T any](v any, parse func(string) (T, error)) (T, error) {
+	switch value := v.(type) {
+	case string:
+		return parse(value)
+	case json.Number:
+		return parse(value.String())
+	case int, int32, int64, uint, uint32, uint64:
+		return parse(fmt.Sprint(value))
+	default:
+		var zero T
+		return zero, ErrInvalidIDInput
+	}
+}
@@ -0,0 +1,130 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type GraphQLTestSuite struct {
+	suite.Suite
+}
+
+func TestGraphQLSuite(t *testing.T) {
+	suite.Run(t, new(GraphQLTestSuite))
+}
+
+func (s *GraphQLTestSuite) write(marshaler gqlgen.Marshaler) string {
+	var buffer bytes.Buffer
+	marshaler.MarshalGQL(&buffer)
+	return buffer.String()
+}
+
+func (s *GraphQLTestSuite) TestItCanRoundTripScalars() {
+	email, err := UnmarshalEmail("Jane@Example.com")
+	s.Require().NoError(err)
+	s.Equal(`"jane@example.com"`, s.write(MarshalEmail(email)))
+
+	country, err := UnmarshalCountryCode("us")
+	s.Require().NoError(err)
+	s.Equal(`"US"`, s.write(MarshalCountryCode(country)))
+
+	phone, err := UnmarshalPhoneNumber("+1 234 567 8900 ext. 12")
+	s.Require().NoError(err)
+	s.Equal(`"+12345678900 ext. 12"`, s.write(MarshalPhoneNumber(phone)))
+
+	uuid, err := UnmarshalUUID("0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b")
+	s.Require().NoError(err)
+	s.Equal(`"0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b"`, s.write(MarshalUUID(uuid)))
+
+	money, err := UnmarshalMoney("10.50 EUR")
+	s.Require().NoError(err)
+	s.Equal(`"10.5 EUR"`, s.write(MarshalMoney(money)))
+
+	rate, err := UnmarshalInterestRate("4.25")
+	s.Require().NoError(err)
+	s.Equal(`"4.25"`, s.write(MarshalInterestRate(rate)))
+}
+
+func (s *GraphQLTestSuite) TestItAcceptsIdentifiersAsStringsOrIntegers() {
+	inputs := []any{"9007199254740993", json.Number("9007199254740993"), int64(9007199254740993)}
+
+	for _, input := range inputs {
+		id, err := UnmarshalIntIdentifier(input)
+		s.Require().NoError(err)
+		s.Equal(uint64(9007199254740993), id.Value())
+		s.Equal(`"9007199254740993"`, s.write(MarshalIntIdentifier(id)))
+	}
+
+	snowflake, err := UnmarshalSnowflake(json.Number("1541815603606036480"))
+	s.Require().NoError(err)
+	s.Equal(`"1541815603606036480"`, s.write(MarshalSnowflake(snowflake)))
+}
+
+func (s *GraphQLTestSuite) TestItValidatesInputs() {
+	testCases := []struct {
+		name      string
+		unmarshal func() error
+		expected  error
+	}{
+		{
+			"Invalid email", func() error {
+				_, err := UnmarshalEmail("not-an-email")
+				return err
+			}, web.ErrMissingAtSymbol,
+		},
+		{
+			"Email given as a number", func() error {
+				_, err := UnmarshalEmail(42)
+				return err
+			}, ErrNotAString,
+		},
+		{
+			"Negative identifier", func() error {
+				_, err := UnmarshalIntIdentifier(int64(-1))
+				return err
+			}, identifier.ErrInvalidIdentifier,
+		},
+		{
+			"Identifier given as a boolean", func() error {
+				_, err := UnmarshalIntIdentifier(true)
+				return err
+			}, ErrInvalidIDInput,
+		},
+		{
+			"Money without a currency", func() error {
+				_, err := UnmarshalMoney("10.50")
+				return err
+			}, finance.ErrInvalidMoney,
+		},
+		{
+			"Invalid phone number", func() error {
+				_, err := UnmarshalPhoneNumber("12")
+				return err
+			}, contact.ErrTooShortPhoneNumber,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				s.ErrorIs(tc.unmarshal(), tc.expected)
+			},
+		)
+	}
+}
+
+func (s *GraphQLTestSuite) TestItSerializesZeroValuesAsNull() {
+	s.Equal("null", s.write(MarshalEmail(web.Email{})))
+	s.Equal("null", s.write(MarshalIntIdentifier(identifier.IntIdentifier{})))
+	s.Equal("null", s.write(MarshalUUID(identifier.UUID{})))
+	s.Equal("null", s.write(MarshalPhoneNumber(contact.PhoneNumber{})))
+	s.Equal("null", s.write(MarshalMoney(finance.Money{})))
+}
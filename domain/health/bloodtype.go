@@ -0,0 +1,133 @@
+package health
+
+import (
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyBloodType = domain.NewLocalizedError(
+		"health.blood_type.empty", nil,
+		"blood type cannot be empty",
+	)
+	ErrInvalidBloodType = domain.NewLocalizedError(
+		"health.blood_type.invalid", nil,
+		"blood type must be one of A, B, AB or O followed by + or -",
+	)
+)
+
+// rhSuffixes maps the ways an Rh factor is written, after upper casing, to its sign
+var rhSuffixes = []struct {
+	suffix string
+	sign   string
+}{
+	{"POSITIVE", "+"}, {"NEGATIVE", "-"}, {"POS", "+"}, {"NEG", "-"}, {"+", "+"}, {"-", "-"},
+}
+
+// BloodType is an ABO blood group with its Rh(D) factor, e.g., "AB+" or "O-"
+type BloodType struct {
+	value string
+}
+
+// NewBloodType creates a new instance of BloodType with validation and normalization. Besides
+// "A+" it accepts spaced, lower case and spelled out forms such as "ab neg", "O Rh+" or
+// "B positive".
+func NewBloodType(value string) (BloodType, error) {
+	normalized, err := NormalizeBloodType(value)
+	if err != nil {
+		return BloodType{}, err
+	}
+
+	return BloodType{
+		value: normalized,
+	}, nil
+}
+
+// ReconstituteBloodType creates a new BloodType instance without validation or normalization
+func ReconstituteBloodType(value string) BloodType {
+	return BloodType{
+		value: value,
+	}
+}
+
+// NormalizeBloodType normalizes a blood type to its short form, e.g., "AB-", and validates it
+func NormalizeBloodType(value string) (string, error) {
+	value = strings.ToUpper(strings.Join(strings.Fields(value), ""))
+	if value == "" {
+		return "", ErrEmptyBloodType
+	}
+
+	for _, rh := range rhSuffixes {
+		if group, found := strings.CutSuffix(value, rh.suffix); found {
+			group = strings.TrimSuffix(group, "RH")
+			switch group {
+			case "A", "B", "AB", "O":
+				return group + rh.sign, nil
+			}
+			return "", ErrInvalidBloodType
+		}
+	}
+
+	return "", ErrInvalidBloodType
+}
+
+// Value returns the blood type in its short form, e.g., "AB-"
+func (b BloodType) Value() string {
+	return b.value
+}
+
+// Group returns the ABO blood group: "A", "B", "AB" or "O"
+func (b BloodType) Group() string {
+	return strings.TrimRight(b.value, "+-")
+}
+
+// RhPositive reports whether the blood carries the Rh(D) antigen
+func (b BloodType) RhPositive() bool {
+	return strings.HasSuffix(b.value, "+")
+}
+
+// CanDonateTo reports whether red blood cells of this type can be transfused to the recipient:
+// the donor must carry no A, B or Rh(D) antigen the recipient lacks, so O- is the universal
+// donor and AB+ the universal recipient
+func (b BloodType) CanDonateTo(recipient BloodType) bool {
+	donorGroup, recipientGroup := b.Group(), recipient.Group()
+	for _, antigen := range []string{"A", "B"} {
+		if strings.Contains(donorGroup, antigen) && !strings.Contains(recipientGroup, antigen) {
+			return false
+		}
+	}
+	return !b.RhPositive() || recipient.RhPositive()
+}
+
+// CanReceiveFrom reports whether red blood cells of the donor type can be transfused to this
+// type
+func (b BloodType) CanReceiveFrom(donor BloodType) bool {
+	return donor.CanDonateTo(b)
+}
+
+// Equals compares two BloodType objects for equality
+func (b BloodType) Equals(other BloodType) bool {
+	return b.value == other.value
+}
+
+// String returns a string representation of the blood type
+func (b BloodType) String() string {
+	return b.value
+}
+
+// UnmarshalText parses the blood type from text, with validation and normalization. Empty
+// text leaves the value unchanged.
+func (b *BloodType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewBloodType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*b = parsed
+	return nil
+}
@@ -0,0 +1,115 @@
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BloodTypeTestSuite struct {
+	suite.Suite
+}
+
+func TestBloodTypeSuite(t *testing.T) {
+	suite.Run(t, new(BloodTypeTestSuite))
+}
+
+func (s *BloodTypeTestSuite) TestItCanBuildNewBloodTypeWithValidValues() {
+	testCases := []struct {
+		value      string
+		expected   string
+		group      string
+		rhPositive bool
+	}{
+		{"A+", "A+", "A", true},
+		{"o-", "O-", "O", false},
+		{" AB + ", "AB+", "AB", true},
+		{"ab neg", "AB-", "AB", false},
+		{"O Rh+", "O+", "O", true},
+		{"B positive", "B+", "B", true},
+		{"A Negative", "A-", "A", false},
+		{"bpos", "B+", "B", true},
+	}
+
+	for _, tc := range testCases {
+		bloodType, err := NewBloodType(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, bloodType.Value())
+		s.Equal(tc.expected, bloodType.String())
+		s.Equal(tc.group, bloodType.Group())
+		s.Equal(tc.rhPositive, bloodType.RhPositive())
+	}
+}
+
+func (s *BloodTypeTestSuite) TestItFailsToBuildNewBloodTypeWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyBloodType},
+		{"  ", ErrEmptyBloodType},
+		{"A", ErrInvalidBloodType},
+		{"C+", ErrInvalidBloodType},
+		{"BA+", ErrInvalidBloodType},
+		{"A+-", ErrInvalidBloodType},
+		{"AB plus", ErrInvalidBloodType},
+		{"+", ErrInvalidBloodType},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewBloodType(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *BloodTypeTestSuite) TestItChecksRedCellCompatibility() {
+	compatibleRecipients := map[string][]string{
+		"O-":  {"O-", "O+", "A-", "A+", "B-", "B+", "AB-", "AB+"},
+		"O+":  {"O+", "A+", "B+", "AB+"},
+		"A-":  {"A-", "A+", "AB-", "AB+"},
+		"A+":  {"A+", "AB+"},
+		"B-":  {"B-", "B+", "AB-", "AB+"},
+		"B+":  {"B+", "AB+"},
+		"AB-": {"AB-", "AB+"},
+		"AB+": {"AB+"},
+	}
+
+	for donorValue, recipients := range compatibleRecipients {
+		donor, _ := NewBloodType(donorValue)
+		for recipientValue := range compatibleRecipients {
+			recipient, _ := NewBloodType(recipientValue)
+			expected := false
+			for _, compatible := range recipients {
+				expected = expected || compatible == recipientValue
+			}
+
+			s.Equal(expected, donor.CanDonateTo(recipient), donorValue+" to "+recipientValue)
+			s.Equal(expected, recipient.CanReceiveFrom(donor), recipientValue+" from "+donorValue)
+		}
+	}
+}
+
+func (s *BloodTypeTestSuite) TestItCanReconstituteBloodType() {
+	bloodType := ReconstituteBloodType("unknown")
+	s.Equal("unknown", bloodType.Value())
+}
+
+func (s *BloodTypeTestSuite) TestItComparesBloodTypes() {
+	first, _ := NewBloodType("AB+")
+	second, _ := NewBloodType("ab positive")
+	third, _ := NewBloodType("AB-")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *BloodTypeTestSuite) TestItUnmarshalsText() {
+	var bloodType BloodType
+	s.NoError(bloodType.UnmarshalText([]byte("o neg")))
+	s.Equal("O-", bloodType.Value())
+
+	s.NoError(bloodType.UnmarshalText(nil))
+	s.Equal("O-", bloodType.Value())
+
+	s.ErrorIs(bloodType.UnmarshalText([]byte("Z+")), ErrInvalidBloodType)
+}
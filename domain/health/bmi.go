@@ -0,0 +1,81 @@
+package health
+
+import (
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var ErrMissingBMIMeasurement = domain.NewError("BMI needs a height and a weight above zero")
+
+// BMICategory is the WHO classification of an adult BMI
+type BMICategory int
+
+const (
+	BMIUnderweight BMICategory = iota
+	BMINormal
+	BMIOverweight
+	BMIObese
+)
+
+// String returns the name of the category, e.g., "overweight"
+func (c BMICategory) String() string {
+	switch c {
+	case BMIUnderweight:
+		return "underweight"
+	case BMINormal:
+		return "normal"
+	case BMIOverweight:
+		return "overweight"
+	default:
+		return "obese"
+	}
+}
+
+// BMI is a body mass index, the weight in kilograms divided by the square of the height in
+// meters
+type BMI struct {
+	value float64
+}
+
+// ComputeBMI computes the body mass index of a person
+func ComputeBMI(height Height, weight Weight) (BMI, error) {
+	if height.millimeters <= 0 || weight.grams <= 0 {
+		return BMI{}, ErrMissingBMIMeasurement
+	}
+
+	meters := height.Meters()
+	return BMI{
+		value: weight.Kilograms() / (meters * meters),
+	}, nil
+}
+
+// Value returns the unrounded body mass index
+func (b BMI) Value() float64 {
+	return b.value
+}
+
+// Category returns the WHO category of the index for adults: underweight below 18.5, normal
+// below 25, overweight below 30 and obese from 30. It does not apply to children.
+func (b BMI) Category() BMICategory {
+	switch {
+	case b.value < 18.5:
+		return BMIUnderweight
+	case b.value < 25:
+		return BMINormal
+	case b.value < 30:
+		return BMIOverweight
+	default:
+		return BMIObese
+	}
+}
+
+// Equals compares two BMI objects for equality
+func (b BMI) Equals(other BMI) bool {
+	return b.value == other.value
+}
+
+// String returns the index rounded to one decimal, e.g., "22.9"
+func (b BMI) String() string {
+	return strconv.FormatFloat(b.value, 'f', 1, 64)
+}
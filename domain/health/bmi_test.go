@@ -0,0 +1,90 @@
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BMITestSuite struct {
+	suite.Suite
+}
+
+func TestBMISuite(t *testing.T) {
+	suite.Run(t, new(BMITestSuite))
+}
+
+func (s *BMITestSuite) TestItComputesBMI() {
+	height, _ := NewHeight(180, Centimeter)
+	weight, _ := NewWeight(75, Kilogram)
+
+	bmi, err := ComputeBMI(height, weight)
+	s.NoError(err)
+	s.InDelta(23.148, bmi.Value(), 0.001)
+	s.Equal("23.1", bmi.String())
+	s.Equal(BMINormal, bmi.Category())
+}
+
+func (s *BMITestSuite) TestItComputesBMIFromImperialUnits() {
+	height, _ := NewHeight(70, Inch)
+	weight, _ := NewWeight(200, Pound)
+
+	bmi, err := ComputeBMI(height, weight)
+	s.NoError(err)
+	s.Equal("28.7", bmi.String())
+	s.Equal(BMIOverweight, bmi.Category())
+}
+
+func (s *BMITestSuite) TestItClassifiesBMI() {
+	height, _ := NewHeight(1, Meter)
+	testCases := []struct {
+		kilograms float64
+		expected  BMICategory
+	}{
+		{18.4, BMIUnderweight},
+		{18.5, BMINormal},
+		{24.9, BMINormal},
+		{25, BMIOverweight},
+		{29.9, BMIOverweight},
+		{30, BMIObese},
+		{45, BMIObese},
+	}
+
+	for _, tc := range testCases {
+		weight, _ := NewWeight(tc.kilograms, Kilogram)
+		bmi, err := ComputeBMI(height, weight)
+		s.Require().NoError(err)
+		s.Equal(tc.expected, bmi.Category(), tc.kilograms)
+	}
+}
+
+func (s *BMITestSuite) TestItRequiresBothMeasurements() {
+	height, _ := NewHeight(180, Centimeter)
+	weight, _ := NewWeight(75, Kilogram)
+
+	_, err := ComputeBMI(Height{}, weight)
+	s.ErrorIs(err, ErrMissingBMIMeasurement)
+
+	_, err = ComputeBMI(height, Weight{})
+	s.ErrorIs(err, ErrMissingBMIMeasurement)
+}
+
+func (s *BMITestSuite) TestItNamesCategories() {
+	s.Equal("underweight", BMIUnderweight.String())
+	s.Equal("normal", BMINormal.String())
+	s.Equal("overweight", BMIOverweight.String())
+	s.Equal("obese", BMIObese.String())
+}
+
+func (s *BMITestSuite) TestItComparesBMI() {
+	height, _ := NewHeight(180, Centimeter)
+	first, _ := NewWeight(75, Kilogram)
+	second, _ := NewWeight(80, Kilogram)
+
+	bmi, _ := ComputeBMI(height, first)
+	same, _ := ComputeBMI(height, first)
+	other, _ := ComputeBMI(height, second)
+
+	s.True(bmi.Equals(same))
+	s.False(bmi.Equals(other))
+}
@@ -0,0 +1,79 @@
+package health
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// LengthUnit is a unit of length, in millimeters
+type LengthUnit float64
+
+// Length units
+const (
+	Millimeter LengthUnit = 1
+	Centimeter LengthUnit = 10
+	Meter      LengthUnit = 1000
+	Inch       LengthUnit = 25.4
+	Foot       LengthUnit = 12 * Inch
+)
+
+// MaxHeightCentimeters is the tallest height accepted, well above any recorded human height
+const MaxHeightCentimeters = 300
+
+var ErrInvalidHeight = domain.NewLocalizedError(
+	"health.height.invalid", domain.MessageParams{"max": MaxHeightCentimeters},
+	"height must be greater than zero and at most %d cm",
+	MaxHeightCentimeters,
+)
+
+// Height is the height of a person, stored to the millimeter
+type Height struct {
+	millimeters int64
+}
+
+// NewHeight creates a new instance of Height from a value in a unit, e.g., NewHeight(5.9,
+// Foot), rounded to the nearest millimeter
+func NewHeight(value float64, unit LengthUnit) (Height, error) {
+	millimeters := math.Round(value * float64(unit))
+	if !(millimeters > 0 && millimeters <= MaxHeightCentimeters*float64(Centimeter)) {
+		return Height{}, ErrInvalidHeight
+	}
+
+	return Height{
+		millimeters: int64(millimeters),
+	}, nil
+}
+
+// ReconstituteHeight creates a new Height instance from millimeters without validation
+func ReconstituteHeight(millimeters int64) Height {
+	return Height{
+		millimeters: millimeters,
+	}
+}
+
+// Millimeters returns the height in millimeters
+func (h Height) Millimeters() int64 {
+	return h.millimeters
+}
+
+// In returns the height in a unit, e.g., h.In(Inch)
+func (h Height) In(unit LengthUnit) float64 {
+	return float64(h.millimeters) / float64(unit)
+}
+
+// Meters returns the height in meters
+func (h Height) Meters() float64 {
+	return h.In(Meter)
+}
+
+// Equals compares two Height objects for equality
+func (h Height) Equals(other Height) bool {
+	return h.millimeters == other.millimeters
+}
+
+// String returns the height in centimeters, e.g., "180.5 cm"
+func (h Height) String() string {
+	return strconv.FormatFloat(h.In(Centimeter), 'f', -1, 64) + " cm"
+}
@@ -0,0 +1,67 @@
+package health
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HeightTestSuite struct {
+	suite.Suite
+}
+
+func TestHeightSuite(t *testing.T) {
+	suite.Run(t, new(HeightTestSuite))
+}
+
+func (s *HeightTestSuite) TestItCanBuildNewHeightInAnyUnit() {
+	testCases := []struct {
+		value       float64
+		unit        LengthUnit
+		millimeters int64
+	}{
+		{180, Centimeter, 1800},
+		{1.755, Meter, 1755},
+		{1805, Millimeter, 1805},
+		{70, Inch, 1778},
+		{6, Foot, 1829},
+		{300, Centimeter, 3000},
+	}
+
+	for _, tc := range testCases {
+		height, err := NewHeight(tc.value, tc.unit)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.millimeters, height.Millimeters())
+	}
+}
+
+func (s *HeightTestSuite) TestItFailsToBuildNewHeightOutOfRange() {
+	for _, value := range []float64{0, -170, 0.04, 300.1, math.NaN(), math.Inf(1)} {
+		_, err := NewHeight(value, Centimeter)
+		s.ErrorIs(err, ErrInvalidHeight, value)
+	}
+}
+
+func (s *HeightTestSuite) TestItConvertsBetweenUnits() {
+	height, _ := NewHeight(180.5, Centimeter)
+
+	s.InDelta(1.805, height.Meters(), 1e-9)
+	s.InDelta(180.5, height.In(Centimeter), 1e-9)
+	s.InDelta(71.06, height.In(Inch), 0.01)
+	s.Equal("180.5 cm", height.String())
+}
+
+func (s *HeightTestSuite) TestItCanReconstituteHeight() {
+	height := ReconstituteHeight(-1)
+	s.Equal(int64(-1), height.Millimeters())
+}
+
+func (s *HeightTestSuite) TestItComparesHeights() {
+	first, _ := NewHeight(6, Foot)
+	second, _ := NewHeight(182.9, Centimeter)
+	third, _ := NewHeight(183, Centimeter)
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
@@ -0,0 +1,79 @@
+package health
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MassUnit is a unit of mass, in grams
+type MassUnit float64
+
+// Mass units
+const (
+	Gram     MassUnit = 1
+	Kilogram MassUnit = 1000
+	Pound    MassUnit = 453.59237
+	Ounce    MassUnit = Pound / 16
+	Stone    MassUnit = 14 * Pound
+)
+
+// MaxWeightKilograms is the heaviest weight accepted, well above any recorded human weight
+const MaxWeightKilograms = 700
+
+var ErrInvalidWeight = domain.NewLocalizedError(
+	"health.weight.invalid", domain.MessageParams{"max": MaxWeightKilograms},
+	"weight must be greater than zero and at most %d kg",
+	MaxWeightKilograms,
+)
+
+// Weight is the body weight of a person, stored to the gram
+type Weight struct {
+	grams int64
+}
+
+// NewWeight creates a new instance of Weight from a value in a unit, e.g., NewWeight(165,
+// Pound), rounded to the nearest gram
+func NewWeight(value float64, unit MassUnit) (Weight, error) {
+	grams := math.Round(value * float64(unit))
+	if !(grams > 0 && grams <= MaxWeightKilograms*float64(Kilogram)) {
+		return Weight{}, ErrInvalidWeight
+	}
+
+	return Weight{
+		grams: int64(grams),
+	}, nil
+}
+
+// ReconstituteWeight creates a new Weight instance from grams without validation
+func ReconstituteWeight(grams int64) Weight {
+	return Weight{
+		grams: grams,
+	}
+}
+
+// Grams returns the weight in grams
+func (w Weight) Grams() int64 {
+	return w.grams
+}
+
+// In returns the weight in a unit, e.g., w.In(Pound)
+func (w Weight) In(unit MassUnit) float64 {
+	return float64(w.grams) / float64(unit)
+}
+
+// Kilograms returns the weight in kilograms
+func (w Weight) Kilograms() float64 {
+	return w.In(Kilogram)
+}
+
+// Equals compares two Weight objects for equality
+func (w Weight) Equals(other Weight) bool {
+	return w.grams == other.grams
+}
+
+// String returns the weight in kilograms, e.g., "72.5 kg"
+func (w Weight) String() string {
+	return strconv.FormatFloat(w.Kilograms(), 'f', -1, 64) + " kg"
+}
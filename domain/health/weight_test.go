@@ -0,0 +1,66 @@
+package health
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WeightTestSuite struct {
+	suite.Suite
+}
+
+func TestWeightSuite(t *testing.T) {
+	suite.Run(t, new(WeightTestSuite))
+}
+
+func (s *WeightTestSuite) TestItCanBuildNewWeightInAnyUnit() {
+	testCases := []struct {
+		value float64
+		unit  MassUnit
+		grams int64
+	}{
+		{72.5, Kilogram, 72500},
+		{3250, Gram, 3250},
+		{165, Pound, 74843},
+		{11, Stone, 69853},
+		{120, Ounce, 3402},
+		{700, Kilogram, 700000},
+	}
+
+	for _, tc := range testCases {
+		weight, err := NewWeight(tc.value, tc.unit)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.grams, weight.Grams())
+	}
+}
+
+func (s *WeightTestSuite) TestItFailsToBuildNewWeightOutOfRange() {
+	for _, value := range []float64{0, -70, 0.0004, 700.001, math.NaN(), math.Inf(1)} {
+		_, err := NewWeight(value, Kilogram)
+		s.ErrorIs(err, ErrInvalidWeight, value)
+	}
+}
+
+func (s *WeightTestSuite) TestItConvertsBetweenUnits() {
+	weight, _ := NewWeight(72.5, Kilogram)
+
+	s.InDelta(72.5, weight.Kilograms(), 1e-9)
+	s.InDelta(159.84, weight.In(Pound), 0.01)
+	s.Equal("72.5 kg", weight.String())
+}
+
+func (s *WeightTestSuite) TestItCanReconstituteWeight() {
+	weight := ReconstituteWeight(-1)
+	s.Equal(int64(-1), weight.Grams())
+}
+
+func (s *WeightTestSuite) TestItComparesWeights() {
+	first, _ := NewWeight(1, Kilogram)
+	second, _ := NewWeight(1000, Gram)
+	third, _ := NewWeight(1, Pound)
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
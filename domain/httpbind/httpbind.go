@@ -0,0 +1,240 @@
+// Package httpbind binds HTTP request parameters directly into value objects, so handlers can
+// accept an Email, an IntIdentifier or a CountryCode without parsing strings by hand.
+//
+// A Binder reads path, query, form and JSON body parameters and collects every failure, so a
+// single response can report all invalid fields at once:
+//
+//	bind := httpbind.New(r)
+//	id := httpbind.Path[identifier.IntIdentifier](bind, "id", httpbind.Required())
+//	email := httpbind.JSON[web.Email](bind, "email", httpbind.Required())
+//	country := httpbind.Query[geography.CountryCode](bind, "country")
+//	if err := bind.Err(); err != nil {
+//		writeProblem(w, http.StatusBadRequest, bind.Errors())
+//		return
+//	}
+//
+// It works with any router exposing the *http.Request. Path parameters are read with
+// http.Request.PathValue unless WithPathParams supplies the router's own lookup:
+//
+//	httpbind.New(r, httpbind.WithPathParams(func(name string) string {
+//		return chi.URLParam(r, name)
+//	}))
+//	httpbind.New(c.Request(), httpbind.WithPathParams(c.Param)) // Echo
+//	httpbind.New(c.Request, httpbind.WithPathParams(c.Param))   // Gin
+//
+// Missing or empty parameters bind to the zero value unless Required is given.
+package httpbind
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	// MaxJSONBodySize is the largest JSON body read by JSON, in bytes
+	MaxJSONBodySize = 1 << 20
+	// MaxFormMemory is the part of a multipart form body held in memory by Form, in bytes,
+	// matching the net/http default
+	MaxFormMemory = 32 << 20
+)
+
+// Parameter sources, recorded in the "source" field of binding errors
+const (
+	SourcePath  = "path"
+	SourceQuery = "query"
+	SourceForm  = "form"
+	SourceJSON  = "json"
+)
+
+var (
+	ErrMissingParameter = domain.NewLocalizedError(
+		"httpbind.parameter.missing", nil,
+		"required parameter is missing",
+	)
+	ErrInvalidJSONBody = domain.NewLocalizedError(
+		"httpbind.json_body.invalid", nil,
+		"request body must be a JSON object",
+	)
+)
+
+// Option configures a Binder
+type Option func(*Binder)
+
+// WithPathParams sets how path parameters are looked up, for routers that do not fill in
+// http.Request.PathValue (e.g., chi.URLParam, or the Param method of Echo and Gin contexts)
+func WithPathParams(lookup func(name string) string) Option {
+	return func(b *Binder) {
+		b.pathValue = lookup
+	}
+}
+
+// ParamOption configures how a single parameter is bound
+type ParamOption func(*paramOptions)
+
+type paramOptions struct {
+	required bool
+}
+
+// Required reports a missing or empty parameter with ErrMissingParameter
+func Required() ParamOption {
+	return func(o *paramOptions) {
+		o.required = true
+	}
+}
+
+// Binder binds the parameters of one request and collects the binding errors. It is not safe
+// for concurrent use.
+type Binder struct {
+	request   *http.Request
+	pathValue func(name string) string
+
+	query    url.Values
+	form     url.Values
+	formErr  error
+	jsonBody map[string]json.RawMessage
+	jsonErr  error
+
+	names []string
+	errs  []error
+}
+
+// New creates a Binder for the request
+func New(r *http.Request, opts ...Option) *Binder {
+	binder := &Binder{
+		request:   r,
+		pathValue: r.PathValue,
+	}
+	for _, opt := range opts {
+		opt(binder)
+	}
+	return binder
+}
+
+// Err returns an error wrapping every binding error, or nil when all parameters were bound.
+// Each cause carries the "parameter" and "source" fields.
+func (b *Binder) Err() error {
+	if len(b.errs) == 0 {
+		return nil
+	}
+	return domain.NewErrorWithWrapAll(b.errs, "invalid request parameters")
+}
+
+// Errors returns the binding errors by parameter name, keeping the first error of each
+func (b *Binder) Errors() map[string]error {
+	errs := make(map[string]error, len(b.errs))
+	for i, err := range b.errs {
+		if _, exists := errs[b.names[i]]; !exists {
+			errs[b.names[i]] = err
+		}
+	}
+	return errs
+}
+
+// textValue is implemented by pointers to value objects parsed from text
+type textValue[T any] interface {
+	*T
+	encoding.TextUnmarshaler
+}
+
+// Path binds the path parameter name
+func Path[T any, P textValue[T]](b *Binder, name string, opts ...ParamOption) T {
+	return bindText[T, P](b, SourcePath, name, b.pathValue(name), opts)
+}
+
+// Query binds the query string parameter name
+func Query[T any, P textValue[T]](b *Binder, name string, opts ...ParamOption) T {
+	if b.query == nil {
+		b.query = b.request.URL.Query()
+	}
+	return bindText[T, P](b, SourceQuery, name, b.query.Get(name), opts)
+}
+
+// Form binds the parameter name from a URL-encoded or multipart form body
+func Form[T any, P textValue[T]](b *Binder, name string, opts ...ParamOption) T {
+	if b.form == nil && b.formErr == nil {
+		err := b.request.ParseMultipartForm(MaxFormMemory)
+		if err != nil && !errors.Is(err, http.ErrNotMultipart) {
+			b.formErr = err
+			b.fail(SourceForm, name, err)
+		}
+		b.form = b.request.PostForm
+	}
+	if b.formErr != nil {
+		return *new(T)
+	}
+	return bindText[T, P](b, SourceForm, name, b.form.Get(name), opts)
+}
+
+// JSON binds the top-level field name of a JSON object body with the value object's JSON
+// decoding. The body is read once, on the first call; a body that is not a JSON object is
+// reported once, with ErrInvalidJSONBody.
+func JSON[T any](b *Binder, name string, opts ...ParamOption) T {
+	var value T
+	if b.jsonBody == nil && b.jsonErr == nil {
+		body, err := io.ReadAll(io.LimitReader(b.request.Body, MaxJSONBodySize))
+		if err == nil {
+			err = json.Unmarshal(body, &b.jsonBody)
+		}
+		if err != nil || b.jsonBody == nil {
+			b.jsonErr = ErrInvalidJSONBody
+			b.fail(SourceJSON, name, ErrInvalidJSONBody)
+		}
+	}
+	if b.jsonErr != nil {
+		return value
+	}
+
+	raw, found := b.jsonBody[name]
+	if !found || string(raw) == "null" {
+		b.checkRequired(SourceJSON, name, opts)
+		return value
+	}
+
+	if err := json.Unmarshal(raw, &value); err != nil {
+		b.fail(SourceJSON, name, err)
+	}
+	return value
+}
+
+// bindText parses a text parameter with the value object's UnmarshalText
+func bindText[T any, P textValue[T]](
+	b *Binder,
+	source, name, text string,
+	opts []ParamOption,
+) T {
+	var value T
+	if text == "" {
+		b.checkRequired(source, name, opts)
+		return value
+	}
+
+	if err := P(&value).UnmarshalText([]byte(text)); err != nil {
+		b.fail(source, name, err)
+	}
+	return value
+}
+
+// checkRequired records ErrMissingParameter when the options require the parameter
+func (b *Binder) checkRequired(source, name string, opts []ParamOption) {
+	var config paramOptions
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if config.required {
+		b.fail(source, name, ErrMissingParameter)
+	}
+}
+
+// fail records a binding error, tagged with the parameter name and source
+func (b *Binder) fail(source, name string, err error) {
+	b.names = append(b.names, name)
+	wrapped := domain.NewErrorWithWrap(err, "invalid %s parameter %q", source, name)
+	b.errs = append(b.errs, wrapped.WithField("parameter", name).WithField("source", source))
+}
@@ -0,0 +1,128 @@
+package httpbind
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type HttpBindTestSuite struct {
+	suite.Suite
+}
+
+func TestHttpBindSuite(t *testing.T) {
+	suite.Run(t, new(HttpBindTestSuite))
+}
+
+func (s *HttpBindTestSuite) TestItBindsPathAndQueryParameters() {
+	mux := http.NewServeMux()
+	var id identifier.IntIdentifier
+	var country geography.CountryCode
+	var err error
+	mux.HandleFunc("GET /customers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		bind := New(r)
+		id = Path[identifier.IntIdentifier](bind, "id", Required())
+		country = Query[geography.CountryCode](bind, "country")
+		err = bind.Err()
+	})
+
+	request := httptest.NewRequest("GET", "/customers/42?country=ro", nil)
+	mux.ServeHTTP(httptest.NewRecorder(), request)
+	s.Require().NoError(err)
+	s.Equal(uint64(42), id.Value())
+	s.Equal("RO", country.Value())
+}
+
+func (s *HttpBindTestSuite) TestItUsesTheRouterPathLookup() {
+	request := httptest.NewRequest("GET", "/customers/7", nil)
+	bind := New(request, WithPathParams(func(name string) string {
+		return map[string]string{"id": "7"}[name]
+	}))
+
+	id := Path[identifier.IntIdentifier](bind, "id")
+	s.Require().NoError(bind.Err())
+	s.Equal(uint64(7), id.Value())
+}
+
+func (s *HttpBindTestSuite) TestItBindsFormParameters() {
+	request := httptest.NewRequest("POST", "/signup", strings.NewReader("email=Jane@Example.com"))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	bind := New(request)
+
+	email := Form[web.Email](bind, "email", Required())
+	country := Form[geography.CountryCode](bind, "country")
+	s.Require().NoError(bind.Err())
+	s.Equal("jane@example.com", email.Value())
+	s.Empty(country.Value())
+}
+
+func (s *HttpBindTestSuite) TestItBindsJSONFields() {
+	body := `{"email": "jane@example.com", "country": "fr", "referrer": null}`
+	bind := New(httptest.NewRequest("POST", "/signup", strings.NewReader(body)))
+
+	email := JSON[web.Email](bind, "email", Required())
+	country := JSON[geography.CountryCode](bind, "country")
+	referrer := JSON[web.Email](bind, "referrer")
+	s.Require().NoError(bind.Err())
+	s.Equal("jane@example.com", email.Value())
+	s.Equal("FR", country.Value())
+	s.Empty(referrer.Value())
+}
+
+func (s *HttpBindTestSuite) TestItAggregatesBindingErrors() {
+	body := `{"email": "not-an-email"}`
+	request := httptest.NewRequest("POST", "/signup?country=XYZ", strings.NewReader(body))
+	bind := New(request)
+
+	JSON[web.Email](bind, "email")
+	JSON[web.Email](bind, "backupEmail", Required())
+	Query[geography.CountryCode](bind, "country")
+	Query[identifier.IntIdentifier](bind, "id", Required())
+
+	err := bind.Err()
+	s.Require().Error(err)
+	s.ErrorIs(err, geography.ErrInvalidCountryCode)
+	s.ErrorIs(err, ErrMissingParameter)
+
+	var domainErr *domain.Error
+	s.Require().True(errors.As(err, &domainErr))
+	s.Len(domainErr.Causes(), 4)
+	s.Equal("email", domainErr.Fields()["parameter"], "the first failure's fields surface")
+	s.Equal(SourceJSON, domainErr.Fields()["source"])
+
+	errs := bind.Errors()
+	s.Len(errs, 4)
+	s.ErrorIs(errs["backupEmail"], ErrMissingParameter)
+	s.ErrorIs(errs["id"], ErrMissingParameter)
+	s.ErrorIs(errs["country"], geography.ErrInvalidCountryCode)
+
+	s.Require().True(errors.As(errs["country"], &domainErr))
+	s.Equal("country", domainErr.Fields()["parameter"])
+	s.Equal(SourceQuery, domainErr.Fields()["source"])
+}
+
+func (s *HttpBindTestSuite) TestItReportsAnInvalidJSONBodyOnce() {
+	bind := New(httptest.NewRequest("POST", "/signup", strings.NewReader(`["jane"]`)))
+
+	JSON[web.Email](bind, "email", Required())
+	JSON[web.Email](bind, "backupEmail", Required())
+
+	s.ErrorIs(bind.Err(), ErrInvalidJSONBody)
+	s.Len(bind.Errors(), 1)
+}
+
+func (s *HttpBindTestSuite) TestItReturnsNoErrorWhenNothingFailed() {
+	bind := New(httptest.NewRequest("GET", "/", nil))
+	Query[web.Email](bind, "email")
+
+	s.NoError(bind.Err())
+	s.Empty(bind.Errors())
+}
@@ -0,0 +1,161 @@
+// Package i18n renders validation errors in the user's language.
+//
+// Sentinel errors created with domain.NewLocalizedError carry a message key (e.g.,
+// "web.email.empty") and parameters (e.g., {"min": 8}); a Catalog maps those keys to message
+// templates per language. The bundled catalog covers English, German, French, Spanish and
+// Romanian:
+//
+//	catalog := i18n.Bundled()
+//	_, err := web.NewEmail(input)
+//	message := domain.Localize(err, catalog, "de-AT")
+//
+// Templates refer to parameters by name in braces, e.g., "must be at least {min} characters".
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// DefaultLanguage is the fallback language of the bundled catalog
+const DefaultLanguage = "en"
+
+//go:embed messages/*.json
+var bundledMessages embed.FS
+
+// Catalog holds message templates keyed by language and message key. It implements
+// domain.Translator and is safe for concurrent use.
+type Catalog struct {
+	mu       sync.RWMutex
+	fallback string
+	messages map[string]map[string]string
+}
+
+// NewCatalog creates a new empty Catalog that falls back to the given language when a
+// message is missing in the requested one
+func NewCatalog(fallback string) *Catalog {
+	return &Catalog{
+		fallback: normalizeLanguage(fallback),
+		messages: make(map[string]map[string]string),
+	}
+}
+
+// Bundled returns a new Catalog loaded with the bundled en, de, fr, es and ro messages and
+// falling back to English. Applications can Add their own languages or override messages.
+func Bundled() *Catalog {
+	catalog := NewCatalog(DefaultLanguage)
+
+	files, _ := bundledMessages.ReadDir("messages")
+	for _, file := range files {
+		data, _ := bundledMessages.ReadFile(path.Join("messages", file.Name()))
+
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: invalid bundled catalog %s: %v", file.Name(), err))
+		}
+		catalog.Add(strings.TrimSuffix(file.Name(), ".json"), messages)
+	}
+
+	return catalog
+}
+
+// Add adds messages for a language, replacing existing messages with the same keys
+func (c *Catalog) Add(language string, messages map[string]string) {
+	language = normalizeLanguage(language)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.messages[language] == nil {
+		c.messages[language] = make(map[string]string, len(messages))
+	}
+	for key, message := range messages {
+		c.messages[language][key] = message
+	}
+}
+
+// Languages returns the languages that have messages, in sorted order
+func (c *Catalog) Languages() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	languages := make([]string, 0, len(c.messages))
+	for language := range c.messages {
+		languages = append(languages, language)
+	}
+	slices.Sort(languages)
+	return languages
+}
+
+// Keys returns the message keys of a language, in sorted order
+func (c *Catalog) Keys(language string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	messages := c.messages[normalizeLanguage(language)]
+	keys := make([]string, 0, len(messages))
+	for key := range messages {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Translate renders the message for key in the language. A regional tag such as "fr-CA"
+// falls back to its base language ("fr") and then to the catalog's fallback language.
+func (c *Catalog) Translate(
+	language, key string,
+	params domain.MessageParams,
+) (string, bool) {
+	template, ok := c.lookup(normalizeLanguage(language), key)
+	if !ok {
+		return "", false
+	}
+	return render(template, params), true
+}
+
+// lookup finds the template for key in the language, its base language or the fallback
+func (c *Catalog) lookup(language, key string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	candidates := []string{language}
+	if base, _, found := strings.Cut(language, "-"); found {
+		candidates = append(candidates, base)
+	}
+	candidates = append(candidates, c.fallback)
+
+	for _, candidate := range candidates {
+		if template, ok := c.messages[candidate][key]; ok {
+			return template, true
+		}
+	}
+	return "", false
+}
+
+// render replaces each {name} in the template with the matching parameter. Placeholders
+// without a parameter are left as they are.
+func render(template string, params domain.MessageParams) string {
+	if len(params) == 0 {
+		return template
+	}
+
+	pairs := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
+
+// normalizeLanguage lowercases a language tag and uses hyphens as separators, so "pt_BR"
+// and "pt-br" match the same messages
+func normalizeLanguage(language string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+}
@@ -0,0 +1,325 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/commerce"
+	"github.com/golibry/go-common-domain/domain/content"
+	"github.com/golibry/go-common-domain/domain/file"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/health"
+	"github.com/golibry/go-common-domain/domain/httpbind"
+	"github.com/golibry/go-common-domain/domain/numbers"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/schema"
+	"github.com/golibry/go-common-domain/domain/social"
+	"github.com/golibry/go-common-domain/domain/vehicle"
+	"github.com/golibry/go-common-domain/domain/version"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+// localizedErrors lists every sentinel error that carries a message key
+var localizedErrors = []*domain.Error{
+	web.ErrEmptyEmail,
+	web.ErrInvalidEmailFormat,
+	web.ErrTooLongEmail,
+	web.ErrTooLongLocalPart,
+	web.ErrTooLongDomainPart,
+	web.ErrInvalidEmailChars,
+	web.ErrMissingAtSymbol,
+	web.ErrMultipleAtSymbols,
+	web.ErrEmptyLocalPart,
+	web.ErrEmptyDomainPart,
+	web.ErrInvalidLocalPart,
+	web.ErrInvalidDomainPart,
+	web.ErrEmailDomainNotAllowed,
+	web.ErrEmptyDomainName,
+	web.ErrInvalidDomainNameChars,
+	web.ErrTooLongDomainName,
+	web.ErrTooLongDomainLabel,
+	web.ErrInvalidDomainFormat,
+	web.ErrConsecutiveDots,
+	web.ErrStartsOrEndsWithDot,
+	web.ErrStartsOrEndsWithHyphen,
+	web.ErrEmptyURL,
+	web.ErrInvalidURL,
+	web.ErrTooLongURL,
+	web.ErrEmptyIPAddress,
+	web.ErrInvalidIPAddress,
+	web.ErrInvalidIPv4Address,
+	web.ErrInvalidIPv6Address,
+	person.ErrEmptyNamePart,
+	person.ErrInvalidNamePartChars,
+	person.ErrTooLongNamePart,
+	person.ErrInvalidNameAffix,
+	person.ErrTooLongNameAffix,
+	person.ErrEmptyNationalID,
+	person.ErrInvalidNationalID,
+	person.ErrInvalidNationalIDChecksum,
+	person.ErrUnsupportedNationalIDCountry,
+	person.ErrInvalidMaritalStatus,
+	person.ErrInvalidEmploymentStatus,
+	person.ErrEmptyPassportNumber,
+	person.ErrInvalidPassportNumber,
+	person.ErrEmptyDriverLicenseNumber,
+	person.ErrInvalidDriverLicenseNumber,
+	person.ErrUnsupportedDriverLicenseCountry,
+	person.ErrIncompleteIdentityDocument,
+	contact.ErrEmptyPhoneNumber,
+	contact.ErrInvalidPhoneNumberChars,
+	contact.ErrTooLongPhoneNumber,
+	contact.ErrTooShortPhoneNumber,
+	contact.ErrInvalidPhoneExtension,
+	geography.ErrEmptyCountryCode,
+	geography.ErrInvalidCountryCode,
+	geography.ErrInvalidLatitude,
+	geography.ErrInvalidLongitude,
+	geography.ErrInvalidBoundingBox,
+	geography.ErrInvalidCoordinateFormat,
+	geography.ErrEmptyIATACode,
+	geography.ErrInvalidIATACode,
+	geography.ErrEmptyICAOCode,
+	geography.ErrInvalidICAOCode,
+	geography.ErrUnknownAirportCode,
+	httpbind.ErrMissingParameter,
+	httpbind.ErrInvalidJSONBody,
+	auth.ErrEmptyUsername,
+	auth.ErrTooShortUsername,
+	auth.ErrTooLongUsername,
+	auth.ErrInvalidUsernameChars,
+	auth.ErrPasswordTooShort,
+	auth.ErrEmptyPassword,
+	auth.ErrPasswordTooLong,
+	auth.ErrPasswordTooWeak,
+	auth.ErrPasswordCommon,
+	auth.ErrInvalidPasswordChars,
+	auth.ErrPasswordContainsUserInfo,
+	finance.ErrEmptyCurrency,
+	finance.ErrInvalidCurrency,
+	finance.ErrCurrencyNotAllowed,
+	finance.ErrEmptyVATNumber,
+	finance.ErrUnsupportedVATCountry,
+	finance.ErrInvalidVATNumberFormat,
+	finance.ErrInvalidVATNumberChecksum,
+	finance.ErrEmptyISIN,
+	finance.ErrInvalidISINFormat,
+	finance.ErrInvalidISINChecksum,
+	finance.ErrEmptyCUSIP,
+	finance.ErrInvalidCUSIPFormat,
+	finance.ErrInvalidCUSIPChecksum,
+	finance.ErrNegativeAmount,
+	finance.ErrInvalidMoney,
+	finance.ErrEmptyAccountNumber,
+	finance.ErrInvalidAccountNumberChars,
+	finance.ErrInvalidAccountNumberLength,
+	finance.ErrEmptyRoutingNumber,
+	finance.ErrInvalidRoutingNumberChars,
+	finance.ErrInvalidRoutingNumberLength,
+	finance.ErrInvalidABARoutingNumber,
+	finance.ErrNegativeInterestRate,
+	finance.ErrInterestRateTooHigh,
+	finance.ErrEmptyTIN,
+	finance.ErrInvalidTIN,
+	finance.ErrInvalidTINChecksum,
+	finance.ErrUnsupportedTINCountry,
+	finance.ErrInvalidInvoiceNumber,
+	finance.ErrEmptyCardToken,
+	finance.ErrInvalidCardTokenChars,
+	finance.ErrInvalidCardTokenLength,
+	finance.ErrCardTokenIsCardNumber,
+	file.ErrEmptyFileSize,
+	file.ErrInvalidFileSize,
+	file.ErrFileSizeTooLarge,
+	file.ErrEmptyFileName,
+	file.ErrTooLongFileName,
+	file.ErrInvalidFileNameChars,
+	file.ErrReservedFileName,
+	file.ErrEmptyFilePath,
+	file.ErrTooLongFilePath,
+	file.ErrInvalidFilePathChars,
+	file.ErrFilePathTraversal,
+	content.ErrEmptyText,
+	content.ErrTooLongText,
+	content.ErrInvalidTextChars,
+	content.ErrEmptyHTML,
+	content.ErrTooLongHTML,
+	commerce.ErrEmptyBarcode,
+	commerce.ErrInvalidBarcodeFormat,
+	commerce.ErrInvalidBarcodeChecksum,
+	commerce.ErrEmptyOrderNumber,
+	commerce.ErrInvalidOrderNumber,
+	commerce.ErrEmptyTrackingNumber,
+	commerce.ErrInvalidTrackingNumber,
+	vehicle.ErrEmptyLicensePlate,
+	vehicle.ErrInvalidLicensePlate,
+	vehicle.ErrUnsupportedLicensePlateCountry,
+	health.ErrEmptyBloodType,
+	health.ErrInvalidBloodType,
+	health.ErrInvalidHeight,
+	health.ErrInvalidWeight,
+	social.ErrEmptyHashtag,
+	social.ErrTooLongHashtag,
+	social.ErrInvalidHashtag,
+	social.ErrEmptyMention,
+	social.ErrTooLongMention,
+	social.ErrInvalidMention,
+	web.ErrEmptyIPNetwork,
+	web.ErrInvalidIPNetwork,
+	web.ErrInvalidDomainPattern,
+	web.ErrEmptyShortCode,
+	web.ErrInvalidShortCodeLength,
+	web.ErrInvalidShortCodeChars,
+	web.ErrEmptyDataURI,
+	web.ErrInvalidDataURI,
+	web.ErrTooLongDataURI,
+	web.ErrEmptyURITemplate,
+	web.ErrInvalidURITemplate,
+	web.ErrEmptyETag,
+	web.ErrInvalidETag,
+	web.ErrEmptyLastModified,
+	web.ErrInvalidLastModified,
+	numbers.ErrNotPositive,
+	numbers.ErrNegative,
+	numbers.ErrOutOfRange,
+	numbers.ErrInvalidNumber,
+	schema.ErrMissingField,
+	schema.ErrUnknownField,
+	version.ErrEmptyVersion,
+	version.ErrInvalidVersion,
+}
+
+var placeholderRegex = regexp.MustCompile(`\{[a-z]+\}`)
+
+type I18nTestSuite struct {
+	suite.Suite
+}
+
+func TestI18nSuite(t *testing.T) {
+	suite.Run(t, new(I18nTestSuite))
+}
+
+func (s *I18nTestSuite) TestBundledCatalogCoversEveryLocalizedError() {
+	catalog := Bundled()
+	s.Equal([]string{"de", "en", "es", "fr", "ro"}, catalog.Languages())
+
+	for _, language := range catalog.Languages() {
+		keys := catalog.Keys(language)
+		for _, err := range localizedErrors {
+			s.Contains(keys, err.MessageKey(), language)
+		}
+		s.Len(keys, len(localizedErrors), language)
+	}
+}
+
+func (s *I18nTestSuite) TestBundledMessagesUseTheErrorParameters() {
+	catalog := Bundled()
+
+	for _, err := range localizedErrors {
+		var params []string
+		for name := range err.MessageParams() {
+			params = append(params, "{"+name+"}")
+		}
+		slices.Sort(params)
+
+		for _, language := range catalog.Languages() {
+			template := catalog.messages[language][err.MessageKey()]
+			placeholders := placeholderRegex.FindAllString(template, -1)
+			slices.Sort(placeholders)
+			s.Equal(params, placeholders, "%s in %s", err.MessageKey(), language)
+
+			message, ok := catalog.Translate(language, err.MessageKey(), err.MessageParams())
+			s.True(ok)
+			s.NotContains(message, "{", "%s in %s", err.MessageKey(), language)
+		}
+	}
+}
+
+func (s *I18nTestSuite) TestItLocalizesConstructorErrors() {
+	catalog := Bundled()
+
+	_, err := web.NewEmail("")
+	s.Equal("Die E-Mail-Adresse darf nicht leer sein", domain.Localize(err, catalog, "de"))
+	s.Equal("L'adresse e-mail ne peut pas être vide", domain.Localize(err, catalog, "fr"))
+
+	_, err = auth.NewUsername("ab")
+	s.Equal(
+		"El nombre de usuario debe tener al menos 3 caracteres",
+		domain.Localize(err, catalog, "es"),
+	)
+
+	_, err = person.NewFullName("Jane", "", "")
+	s.Equal("Această parte a numelui nu poate fi goală", domain.Localize(err, catalog, "ro"))
+}
+
+func (s *I18nTestSuite) TestItFallsBackToTheBaseAndDefaultLanguages() {
+	catalog := Bundled()
+
+	message, ok := catalog.Translate("de-AT", "web.url.empty", nil)
+	s.True(ok)
+	s.Equal("Die URL darf nicht leer sein", message)
+
+	message, ok = catalog.Translate("FR_ca", "web.url.empty", nil)
+	s.True(ok)
+	s.Equal("L'URL ne peut pas être vide", message)
+
+	message, ok = catalog.Translate("ja", "web.url.empty", nil)
+	s.True(ok)
+	s.Equal("URL cannot be empty", message)
+
+	_, ok = catalog.Translate("de", "unknown.key", nil)
+	s.False(ok)
+}
+
+func (s *I18nTestSuite) TestItAddsAndOverridesMessages() {
+	catalog := Bundled()
+	catalog.Add("de", map[string]string{"web.url.empty": "Bitte geben Sie eine URL ein"})
+	catalog.Add("pt-BR", map[string]string{"web.url.empty": "A URL não pode estar vazia"})
+
+	message, _ := catalog.Translate("de", "web.url.empty", nil)
+	s.Equal("Bitte geben Sie eine URL ein", message)
+
+	message, _ = catalog.Translate("pt-br", "web.url.empty", nil)
+	s.Equal("A URL não pode estar vazia", message)
+
+	message, _ = catalog.Translate("pt-BR", "web.url.too_long", nil)
+	s.Equal("URL is too long", message)
+
+	_, ok := NewCatalog("en").Translate("en", "web.url.empty", nil)
+	s.False(ok)
+}
+
+func (s *I18nTestSuite) TestItRendersNamedParameters() {
+	catalog := NewCatalog("en")
+	catalog.Add("en", map[string]string{"range": "between {min} and {max}, not {other}"})
+
+	message, ok := catalog.Translate("en", "range", domain.MessageParams{"min": 4, "max": 34})
+	s.True(ok)
+	s.Equal("between 4 and 34, not {other}", message)
+}
+
+func (s *I18nTestSuite) TestItIsSafeForConcurrentUse() {
+	catalog := Bundled()
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			catalog.Add("it", map[string]string{"web.url.empty": "L'URL non può essere vuota"})
+		}()
+		go func() {
+			defer wg.Done()
+			_, ok := catalog.Translate("it", "web.url.empty", domain.MessageParams{"i": i})
+			s.True(ok)
+		}()
+	}
+	wg.Wait()
+}
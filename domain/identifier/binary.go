@@ -0,0 +1,136 @@
+package identifier
+
+import (
+	"encoding/binary"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MarshalBinary encodes the identifier as 8 big-endian bytes
+func (i IntIdentifier) MarshalBinary() ([]byte, error) {
+	if i.value == 0 {
+		return nil, nil
+	}
+	return binary.BigEndian.AppendUint64(nil, i.value), nil
+}
+
+// UnmarshalBinary decodes the identifier from 8 big-endian bytes, with validation
+func (i *IntIdentifier) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if len(data) != 8 {
+		return domain.ErrInvalidBinary
+	}
+
+	parsed, err := NewIntIdentifier(binary.BigEndian.Uint64(data))
+	if err != nil {
+		return err
+	}
+
+	*i = parsed
+	return nil
+}
+
+// MarshalBinary encodes the snowflake as 8 big-endian bytes
+func (s Snowflake) MarshalBinary() ([]byte, error) {
+	if s.value == 0 {
+		return nil, nil
+	}
+	return binary.BigEndian.AppendUint64(nil, s.value), nil
+}
+
+// UnmarshalBinary decodes the snowflake from 8 big-endian bytes, with validation
+func (s *Snowflake) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if len(data) != 8 {
+		return domain.ErrInvalidBinary
+	}
+
+	parsed, err := NewSnowflake(binary.BigEndian.Uint64(data))
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+	return nil
+}
+
+// MarshalBinary encodes the UUID as its 16 raw bytes
+func (u UUID) MarshalBinary() ([]byte, error) {
+	if u.value == ([16]byte{}) {
+		return nil, nil
+	}
+	return u.value[:], nil
+}
+
+// UnmarshalBinary decodes the UUID from its 16 raw bytes (the nil UUID is rejected)
+func (u *UUID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if len(data) != 16 {
+		return domain.ErrInvalidBinary
+	}
+
+	value := [16]byte(data)
+	if value == ([16]byte{}) {
+		return ErrNilUUID
+	}
+
+	*u = ReconstituteUUID(value)
+	return nil
+}
+
+// MarshalBinary encodes the ULID as its 16 raw bytes
+func (u ULID) MarshalBinary() ([]byte, error) {
+	return u.value[:], nil
+}
+
+// UnmarshalBinary decodes the ULID from its 16 raw bytes
+func (u *ULID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if len(data) != 16 {
+		return domain.ErrInvalidBinary
+	}
+
+	*u = ReconstituteULID([16]byte(data))
+	return nil
+}
+
+// MarshalBinary encodes every part of the composite identifier
+func (c Composite) MarshalBinary() ([]byte, error) {
+	if len(c.parts) == 0 {
+		return nil, nil
+	}
+	return domain.MarshalBinaryFields(c.parts...), nil
+}
+
+// UnmarshalBinary decodes the parts of the composite identifier, with validation
+func (c *Composite) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parts, err := domain.UnmarshalBinaryFields(data)
+	if err != nil {
+		return err
+	}
+
+	if err := IsValidComposite(parts); err != nil {
+		return err
+	}
+
+	*c = Composite{
+		parts: parts,
+	}
+	return nil
+}
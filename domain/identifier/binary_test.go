@@ -0,0 +1,101 @@
+package identifier
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type BinaryTestSuite struct {
+	suite.Suite
+}
+
+func TestBinarySuite(t *testing.T) {
+	suite.Run(t, new(BinaryTestSuite))
+}
+
+type cachedIdentifiers struct {
+	ID        IntIdentifier
+	PublicID  StringIntIdentifier
+	Snowflake Snowflake
+	UUID      UUID
+	ULID      ULID
+	Key       Composite
+	Missing   UUID
+}
+
+func (s *BinaryTestSuite) TestItCanRoundTripIdentifiersThroughGob() {
+	uuid, err := NewUUIDv7()
+	s.Require().NoError(err)
+	ulid, err := NewULID()
+	s.Require().NoError(err)
+	id, _ := NewIntIdentifier(42)
+	publicID, _ := NewStringIntIdentifier(9007199254740993)
+	snowflake, _ := NewSnowflake(1541815603606036480)
+	key, err := NewComposite(id, ulid)
+	s.Require().NoError(err)
+
+	original := cachedIdentifiers{
+		ID:        id,
+		PublicID:  publicID,
+		Snowflake: snowflake,
+		UUID:      uuid,
+		ULID:      ulid,
+		Key:       key,
+	}
+
+	var buffer bytes.Buffer
+	s.Require().NoError(gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded cachedIdentifiers
+	s.Require().NoError(gob.NewDecoder(&buffer).Decode(&decoded))
+
+	s.True(original.ID.Equals(decoded.ID))
+	s.True(original.PublicID.Equals(decoded.PublicID.IntIdentifier))
+	s.True(original.Snowflake.Equals(decoded.Snowflake))
+	s.True(original.UUID.Equals(decoded.UUID))
+	s.True(original.ULID.Equals(decoded.ULID))
+	s.True(original.Key.Equals(decoded.Key))
+	s.Equal(UUID{}, decoded.Missing)
+}
+
+func (s *BinaryTestSuite) TestItEncodesIntegersAsBigEndianBytes() {
+	id, _ := NewIntIdentifier(258)
+
+	data, err := id.MarshalBinary()
+	s.NoError(err)
+	s.Equal([]byte{0, 0, 0, 0, 0, 0, 1, 2}, data)
+}
+
+func (s *BinaryTestSuite) TestItRejectsInvalidBinaryData() {
+	var id IntIdentifier
+	s.ErrorIs(id.UnmarshalBinary([]byte{1, 2, 3}), domain.ErrInvalidBinary)
+	s.ErrorIs(id.UnmarshalBinary(make([]byte, 8)), ErrZeroIdentifier)
+
+	var uuid UUID
+	s.ErrorIs(uuid.UnmarshalBinary([]byte{1}), domain.ErrInvalidBinary)
+	s.ErrorIs(uuid.UnmarshalBinary(make([]byte, 16)), ErrNilUUID)
+
+	var ulid ULID
+	s.ErrorIs(ulid.UnmarshalBinary(make([]byte, 15)), domain.ErrInvalidBinary)
+
+	var composite Composite
+	s.ErrorIs(
+		composite.UnmarshalBinary(domain.MarshalBinaryFields("only")),
+		ErrTooFewCompositeParts,
+	)
+	s.ErrorIs(
+		composite.UnmarshalBinary(domain.MarshalBinaryFields("a:b", "c")),
+		ErrInvalidCompositePart,
+	)
+}
+
+func (s *BinaryTestSuite) TestItLeavesTheValueUnchangedForEmptyData() {
+	id, _ := NewIntIdentifier(7)
+
+	s.NoError(id.UnmarshalBinary(nil))
+	s.Equal(uint64(7), id.Value())
+}
@@ -0,0 +1,151 @@
+package identifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	// CompositeSeparator separates the parts of a composite identifier in its canonical form
+	CompositeSeparator = ":"
+	MinCompositeParts  = 2
+)
+
+var (
+	ErrTooFewCompositeParts = domain.NewError(
+		"composite identifier needs at least %d parts",
+		MinCompositeParts,
+	)
+	ErrEmptyCompositePart   = domain.NewError("composite identifier part cannot be empty")
+	ErrInvalidCompositePart = domain.NewError(
+		"composite identifier part cannot contain %q",
+		CompositeSeparator,
+	)
+	ErrInvalidCompositeJSON    = domain.NewError("composite identifier JSON must be a string")
+	ErrCompositePartOutOfRange = domain.NewError("composite identifier part index is out of range")
+)
+
+// Composite combines two or more identifiers (e.g., tenant ID and entity ID) into one key.
+// Its canonical string form joins the string form of each part with CompositeSeparator,
+// e.g., "42:01ARZ3NDEKTSV4RRFFQ69G5FAV".
+type Composite struct {
+	parts []string
+}
+
+// NewComposite creates a new instance of Composite from the given identifiers
+func NewComposite(parts ...fmt.Stringer) (Composite, error) {
+	values := make([]string, 0, len(parts))
+	for _, part := range parts {
+		values = append(values, part.String())
+	}
+
+	if err := IsValidComposite(values); err != nil {
+		return Composite{}, err
+	}
+
+	return Composite{
+		parts: values,
+	}, nil
+}
+
+// NewCompositeFromString parses a composite identifier from its canonical string form
+func NewCompositeFromString(value string) (Composite, error) {
+	parts := strings.Split(value, CompositeSeparator)
+	if err := IsValidComposite(parts); err != nil {
+		return Composite{}, err
+	}
+
+	return Composite{
+		parts: parts,
+	}, nil
+}
+
+// ReconstituteComposite creates a new Composite instance without validation
+func ReconstituteComposite(parts ...string) Composite {
+	return Composite{
+		parts: append([]string(nil), parts...),
+	}
+}
+
+// Parts returns the string form of every part, in order
+func (c Composite) Parts() []string {
+	return append([]string(nil), c.parts...)
+}
+
+// Part returns the string form of the part at the given index
+func (c Composite) Part(index int) (string, error) {
+	if index < 0 || index >= len(c.parts) {
+		return "", ErrCompositePartOutOfRange
+	}
+	return c.parts[index], nil
+}
+
+// Len returns the number of parts
+func (c Composite) Len() int {
+	return len(c.parts)
+}
+
+// Equals compares two Composite objects for equality
+func (c Composite) Equals(other Composite) bool {
+	if len(c.parts) != len(other.parts) {
+		return false
+	}
+	for i := range c.parts {
+		if c.parts[i] != other.parts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the canonical string representation of the composite identifier
+func (c Composite) String() string {
+	return strings.Join(c.parts, CompositeSeparator)
+}
+
+// MarshalJSON encodes the composite identifier as a JSON string in canonical form
+func (c Composite) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON decodes the composite identifier from a JSON string in canonical form
+func (c *Composite) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return ErrInvalidCompositeJSON
+	}
+
+	parsed, err := NewCompositeFromString(raw)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// IsValidComposite validates the string parts of a composite identifier
+func IsValidComposite(parts []string) error {
+	if len(parts) < MinCompositeParts {
+		return ErrTooFewCompositeParts
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return ErrEmptyCompositePart
+		}
+		if strings.Contains(part, CompositeSeparator) {
+			return ErrInvalidCompositePart
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package identifier
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CompositeTestSuite struct {
+	suite.Suite
+}
+
+func TestCompositeSuite(t *testing.T) {
+	suite.Run(t, new(CompositeTestSuite))
+}
+
+func (s *CompositeTestSuite) TestItCanBuildFromIdentifiers() {
+	tenantID, _ := NewIntIdentifier(42)
+	entityID, _ := NewULIDFromString("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	composite, err := NewComposite(tenantID, entityID)
+	s.NoError(err)
+	s.Equal("42:01ARZ3NDEKTSV4RRFFQ69G5FAV", composite.String())
+	s.Equal(2, composite.Len())
+
+	tenantPart, err := composite.Part(0)
+	s.NoError(err)
+	parsedTenant, err := NewIntIdentifierFromString(tenantPart)
+	s.NoError(err)
+	s.True(parsedTenant.Equals(tenantID))
+
+	_, err = composite.Part(2)
+	s.ErrorIs(err, ErrCompositePartOutOfRange)
+
+	_, err = NewComposite(tenantID)
+	s.ErrorIs(err, ErrTooFewCompositeParts)
+}
+
+func (s *CompositeTestSuite) TestItCanParseCanonicalStrings() {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedParts []string
+		expectedError error
+	}{
+		{name: "two parts", input: "1:2", expectedParts: []string{"1", "2"}},
+		{name: "three parts", input: "1:2:3", expectedParts: []string{"1", "2", "3"}},
+		{name: "single part", input: "1", expectedError: ErrTooFewCompositeParts},
+		{name: "empty part", input: "1::3", expectedError: ErrEmptyCompositePart},
+		{name: "empty input", input: "", expectedError: ErrTooFewCompositeParts},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				composite, err := NewCompositeFromString(tc.input)
+				if tc.expectedError != nil {
+					s.ErrorIs(err, tc.expectedError)
+					return
+				}
+				s.NoError(err)
+				s.Equal(tc.expectedParts, composite.Parts())
+			},
+		)
+	}
+
+	s.ErrorIs(IsValidComposite([]string{"a:b", "c"}), ErrInvalidCompositePart)
+}
+
+func (s *CompositeTestSuite) TestEqualsAndJSON() {
+	first, _ := NewCompositeFromString("1:2")
+	second := ReconstituteComposite("1", "2")
+	third := ReconstituteComposite("1", "2", "3")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+
+	encoded, err := json.Marshal(first)
+	s.NoError(err)
+	s.Equal(`"1:2"`, string(encoded))
+
+	var decoded Composite
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(first.Equals(decoded))
+	s.ErrorIs(json.Unmarshal([]byte(`12`), &decoded), ErrInvalidCompositeJSON)
+}
+
+func (s *CompositeTestSuite) TestJSONUsesJSONEscaping() {
+	withControl, err := NewCompositeFromString("a\x01b:c")
+	s.Require().NoError(err)
+
+	encoded, err := json.Marshal(withControl)
+	s.Require().NoError(err)
+	s.Equal(`"a\u0001b:c"`, string(encoded))
+
+	var decoded Composite
+	s.Require().NoError(json.Unmarshal(encoded, &decoded))
+	s.True(withControl.Equals(decoded))
+
+	s.Require().NoError(json.Unmarshal([]byte(`"a\/b:c"`), &decoded))
+	s.Equal([]string{"a/b", "c"}, decoded.Parts())
+
+	s.Require().NoError(json.Unmarshal([]byte(`"caf\u00e9:1"`), &decoded))
+	s.Equal("café:1", decoded.String())
+}
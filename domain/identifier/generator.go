@@ -0,0 +1,170 @@
+package identifier
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrSequenceExhausted = domain.NewError("identifier sequence is exhausted")
+	ErrULIDOverflow      = domain.NewError("ULID randomness overflowed within the same millisecond")
+)
+
+// Generator produces new identifiers, letting aggregates receive a generation strategy
+// instead of hardcoding one. Implementations are safe for concurrent use.
+type Generator[T any] interface {
+	Next() (T, error)
+}
+
+var (
+	_ Generator[IntIdentifier] = (*SequenceGenerator)(nil)
+	_ Generator[IntIdentifier] = (*RandomGenerator)(nil)
+	_ Generator[UUID]          = (*UUIDGenerator)(nil)
+	_ Generator[ULID]          = (*ULIDGenerator)(nil)
+	_ Generator[Snowflake]     = (*SnowflakeGenerator)(nil)
+)
+
+// SequenceGenerator generates sequential IntIdentifier values held in memory
+type SequenceGenerator struct {
+	mu        sync.Mutex
+	next      uint64
+	exhausted bool
+}
+
+// NewSequenceGenerator creates a new SequenceGenerator whose first identifier is start
+func NewSequenceGenerator(start uint64) (*SequenceGenerator, error) {
+	if err := IsValidIntIdentifier(start); err != nil {
+		return nil, err
+	}
+
+	return &SequenceGenerator{
+		next: start,
+	}, nil
+}
+
+// Next returns the next identifier in the sequence
+func (g *SequenceGenerator) Next() (IntIdentifier, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.exhausted {
+		return IntIdentifier{}, ErrSequenceExhausted
+	}
+
+	value := g.next
+	if value == math.MaxUint64 {
+		g.exhausted = true
+	} else {
+		g.next++
+	}
+
+	return NewIntIdentifier(value)
+}
+
+// RandomGenerator generates IntIdentifier values from a cryptographically secure source.
+// Values are limited to 63 bits so they fit signed 64-bit database columns.
+type RandomGenerator struct{}
+
+// NewRandomGenerator creates a new RandomGenerator
+func NewRandomGenerator() *RandomGenerator {
+	return &RandomGenerator{}
+}
+
+// Next returns a new random, non-zero identifier
+func (g *RandomGenerator) Next() (IntIdentifier, error) {
+	var buf [8]byte
+	for {
+		if _, err := rand.Read(buf[:]); err != nil {
+			return IntIdentifier{}, domain.NewInternalErrorWithWrap(
+				err, "failed to generate identifier",
+			)
+		}
+
+		value := binary.BigEndian.Uint64(buf[:]) >> 1
+		if value != 0 {
+			return NewIntIdentifier(value)
+		}
+	}
+}
+
+// UUIDGenerator generates UUID values of a fixed version (4 or 7)
+type UUIDGenerator struct {
+	version int
+	now     func() time.Time
+}
+
+// NewUUIDGenerator creates a new UUIDGenerator for the given UUID version (4 or 7)
+func NewUUIDGenerator(version int) (*UUIDGenerator, error) {
+	if version != 4 && version != 7 {
+		return nil, ErrUUIDVersion
+	}
+
+	return &UUIDGenerator{
+		version: version,
+		now:     time.Now,
+	}, nil
+}
+
+// Next returns a new UUID
+func (g *UUIDGenerator) Next() (UUID, error) {
+	if g.version == 4 {
+		return NewUUIDv4()
+	}
+	return newUUIDv7At(g.now())
+}
+
+// ULIDGenerator generates monotonically increasing ULID values.
+// Identifiers generated within the same millisecond increment the random component,
+// so they keep sorting in generation order.
+type ULIDGenerator struct {
+	mu   sync.Mutex
+	last ULID
+	now  func() time.Time
+}
+
+// NewULIDGenerator creates a new ULIDGenerator
+func NewULIDGenerator() *ULIDGenerator {
+	return &ULIDGenerator{
+		now: time.Now,
+	}
+}
+
+// Next returns a new ULID greater than any previously generated by this generator
+func (g *ULIDGenerator) Next() (ULID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	moment := g.now()
+	if g.last != (ULID{}) && moment.UnixMilli() <= g.last.Time().UnixMilli() {
+		next, ok := incrementULIDEntropy(g.last)
+		if !ok {
+			return ULID{}, ErrULIDOverflow
+		}
+		g.last = next
+		return next, nil
+	}
+
+	next, err := newULIDAt(moment)
+	if err != nil {
+		return ULID{}, err
+	}
+	g.last = next
+	return next, nil
+}
+
+// incrementULIDEntropy adds one to the 80-bit random component of the ULID
+func incrementULIDEntropy(id ULID) (ULID, bool) {
+	value := id.value
+	for i := 15; i >= 6; i-- {
+		value[i]++
+		if value[i] != 0 {
+			return ULID{value: value}, true
+		}
+	}
+	return ULID{}, false
+}
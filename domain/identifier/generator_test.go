@@ -0,0 +1,88 @@
+package identifier
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GeneratorTestSuite struct {
+	suite.Suite
+}
+
+func TestGeneratorSuite(t *testing.T) {
+	suite.Run(t, new(GeneratorTestSuite))
+}
+
+func (s *GeneratorTestSuite) TestItCanGenerateSequentialIdentifiers() {
+	generator, err := NewSequenceGenerator(10)
+	s.NoError(err)
+
+	first, _ := generator.Next()
+	second, _ := generator.Next()
+	s.Equal(uint64(10), first.Value())
+	s.Equal(uint64(11), second.Value())
+
+	_, err = NewSequenceGenerator(0)
+	s.ErrorIs(err, ErrZeroIdentifier)
+
+	last, _ := NewSequenceGenerator(math.MaxUint64)
+	id, err := last.Next()
+	s.NoError(err)
+	s.Equal(uint64(math.MaxUint64), id.Value())
+	_, err = last.Next()
+	s.ErrorIs(err, ErrSequenceExhausted)
+}
+
+func (s *GeneratorTestSuite) TestItCanGenerateRandomIdentifiers() {
+	var generator Generator[IntIdentifier] = NewRandomGenerator()
+
+	first, err := generator.Next()
+	s.NoError(err)
+	second, err := generator.Next()
+	s.NoError(err)
+	s.False(first.Equals(second))
+	s.LessOrEqual(first.Value(), uint64(math.MaxInt64))
+}
+
+func (s *GeneratorTestSuite) TestItCanGenerateUUIDs() {
+	v4, err := NewUUIDGenerator(4)
+	s.NoError(err)
+	id, err := v4.Next()
+	s.NoError(err)
+	s.Equal(4, id.Version())
+
+	v7, err := NewUUIDGenerator(7)
+	s.NoError(err)
+	id, err = v7.Next()
+	s.NoError(err)
+	s.Equal(7, id.Version())
+
+	_, err = NewUUIDGenerator(1)
+	s.ErrorIs(err, ErrUUIDVersion)
+}
+
+func (s *GeneratorTestSuite) TestItCanGenerateMonotonicULIDs() {
+	moment := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	generator := NewULIDGenerator()
+	generator.now = func() time.Time { return moment }
+
+	previous, err := generator.Next()
+	s.NoError(err)
+	for range 100 {
+		next, err := generator.Next()
+		s.NoError(err)
+		s.Greater(next.String(), previous.String())
+		s.True(next.Time().Equal(moment))
+		previous = next
+	}
+
+	var saturated [16]byte
+	for i := 6; i < 16; i++ {
+		saturated[i] = 0xff
+	}
+	_, ok := incrementULIDEntropy(ULID{value: saturated})
+	s.False(ok)
+}
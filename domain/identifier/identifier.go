@@ -1,6 +1,7 @@
 package identifier
 
 import (
+	"bytes"
 	"strconv"
 
 	"github.com/golibry/go-common-domain/domain"
@@ -63,6 +64,57 @@ func (i IntIdentifier) String() string {
 	return strconv.FormatUint(i.value, 10)
 }
 
+// MarshalJSON encodes the identifier as a JSON number
+func (i IntIdentifier) MarshalJSON() ([]byte, error) {
+	return strconv.AppendUint(nil, i.value, 10), nil
+}
+
+// UnmarshalJSON decodes the identifier from a JSON number or a JSON string holding a number
+func (i *IntIdentifier) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+
+	parsed, err := NewIntIdentifierFromString(string(data))
+	if err != nil {
+		return err
+	}
+
+	*i = parsed
+	return nil
+}
+
+// StringIntIdentifier is an IntIdentifier that is encoded as a JSON string.
+// Use it for identifiers consumed by JavaScript clients, where numbers above 2^53
+// lose precision. Decoding accepts both JSON strings and JSON numbers.
+type StringIntIdentifier struct {
+	IntIdentifier
+}
+
+// NewStringIntIdentifier creates a new instance of StringIntIdentifier with validation
+func NewStringIntIdentifier(value uint64) (StringIntIdentifier, error) {
+	identifier, err := NewIntIdentifier(value)
+	if err != nil {
+		return StringIntIdentifier{}, err
+	}
+
+	return StringIntIdentifier{IntIdentifier: identifier}, nil
+}
+
+// AsStringIntIdentifier returns the identifier as a StringIntIdentifier
+func (i IntIdentifier) AsStringIntIdentifier() StringIntIdentifier {
+	return StringIntIdentifier{IntIdentifier: i}
+}
+
+// MarshalJSON encodes the identifier as a JSON string
+func (i StringIntIdentifier) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, i.String()), nil
+}
+
 // IsValidIntIdentifier validates an identifier (must be positive and non-zero)
 func IsValidIntIdentifier(value uint64) error {
 	if value == 0 {
@@ -1,6 +1,7 @@
 package identifier
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -159,3 +160,69 @@ func (s *IdentifierTestSuite) TestReconstitute() {
 	s.Equal(uint64(12345), identifier.Value())
 	s.Equal("12345", identifier.String())
 }
+
+func (s *IdentifierTestSuite) TestItCanMarshalJSON() {
+	identifier, _ := NewIntIdentifier(18446744073709551615)
+
+	asNumber, err := json.Marshal(identifier)
+	s.NoError(err)
+	s.Equal("18446744073709551615", string(asNumber))
+
+	asString, err := json.Marshal(identifier.AsStringIntIdentifier())
+	s.NoError(err)
+	s.Equal(`"18446744073709551615"`, string(asString))
+}
+
+func (s *IdentifierTestSuite) TestItCanUnmarshalJSON() {
+	testCases := []struct {
+		name          string
+		input         string
+		expected      uint64
+		expectedError error
+	}{
+		{name: "number", input: `123`, expected: 123},
+		{name: "string", input: `"9007199254740993"`, expected: 9007199254740993},
+		{name: "zero", input: `"0"`, expectedError: ErrZeroIdentifier},
+		{name: "unbalanced quotes", input: `"123`, expectedError: ErrInvalidIdentifier},
+		{name: "non numeric string", input: `"abc"`, expectedError: ErrInvalidIdentifier},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				var identifier IntIdentifier
+				err := identifier.UnmarshalJSON([]byte(tc.input))
+				var stringIdentifier StringIntIdentifier
+				stringErr := stringIdentifier.UnmarshalJSON([]byte(tc.input))
+				if tc.expectedError != nil {
+					s.ErrorIs(err, tc.expectedError)
+					s.ErrorIs(stringErr, tc.expectedError)
+					return
+				}
+				s.NoError(err)
+				s.NoError(stringErr)
+				s.Equal(tc.expected, identifier.Value())
+				s.Equal(tc.expected, stringIdentifier.Value())
+			},
+		)
+	}
+}
+
+func (s *IdentifierTestSuite) TestItKeepsStringIdentifierRoundTrip() {
+	type payload struct {
+		ID StringIntIdentifier `json:"id"`
+	}
+	identifier, err := NewStringIntIdentifier(42)
+	s.NoError(err)
+
+	encoded, err := json.Marshal(payload{ID: identifier})
+	s.NoError(err)
+	s.Equal(`{"id":"42"}`, string(encoded))
+
+	var decoded payload
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(decoded.ID.Equals(identifier.IntIdentifier))
+
+	_, err = NewStringIntIdentifier(0)
+	s.ErrorIs(err, ErrZeroIdentifier)
+}
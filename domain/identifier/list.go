@@ -0,0 +1,82 @@
+package identifier
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ElementError associates a parsing error with the list element that produced it
+type ElementError struct {
+	Index int
+	Value string
+	Err   error
+}
+
+// Error returns the error message prefixed with the element index and value
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %d (%q): %v", e.Index, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying parsing error
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// ListErrors is the list of element errors produced while parsing identifier lists
+type ListErrors []*ElementError
+
+// Error returns all element errors joined by a semicolon
+func (l ListErrors) Error() string {
+	messages := make([]string, 0, len(l))
+	for _, elementErr := range l {
+		messages = append(messages, elementErr.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the element errors, enabling compatibility with errors.Is and errors.As
+func (l ListErrors) Unwrap() []error {
+	errs := make([]error, 0, len(l))
+	for _, elementErr := range l {
+		errs = append(errs, elementErr)
+	}
+	return errs
+}
+
+// ParseIntIdentifierList parses a comma-separated list of identifiers such as "1,2,3".
+// Spaces around elements are ignored and an empty input yields an empty list.
+// If any element is invalid, the returned error is a ListErrors naming every bad element.
+func ParseIntIdentifierList(value string) ([]IntIdentifier, error) {
+	if strings.TrimSpace(value) == "" {
+		return []IntIdentifier{}, nil
+	}
+
+	elements := strings.Split(value, ",")
+	for i, element := range elements {
+		elements[i] = strings.TrimSpace(element)
+	}
+
+	return NewIntIdentifiersFromStrings(elements)
+}
+
+// NewIntIdentifiersFromStrings creates identifiers from a slice of strings.
+// If any element is invalid, the returned error is a ListErrors naming every bad element.
+func NewIntIdentifiersFromStrings(values []string) ([]IntIdentifier, error) {
+	identifiers := make([]IntIdentifier, 0, len(values))
+	var errs ListErrors
+
+	for i, value := range values {
+		identifier, err := NewIntIdentifierFromString(value)
+		if err != nil {
+			errs = append(errs, &ElementError{Index: i, Value: value, Err: err})
+			continue
+		}
+		identifiers = append(identifiers, identifier)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return identifiers, nil
+}
@@ -0,0 +1,75 @@
+package identifier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ListTestSuite struct {
+	suite.Suite
+}
+
+func TestListSuite(t *testing.T) {
+	suite.Run(t, new(ListTestSuite))
+}
+
+func (s *ListTestSuite) TestItCanParseValidLists() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []uint64
+	}{
+		{name: "single element", input: "7", expected: []uint64{7}},
+		{name: "multiple elements", input: "1,2,3", expected: []uint64{1, 2, 3}},
+		{name: "spaces around elements", input: " 1 , 2 ,3 ", expected: []uint64{1, 2, 3}},
+		{name: "empty input", input: "  ", expected: []uint64{}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				identifiers, err := ParseIntIdentifierList(tc.input)
+				s.NoError(err)
+
+				values := make([]uint64, 0, len(identifiers))
+				for _, identifier := range identifiers {
+					values = append(values, identifier.Value())
+				}
+				s.Equal(tc.expected, values)
+			},
+		)
+	}
+}
+
+func (s *ListTestSuite) TestItNamesEveryInvalidElement() {
+	_, err := ParseIntIdentifierList("1,abc,0,4,")
+	s.Error(err)
+
+	var listErrs ListErrors
+	s.True(errors.As(err, &listErrs))
+	s.Len(listErrs, 3)
+
+	s.Equal(1, listErrs[0].Index)
+	s.Equal("abc", listErrs[0].Value)
+	s.ErrorIs(listErrs[0], ErrInvalidIdentifier)
+	s.Equal(2, listErrs[1].Index)
+	s.ErrorIs(listErrs[1], ErrZeroIdentifier)
+	s.Equal(4, listErrs[2].Index)
+
+	s.ErrorIs(err, ErrZeroIdentifier)
+	s.Contains(err.Error(), `element 1 ("abc"): identifier format is invalid`)
+}
+
+func (s *ListTestSuite) TestItCanBuildFromStrings() {
+	identifiers, err := NewIntIdentifiersFromStrings([]string{"10", "20"})
+	s.NoError(err)
+	s.Len(identifiers, 2)
+	s.Equal(uint64(20), identifiers[1].Value())
+
+	_, err = NewIntIdentifiersFromStrings([]string{"10", " 20"})
+	var listErrs ListErrors
+	s.True(errors.As(err, &listErrs))
+	s.Equal(1, listErrs[0].Index)
+}
@@ -0,0 +1,202 @@
+package identifier
+
+import (
+	"bytes"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	SnowflakeNodeBits     = 10
+	SnowflakeSequenceBits = 12
+	SnowflakeTimeBits     = 41
+	MaxSnowflakeNode      = 1<<SnowflakeNodeBits - 1
+	MaxSnowflakeSequence  = 1<<SnowflakeSequenceBits - 1
+
+	snowflakeNodeShift = SnowflakeSequenceBits
+	snowflakeTimeShift = SnowflakeSequenceBits + SnowflakeNodeBits
+	maxSnowflakeTime   = 1<<SnowflakeTimeBits - 1
+)
+
+// SnowflakeEpoch is the reference point for Snowflake timestamps (2020-01-01T00:00:00Z)
+var SnowflakeEpoch = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	ErrInvalidSnowflake     = domain.NewError("snowflake identifier is invalid")
+	ErrInvalidSnowflakeNode = domain.NewError(
+		"snowflake node must be between 0 and %d",
+		MaxSnowflakeNode,
+	)
+	ErrSnowflakeClockMovedBackwards = domain.NewError(
+		"clock moved backwards; refusing to generate snowflake",
+	)
+	ErrSnowflakeTimeOverflow = domain.NewError("snowflake timestamp is out of range")
+)
+
+// Snowflake is a roughly time-ordered 64-bit identifier.
+// The layout (from the most significant bit) is: 1 unused bit, 41 bits of milliseconds since
+// SnowflakeEpoch, 10 bits of node ID and 12 bits of per-millisecond sequence.
+type Snowflake struct {
+	value uint64
+}
+
+// NewSnowflake creates a new instance of Snowflake with validation
+func NewSnowflake(value uint64) (Snowflake, error) {
+	if err := IsValidSnowflake(value); err != nil {
+		return Snowflake{}, err
+	}
+
+	return Snowflake{
+		value: value,
+	}, nil
+}
+
+// NewSnowflakeFromString creates a new instance of Snowflake from its decimal string form
+func NewSnowflakeFromString(value string) (Snowflake, error) {
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return Snowflake{}, ErrInvalidSnowflake
+	}
+
+	return NewSnowflake(parsed)
+}
+
+// ReconstituteSnowflake creates a new Snowflake instance without validation
+func ReconstituteSnowflake(value uint64) Snowflake {
+	return Snowflake{
+		value: value,
+	}
+}
+
+// Value returns the snowflake value as uint64
+func (s Snowflake) Value() uint64 {
+	return s.value
+}
+
+// Time returns the moment the snowflake was generated, with millisecond precision
+func (s Snowflake) Time() time.Time {
+	millis := int64(s.value >> snowflakeTimeShift)
+	return SnowflakeEpoch.Add(time.Duration(millis) * time.Millisecond)
+}
+
+// Node returns the ID of the node that generated the snowflake
+func (s Snowflake) Node() uint16 {
+	return uint16((s.value >> snowflakeNodeShift) & MaxSnowflakeNode)
+}
+
+// Sequence returns the per-millisecond sequence number of the snowflake
+func (s Snowflake) Sequence() uint16 {
+	return uint16(s.value & MaxSnowflakeSequence)
+}
+
+// Equals compares two Snowflake objects for equality
+func (s Snowflake) Equals(other Snowflake) bool {
+	return s.value == other.value
+}
+
+// String returns a string representation of the snowflake
+func (s Snowflake) String() string {
+	return strconv.FormatUint(s.value, 10)
+}
+
+// MarshalJSON encodes the snowflake as a JSON string, keeping it safe for JavaScript clients
+func (s Snowflake) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, s.String()), nil
+}
+
+// UnmarshalJSON decodes the snowflake from a JSON string or a JSON number
+func (s *Snowflake) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+
+	parsed, err := NewSnowflakeFromString(string(data))
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+	return nil
+}
+
+// IsValidSnowflake validates a snowflake (must be non-zero with the unused top bit cleared)
+func IsValidSnowflake(value uint64) error {
+	if value == 0 || value>>63 != 0 {
+		return ErrInvalidSnowflake
+	}
+
+	return nil
+}
+
+// SnowflakeGenerator generates Snowflake identifiers for a single node.
+// It is safe for concurrent use.
+type SnowflakeGenerator struct {
+	mu         sync.Mutex
+	node       uint16
+	lastMillis int64
+	sequence   uint16
+	now        func() time.Time
+}
+
+// NewSnowflakeGenerator creates a new SnowflakeGenerator for the given node ID
+func NewSnowflakeGenerator(node uint16) (*SnowflakeGenerator, error) {
+	if node > MaxSnowflakeNode {
+		return nil, ErrInvalidSnowflakeNode
+	}
+
+	return &SnowflakeGenerator{
+		node:       node,
+		lastMillis: -1,
+		now:        time.Now,
+	}, nil
+}
+
+// Node returns the node ID embedded in generated snowflakes
+func (g *SnowflakeGenerator) Node() uint16 {
+	return g.node
+}
+
+// Next generates the next Snowflake.
+// When the sequence is exhausted within a millisecond, it waits for the next millisecond.
+func (g *SnowflakeGenerator) Next() (Snowflake, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	millis := g.currentMillis()
+	if millis < 0 || millis > maxSnowflakeTime {
+		return Snowflake{}, ErrSnowflakeTimeOverflow
+	}
+	if millis < g.lastMillis {
+		return Snowflake{}, ErrSnowflakeClockMovedBackwards
+	}
+
+	if millis == g.lastMillis {
+		g.sequence = (g.sequence + 1) & MaxSnowflakeSequence
+		if g.sequence == 0 {
+			for millis <= g.lastMillis {
+				millis = g.currentMillis()
+			}
+		}
+	} else {
+		g.sequence = 0
+	}
+
+	g.lastMillis = millis
+	value := uint64(millis)<<snowflakeTimeShift |
+		uint64(g.node)<<snowflakeNodeShift |
+		uint64(g.sequence)
+
+	return NewSnowflake(value)
+}
+
+// currentMillis returns the milliseconds elapsed since SnowflakeEpoch
+func (g *SnowflakeGenerator) currentMillis() int64 {
+	return g.now().Sub(SnowflakeEpoch).Milliseconds()
+}
@@ -0,0 +1,142 @@
+package identifier
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SnowflakeTestSuite struct {
+	suite.Suite
+}
+
+func TestSnowflakeSuite(t *testing.T) {
+	suite.Run(t, new(SnowflakeTestSuite))
+}
+
+func (s *SnowflakeTestSuite) TestItCanGenerateSnowflakesWithEmbeddedParts() {
+	generatedAt := SnowflakeEpoch.Add(48 * time.Hour)
+	generator, err := NewSnowflakeGenerator(513)
+	s.NoError(err)
+	generator.now = func() time.Time { return generatedAt }
+
+	first, err := generator.Next()
+	s.NoError(err)
+	second, err := generator.Next()
+	s.NoError(err)
+
+	s.True(first.Time().Equal(generatedAt))
+	s.Equal(uint16(513), first.Node())
+	s.Equal(uint16(0), first.Sequence())
+	s.Equal(uint16(1), second.Sequence())
+	s.Greater(second.Value(), first.Value())
+}
+
+func (s *SnowflakeTestSuite) TestItWaitsForNextMillisecondWhenSequenceIsExhausted() {
+	current := SnowflakeEpoch.Add(time.Hour)
+	generator, _ := NewSnowflakeGenerator(1)
+	generator.now = func() time.Time { return current }
+
+	var last Snowflake
+	for range MaxSnowflakeSequence + 1 {
+		last, _ = generator.Next()
+	}
+	s.Equal(uint16(MaxSnowflakeSequence), last.Sequence())
+
+	calls := 0
+	generator.now = func() time.Time {
+		calls++
+		if calls > 2 {
+			return current.Add(time.Millisecond)
+		}
+		return current
+	}
+	next, err := generator.Next()
+	s.NoError(err)
+	s.Equal(uint16(0), next.Sequence())
+	s.True(next.Time().Equal(current.Add(time.Millisecond)))
+}
+
+func (s *SnowflakeTestSuite) TestItFailsWhenClockMovesBackwardsOrIsOutOfRange() {
+	current := SnowflakeEpoch.Add(time.Hour)
+	generator, _ := NewSnowflakeGenerator(1)
+	generator.now = func() time.Time { return current }
+	_, err := generator.Next()
+	s.NoError(err)
+
+	generator.now = func() time.Time { return current.Add(-time.Second) }
+	_, err = generator.Next()
+	s.ErrorIs(err, ErrSnowflakeClockMovedBackwards)
+
+	beforeEpoch, _ := NewSnowflakeGenerator(1)
+	beforeEpoch.now = func() time.Time { return SnowflakeEpoch.Add(-time.Hour) }
+	_, err = beforeEpoch.Next()
+	s.ErrorIs(err, ErrSnowflakeTimeOverflow)
+}
+
+func (s *SnowflakeTestSuite) TestItGeneratesUniqueSnowflakesConcurrently() {
+	generator, _ := NewSnowflakeGenerator(7)
+	seen := sync.Map{}
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 500 {
+				id, err := generator.Next()
+				s.NoError(err)
+				_, duplicate := seen.LoadOrStore(id.Value(), true)
+				s.False(duplicate)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *SnowflakeTestSuite) TestItFailsToBuildInvalidSnowflakes() {
+	_, err := NewSnowflakeGenerator(MaxSnowflakeNode + 1)
+	s.ErrorIs(err, ErrInvalidSnowflakeNode)
+
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{"zero", "0"},
+		{"top bit set", "9223372036854775808"},
+		{"non numeric", "abc"},
+		{"empty", ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewSnowflakeFromString(tc.input)
+				s.ErrorIs(err, ErrInvalidSnowflake)
+			},
+		)
+	}
+}
+
+func (s *SnowflakeTestSuite) TestItCanParseAndMarshalSnowflakes() {
+	value := uint64(3600000)<<snowflakeTimeShift | uint64(5)<<snowflakeNodeShift | 9
+	parsed, err := NewSnowflakeFromString(ReconstituteSnowflake(value).String())
+	s.NoError(err)
+	s.Equal(value, parsed.Value())
+	s.Equal(uint16(5), parsed.Node())
+	s.Equal(uint16(9), parsed.Sequence())
+	s.True(parsed.Time().Equal(SnowflakeEpoch.Add(time.Hour)))
+
+	encoded, err := json.Marshal(parsed)
+	s.NoError(err)
+	s.Equal(`"`+parsed.String()+`"`, string(encoded))
+
+	var fromString, fromNumber Snowflake
+	s.NoError(json.Unmarshal(encoded, &fromString))
+	s.NoError(json.Unmarshal([]byte(parsed.String()), &fromNumber))
+	s.True(parsed.Equals(fromString))
+	s.True(parsed.Equals(fromNumber))
+}
@@ -0,0 +1,80 @@
+package identifier
+
+// Identifiers implement encoding.TextUnmarshaler, so CSV readers and form decoders can parse
+// them directly; writers use String. Empty text leaves the value unchanged, so an empty CSV
+// cell or form field reads as a missing value.
+
+// UnmarshalText parses the identifier from text, with validation
+func (i *IntIdentifier) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewIntIdentifierFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = parsed
+	return nil
+}
+
+// UnmarshalText parses the snowflake from text, with validation
+func (s *Snowflake) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewSnowflakeFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+	return nil
+}
+
+// UnmarshalText parses the UUID from text, with validation
+func (u *UUID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewUUIDFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// UnmarshalText parses the ULID from text, with validation
+func (u *ULID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewULIDFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// UnmarshalText parses the composite identifier from text, with validation
+func (c *Composite) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewCompositeFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,53 @@
+package identifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TextTestSuite struct {
+	suite.Suite
+}
+
+func TestTextSuite(t *testing.T) {
+	suite.Run(t, new(TextTestSuite))
+}
+
+func (s *TextTestSuite) TestItCanParseIdentifiersFromText() {
+	var id IntIdentifier
+	s.Require().NoError(id.UnmarshalText([]byte("42")))
+	s.Equal(uint64(42), id.Value())
+
+	var snowflake Snowflake
+	s.Require().NoError(snowflake.UnmarshalText([]byte("1541815603606036480")))
+	s.Equal(uint64(1541815603606036480), snowflake.Value())
+
+	var uuid UUID
+	s.Require().NoError(uuid.UnmarshalText([]byte("0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b")))
+	s.Equal("0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b", uuid.String())
+
+	var ulid ULID
+	s.Require().NoError(ulid.UnmarshalText([]byte("01ARZ3NDEKTSV4RRFFQ69G5FAV")))
+	s.Equal("01ARZ3NDEKTSV4RRFFQ69G5FAV", ulid.String())
+
+	var composite Composite
+	s.Require().NoError(composite.UnmarshalText([]byte("tenant-1:42")))
+	s.Equal("tenant-1:42", composite.String())
+}
+
+func (s *TextTestSuite) TestItValidatesText() {
+	var id IntIdentifier
+	s.ErrorIs(id.UnmarshalText([]byte("abc")), ErrInvalidIdentifier)
+	s.ErrorIs(id.UnmarshalText([]byte("0")), ErrZeroIdentifier)
+
+	var uuid UUID
+	s.Error(uuid.UnmarshalText([]byte("not-a-uuid")))
+}
+
+func (s *TextTestSuite) TestItLeavesTheValueUnchangedForEmptyText() {
+	id, _ := NewIntIdentifier(7)
+
+	s.NoError(id.UnmarshalText(nil))
+	s.Equal(uint64(7), id.Value())
+}
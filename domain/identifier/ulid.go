@@ -0,0 +1,187 @@
+package identifier
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	ulidStringLength  = 26
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	maxULIDMillis     = 1<<48 - 1
+)
+
+var (
+	ErrInvalidULID      = domain.NewError("ULID format is invalid")
+	ErrULIDTimeOverflow = domain.NewError("ULID timestamp is out of range")
+)
+
+// ULID is a lexicographically sortable identifier made of a 48-bit millisecond timestamp
+// followed by 80 bits of randomness, encoded as 26 Crockford base32 characters.
+type ULID struct {
+	value [16]byte
+}
+
+// NewULID generates a new ULID for the current time
+func NewULID() (ULID, error) {
+	return newULIDAt(time.Now())
+}
+
+// NewULIDFromString creates a new instance of ULID from its string form (case-insensitive)
+func NewULIDFromString(value string) (ULID, error) {
+	normalized, err := NormalizeULID(value)
+	if err != nil {
+		return ULID{}, err
+	}
+
+	var decoded [16]byte
+	var bits uint
+	var accumulator uint32
+	index := 15
+	for i := len(normalized) - 1; i >= 0; i-- {
+		accumulator |= uint32(strings.IndexByte(crockfordAlphabet, normalized[i])) << bits
+		bits += 5
+		for bits >= 8 && index >= 0 {
+			decoded[index] = byte(accumulator)
+			index--
+			accumulator >>= 8
+			bits -= 8
+		}
+	}
+	if index >= 0 {
+		decoded[index] = byte(accumulator)
+	}
+
+	return ULID{value: decoded}, nil
+}
+
+// ReconstituteULID creates a new ULID instance without validation
+func ReconstituteULID(value [16]byte) ULID {
+	return ULID{
+		value: value,
+	}
+}
+
+// Bytes returns the 16 bytes of the ULID
+func (u ULID) Bytes() [16]byte {
+	return u.value
+}
+
+// Time returns the timestamp embedded in the ULID, with millisecond precision
+func (u ULID) Time() time.Time {
+	var millis uint64
+	for _, b := range u.value[:6] {
+		millis = millis<<8 | uint64(b)
+	}
+	return time.UnixMilli(int64(millis)).UTC()
+}
+
+// Equals compares two ULID objects for equality
+func (u ULID) Equals(other ULID) bool {
+	return u.value == other.value
+}
+
+// String returns the canonical uppercase Crockford base32 representation of the ULID
+func (u ULID) String() string {
+	var buf [ulidStringLength]byte
+	var bits uint
+	var accumulator uint32
+	index := ulidStringLength - 1
+	for i := 15; i >= 0; i-- {
+		accumulator |= uint32(u.value[i]) << bits
+		bits += 8
+		for bits >= 5 {
+			buf[index] = crockfordAlphabet[accumulator&0x1f]
+			index--
+			accumulator >>= 5
+			bits -= 5
+		}
+	}
+	buf[index] = crockfordAlphabet[accumulator&0x1f]
+	return string(buf[:])
+}
+
+// MarshalJSON encodes the ULID as a JSON string
+func (u ULID) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, u.String()), nil
+}
+
+// UnmarshalJSON decodes the ULID from a JSON string
+func (u *ULID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	unquoted, err := strconv.Unquote(string(data))
+	if err != nil {
+		return ErrInvalidULID
+	}
+
+	parsed, err := NewULIDFromString(unquoted)
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// NormalizeULID normalizes a ULID string by trimming spaces and converting to uppercase
+func NormalizeULID(value string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+
+	if err := IsValidULID(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// IsValidULID validates an uppercase ULID string
+func IsValidULID(value string) error {
+	if len(value) != ulidStringLength {
+		return ErrInvalidULID
+	}
+
+	// The first character only carries 3 bits, so anything above '7' overflows 128 bits
+	if value[0] > '7' {
+		return ErrInvalidULID
+	}
+
+	for i := 0; i < len(value); i++ {
+		if strings.IndexByte(crockfordAlphabet, value[i]) < 0 {
+			return ErrInvalidULID
+		}
+	}
+
+	return nil
+}
+
+// newULIDAt generates a ULID using the given moment as its timestamp
+func newULIDAt(moment time.Time) (ULID, error) {
+	millis := moment.UnixMilli()
+	if millis < 0 || millis > maxULIDMillis {
+		return ULID{}, ErrULIDTimeOverflow
+	}
+
+	var value [16]byte
+	if _, err := rand.Read(value[6:]); err != nil {
+		return ULID{}, domain.NewInternalErrorWithWrap(err, "failed to generate ULID")
+	}
+	putULIDMillis(&value, uint64(millis))
+
+	return ULID{value: value}, nil
+}
+
+// putULIDMillis writes the 48-bit timestamp into the first 6 bytes of the ULID
+func putULIDMillis(value *[16]byte, millis uint64) {
+	for i := 5; i >= 0; i-- {
+		value[i] = byte(millis)
+		millis >>= 8
+	}
+}
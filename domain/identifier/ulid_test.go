@@ -0,0 +1,81 @@
+package identifier
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ULIDTestSuite struct {
+	suite.Suite
+}
+
+func TestULIDSuite(t *testing.T) {
+	suite.Run(t, new(ULIDTestSuite))
+}
+
+func (s *ULIDTestSuite) TestItCanGenerateAndParseULIDs() {
+	moment := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	id, err := newULIDAt(moment)
+	s.NoError(err)
+	s.True(id.Time().Equal(moment))
+
+	parsed, err := NewULIDFromString(id.String())
+	s.NoError(err)
+	s.True(parsed.Equals(id))
+
+	generated, err := NewULID()
+	s.NoError(err)
+	s.NoError(IsValidULID(generated.String()))
+}
+
+func (s *ULIDTestSuite) TestItEncodesKnownValues() {
+	var maxValue [16]byte
+	for i := range maxValue {
+		maxValue[i] = 0xff
+	}
+	s.Equal("7ZZZZZZZZZZZZZZZZZZZZZZZZZ", ReconstituteULID(maxValue).String())
+
+	parsed, err := NewULIDFromString(" 01arz3ndektsv4rrffq69g5fav ")
+	s.NoError(err)
+	s.Equal("01ARZ3NDEKTSV4RRFFQ69G5FAV", parsed.String())
+	s.Equal(int64(1469922850259), parsed.Time().UnixMilli())
+}
+
+func (s *ULIDTestSuite) TestItFailsToParseInvalidULIDs() {
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{"too short", "01ARZ3NDEKTSV4RRFFQ69G5FA"},
+		{"overflowing first character", "81ARZ3NDEKTSV4RRFFQ69G5FAV"},
+		{"excluded letter", "01ARZ3NDEKTSV4RRFFQ69G5FAU"},
+		{"empty", ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewULIDFromString(tc.input)
+				s.ErrorIs(err, ErrInvalidULID)
+			},
+		)
+	}
+
+	_, err := newULIDAt(time.UnixMilli(-1))
+	s.ErrorIs(err, ErrULIDTimeOverflow)
+}
+
+func (s *ULIDTestSuite) TestItCanMarshalJSON() {
+	id, _ := NewULIDFromString("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	encoded, err := json.Marshal(id)
+	s.NoError(err)
+	s.Equal(`"01ARZ3NDEKTSV4RRFFQ69G5FAV"`, string(encoded))
+
+	var decoded ULID
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(id.Equals(decoded))
+}
@@ -0,0 +1,183 @@
+package identifier
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const uuidStringLength = 36
+
+var (
+	ErrInvalidUUID = domain.NewError("UUID format is invalid")
+	ErrNilUUID     = domain.NewError("UUID cannot be the nil UUID")
+	ErrUUIDVersion = domain.NewError("UUID version is not supported")
+)
+
+// UUID is an RFC 9562 universally unique identifier
+type UUID struct {
+	value [16]byte
+}
+
+// NewUUIDv4 generates a new random (version 4) UUID
+func NewUUIDv4() (UUID, error) {
+	var value [16]byte
+	if _, err := rand.Read(value[:]); err != nil {
+		return UUID{}, domain.NewInternalErrorWithWrap(err, "failed to generate UUID")
+	}
+
+	return UUID{value: withUUIDVersion(value, 4)}, nil
+}
+
+// NewUUIDv7 generates a new time-ordered (version 7) UUID
+func NewUUIDv7() (UUID, error) {
+	return newUUIDv7At(time.Now())
+}
+
+// NewUUIDFromString creates a new instance of UUID from its canonical string form.
+// The 8-4-4-4-12 hexadecimal form is required; letter case is ignored.
+func NewUUIDFromString(value string) (UUID, error) {
+	normalized, err := NormalizeUUID(value)
+	if err != nil {
+		return UUID{}, err
+	}
+
+	var parsed [16]byte
+	_, _ = hex.Decode(parsed[:], []byte(strings.ReplaceAll(normalized, "-", "")))
+	return UUID{value: parsed}, nil
+}
+
+// ReconstituteUUID creates a new UUID instance without validation
+func ReconstituteUUID(value [16]byte) UUID {
+	return UUID{
+		value: value,
+	}
+}
+
+// Bytes returns the 16 bytes of the UUID
+func (u UUID) Bytes() [16]byte {
+	return u.value
+}
+
+// Version returns the UUID version number
+func (u UUID) Version() int {
+	return int(u.value[6] >> 4)
+}
+
+// Equals compares two UUID objects for equality
+func (u UUID) Equals(other UUID) bool {
+	return u.value == other.value
+}
+
+// String returns the canonical lowercase string representation of the UUID
+func (u UUID) String() string {
+	var buf [uuidStringLength]byte
+	hex.Encode(buf[0:8], u.value[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u.value[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u.value[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u.value[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u.value[10:])
+	return string(buf[:])
+}
+
+// MarshalJSON encodes the UUID as a JSON string
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, u.String()), nil
+}
+
+// UnmarshalJSON decodes the UUID from a JSON string
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	unquoted, err := strconv.Unquote(string(data))
+	if err != nil {
+		return ErrInvalidUUID
+	}
+
+	parsed, err := NewUUIDFromString(unquoted)
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// NormalizeUUID normalizes a UUID string by trimming spaces and converting to lowercase
+func NormalizeUUID(value string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+
+	if err := IsValidUUID(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// IsValidUUID validates a UUID string in canonical 8-4-4-4-12 form (the nil UUID is rejected)
+func IsValidUUID(value string) error {
+	if len(value) != uuidStringLength {
+		return ErrInvalidUUID
+	}
+
+	nonZero := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if c != '-' {
+				return ErrInvalidUUID
+			}
+			continue
+		}
+		if !isHexDigit(c) {
+			return ErrInvalidUUID
+		}
+		if c != '0' {
+			nonZero = true
+		}
+	}
+
+	if !nonZero {
+		return ErrNilUUID
+	}
+
+	return nil
+}
+
+// newUUIDv7At generates a version 7 UUID using the given moment as its timestamp
+func newUUIDv7At(moment time.Time) (UUID, error) {
+	var value [16]byte
+	if _, err := rand.Read(value[6:]); err != nil {
+		return UUID{}, domain.NewInternalErrorWithWrap(err, "failed to generate UUID")
+	}
+
+	var millis [8]byte
+	binary.BigEndian.PutUint64(millis[:], uint64(moment.UnixMilli()))
+	copy(value[0:6], millis[2:])
+
+	return UUID{value: withUUIDVersion(value, 7)}, nil
+}
+
+// withUUIDVersion sets the version and RFC 9562 variant bits on the raw UUID bytes
+func withUUIDVersion(value [16]byte, version byte) [16]byte {
+	value[6] = (value[6] & 0x0f) | version<<4
+	value[8] = (value[8] & 0x3f) | 0x80
+	return value
+}
+
+// isHexDigit reports whether c is a hexadecimal digit
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
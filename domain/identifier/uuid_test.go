@@ -0,0 +1,95 @@
+package identifier
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UUIDTestSuite struct {
+	suite.Suite
+}
+
+func TestUUIDSuite(t *testing.T) {
+	suite.Run(t, new(UUIDTestSuite))
+}
+
+func (s *UUIDTestSuite) TestItCanGenerateVersionedUUIDs() {
+	v4, err := NewUUIDv4()
+	s.NoError(err)
+	s.Equal(4, v4.Version())
+	s.NoError(IsValidUUID(v4.String()))
+
+	v7, err := NewUUIDv7()
+	s.NoError(err)
+	s.Equal(7, v7.Version())
+
+	earlier, _ := newUUIDv7At(time.UnixMilli(1000))
+	later, _ := newUUIDv7At(time.UnixMilli(2000))
+	s.Less(earlier.String(), later.String())
+}
+
+func (s *UUIDTestSuite) TestItCanParseUUIDs() {
+	testCases := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:     "canonical lowercase",
+			input:    "123e4567-e89b-12d3-a456-426614174000",
+			expected: "123e4567-e89b-12d3-a456-426614174000",
+		},
+		{
+			name:     "uppercase with spaces",
+			input:    " 123E4567-E89B-12D3-A456-426614174000 ",
+			expected: "123e4567-e89b-12d3-a456-426614174000",
+		},
+		{
+			name:          "nil UUID",
+			input:         "00000000-0000-0000-0000-000000000000",
+			expectedError: ErrNilUUID,
+		},
+		{
+			name:          "missing hyphens",
+			input:         "123e4567e89b12d3a456426614174000",
+			expectedError: ErrInvalidUUID,
+		},
+		{
+			name:          "non hex characters",
+			input:         "123e4567-e89b-12d3-a456-42661417400g",
+			expectedError: ErrInvalidUUID,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				uuid, err := NewUUIDFromString(tc.input)
+				if tc.expectedError != nil {
+					s.ErrorIs(err, tc.expectedError)
+					return
+				}
+				s.NoError(err)
+				s.Equal(tc.expected, uuid.String())
+				s.True(uuid.Equals(ReconstituteUUID(uuid.Bytes())))
+			},
+		)
+	}
+}
+
+func (s *UUIDTestSuite) TestItCanMarshalJSON() {
+	uuid, _ := NewUUIDFromString("123e4567-e89b-12d3-a456-426614174000")
+
+	encoded, err := json.Marshal(uuid)
+	s.NoError(err)
+	s.Equal(`"123e4567-e89b-12d3-a456-426614174000"`, string(encoded))
+
+	var decoded UUID
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(uuid.Equals(decoded))
+	s.ErrorIs(json.Unmarshal([]byte(`123`), &decoded), ErrInvalidUUID)
+}
@@ -0,0 +1,138 @@
+package identifier
+
+import "github.com/golibry/go-common-domain/domain"
+
+// YAML encodings let value objects bind directly from configuration files. The methods follow
+// the MarshalYAML/UnmarshalYAML(func(any) error) convention understood by gopkg.in/yaml.v2,
+// gopkg.in/yaml.v3 and other YAML libraries, so this package does not depend on any of them.
+// Zero values encode as YAML null, which decoders skip.
+
+// MarshalYAML encodes the identifier as a YAML integer
+func (i IntIdentifier) MarshalYAML() (any, error) {
+	if i.value == 0 {
+		return nil, nil
+	}
+
+	return i.value, nil
+}
+
+// UnmarshalYAML decodes the identifier from a YAML integer or string, with validation
+func (i *IntIdentifier) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid identifier YAML")
+	}
+
+	parsed, err := NewIntIdentifierFromString(raw)
+	if err != nil {
+		return err
+	}
+
+	*i = parsed
+	return nil
+}
+
+// MarshalYAML encodes the identifier as a YAML string
+func (i StringIntIdentifier) MarshalYAML() (any, error) {
+	if i.value == 0 {
+		return nil, nil
+	}
+
+	return i.String(), nil
+}
+
+// MarshalYAML encodes the snowflake as a YAML string
+func (s Snowflake) MarshalYAML() (any, error) {
+	if s.value == 0 {
+		return nil, nil
+	}
+
+	return s.String(), nil
+}
+
+// UnmarshalYAML decodes the snowflake from a YAML integer or string, with validation
+func (s *Snowflake) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid snowflake YAML")
+	}
+
+	parsed, err := NewSnowflakeFromString(raw)
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+	return nil
+}
+
+// MarshalYAML encodes the UUID as a YAML string
+func (u UUID) MarshalYAML() (any, error) {
+	if u.value == ([16]byte{}) {
+		return nil, nil
+	}
+
+	return u.String(), nil
+}
+
+// UnmarshalYAML decodes the UUID from a YAML string, with validation
+func (u *UUID) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid UUID YAML")
+	}
+
+	parsed, err := NewUUIDFromString(raw)
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// MarshalYAML encodes the ULID as a YAML string
+func (u ULID) MarshalYAML() (any, error) {
+	return u.String(), nil
+}
+
+// UnmarshalYAML decodes the ULID from a YAML string, with validation
+func (u *ULID) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid ULID YAML")
+	}
+
+	parsed, err := NewULIDFromString(raw)
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// MarshalYAML encodes the composite identifier as a YAML string in canonical form
+func (c Composite) MarshalYAML() (any, error) {
+	if len(c.parts) == 0 {
+		return nil, nil
+	}
+
+	return c.String(), nil
+}
+
+// UnmarshalYAML decodes the composite identifier from a YAML string, with validation
+func (c *Composite) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid composite identifier YAML")
+	}
+
+	parsed, err := NewCompositeFromString(raw)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,101 @@
+package identifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLTestSuite struct {
+	suite.Suite
+}
+
+func TestYAMLSuite(t *testing.T) {
+	suite.Run(t, new(YAMLTestSuite))
+}
+
+type identifierConfig struct {
+	ID        IntIdentifier       `yaml:"id"`
+	PublicID  StringIntIdentifier `yaml:"publicId"`
+	Snowflake Snowflake           `yaml:"snowflake"`
+	UUID      UUID                `yaml:"uuid"`
+	ULID      ULID                `yaml:"ulid"`
+	Key       Composite           `yaml:"key"`
+}
+
+func (s *YAMLTestSuite) TestItCanBindIdentifiersFromYAML() {
+	document := `
+id: 42
+publicId: 9007199254740993
+snowflake: "1541815603606036480"
+uuid: 0190A2B4-5C6D-7E8F-9A0B-1C2D3E4F5A6B
+ulid: 01ARZ3NDEKTSV4RRFFQ69G5FAV
+key: 42:abc
+`
+
+	var config identifierConfig
+	s.Require().NoError(yaml.Unmarshal([]byte(document), &config))
+
+	s.Equal(uint64(42), config.ID.Value())
+	s.Equal(uint64(9007199254740993), config.PublicID.Value())
+	s.Equal(uint64(1541815603606036480), config.Snowflake.Value())
+	s.Equal("0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b", config.UUID.String())
+	s.Equal("01ARZ3NDEKTSV4RRFFQ69G5FAV", config.ULID.String())
+	s.Equal([]string{"42", "abc"}, config.Key.Parts())
+}
+
+func (s *YAMLTestSuite) TestItCanRoundTripIdentifiersThroughYAML() {
+	id, _ := NewIntIdentifier(42)
+	publicID, _ := NewStringIntIdentifier(7)
+	snowflake, _ := NewSnowflake(1541815603606036480)
+	uuid, _ := NewUUIDFromString("0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b")
+	ulid, _ := NewULIDFromString("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	key, _ := NewCompositeFromString("42:abc")
+	original := identifierConfig{
+		ID:        id,
+		PublicID:  publicID,
+		Snowflake: snowflake,
+		UUID:      uuid,
+		ULID:      ulid,
+		Key:       key,
+	}
+
+	encoded, err := yaml.Marshal(original)
+	s.Require().NoError(err)
+	s.Equal(
+		"id: 42\n"+
+			"publicId: \"7\"\n"+
+			"snowflake: \"1541815603606036480\"\n"+
+			"uuid: 0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b\n"+
+			"ulid: 01ARZ3NDEKTSV4RRFFQ69G5FAV\n"+
+			"key: 42:abc\n",
+		string(encoded),
+	)
+
+	var decoded identifierConfig
+	s.Require().NoError(yaml.Unmarshal(encoded, &decoded))
+	s.Equal(original, decoded)
+}
+
+func (s *YAMLTestSuite) TestItValidatesWhenUnmarshalingYAML() {
+	testCases := []struct {
+		name     string
+		document string
+		expected error
+	}{
+		{"Zero identifier", "id: 0", ErrZeroIdentifier},
+		{"Non-numeric identifier", "id: abc", ErrInvalidIdentifier},
+		{"Invalid UUID", "uuid: not-a-uuid", ErrInvalidUUID},
+		{"Single composite part", "key: only", ErrTooFewCompositeParts},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				var config identifierConfig
+				s.ErrorIs(yaml.Unmarshal([]byte(tc.document), &config), tc.expected)
+			},
+		)
+	}
+}
@@ -0,0 +1,391 @@
+// Package jsonschema emits JSON Schema (draft 2020-12) definitions for the value objects, built
+// from the same length limits and patterns the constructors validate with, so API contracts
+// stay in sync with the runtime rules.
+//
+// Each schema describes the JSON form of a value object: its MarshalJSON output where it has
+// one, otherwise its canonical string. Patterns match normalized values, so input that a
+// constructor would normalize first (e.g., a lowercase country code) can fail a strict
+// client-side check. Publish every definition under $defs with Definitions:
+//
+//	document := map[string]any{"$defs": jsonschema.Definitions()}
+//	property := jsonschema.Ref("Email")
+package jsonschema
+
+import (
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+)
+
+// Patterns for value objects validated by code rather than a single regular expression. Each
+// one mirrors the matching IsValid function or constructor.
+const (
+	UsernamePattern      = `^[a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?$`
+	UUIDPattern          = `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`
+	ULIDPattern          = `^[0-7][0-9A-HJKMNP-TV-Z]{25}$`
+	JWTPattern           = `^[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*$`
+	UnsignedPattern      = `^[1-9][0-9]*$`
+	AmountPattern        = `^[0-9]+(\.[0-9]+)?$`
+	AccountNumberPattern = `^[A-Z0-9]+$`
+	RoutingNumberPattern = `^[0-9]+$`
+	CompositePattern     = "^[^" + identifier.CompositeSeparator + "]+(" +
+		identifier.CompositeSeparator + "[^" + identifier.CompositeSeparator + "]+)+$"
+)
+
+// Schema is a JSON Schema definition, modelling only the keywords the value objects need
+type Schema struct {
+	Ref         string `json:"$ref,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Format      string `json:"format,omitempty"`
+	Pattern     string `json:"pattern,omitempty"`
+	MinLength   *int   `json:"minLength,omitempty"`
+	MaxLength   *int   `json:"maxLength,omitempty"`
+	Minimum     *int64 `json:"minimum,omitempty"`
+	// Enum lists the allowed values
+	Enum       []string          `json:"enum,omitempty"`
+	Properties map[string]Schema `json:"properties,omitempty"`
+	Required   []string          `json:"required,omitempty"`
+	// AdditionalProperties is either false or a *Schema for the values of a map
+	AdditionalProperties any      `json:"additionalProperties,omitempty"`
+	PropertyNames        *Schema  `json:"propertyNames,omitempty"`
+	AnyOf                []Schema `json:"anyOf,omitempty"`
+}
+
+// Ref returns a schema referencing the named definition under $defs
+func Ref(name string) Schema {
+	return Schema{Ref: "#/$defs/" + name}
+}
+
+// Definitions returns the schema of every value object, keyed by type name. Money uses
+// MoneyJSONString, the format of Money.MarshalJSON.
+func Definitions() map[string]Schema {
+	return map[string]Schema{
+		"Email":               Email(),
+		"URL":                 URL(),
+		"DomainName":          DomainName(),
+		"IPAddress":           IPAddress(),
+		"CountryCode":         CountryCode(),
+		"PhoneNumber":         PhoneNumber(),
+		"FullName":            FullName(),
+		"NationalID":          NationalID(),
+		"Username":            Username(),
+		"Password":            Password(),
+		"JWT":                 JWT(),
+		"IntIdentifier":       IntIdentifier(),
+		"StringIntIdentifier": StringIntIdentifier(),
+		"Snowflake":           Snowflake(),
+		"UUID":                UUID(),
+		"ULID":                ULID(),
+		"Composite":           Composite(),
+		"Currency":            Currency(),
+		"Money":               Money(finance.MoneyJSONString),
+		"MoneyBag":            MoneyBag(),
+		"InterestRate":        InterestRate(),
+		"RoundingMode":        RoundingMode(),
+		"ISIN":                ISIN(),
+		"CUSIP":               CUSIP(),
+		"AccountNumber":       AccountNumber(),
+		"RoutingNumber":       RoutingNumber(),
+	}
+}
+
+// Email returns the schema of web.Email
+func Email() Schema {
+	return Schema{
+		Type:      "string",
+		Format:    "email",
+		Pattern:   web.EmailPattern,
+		MinLength: intPtr(web.MinEmailLength),
+		MaxLength: intPtr(web.MaxEmailLength),
+	}
+}
+
+// URL returns the schema of web.URL
+func URL() Schema {
+	return Schema{
+		Type:      "string",
+		Format:    "uri",
+		MaxLength: intPtr(web.MaxURLLength),
+	}
+}
+
+// DomainName returns the schema of web.DomainName
+func DomainName() Schema {
+	return Schema{
+		Type:      "string",
+		Format:    "hostname",
+		Pattern:   web.DomainNamePattern,
+		MinLength: intPtr(web.MinDomainNameLength),
+		MaxLength: intPtr(web.MaxDomainNameLength),
+	}
+}
+
+// IPAddress returns the schema of web.IPAddress, either an IPv4 or an IPv6 address
+func IPAddress() Schema {
+	return Schema{
+		Type:  "string",
+		AnyOf: []Schema{{Format: "ipv4"}, {Format: "ipv6"}},
+	}
+}
+
+// CountryCode returns the schema of geography.CountryCode (ISO 3166-1 alpha-2)
+func CountryCode() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: geography.CountryCodePattern,
+	}
+}
+
+// PhoneNumber returns the schema of contact.PhoneNumber, an E.164 number without extension
+func PhoneNumber() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: contact.PhoneNumberPattern,
+	}
+}
+
+// FullName returns the schema of person.FullName. Name parts are limited in characters (code
+// points), as JSON Schema counts them.
+func FullName() Schema {
+	part := Schema{
+		Type:      "string",
+		MinLength: intPtr(1),
+		MaxLength: intPtr(person.MaxNamePartLength),
+	}
+	optionalPart := Schema{Type: "string", MaxLength: intPtr(person.MaxNamePartLength)}
+	affix := Schema{Type: "string", MaxLength: intPtr(person.MaxNameAffixLength)}
+
+	return Schema{
+		Type: "object",
+		Properties: map[string]Schema{
+			"prefix":     affix,
+			"firstName":  part,
+			"middleName": optionalPart,
+			"lastName":   part,
+			"suffix":     affix,
+		},
+		Required:             []string{"firstName", "lastName"},
+		AdditionalProperties: false,
+	}
+}
+
+// NationalID returns the schema of person.NationalID. The value is masked on output.
+func NationalID() Schema {
+	return Schema{
+		Type: "object",
+		Properties: map[string]Schema{
+			"country": CountryCode(),
+			"value": {
+				Type:        "string",
+				MinLength:   intPtr(1),
+				Description: "masked on output, except for the last few characters",
+			},
+		},
+		Required:             []string{"country", "value"},
+		AdditionalProperties: false,
+	}
+}
+
+// Username returns the schema of auth.Username
+func Username() Schema {
+	return Schema{
+		Type:      "string",
+		Pattern:   UsernamePattern,
+		MinLength: intPtr(auth.MinUsernameLength),
+		MaxLength: intPtr(auth.MaxUsernameLength),
+	}
+}
+
+// Password returns the schema of a plaintext password accepted by auth.NewPassword. It only
+// applies to input; passwords are never serialized.
+func Password() Schema {
+	return Schema{
+		Type:      "string",
+		Format:    "password",
+		MinLength: intPtr(auth.MinPasswordLength),
+		MaxLength: intPtr(auth.MaxPasswordLength),
+	}
+}
+
+// JWT returns the schema of auth.JWT in compact serialization
+func JWT() Schema {
+	return Schema{
+		Type:      "string",
+		Pattern:   JWTPattern,
+		MaxLength: intPtr(auth.MaxJWTLength),
+	}
+}
+
+// IntIdentifier returns the schema of identifier.IntIdentifier, a positive JSON number
+func IntIdentifier() Schema {
+	return Schema{
+		Type:    "integer",
+		Minimum: int64Ptr(1),
+	}
+}
+
+// StringIntIdentifier returns the schema of identifier.StringIntIdentifier, a positive integer
+// encoded as a string to survive JavaScript's 53-bit numbers
+func StringIntIdentifier() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: UnsignedPattern,
+	}
+}
+
+// Snowflake returns the schema of identifier.Snowflake, encoded as a string
+func Snowflake() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: UnsignedPattern,
+	}
+}
+
+// UUID returns the schema of identifier.UUID in its lowercase canonical form
+func UUID() Schema {
+	return Schema{
+		Type:    "string",
+		Format:  "uuid",
+		Pattern: UUIDPattern,
+	}
+}
+
+// ULID returns the schema of identifier.ULID in Crockford base32
+func ULID() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: ULIDPattern,
+	}
+}
+
+// Composite returns the schema of identifier.Composite, at least identifier.MinCompositeParts
+// non-empty parts joined by identifier.CompositeSeparator
+func Composite() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: CompositePattern,
+	}
+}
+
+// Currency returns the schema of finance.Currency (ISO 4217)
+func Currency() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: finance.CurrencyPattern,
+	}
+}
+
+// Money returns the schema of finance.Money encoded with the given JSON format
+func Money(format finance.MoneyJSONFormat) Schema {
+	schema := Schema{
+		Type:                 "object",
+		Properties:           map[string]Schema{"currency": Currency()},
+		AdditionalProperties: false,
+	}
+
+	switch format {
+	case finance.MoneyJSONNumber:
+		schema.Properties["amount"] = Schema{Type: "number", Minimum: int64Ptr(0)}
+		schema.Required = []string{"amount", "currency"}
+	case finance.MoneyJSONMinorUnits:
+		schema.Properties["minorUnits"] = Schema{Type: "integer", Minimum: int64Ptr(0)}
+		schema.Required = []string{"minorUnits", "currency"}
+	default:
+		schema.Properties["amount"] = Schema{Type: "string", Pattern: AmountPattern}
+		schema.Required = []string{"amount", "currency"}
+	}
+
+	return schema
+}
+
+// MoneyBag returns the schema of finance.MoneyBag, decimal amounts keyed by currency code
+func MoneyBag() Schema {
+	return Schema{
+		Type:                 "object",
+		PropertyNames:        &Schema{Pattern: finance.CurrencyPattern},
+		AdditionalProperties: &Schema{Type: "string", Pattern: AmountPattern},
+	}
+}
+
+// InterestRate returns the schema of finance.InterestRate, an annual percentage encoded as a
+// decimal string between 0 and finance.MaxInterestRatePercent
+func InterestRate() Schema {
+	return Schema{
+		Type:        "string",
+		Pattern:     AmountPattern,
+		Description: "annual percentage rate, at most " + strconv.Itoa(finance.MaxInterestRatePercent),
+	}
+}
+
+// RoundingMode returns the schema of finance.RoundingMode, one of its names
+func RoundingMode() Schema {
+	modes := []finance.RoundingMode{
+		finance.RoundHalfUp,
+		finance.RoundHalfEven,
+		finance.RoundDown,
+		finance.RoundUp,
+	}
+
+	names := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		names = append(names, mode.String())
+	}
+
+	return Schema{
+		Type: "string",
+		Enum: names,
+	}
+}
+
+// ISIN returns the schema of finance.ISIN
+func ISIN() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: finance.ISINPattern,
+	}
+}
+
+// CUSIP returns the schema of finance.CUSIP
+func CUSIP() Schema {
+	return Schema{
+		Type:    "string",
+		Pattern: finance.CUSIPPattern,
+	}
+}
+
+// AccountNumber returns the schema of a full finance.AccountNumber as accepted on input. The
+// JSON output is masked.
+func AccountNumber() Schema {
+	return Schema{
+		Type:        "string",
+		Pattern:     AccountNumberPattern,
+		MinLength:   intPtr(finance.MinAccountNumberLength),
+		MaxLength:   intPtr(finance.MaxAccountNumberLength),
+		Description: "masked on output, except for the last few characters",
+	}
+}
+
+// RoutingNumber returns the schema of finance.RoutingNumber
+func RoutingNumber() Schema {
+	return Schema{
+		Type:      "string",
+		Pattern:   RoutingNumberPattern,
+		MinLength: intPtr(finance.MinRoutingNumberLength),
+		MaxLength: intPtr(finance.MaxRoutingNumberLength),
+	}
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}
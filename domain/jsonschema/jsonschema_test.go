@@ -0,0 +1,161 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type JSONSchemaTestSuite struct {
+	suite.Suite
+}
+
+func TestJSONSchemaSuite(t *testing.T) {
+	suite.Run(t, new(JSONSchemaTestSuite))
+}
+
+// accepts reports whether a string satisfies the pattern and length keywords of the schema
+func (s *JSONSchemaTestSuite) accepts(schema Schema, value string) bool {
+	if schema.Pattern != "" && !regexp.MustCompile(schema.Pattern).MatchString(value) {
+		return false
+	}
+	length := utf8.RuneCountInString(value)
+	if schema.MinLength != nil && length < *schema.MinLength {
+		return false
+	}
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		return false
+	}
+	return true
+}
+
+func (s *JSONSchemaTestSuite) TestEveryPatternCompiles() {
+	for name, schema := range Definitions() {
+		if schema.Pattern != "" {
+			_, err := regexp.Compile(schema.Pattern)
+			s.NoError(err, name)
+		}
+	}
+}
+
+func (s *JSONSchemaTestSuite) TestSchemasAcceptValuesProducedByConstructors() {
+	email, _ := web.NewEmail("Jane.Doe@Example.com")
+	username, _ := auth.NewUsername("Jane.Doe")
+	uuid, _ := identifier.NewUUIDv7()
+	ulid, _ := identifier.NewULID()
+	snowflake, _ := identifier.NewSnowflake(1541815603606036480)
+	composite, _ := identifier.NewCompositeFromString("tenant-1:42:x")
+	isin, _ := finance.NewISIN("US0378331005")
+	routing, _ := finance.NewRoutingNumber("021000021")
+
+	testCases := []struct {
+		name   string
+		schema Schema
+		value  string
+	}{
+		{"Email", Email(), email.Value()},
+		{"Username", Username(), username.Value()},
+		{"UUID", UUID(), uuid.String()},
+		{"ULID", ULID(), ulid.String()},
+		{"Snowflake", Snowflake(), snowflake.String()},
+		{"Composite", Composite(), composite.String()},
+		{"ISIN", ISIN(), isin.Value()},
+		{"RoutingNumber", RoutingNumber(), routing.Value()},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				s.True(s.accepts(tc.schema, tc.value), tc.value)
+			},
+		)
+	}
+}
+
+func (s *JSONSchemaTestSuite) TestSchemasRejectValuesRejectedByConstructors() {
+	usernames := []string{"ab", "jane.", "-jane", strings.Repeat("a", auth.MaxUsernameLength+1)}
+	for _, value := range usernames {
+		s.Error(check(auth.NewUsername, value), value)
+		s.False(s.accepts(Username(), value), value)
+	}
+
+	composites := []string{"42", "a::b", ":a"}
+	for _, value := range composites {
+		s.Error(check(identifier.NewCompositeFromString, value), value)
+		s.False(s.accepts(Composite(), value), value)
+	}
+
+	overflowingULID := "8ZZZZZZZZZZZZZZZZZZZZZZZZZ"
+	s.Error(check(identifier.NewULIDFromString, overflowingULID))
+	s.False(s.accepts(ULID(), overflowingULID))
+
+	s.Error(check(finance.NewAccountNumber, "12$45"))
+	s.False(s.accepts(AccountNumber(), "12$45"))
+}
+
+func (s *JSONSchemaTestSuite) TestMoneySchemaFollowsTheJSONFormat() {
+	money, _ := finance.NewMoneyFromString("10.50", "USD")
+
+	formats := []finance.MoneyJSONFormat{
+		finance.MoneyJSONString,
+		finance.MoneyJSONNumber,
+		finance.MoneyJSONMinorUnits,
+	}
+	for _, format := range formats {
+		encoded, err := money.MarshalJSONWith(format)
+		s.Require().NoError(err)
+
+		var fields map[string]any
+		s.Require().NoError(json.Unmarshal(encoded, &fields))
+
+		schema := Money(format)
+		s.ElementsMatch(schema.Required, keys(fields))
+		s.True(s.accepts(schema.Properties["currency"], fields["currency"].(string)))
+	}
+
+	s.Equal("string", Money(finance.MoneyJSONString).Properties["amount"].Type)
+	s.Equal("integer", Money(finance.MoneyJSONMinorUnits).Properties["minorUnits"].Type)
+}
+
+func (s *JSONSchemaTestSuite) TestItDerivesLimitsFromTheValidationConstants() {
+	s.Equal(web.MaxEmailLength, *Email().MaxLength)
+	s.Equal(auth.MinPasswordLength, *Password().MinLength)
+	s.Equal(finance.MaxAccountNumberLength, *AccountNumber().MaxLength)
+	s.Equal(finance.CurrencyPattern, Currency().Pattern)
+	s.Equal([]string{"half-up", "half-even", "down", "up"}, RoundingMode().Enum)
+}
+
+func (s *JSONSchemaTestSuite) TestItSerializesAsJSONSchema() {
+	encoded, err := json.Marshal(
+		map[string]any{
+			"$defs":      Definitions(),
+			"properties": map[string]Schema{"email": Ref("Email")},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Contains(string(encoded), `"email":{"$ref":"#/$defs/Email"}`)
+	s.Contains(string(encoded), `"additionalProperties":false`)
+	s.Contains(string(encoded), `"minimum":1`)
+}
+
+func check[T any](parse func(string) (T, error), value string) error {
+	_, err := parse(value)
+	return err
+}
+
+func keys(fields map[string]any) []string {
+	result := make([]string, 0, len(fields))
+	for key := range fields {
+		result = append(result, key)
+	}
+	return result
+}
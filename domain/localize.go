@@ -0,0 +1,47 @@
+package domain
+
+import "errors"
+
+// MessageParams are the named values substituted into a translated error message
+type MessageParams map[string]any
+
+// Translator renders a message key in a language. It reports false when it has no message
+// for the key in that language or in its fallback language.
+type Translator interface {
+	Translate(language, key string, params MessageParams) (string, bool)
+}
+
+// Localize returns the message of err in the language (a BCP 47 tag such as "de" or
+// "fr-CA"). It translates the outermost error in the chain carrying a message key and falls
+// back to err.Error() when there is none or the translator has no message for it.
+func Localize(err error, translator Translator, language string) string {
+	if err == nil {
+		return ""
+	}
+
+	localized := localizedError(err)
+	if localized == nil {
+		return err.Error()
+	}
+
+	message, ok := translator.Translate(language, localized.key, localized.params)
+	if !ok {
+		return err.Error()
+	}
+	return message
+}
+
+// localizedError returns the outermost Error in the chain that carries a message key
+func localizedError(err error) *Error {
+	for err != nil {
+		var domainErr *Error
+		if !errors.As(err, &domainErr) {
+			return nil
+		}
+		if domainErr.key != "" {
+			return domainErr
+		}
+		err = domainErr.prevErr
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LocalizeTestSuite struct {
+	suite.Suite
+}
+
+func TestLocalizeSuite(t *testing.T) {
+	suite.Run(t, new(LocalizeTestSuite))
+}
+
+// translatorFunc adapts a function to the Translator interface
+type translatorFunc func(language, key string, params MessageParams) (string, bool)
+
+func (f translatorFunc) Translate(language, key string, params MessageParams) (string, bool) {
+	return f(language, key, params)
+}
+
+var germanTranslator = translatorFunc(
+	func(language, key string, params MessageParams) (string, bool) {
+		if language != "de" || key != "auth.username.too_short" {
+			return "", false
+		}
+		return fmt.Sprintf("Mindestens %v Zeichen", params["min"]), true
+	},
+)
+
+var errTooShortUsername = NewLocalizedError(
+	"auth.username.too_short", MessageParams{"min": 3},
+	"username must be at least %d characters long", 3,
+)
+
+func (s *LocalizeTestSuite) TestItTranslatesTheKeyedErrorInTheChain() {
+	s.Equal("Mindestens 3 Zeichen", Localize(errTooShortUsername, germanTranslator, "de"))
+
+	wrapped := NewErrorWithWrap(fmt.Errorf("%w (username)", errTooShortUsername), "signup failed")
+	s.Equal("Mindestens 3 Zeichen", Localize(wrapped, germanTranslator, "de"))
+
+	withParams := errTooShortUsername.WithParams(MessageParams{"min": 5})
+	s.Equal("Mindestens 5 Zeichen", Localize(withParams, germanTranslator, "de"))
+}
+
+func (s *LocalizeTestSuite) TestItFallsBackToTheErrorMessage() {
+	s.Equal(
+		"username must be at least 3 characters long",
+		Localize(errTooShortUsername, germanTranslator, "fr"),
+	)
+	s.Equal("plain", Localize(errors.New("plain"), germanTranslator, "de"))
+	s.Equal("domain", Localize(NewError("domain"), germanTranslator, "de"))
+	s.Empty(Localize(nil, germanTranslator, "de"))
+}
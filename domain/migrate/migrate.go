@@ -0,0 +1,219 @@
+// Package migrate provides best-effort converters from legacy primitive values to value objects.
+// Instead of failing, converters return a zero value (or an adjusted value) and report a
+// data-quality Issue, which supports incremental adoption of value objects over databases
+// holding unvalidated primitives.
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrPrecisionLoss = domain.NewError("value was rounded and lost precision")
+	ErrNotFinite     = domain.NewError("value is not a finite number")
+)
+
+// Severity classifies how serious a data-quality issue is
+type Severity int
+
+const (
+	// SeverityWarning means the value was converted but adjusted (e.g., rounded)
+	SeverityWarning Severity = iota
+	// SeverityError means the value could not be converted and a zero value was used
+	SeverityError
+)
+
+// String returns a string representation of the severity
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Issue describes a data-quality problem found while converting a legacy value
+type Issue struct {
+	Converter string
+	Input     string
+	Severity  Severity
+	Err       error
+}
+
+// Error returns a description of the issue, satisfying the error interface
+func (i Issue) Error() string {
+	return fmt.Sprintf("%s %s(%q): %v", i.Severity, i.Converter, i.Input, i.Err)
+}
+
+// Unwrap returns the underlying error, enabling compatibility with errors.Is and errors.As
+func (i Issue) Unwrap() error {
+	return i.Err
+}
+
+// Reporter receives data-quality issues found by converters
+type Reporter interface {
+	Report(issue Issue)
+}
+
+// Collector is a Reporter that keeps every reported issue in memory.
+// It is safe for concurrent use.
+type Collector struct {
+	mu     sync.Mutex
+	issues []Issue
+}
+
+// NewCollector creates a new, empty Collector
+func NewCollector() *Collector {
+	return &Collector{}
+}
+
+// Report records the issue
+func (c *Collector) Report(issue Issue) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.issues = append(c.issues, issue)
+}
+
+// Issues returns a copy of the recorded issues in reporting order
+func (c *Collector) Issues() []Issue {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Issue(nil), c.issues...)
+}
+
+// HasErrors reports whether any recorded issue has SeverityError
+func (c *Collector) HasErrors() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, issue := range c.issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// LogReporter is a Reporter that writes issues to a structured logger
+type LogReporter struct {
+	logger *slog.Logger
+}
+
+// NewLogReporter creates a new LogReporter; a nil logger falls back to slog.Default()
+func NewLogReporter(logger *slog.Logger) LogReporter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return LogReporter{logger: logger}
+}
+
+// Report logs the issue at warning or error level depending on its severity
+func (r LogReporter) Report(issue Issue) {
+	level := slog.LevelWarn
+	if issue.Severity == SeverityError {
+		level = slog.LevelError
+	}
+	r.logger.Log(
+		context.Background(), level, "data quality issue",
+		slog.String("converter", issue.Converter),
+		slog.String("input", issue.Input),
+		slog.String("error", issue.Err.Error()),
+	)
+}
+
+// ToOrZero converts a string with the given value-object constructor.
+// On failure, it reports an error issue under the converter name and returns the zero value.
+// A nil reporter discards issues.
+func ToOrZero[T any](
+	converter string,
+	value string,
+	constructor func(string) (T, error),
+	reporter Reporter,
+) T {
+	converted, err := constructor(value)
+	if err != nil {
+		report(reporter, converter, value, SeverityError, err)
+		var zero T
+		return zero
+	}
+	return converted
+}
+
+// StringToEmailOrZero converts a legacy string to an Email, or the zero Email on failure
+func StringToEmailOrZero(value string, reporter Reporter) web.Email {
+	return ToOrZero("StringToEmailOrZero", value, web.NewEmail, reporter)
+}
+
+// Uint64ToIdentifierOrZero converts a legacy uint64 to an IntIdentifier,
+// or the zero IntIdentifier on failure
+func Uint64ToIdentifierOrZero(value uint64, reporter Reporter) identifier.IntIdentifier {
+	converted, err := identifier.NewIntIdentifier(value)
+	if err != nil {
+		report(
+			reporter, "Uint64ToIdentifierOrZero", strconv.FormatUint(value, 10),
+			SeverityError, err,
+		)
+		return identifier.IntIdentifier{}
+	}
+	return converted
+}
+
+// FloatToMoney converts a legacy float amount to Money rounded to the given number of places.
+// A warning is reported when rounding loses precision; an error is reported and the zero
+// Money returned when the amount or currency is invalid.
+func FloatToMoney(amount float64, currency string, places int32, reporter Reporter) finance.Money {
+	const converter = "FloatToMoney"
+	input := strconv.FormatFloat(amount, 'g', -1, 64) + " " + currency
+
+	exact, err := decimalFromFloat(amount)
+	if err != nil {
+		report(reporter, converter, input, SeverityError, err)
+		return finance.Money{}
+	}
+
+	rounded := exact.Round(places)
+	money, err := finance.NewMoneyFromString(rounded.String(), currency)
+	if err != nil {
+		report(reporter, converter, input, SeverityError, err)
+		return finance.Money{}
+	}
+
+	if !rounded.Equal(exact) {
+		report(reporter, converter, input, SeverityWarning, ErrPrecisionLoss)
+	}
+
+	return money
+}
+
+// decimalFromFloat converts a float to its shortest exact decimal representation
+func decimalFromFloat(amount float64) (decimal.Decimal, error) {
+	text := strconv.FormatFloat(amount, 'f', -1, 64)
+	converted, err := decimal.NewFromString(text)
+	if err != nil {
+		return decimal.Decimal{}, ErrNotFinite
+	}
+	return converted, nil
+}
+
+// report forwards an issue to the reporter, if any
+func report(reporter Reporter, converter, input string, severity Severity, err error) {
+	if reporter == nil {
+		return
+	}
+	reporter.Report(
+		Issue{
+			Converter: converter,
+			Input:     input,
+			Severity:  severity,
+			Err:       err,
+		},
+	)
+}
@@ -0,0 +1,136 @@
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"math"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrateTestSuite struct {
+	suite.Suite
+}
+
+func TestMigrateSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
+
+func (s *MigrateTestSuite) TestItConvertsValidEmailsAndCollectsInvalidOnes() {
+	collector := NewCollector()
+
+	email := StringToEmailOrZero(" John@Example.com ", collector)
+	s.Equal("john@example.com", email.Value())
+	s.Empty(collector.Issues())
+
+	email = StringToEmailOrZero("not-an-email", collector)
+	s.Equal(web.Email{}, email)
+
+	issues := collector.Issues()
+	s.Len(issues, 1)
+	s.Equal("StringToEmailOrZero", issues[0].Converter)
+	s.Equal("not-an-email", issues[0].Input)
+	s.Equal(SeverityError, issues[0].Severity)
+	s.True(errors.Is(issues[0], web.ErrMissingAtSymbol))
+	s.True(collector.HasErrors())
+}
+
+func (s *MigrateTestSuite) TestItConvertsIdentifiers() {
+	collector := NewCollector()
+
+	id := Uint64ToIdentifierOrZero(42, collector)
+	s.Equal(uint64(42), id.Value())
+
+	id = Uint64ToIdentifierOrZero(0, collector)
+	s.Equal(identifier.IntIdentifier{}, id)
+	s.Len(collector.Issues(), 1)
+	s.ErrorIs(collector.Issues()[0], identifier.ErrZeroIdentifier)
+}
+
+func (s *MigrateTestSuite) TestItConvertsFloatsToMoney() {
+	testCases := []struct {
+		name             string
+		amount           float64
+		currency         string
+		expectedAmount   string
+		expectedSeverity Severity
+		expectsIssue     bool
+		expectedError    error
+	}{
+		{name: "exact amount", amount: 10.25, currency: "usd", expectedAmount: "10.25"},
+		{
+			name:             "rounded amount",
+			amount:           10.255,
+			currency:         "USD",
+			expectedAmount:   "10.26",
+			expectedSeverity: SeverityWarning,
+			expectsIssue:     true,
+			expectedError:    ErrPrecisionLoss,
+		},
+		{
+			name:             "negative amount",
+			amount:           -1,
+			currency:         "USD",
+			expectedAmount:   "0",
+			expectedSeverity: SeverityError,
+			expectsIssue:     true,
+			expectedError:    finance.ErrNegativeAmount,
+		},
+		{
+			name:             "invalid currency",
+			amount:           1,
+			currency:         "US",
+			expectedAmount:   "0",
+			expectedSeverity: SeverityError,
+			expectsIssue:     true,
+			expectedError:    finance.ErrInvalidCurrency,
+		},
+		{
+			name:             "not a number",
+			amount:           math.NaN(),
+			currency:         "USD",
+			expectedAmount:   "0",
+			expectedSeverity: SeverityError,
+			expectsIssue:     true,
+			expectedError:    ErrNotFinite,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				collector := NewCollector()
+				money := FloatToMoney(tc.amount, tc.currency, 2, collector)
+				s.Equal(tc.expectedAmount, money.Amount().String())
+
+				if !tc.expectsIssue {
+					s.Empty(collector.Issues())
+					return
+				}
+				s.Len(collector.Issues(), 1)
+				s.Equal(tc.expectedSeverity, collector.Issues()[0].Severity)
+				s.ErrorIs(collector.Issues()[0], tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *MigrateTestSuite) TestItCanLogIssuesOrDiscardThem() {
+	var buf bytes.Buffer
+	reporter := NewLogReporter(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	StringToEmailOrZero("invalid", reporter)
+	s.Contains(buf.String(), "level=ERROR")
+	s.Contains(buf.String(), "converter=StringToEmailOrZero")
+
+	s.NotPanics(
+		func() {
+			StringToEmailOrZero("invalid", nil)
+		},
+	)
+}
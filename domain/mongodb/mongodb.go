@@ -0,0 +1,455 @@
+// Package mongodb provides BSON codecs for the value objects, so MongoDB-backed repositories can
+// store domain types directly instead of mirroring them in DTO structs.
+//
+// Register the codecs once, when the client is created:
+//
+//	registry := mongodb.NewRegistry()
+//	client, err := mongo.Connect(options.Client().ApplyURI(uri).SetRegistry(registry))
+//
+// Simple value objects are stored as BSON strings, identifiers as int64 (UUIDs as binary
+// subtype 4), amounts as decimal128 and composite value objects as embedded documents.
+// Zero values are stored as null and every value is validated when decoded. Credentials has
+// no codec because it holds a plaintext password.
+package mongodb
+
+import (
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var (
+	ErrIdentifierOutOfRange = domain.NewError("identifier does not fit in a BSON int64")
+	ErrInvalidUUIDBinary    = domain.NewError("UUID must be stored as 16 bytes of BSON binary")
+	ErrInvalidDecimal128    = domain.NewError("decimal128 value must be a finite number")
+)
+
+// NewRegistry returns the default BSON registry extended with codecs for every value object
+func NewRegistry() *bson.Registry {
+	registry := bson.NewRegistry()
+	Register(registry)
+	return registry
+}
+
+// Register adds codecs for every value object to an existing registry
+func Register(registry *bson.Registry) {
+	registerIdentifiers(registry)
+
+	registerString(registry, web.URL.Value, web.NewURL)
+	registerString(registry, web.Email.Value, web.NewEmail)
+	registerString(registry, web.DomainName.Value, web.NewDomainName)
+	registerString(registry, web.IPAddress.Value, web.NewIPAddress)
+
+	registerString(registry, geography.CountryCode.Value, geography.NewCountryCode)
+
+	registerPerson(registry)
+	registerString(registry, contact.PhoneNumber.String, contact.NewPhoneNumber)
+
+	registerAuth(registry)
+	registerFinance(registry)
+}
+
+// register adds a codec that stores T through its BSON representation R, using the codecs
+// already registered for R. Zero values are stored as null, and null decodes to the zero value.
+func register[T any, R any](
+	registry *bson.Registry,
+	encode func(T) (R, error),
+	decode func(R) (T, error),
+) {
+	representationType := reflect.TypeFor[R]()
+
+	encoder := func(ec bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
+		if val.IsZero() {
+			return vw.WriteNull()
+		}
+
+		representation, err := encode(val.Interface().(T))
+		if err != nil {
+			return err
+		}
+
+		representationEncoder, err := ec.LookupEncoder(representationType)
+		if err != nil {
+			return err
+		}
+
+		return representationEncoder.EncodeValue(ec, vw, reflect.ValueOf(representation))
+	}
+
+	decoder := func(dc bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+		if vr.Type() == bson.TypeNull {
+			val.SetZero()
+			return vr.ReadNull()
+		}
+
+		representationDecoder, err := dc.LookupDecoder(representationType)
+		if err != nil {
+			return err
+		}
+
+		representation := reflect.New(representationType).Elem()
+		if err := representationDecoder.DecodeValue(dc, vr, representation); err != nil {
+			return err
+		}
+
+		parsed, err := decode(representation.Interface().(R))
+		if err != nil {
+			return err
+		}
+
+		val.Set(reflect.ValueOf(parsed))
+		return nil
+	}
+
+	valueType := reflect.TypeFor[T]()
+	registry.RegisterTypeEncoder(valueType, bson.ValueEncoderFunc(encoder))
+	registry.RegisterTypeDecoder(valueType, bson.ValueDecoderFunc(decoder))
+}
+
+// registerString adds a codec that stores T as a BSON string
+func registerString[T any](
+	registry *bson.Registry,
+	format func(T) string,
+	parse func(string) (T, error),
+) {
+	register(
+		registry,
+		func(value T) (string, error) {
+			return format(value), nil
+		},
+		parse,
+	)
+}
+
+func registerIdentifiers(registry *bson.Registry) {
+	register(registry, intIdentifierToInt64, identifierFromInt64)
+	register(
+		registry,
+		func(value identifier.StringIntIdentifier) (int64, error) {
+			return intIdentifierToInt64(value.IntIdentifier)
+		},
+		func(value int64) (identifier.StringIntIdentifier, error) {
+			parsed, err := identifierFromInt64(value)
+			return parsed.AsStringIntIdentifier(), err
+		},
+	)
+	register(
+		registry,
+		func(value identifier.Snowflake) (int64, error) {
+			return int64(value.Value()), nil
+		},
+		func(value int64) (identifier.Snowflake, error) {
+			return identifier.NewSnowflake(uint64(value))
+		},
+	)
+	register(
+		registry,
+		func(value identifier.UUID) (bson.Binary, error) {
+			bytes := value.Bytes()
+			return bson.Binary{Subtype: bson.TypeBinaryUUID, Data: bytes[:]}, nil
+		},
+		func(value bson.Binary) (identifier.UUID, error) {
+			if value.Subtype != bson.TypeBinaryUUID || len(value.Data) != 16 {
+				return identifier.UUID{}, ErrInvalidUUIDBinary
+			}
+			return identifier.NewUUIDFromString(
+				identifier.ReconstituteUUID([16]byte(value.Data)).String(),
+			)
+		},
+	)
+	registerString(registry, identifier.ULID.String, identifier.NewULIDFromString)
+	registerString(registry, identifier.Composite.String, identifier.NewCompositeFromString)
+}
+
+func intIdentifierToInt64(value identifier.IntIdentifier) (int64, error) {
+	if value.Value() > math.MaxInt64 {
+		return 0, ErrIdentifierOutOfRange
+	}
+	return int64(value.Value()), nil
+}
+
+func identifierFromInt64(value int64) (identifier.IntIdentifier, error) {
+	if value < 0 {
+		return identifier.IntIdentifier{}, identifier.ErrInvalidIdentifier
+	}
+	return identifier.NewIntIdentifier(uint64(value))
+}
+
+// fullNameDocument is the BSON representation of person.FullName
+type fullNameDocument struct {
+	Prefix     string `bson:"prefix,omitempty"`
+	FirstName  string `bson:"firstName"`
+	MiddleName string `bson:"middleName,omitempty"`
+	LastName   string `bson:"lastName"`
+	Suffix     string `bson:"suffix,omitempty"`
+}
+
+// nationalIDDocument is the BSON representation of person.NationalID, holding the full value
+type nationalIDDocument struct {
+	Country string `bson:"country"`
+	Value   string `bson:"value"`
+}
+
+func registerPerson(registry *bson.Registry) {
+	register(
+		registry,
+		func(value person.FullName) (fullNameDocument, error) {
+			return fullNameDocument{
+				Prefix:     value.Prefix(),
+				FirstName:  value.FirstName(),
+				MiddleName: value.MiddleName(),
+				LastName:   value.LastName(),
+				Suffix:     value.Suffix(),
+			}, nil
+		},
+		func(document fullNameDocument) (person.FullName, error) {
+			return person.NewFullNameWithAffixes(
+				document.Prefix,
+				document.FirstName,
+				document.MiddleName,
+				document.LastName,
+				document.Suffix,
+			)
+		},
+	)
+	register(
+		registry,
+		func(value person.NationalID) (nationalIDDocument, error) {
+			return nationalIDDocument{Country: value.Country().Value(), Value: value.Value()}, nil
+		},
+		func(document nationalIDDocument) (person.NationalID, error) {
+			country, err := geography.NewCountryCode(document.Country)
+			if err != nil {
+				return person.NationalID{}, err
+			}
+			return person.NewNationalID(country, document.Value)
+		},
+	)
+}
+
+// expiringTokenDocument is the BSON representation of auth.ExpiringToken. BSON dates have
+// millisecond precision.
+type expiringTokenDocument struct {
+	Token     string    `bson:"token"`
+	IssuedAt  time.Time `bson:"issuedAt"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+func registerAuth(registry *bson.Registry) {
+	registerString(registry, auth.Username.Value, auth.NewUsername)
+	registerString(registry, auth.Token.Value, auth.NewToken)
+	registerString(registry, auth.HashedToken.Value, auth.NewHashedToken)
+	registerString(registry, auth.JWT.Value, auth.NewJWT)
+	registerString(
+		registry,
+		auth.Password.HashedValue,
+		func(hashedValue string) (auth.Password, error) {
+			return auth.ReconstitutePassword(hashedValue), nil
+		},
+	)
+	register(
+		registry,
+		func(value auth.ExpiringToken) (expiringTokenDocument, error) {
+			return expiringTokenDocument{
+				Token:     value.Token().Value(),
+				IssuedAt:  value.IssuedAt(),
+				ExpiresAt: value.ExpiresAt(),
+			}, nil
+		},
+		func(document expiringTokenDocument) (auth.ExpiringToken, error) {
+			token, err := auth.NewToken(document.Token)
+			if err != nil {
+				return auth.ExpiringToken{}, err
+			}
+			return auth.NewExpiringToken(token, document.IssuedAt, document.ExpiresAt)
+		},
+	)
+}
+
+// moneyDocument is the BSON representation of finance.Money
+type moneyDocument struct {
+	Amount   bson.Decimal128 `bson:"amount"`
+	Currency string          `bson:"currency"`
+}
+
+// roundingDocument is the BSON representation of finance.Rounding
+type roundingDocument struct {
+	Places int32  `bson:"places"`
+	Mode   string `bson:"mode"`
+}
+
+// unitPriceDocument is the BSON representation of finance.UnitPrice
+type unitPriceDocument struct {
+	Price    moneyDocument    `bson:"price"`
+	Rounding roundingDocument `bson:"rounding"`
+}
+
+// lineTotalDocument is the BSON representation of finance.LineTotal. The gross amount is
+// stored for queries but derived again from net and tax when decoding.
+type lineTotalDocument struct {
+	Net   moneyDocument `bson:"net"`
+	Tax   moneyDocument `bson:"tax"`
+	Gross moneyDocument `bson:"gross"`
+}
+
+func registerFinance(registry *bson.Registry) {
+	registerString(registry, finance.Currency.Value, finance.NewCurrency)
+	registerString(registry, finance.VATNumber.Value, finance.NewVATNumber)
+	registerString(registry, finance.ISIN.Value, finance.NewISIN)
+	registerString(registry, finance.CUSIP.Value, finance.NewCUSIP)
+	registerString(registry, finance.AccountNumber.Value, finance.NewAccountNumber)
+	registerString(registry, finance.RoutingNumber.Value, finance.NewRoutingNumber)
+	registerString(registry, finance.RoundingMode.String, finance.ParseRoundingMode)
+
+	register(
+		registry,
+		func(value finance.InterestRate) (bson.Decimal128, error) {
+			return toDecimal128(value.AnnualPercent())
+		},
+		func(value bson.Decimal128) (finance.InterestRate, error) {
+			annualPercent, err := fromDecimal128(value)
+			if err != nil {
+				return finance.InterestRate{}, err
+			}
+			return finance.NewInterestRate(annualPercent)
+		},
+	)
+	register(registry, toMoneyDocument, fromMoneyDocument)
+	register(
+		registry,
+		func(value finance.MoneyBag) (map[string]bson.Decimal128, error) {
+			document := make(map[string]bson.Decimal128, value.Len())
+			for money := range value.All() {
+				amount, err := toDecimal128(money.Amount())
+				if err != nil {
+					return nil, err
+				}
+				document[money.Currency().Value()] = amount
+			}
+			return document, nil
+		},
+		func(document map[string]bson.Decimal128) (finance.MoneyBag, error) {
+			amounts := make([]finance.Money, 0, len(document))
+			for code, amount := range document {
+				money, err := fromMoneyDocument(moneyDocument{Amount: amount, Currency: code})
+				if err != nil {
+					return finance.MoneyBag{}, err
+				}
+				amounts = append(amounts, money)
+			}
+			return finance.NewMoneyBag(amounts...)
+		},
+	)
+	register(registry, toRoundingDocument, fromRoundingDocument)
+	register(
+		registry,
+		func(value finance.UnitPrice) (unitPriceDocument, error) {
+			price, err := toMoneyDocument(value.Price())
+			if err != nil {
+				return unitPriceDocument{}, err
+			}
+			rounding, _ := toRoundingDocument(value.Rounding())
+			return unitPriceDocument{Price: price, Rounding: rounding}, nil
+		},
+		func(document unitPriceDocument) (finance.UnitPrice, error) {
+			price, err := fromMoneyDocument(document.Price)
+			if err != nil {
+				return finance.UnitPrice{}, err
+			}
+			rounding, err := fromRoundingDocument(document.Rounding)
+			if err != nil {
+				return finance.UnitPrice{}, err
+			}
+			return finance.NewUnitPrice(price, rounding)
+		},
+	)
+	register(
+		registry,
+		func(value finance.LineTotal) (lineTotalDocument, error) {
+			var document lineTotalDocument
+			var err error
+			if document.Net, err = toMoneyDocument(value.Net()); err != nil {
+				return lineTotalDocument{}, err
+			}
+			if document.Tax, err = toMoneyDocument(value.Tax()); err != nil {
+				return lineTotalDocument{}, err
+			}
+			if document.Gross, err = toMoneyDocument(value.Gross()); err != nil {
+				return lineTotalDocument{}, err
+			}
+			return document, nil
+		},
+		func(document lineTotalDocument) (finance.LineTotal, error) {
+			net, err := fromMoneyDocument(document.Net)
+			if err != nil {
+				return finance.LineTotal{}, err
+			}
+			tax, err := fromMoneyDocument(document.Tax)
+			if err != nil {
+				return finance.LineTotal{}, err
+			}
+			return finance.NewLineTotal(net, tax)
+		},
+	)
+}
+
+func toMoneyDocument(value finance.Money) (moneyDocument, error) {
+	amount, err := toDecimal128(value.Amount())
+	if err != nil {
+		return moneyDocument{}, err
+	}
+	return moneyDocument{Amount: amount, Currency: value.Currency().Value()}, nil
+}
+
+func fromMoneyDocument(document moneyDocument) (finance.Money, error) {
+	amount, err := fromDecimal128(document.Amount)
+	if err != nil {
+		return finance.Money{}, err
+	}
+
+	currency, err := finance.NewCurrency(document.Currency)
+	if err != nil {
+		return finance.Money{}, err
+	}
+
+	return finance.NewMoney(amount, currency)
+}
+
+func toRoundingDocument(value finance.Rounding) (roundingDocument, error) {
+	return roundingDocument{Places: value.Places(), Mode: value.Mode().String()}, nil
+}
+
+func fromRoundingDocument(document roundingDocument) (finance.Rounding, error) {
+	mode, err := finance.ParseRoundingMode(document.Mode)
+	if err != nil {
+		return finance.Rounding{}, err
+	}
+	return finance.NewRounding(document.Places, mode)
+}
+
+func toDecimal128(value decimal.Decimal) (bson.Decimal128, error) {
+	converted, err := bson.ParseDecimal128(value.String())
+	if err != nil {
+		return bson.Decimal128{}, domain.NewErrorWithWrap(err, "amount does not fit in decimal128")
+	}
+	return converted, nil
+}
+
+func fromDecimal128(value bson.Decimal128) (decimal.Decimal, error) {
+	converted, err := decimal.NewFromString(value.String())
+	if err != nil {
+		return decimal.Decimal{}, ErrInvalidDecimal128
+	}
+	return converted, nil
+}
@@ -0,0 +1,233 @@
+package mongodb
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type MongoDBTestSuite struct {
+	suite.Suite
+	registry *bson.Registry
+}
+
+func TestMongoDBSuite(t *testing.T) {
+	suite.Run(t, new(MongoDBTestSuite))
+}
+
+func (s *MongoDBTestSuite) SetupTest() {
+	s.registry = NewRegistry()
+}
+
+func (s *MongoDBTestSuite) marshal(value any) bson.Raw {
+	var buffer bytes.Buffer
+	encoder := bson.NewEncoder(bson.NewDocumentWriter(&buffer))
+	encoder.SetRegistry(s.registry)
+	s.Require().NoError(encoder.Encode(value))
+	return buffer.Bytes()
+}
+
+func (s *MongoDBTestSuite) unmarshal(document bson.Raw, target any) error {
+	decoder := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(document)))
+	decoder.SetRegistry(s.registry)
+	return decoder.Decode(target)
+}
+
+type customerDocument struct {
+	ID         identifier.IntIdentifier `bson:"_id"`
+	ExternalID identifier.UUID          `bson:"externalId"`
+	TraceID    identifier.ULID          `bson:"traceId"`
+	Name       person.FullName          `bson:"name"`
+	NationalID person.NationalID        `bson:"nationalId"`
+	Email      web.Email                `bson:"email"`
+	Website    web.URL                  `bson:"website"`
+	Country    geography.CountryCode    `bson:"country"`
+	Phone      contact.PhoneNumber      `bson:"phone"`
+	Username   auth.Username            `bson:"username"`
+	Password   auth.Password            `bson:"password"`
+	Invite     auth.ExpiringToken       `bson:"invite"`
+	Balance    finance.Money            `bson:"balance"`
+	Wallet     finance.MoneyBag         `bson:"wallet"`
+	Rate       finance.InterestRate     `bson:"rate"`
+	Price      finance.UnitPrice        `bson:"price"`
+	Line       finance.LineTotal        `bson:"line"`
+	Account    finance.AccountNumber    `bson:"account"`
+	Referrer   *web.Email               `bson:"referrer"`
+	Backup     web.Email                `bson:"backup"`
+}
+
+func (s *MongoDBTestSuite) customer() customerDocument {
+	id, _ := identifier.NewIntIdentifier(42)
+	externalID, _ := identifier.NewUUIDFromString("0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b")
+	traceID, _ := identifier.NewULIDFromString("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	name, _ := person.NewFullNameWithAffixes("Dr.", "Jane", "", "Doe", "")
+	country, _ := geography.NewCountryCode("US")
+	nationalID, _ := person.NewNationalID(country, "123-45-6789")
+	email, _ := web.NewEmail("jane@example.com")
+	website, _ := web.NewURL("https://example.com")
+	phone, _ := contact.NewPhoneNumber("+1 234 567 8900 ext. 12")
+	username, _ := auth.NewUsername("jane.doe")
+	token, _ := auth.GenerateToken()
+	issuedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	invite, _ := auth.NewExpiringToken(token, issuedAt, issuedAt.Add(time.Hour))
+	balance, _ := finance.NewMoneyFromString("1234.5678", "USD")
+	euros, _ := finance.NewMoneyFromString("5", "EUR")
+	wallet, _ := finance.NewMoneyBag(balance, euros)
+	rate, _ := finance.NewInterestRateFromString("4.25")
+	rounding, _ := finance.NewRounding(2, finance.RoundHalfEven)
+	price, _ := finance.NewUnitPrice(euros, rounding)
+	line, _ := price.LineTotal(decimal.NewFromInt(3), decimal.NewFromInt(19))
+	account, _ := finance.NewAccountNumber("123456789")
+
+	return customerDocument{
+		ID:         id,
+		ExternalID: externalID,
+		TraceID:    traceID,
+		Name:       name,
+		NationalID: nationalID,
+		Email:      email,
+		Website:    website,
+		Country:    country,
+		Phone:      phone,
+		Username:   username,
+		Password:   auth.ReconstitutePassword("$2a$10$abcdefghijklmnopqrstuu"),
+		Invite:     invite,
+		Balance:    balance,
+		Wallet:     wallet,
+		Rate:       rate,
+		Price:      price,
+		Line:       line,
+		Account:    account,
+		Referrer:   &email,
+	}
+}
+
+func (s *MongoDBTestSuite) TestItCanRoundTripValueObjects() {
+	original := s.customer()
+
+	var decoded customerDocument
+	s.Require().NoError(s.unmarshal(s.marshal(original), &decoded))
+
+	s.True(original.ID.Equals(decoded.ID))
+	s.True(original.ExternalID.Equals(decoded.ExternalID))
+	s.True(original.TraceID.Equals(decoded.TraceID))
+	s.True(original.Name.Equals(decoded.Name))
+	s.True(original.NationalID.Equals(decoded.NationalID))
+	s.True(original.Email.Equals(decoded.Email))
+	s.True(original.Website.Equals(decoded.Website))
+	s.True(original.Country.Equals(decoded.Country))
+	s.True(original.Phone.Equals(decoded.Phone))
+	s.True(original.Username.Equals(decoded.Username))
+	s.True(original.Password.Equals(decoded.Password))
+	s.True(original.Invite.Equals(decoded.Invite))
+	s.True(original.Balance.Equals(decoded.Balance))
+	s.True(original.Wallet.Equals(decoded.Wallet))
+	s.True(original.Rate.Equals(decoded.Rate))
+	s.True(original.Price.Equals(decoded.Price))
+	s.True(original.Line.Equals(decoded.Line))
+	s.True(original.Account.Equals(decoded.Account))
+	s.Require().NotNil(decoded.Referrer)
+	s.True(original.Referrer.Equals(*decoded.Referrer))
+	s.Equal(web.Email{}, decoded.Backup)
+}
+
+func (s *MongoDBTestSuite) TestItStoresQueryableRepresentations() {
+	document := s.marshal(s.customer())
+
+	s.Equal(int64(42), document.Lookup("_id").Int64())
+	s.Equal("jane@example.com", document.Lookup("email").StringValue())
+	s.Equal("+12345678900 ext. 12", document.Lookup("phone").StringValue())
+	s.Equal("Doe", document.Lookup("name", "lastName").StringValue())
+	s.Equal("123456789", document.Lookup("nationalId", "value").StringValue())
+	s.Equal("USD", document.Lookup("balance", "currency").StringValue())
+	s.Equal("1234.5678", document.Lookup("balance", "amount").Decimal128().String())
+	s.Equal("5", document.Lookup("wallet", "EUR").Decimal128().String())
+	s.Equal("half-even", document.Lookup("price", "rounding", "mode").StringValue())
+	s.Equal(bson.TypeNull, document.Lookup("backup").Type)
+
+	subtype, data := document.Lookup("externalId").Binary()
+	s.Equal(bson.TypeBinaryUUID, subtype)
+	s.Len(data, 16)
+}
+
+func (s *MongoDBTestSuite) TestItValidatesWhenDecoding() {
+	testCases := []struct {
+		name     string
+		document bson.D
+		expected error
+	}{
+		{"Invalid email", bson.D{{Key: "email", Value: "not-an-email"}}, web.ErrMissingAtSymbol},
+		{"Zero identifier", bson.D{{Key: "_id", Value: int64(0)}}, identifier.ErrZeroIdentifier},
+		{
+			"Negative identifier", bson.D{{Key: "_id", Value: int64(-1)}},
+			identifier.ErrInvalidIdentifier,
+		},
+		{
+			"UUID with wrong subtype",
+			bson.D{{Key: "externalId", Value: bson.Binary{Subtype: 0, Data: make([]byte, 16)}}},
+			ErrInvalidUUIDBinary,
+		},
+		{
+			"Negative money",
+			bson.D{
+				{
+					Key: "balance", Value: bson.D{
+						{Key: "amount", Value: mustDecimal128("-1")},
+						{Key: "currency", Value: "USD"},
+					},
+				},
+			},
+			finance.ErrNegativeAmount,
+		},
+		{
+			"Infinite money",
+			bson.D{
+				{
+					Key: "balance", Value: bson.D{
+						{Key: "amount", Value: mustDecimal128("Infinity")},
+						{Key: "currency", Value: "USD"},
+					},
+				},
+			},
+			ErrInvalidDecimal128,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				var decoded customerDocument
+				err := s.unmarshal(s.marshal(tc.document), &decoded)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *MongoDBTestSuite) TestItRejectsIdentifiersAboveInt64() {
+	id := identifier.ReconstituteIntIdentifier(1 << 63)
+
+	var buffer bytes.Buffer
+	encoder := bson.NewEncoder(bson.NewDocumentWriter(&buffer))
+	encoder.SetRegistry(s.registry)
+	s.ErrorIs(encoder.Encode(bson.D{{Key: "_id", Value: id}}), ErrIdentifierOutOfRange)
+}
+
+func mustDecimal128(value string) bson.Decimal128 {
+	converted, err := bson.ParseDecimal128(value)
+	if err != nil {
+		panic(err)
+	}
+	return converted
+}
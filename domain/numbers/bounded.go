@@ -0,0 +1,123 @@
+package numbers
+
+import (
+	"strconv"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrOutOfRange = domain.NewLocalizedError(
+		"numbers.out_of_range", nil,
+		"value is out of the allowed range",
+	)
+	ErrInvalidRange = domain.NewError("range minimum cannot be greater than its maximum")
+)
+
+// IntRange is an inclusive range of integers that BoundedInt values must fall within, such
+// as 1 to 10 for a rating
+type IntRange struct {
+	min int64
+	max int64
+}
+
+// NewIntRange creates a new instance of IntRange with validation
+func NewIntRange(min, max int64) (IntRange, error) {
+	if min > max {
+		return IntRange{}, ErrInvalidRange
+	}
+
+	return IntRange{
+		min: min,
+		max: max,
+	}, nil
+}
+
+// Min returns the smallest value in the range
+func (r IntRange) Min() int64 {
+	return r.min
+}
+
+// Max returns the largest value in the range
+func (r IntRange) Max() int64 {
+	return r.max
+}
+
+// Contains reports whether the value is within the range
+func (r IntRange) Contains(value int64) bool {
+	return value >= r.min && value <= r.max
+}
+
+// New creates a BoundedInt within the range, with validation. The error carries the bounds
+// as "min" and "max" fields.
+func (r IntRange) New(value int64) (BoundedInt, error) {
+	if !r.Contains(value) {
+		return BoundedInt{}, ErrOutOfRange.WithField("min", r.min).WithField("max", r.max)
+	}
+
+	return BoundedInt{
+		value:  value,
+		bounds: r,
+	}, nil
+}
+
+// Equals compares two IntRange objects for equality
+func (r IntRange) Equals(other IntRange) bool {
+	return r == other
+}
+
+// String returns a string representation of the range, e.g., "[1, 10]"
+func (r IntRange) String() string {
+	return "[" + strconv.FormatInt(r.min, 10) + ", " + strconv.FormatInt(r.max, 10) + "]"
+}
+
+// BoundedInt is an integer within an inclusive range known when it is created
+type BoundedInt struct {
+	value  int64
+	bounds IntRange
+}
+
+// NewBoundedInt creates a new instance of BoundedInt with validation
+func NewBoundedInt(value, min, max int64) (BoundedInt, error) {
+	bounds, err := NewIntRange(min, max)
+	if err != nil {
+		return BoundedInt{}, err
+	}
+	return bounds.New(value)
+}
+
+// ReconstituteBoundedInt creates a new BoundedInt instance without validation
+func ReconstituteBoundedInt(value, min, max int64) BoundedInt {
+	return BoundedInt{
+		value: value,
+		bounds: IntRange{
+			min: min,
+			max: max,
+		},
+	}
+}
+
+// Value returns the number
+func (b BoundedInt) Value() int64 {
+	return b.value
+}
+
+// Bounds returns the range the number falls within
+func (b BoundedInt) Bounds() IntRange {
+	return b.bounds
+}
+
+// Equals compares two BoundedInt objects for equality, including their bounds
+func (b BoundedInt) Equals(other BoundedInt) bool {
+	return b.value == other.value && b.bounds == other.bounds
+}
+
+// String returns a string representation of the number
+func (b BoundedInt) String() string {
+	return strconv.FormatInt(b.value, 10)
+}
+
+// MarshalJSON encodes the number as a JSON number; the bounds are not encoded
+func (b BoundedInt) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, b.value, 10), nil
+}
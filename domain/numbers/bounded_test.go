@@ -0,0 +1,86 @@
+package numbers
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type BoundedIntTestSuite struct {
+	suite.Suite
+}
+
+func TestBoundedIntSuite(t *testing.T) {
+	suite.Run(t, new(BoundedIntTestSuite))
+}
+
+func (s *BoundedIntTestSuite) TestItCanBuildNewBoundedIntWithValidValues() {
+	for _, value := range []int64{1, 5, 10} {
+		rating, err := NewBoundedInt(value, 1, 10)
+		s.NoError(err)
+		s.Equal(value, rating.Value())
+		s.Equal(int64(1), rating.Bounds().Min())
+		s.Equal(int64(10), rating.Bounds().Max())
+	}
+
+	single, err := NewBoundedInt(math.MinInt64, math.MinInt64, math.MinInt64)
+	s.NoError(err)
+	s.Equal(int64(math.MinInt64), single.Value())
+}
+
+func (s *BoundedIntTestSuite) TestItFailsToBuildNewBoundedIntOutsideItsRange() {
+	for _, value := range []int64{0, 11, math.MinInt64, math.MaxInt64} {
+		_, err := NewBoundedInt(value, 1, 10)
+		s.ErrorIs(err, ErrOutOfRange)
+
+		var domainErr *domain.Error
+		s.Require().ErrorAs(err, &domainErr)
+		s.Equal(map[string]any{"min": int64(1), "max": int64(10)}, domainErr.Fields())
+	}
+}
+
+func (s *BoundedIntTestSuite) TestItFailsToBuildAnInvertedRange() {
+	_, err := NewIntRange(10, 1)
+	s.ErrorIs(err, ErrInvalidRange)
+
+	_, err = NewBoundedInt(5, 10, 1)
+	s.ErrorIs(err, ErrInvalidRange)
+}
+
+func (s *BoundedIntTestSuite) TestItCanBuildValuesFromARange() {
+	percent, err := NewIntRange(0, 100)
+	s.Require().NoError(err)
+	s.Equal("[0, 100]", percent.String())
+	s.True(percent.Contains(0))
+	s.True(percent.Contains(100))
+	s.False(percent.Contains(101))
+
+	value, err := percent.New(42)
+	s.NoError(err)
+	s.True(value.Bounds().Equals(percent))
+	s.Equal("42", value.String())
+
+	_, err = percent.New(-1)
+	s.ErrorIs(err, ErrOutOfRange)
+}
+
+func (s *BoundedIntTestSuite) TestItComparesValuesAndBounds() {
+	s.True(ReconstituteBoundedInt(5, 1, 10).Equals(ReconstituteBoundedInt(5, 1, 10)))
+	s.False(ReconstituteBoundedInt(5, 1, 10).Equals(ReconstituteBoundedInt(6, 1, 10)))
+	s.False(ReconstituteBoundedInt(5, 1, 10).Equals(ReconstituteBoundedInt(5, 0, 10)))
+}
+
+func (s *BoundedIntTestSuite) TestItCanReconstituteBoundedIntWithoutValidation() {
+	value := ReconstituteBoundedInt(50, 1, 10)
+	s.Equal(int64(50), value.Value())
+	s.False(value.Bounds().Contains(value.Value()))
+}
+
+func (s *BoundedIntTestSuite) TestItEncodesJSONAsANumber() {
+	data, err := json.Marshal(ReconstituteBoundedInt(-7, -10, 10))
+	s.NoError(err)
+	s.Equal(`-7`, string(data))
+}
@@ -0,0 +1,94 @@
+package numbers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var ErrNegative = domain.NewLocalizedError(
+	"numbers.negative", nil,
+	"value cannot be negative",
+)
+
+// NonNegative is a number greater than or equal to zero, such as a stock level
+type NonNegative[T Number] struct {
+	value T
+}
+
+// WholeNumber is a non-negative int: 0, 1, 2, ...
+type WholeNumber = NonNegative[int]
+
+// NewNonNegative creates a new instance of NonNegative with validation
+func NewNonNegative[T Number](value T) (NonNegative[T], error) {
+	if err := IsNonNegative(value); err != nil {
+		return NonNegative[T]{}, err
+	}
+
+	return NonNegative[T]{
+		value: value,
+	}, nil
+}
+
+// ReconstituteNonNegative creates a new NonNegative instance without validation
+func ReconstituteNonNegative[T Number](value T) NonNegative[T] {
+	return NonNegative[T]{
+		value: value,
+	}
+}
+
+// IsNonNegative validates that a number is greater than or equal to zero
+func IsNonNegative[T Number](value T) error {
+	// NaN fails the comparison as well
+	if !(value >= 0) {
+		return ErrNegative
+	}
+	return nil
+}
+
+// Value returns the number
+func (n NonNegative[T]) Value() T {
+	return n.value
+}
+
+// IsZero reports whether the number is zero
+func (n NonNegative[T]) IsZero() bool {
+	return n.value == 0
+}
+
+// Equals compares two NonNegative objects for equality
+func (n NonNegative[T]) Equals(other NonNegative[T]) bool {
+	return n.value == other.value
+}
+
+// String returns a string representation of the number
+func (n NonNegative[T]) String() string {
+	return fmt.Sprint(n.value)
+}
+
+// MarshalJSON encodes the number as a JSON number
+func (n NonNegative[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON decodes the number from a JSON number, with validation
+func (n *NonNegative[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	value, err := unmarshalNumber[T](data)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewNonNegative(value)
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
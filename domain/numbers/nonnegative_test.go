@@ -0,0 +1,72 @@
+package numbers
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NonNegativeTestSuite struct {
+	suite.Suite
+}
+
+func TestNonNegativeSuite(t *testing.T) {
+	suite.Run(t, new(NonNegativeTestSuite))
+}
+
+func (s *NonNegativeTestSuite) TestItCanBuildNewNonNegativeWithValidValues() {
+	stock, err := NewNonNegative(0)
+	s.NoError(err)
+	s.Equal(0, stock.Value())
+	s.True(stock.IsZero())
+	s.Equal("0", stock.String())
+
+	balance, err := NewNonNegative(12.5)
+	s.NoError(err)
+	s.Equal(12.5, balance.Value())
+	s.False(balance.IsZero())
+
+	var whole WholeNumber
+	whole, err = NewNonNegative(9)
+	s.NoError(err)
+	s.Equal(9, whole.Value())
+}
+
+func (s *NonNegativeTestSuite) TestItFailsToBuildNewNonNegativeWithInvalidValues() {
+	_, err := NewNonNegative(-1)
+	s.ErrorIs(err, ErrNegative)
+
+	_, err = NewNonNegative(int8(math.MinInt8))
+	s.ErrorIs(err, ErrNegative)
+
+	_, err = NewNonNegative(math.Inf(-1))
+	s.ErrorIs(err, ErrNegative)
+
+	_, err = NewNonNegative(math.NaN())
+	s.ErrorIs(err, ErrNegative)
+}
+
+func (s *NonNegativeTestSuite) TestItCanReconstituteNonNegativeWithoutValidation() {
+	s.Equal(-3, ReconstituteNonNegative(-3).Value())
+}
+
+func (s *NonNegativeTestSuite) TestItComparesNonNegativesByValue() {
+	s.True(ReconstituteNonNegative(0).Equals(ReconstituteNonNegative(0)))
+	s.False(ReconstituteNonNegative(0).Equals(ReconstituteNonNegative(1)))
+}
+
+func (s *NonNegativeTestSuite) TestItCanEncodeAndDecodeJSON() {
+	data, err := json.Marshal(ReconstituteNonNegative(uint16(0)))
+	s.NoError(err)
+	s.Equal(`0`, string(data))
+
+	var decoded NonNegative[uint16]
+	s.NoError(json.Unmarshal([]byte(`65535`), &decoded))
+	s.Equal(uint16(65535), decoded.Value())
+
+	var signed NonNegative[int]
+	s.ErrorIs(json.Unmarshal([]byte(`-1`), &signed), ErrNegative)
+	s.ErrorIs(json.Unmarshal([]byte(`true`), &signed), ErrInvalidNumber)
+}
@@ -0,0 +1,115 @@
+package numbers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrNotPositive = domain.NewLocalizedError(
+		"numbers.not_positive", nil,
+		"value must be greater than zero",
+	)
+	ErrInvalidNumber = domain.NewLocalizedError(
+		"numbers.invalid", nil,
+		"value is not a valid number",
+	)
+)
+
+// Integer is satisfied by the built-in integer types and types based on them
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Number is satisfied by the built-in integer and floating-point types and types based on them
+type Number interface {
+	Integer | ~float32 | ~float64
+}
+
+// Positive is a number greater than zero, such as an order quantity
+type Positive[T Number] struct {
+	value T
+}
+
+// NaturalNumber is a positive int: 1, 2, 3, ...
+type NaturalNumber = Positive[int]
+
+// NewPositive creates a new instance of Positive with validation
+func NewPositive[T Number](value T) (Positive[T], error) {
+	if err := IsPositive(value); err != nil {
+		return Positive[T]{}, err
+	}
+
+	return Positive[T]{
+		value: value,
+	}, nil
+}
+
+// ReconstitutePositive creates a new Positive instance without validation
+func ReconstitutePositive[T Number](value T) Positive[T] {
+	return Positive[T]{
+		value: value,
+	}
+}
+
+// IsPositive validates that a number is greater than zero
+func IsPositive[T Number](value T) error {
+	// NaN fails the comparison as well
+	if !(value > 0) {
+		return ErrNotPositive
+	}
+	return nil
+}
+
+// Value returns the number
+func (p Positive[T]) Value() T {
+	return p.value
+}
+
+// Equals compares two Positive objects for equality
+func (p Positive[T]) Equals(other Positive[T]) bool {
+	return p.value == other.value
+}
+
+// String returns a string representation of the number
+func (p Positive[T]) String() string {
+	return fmt.Sprint(p.value)
+}
+
+// MarshalJSON encodes the number as a JSON number
+func (p Positive[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.value)
+}
+
+// UnmarshalJSON decodes the number from a JSON number, with validation
+func (p *Positive[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	value, err := unmarshalNumber[T](data)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewPositive(value)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// unmarshalNumber decodes a JSON number into T, rejecting values T cannot hold
+func unmarshalNumber[T Number](data []byte) (T, error) {
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return value, ErrInvalidNumber
+	}
+	return value, nil
+}
@@ -0,0 +1,88 @@
+package numbers
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PositiveTestSuite struct {
+	suite.Suite
+}
+
+func TestPositiveSuite(t *testing.T) {
+	suite.Run(t, new(PositiveTestSuite))
+}
+
+func (s *PositiveTestSuite) TestItCanBuildNewPositiveWithValidValues() {
+	quantity, err := NewPositive(3)
+	s.NoError(err)
+	s.Equal(3, quantity.Value())
+	s.Equal("3", quantity.String())
+
+	weight, err := NewPositive(0.25)
+	s.NoError(err)
+	s.Equal(0.25, weight.Value())
+
+	count, err := NewPositive(uint8(1))
+	s.NoError(err)
+	s.Equal(uint8(1), count.Value())
+
+	var natural NaturalNumber
+	natural, err = NewPositive(42)
+	s.NoError(err)
+	s.Equal(42, natural.Value())
+}
+
+func (s *PositiveTestSuite) TestItFailsToBuildNewPositiveWithInvalidValues() {
+	_, err := NewPositive(0)
+	s.ErrorIs(err, ErrNotPositive)
+
+	_, err = NewPositive(-1)
+	s.ErrorIs(err, ErrNotPositive)
+
+	_, err = NewPositive(-0.5)
+	s.ErrorIs(err, ErrNotPositive)
+
+	_, err = NewPositive(math.NaN())
+	s.ErrorIs(err, ErrNotPositive)
+
+	_, err = NewPositive(uint(0))
+	s.ErrorIs(err, ErrNotPositive)
+}
+
+func (s *PositiveTestSuite) TestItCanReconstitutePositiveWithoutValidation() {
+	s.Equal(-1, ReconstitutePositive(-1).Value())
+}
+
+func (s *PositiveTestSuite) TestItComparesPositivesByValue() {
+	s.True(ReconstitutePositive(5).Equals(ReconstitutePositive(5)))
+	s.False(ReconstitutePositive(5).Equals(ReconstitutePositive(6)))
+}
+
+func (s *PositiveTestSuite) TestItCanEncodeAndDecodeJSON() {
+	type order struct {
+		Quantity Positive[int] `json:"quantity"`
+	}
+
+	data, err := json.Marshal(order{Quantity: ReconstitutePositive(7)})
+	s.NoError(err)
+	s.JSONEq(`{"quantity":7}`, string(data))
+
+	var decoded order
+	s.NoError(json.Unmarshal(data, &decoded))
+	s.Equal(7, decoded.Quantity.Value())
+
+	s.ErrorIs(json.Unmarshal([]byte(`{"quantity":0}`), &decoded), ErrNotPositive)
+	s.ErrorIs(json.Unmarshal([]byte(`{"quantity":"7"}`), &decoded), ErrInvalidNumber)
+	s.ErrorIs(json.Unmarshal([]byte(`{"quantity":1.5}`), &decoded), ErrInvalidNumber)
+
+	var small Positive[uint8]
+	s.ErrorIs(json.Unmarshal([]byte(`300`), &small), ErrInvalidNumber)
+
+	unchanged := order{Quantity: ReconstitutePositive(7)}
+	s.NoError(json.Unmarshal([]byte(`{"quantity":null}`), &unchanged))
+	s.Equal(7, unchanged.Quantity.Value())
+}
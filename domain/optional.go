@@ -0,0 +1,279 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"time"
+)
+
+var (
+	ErrUnsupportedOptionalScan  = NewInternalError("unsupported source type for optional value")
+	ErrUnsupportedOptionalValue = NewInternalError(
+		"optional value cannot be converted to a SQL value",
+	)
+)
+
+// Optional holds a value that may be absent, such as a contact without a phone number.
+// Unlike a zero-value struct, an absent Optional never equals a present one.
+//
+// It encodes as JSON null when absent and works with the omitzero struct tag option. As a
+// sql.Scanner it maps NULL and empty text to absent and parses other text through the value's
+// UnmarshalText; as a driver.Valuer it stores the value's Value() string or String form.
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some returns a present Optional holding value
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{
+		value:   value,
+		present: true,
+	}
+}
+
+// None returns an absent Optional
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// OptionalFromPointer returns an Optional holding *value, absent when value is nil
+func OptionalFromPointer[T any](value *T) Optional[T] {
+	if value == nil {
+		return None[T]()
+	}
+	return Some(*value)
+}
+
+// OptionalFromString builds the value with the constructor, or returns an absent Optional when
+// raw is empty. It models optional input fields such as "phone number, if any".
+func OptionalFromString[T any](
+	raw string,
+	constructor func(string) (T, error),
+) (Optional[T], error) {
+	if raw == "" {
+		return None[T](), nil
+	}
+
+	value, err := constructor(raw)
+	if err != nil {
+		return None[T](), err
+	}
+	return Some(value), nil
+}
+
+// MapOptional applies fn to the value of a present Optional
+func MapOptional[T, U any](o Optional[T], fn func(T) U) Optional[U] {
+	if !o.present {
+		return None[U]()
+	}
+	return Some(fn(o.value))
+}
+
+// FlatMapOptional applies fn, which may itself return an absent Optional, to the value of a
+// present Optional
+func FlatMapOptional[T, U any](o Optional[T], fn func(T) Optional[U]) Optional[U] {
+	if !o.present {
+		return None[U]()
+	}
+	return fn(o.value)
+}
+
+// Get returns the value and whether it is present
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
+
+// IsPresent reports whether the Optional holds a value
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// IsZero reports whether the Optional is absent, so the omitzero JSON option omits it
+func (o Optional[T]) IsZero() bool {
+	return !o.present
+}
+
+// OrElse returns the value, or fallback when absent
+func (o Optional[T]) OrElse(fallback T) T {
+	if !o.present {
+		return fallback
+	}
+	return o.value
+}
+
+// Ptr returns a pointer to a copy of the value, or nil when absent
+func (o Optional[T]) Ptr() *T {
+	if !o.present {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
+// Equals reports whether both Optionals are absent, or both are present with equal values.
+// Values are compared with their Equals method when they have one.
+func (o Optional[T]) Equals(other Optional[T]) bool {
+	if o.present != other.present {
+		return false
+	}
+	if !o.present {
+		return true
+	}
+
+	if equatable, ok := any(o.value).(Equatable[T]); ok {
+		return equatable.Equals(other.value)
+	}
+	return reflect.DeepEqual(o.value, other.value)
+}
+
+// String returns the string form of the value, or an empty string when absent
+func (o Optional[T]) String() string {
+	if !o.present {
+		return ""
+	}
+	return fmt.Sprint(o.value)
+}
+
+// LogValue implements slog.LogValuer, logging the value itself so that its own LogValue,
+// such as the masked form of personal data, applies. An absent value logs as nil.
+func (o Optional[T]) LogValue() slog.Value {
+	if !o.present {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.value)
+}
+
+// get returns the value and whether it is present, for UnwrapOptional
+func (o Optional[T]) get() (any, bool) {
+	return o.value, o.present
+}
+
+// optionalValue is implemented by every Optional, whatever its type parameter
+type optionalValue interface {
+	get() (any, bool)
+}
+
+// UnwrapOptional returns the value held by an Optional of any type, looking through nested
+// Optionals, and whether it is present. Other values are returned as they are. It lets code
+// handling values of unknown types, such as the otel package, inspect the wrapped value.
+func UnwrapOptional(value any) (any, bool) {
+	for {
+		optional, ok := value.(optionalValue)
+		if !ok {
+			return value, true
+		}
+
+		var present bool
+		if value, present = optional.get(); !present {
+			return nil, false
+		}
+	}
+}
+
+// MarshalJSON encodes the value, or null when absent
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.present {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as absent and anything else as a present value
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// Scan implements sql.Scanner. NULL and empty text scan as absent; other sources are handed
+// to the value's own Scan, assigned directly when they already have the value's type, or
+// parsed with its UnmarshalText.
+func (o *Optional[T]) Scan(src any) error {
+	if src == nil {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if scanner, ok := any(&value).(sql.Scanner); ok {
+		if err := scanner.Scan(src); err != nil {
+			return err
+		}
+		*o = Some(value)
+		return nil
+	}
+
+	if typed, ok := src.(T); ok {
+		*o = Some(typed)
+		return nil
+	}
+
+	unmarshaler, ok := any(&value).(encoding.TextUnmarshaler)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedOptionalScan, src)
+	}
+
+	var text string
+	switch src := src.(type) {
+	case string:
+		text = src
+	case []byte:
+		text = string(src)
+	case time.Time:
+		text = src.Format(time.RFC3339Nano)
+	default:
+		text = fmt.Sprint(src)
+	}
+
+	if text == "" {
+		*o = None[T]()
+		return nil
+	}
+
+	if err := unmarshaler.UnmarshalText([]byte(text)); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// Value implements driver.Valuer, returning nil when absent. Present values are converted
+// with their own Value method, their Value() string or String form, or the default conversion
+// of basic types.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.present {
+		return nil, nil
+	}
+
+	switch value := any(o.value).(type) {
+	case driver.Valuer:
+		return value.Value()
+	case interface{ Value() string }:
+		return value.Value(), nil
+	case fmt.Stringer:
+		return value.String(), nil
+	}
+
+	converted, err := driver.DefaultParameterConverter.ConvertValue(o.value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedOptionalValue, o.value)
+	}
+	return converted, nil
+}
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+var errInvalidCode = NewError("code must be uppercase letters")
+
+// code is a minimal value object used to exercise the generic helpers
+type code struct {
+	value string
+}
+
+func newCode(value string) (code, error) {
+	if value == "" || strings.ToUpper(value) != value {
+		return code{}, errInvalidCode
+	}
+	return code{value: value}, nil
+}
+
+func (c code) Value() string {
+	return c.value
+}
+
+func (c code) Equals(other code) bool {
+	return c.value == other.value
+}
+
+func (c code) String() string {
+	return c.value
+}
+
+func (c code) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.value)
+}
+
+func (c *code) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	parsed, err := newCode(raw)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+func (c *code) UnmarshalText(text []byte) error {
+	parsed, err := newCode(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// maskedCode logs its first letter only, as value objects holding personal data do
+type maskedCode code
+
+func (c maskedCode) LogValue() slog.Value {
+	return slog.StringValue(c.value[:1] + "•")
+}
+
+type OptionalTestSuite struct {
+	suite.Suite
+}
+
+func TestOptionalSuite(t *testing.T) {
+	suite.Run(t, new(OptionalTestSuite))
+}
+
+func (s *OptionalTestSuite) TestPresentAndAbsent() {
+	present := Some(code{value: "RO"})
+	value, ok := present.Get()
+	s.True(ok)
+	s.Equal("RO", value.Value())
+	s.True(present.IsPresent())
+	s.False(present.IsZero())
+	s.Equal("RO", present.Ptr().Value())
+
+	absent := None[code]()
+	_, ok = absent.Get()
+	s.False(ok)
+	s.False(absent.IsPresent())
+	s.True(absent.IsZero())
+	s.Nil(absent.Ptr())
+	s.Equal("US", absent.OrElse(code{value: "US"}).Value())
+	s.Equal("RO", present.OrElse(code{value: "US"}).Value())
+	s.Equal("", absent.String())
+}
+
+func (s *OptionalTestSuite) TestAbsentNeverEqualsAPresentZeroValue() {
+	s.False(None[code]().Equals(Some(code{})))
+	s.True(None[code]().Equals(None[code]()))
+	s.True(Some(code{value: "RO"}).Equals(Some(code{value: "RO"})))
+	s.False(Some(code{value: "RO"}).Equals(Some(code{value: "US"})))
+	s.True(Some([]int{1}).Equals(Some([]int{1})))
+}
+
+func (s *OptionalTestSuite) TestConstructors() {
+	s.False(OptionalFromPointer[int](nil).IsPresent())
+	seven := 7
+	s.Equal(7, OptionalFromPointer(&seven).OrElse(0))
+
+	empty, err := OptionalFromString("", newCode)
+	s.NoError(err)
+	s.False(empty.IsPresent())
+
+	present, err := OptionalFromString("RO", newCode)
+	s.NoError(err)
+	s.True(present.Equals(Some(code{value: "RO"})))
+
+	_, err = OptionalFromString("ro", newCode)
+	s.ErrorIs(err, errInvalidCode)
+}
+
+func (s *OptionalTestSuite) TestMapAndFlatMap() {
+	length := func(c code) int { return len(c.value) }
+	s.Equal(3, MapOptional(Some(code{value: "ROU"}), length).OrElse(0))
+	s.False(MapOptional(None[code](), length).IsPresent())
+
+	parse := func(raw string) Optional[code] {
+		parsed, err := newCode(raw)
+		if err != nil {
+			return None[code]()
+		}
+		return Some(parsed)
+	}
+	s.True(FlatMapOptional(Some("RO"), parse).IsPresent())
+	s.False(FlatMapOptional(Some("ro"), parse).IsPresent())
+	s.False(FlatMapOptional(None[string](), parse).IsPresent())
+}
+
+func (s *OptionalTestSuite) TestUnwrapOptional() {
+	value, present := UnwrapOptional(Some(Some(code{value: "RO"})))
+	s.True(present)
+	s.Equal(code{value: "RO"}, value)
+
+	value, present = UnwrapOptional(Some(None[code]()))
+	s.False(present)
+	s.Nil(value)
+
+	value, present = UnwrapOptional(42)
+	s.True(present)
+	s.Equal(42, value)
+}
+
+func (s *OptionalTestSuite) TestLogValueUsesTheValueLogValue() {
+	s.Equal("R•", Some(maskedCode{value: "RO"}).LogValue().Resolve().String())
+	s.Equal(slog.KindAny, None[maskedCode]().LogValue().Kind())
+}
+
+func (s *OptionalTestSuite) TestJSON() {
+	type contact struct {
+		Phone   Optional[code] `json:"phone"`
+		Country Optional[code] `json:"country,omitzero"`
+	}
+
+	encoded, err := json.Marshal(contact{})
+	s.Require().NoError(err)
+	s.JSONEq(`{"phone":null}`, string(encoded))
+
+	encoded, err = json.Marshal(
+		contact{Phone: Some(code{value: "A"}), Country: Some(code{value: "RO"})},
+	)
+	s.Require().NoError(err)
+	s.JSONEq(`{"phone":"A","country":"RO"}`, string(encoded))
+
+	var decoded contact
+	s.Require().NoError(json.Unmarshal([]byte(`{"phone":null,"country":"RO"}`), &decoded))
+	s.False(decoded.Phone.IsPresent())
+	s.True(decoded.Country.Equals(Some(code{value: "RO"})))
+
+	s.ErrorIs(json.Unmarshal([]byte(`{"country":"ro"}`), &decoded), errInvalidCode)
+}
+
+func (s *OptionalTestSuite) TestScan() {
+	var scanned Optional[code]
+	var _ sql.Scanner = &scanned
+
+	s.NoError(scanned.Scan(nil))
+	s.False(scanned.IsPresent())
+
+	s.NoError(scanned.Scan([]byte("RO")))
+	s.True(scanned.Equals(Some(code{value: "RO"})))
+
+	s.NoError(scanned.Scan(""))
+	s.False(scanned.IsPresent())
+
+	s.ErrorIs(scanned.Scan("ro"), errInvalidCode)
+
+	var number Optional[int64]
+	s.NoError(number.Scan(int64(42)))
+	s.Equal(int64(42), number.OrElse(0))
+	s.ErrorIs(number.Scan("42"), ErrUnsupportedOptionalScan)
+}
+
+func (s *OptionalTestSuite) TestValue() {
+	var _ driver.Valuer = Optional[code]{}
+
+	value, err := None[code]().Value()
+	s.NoError(err)
+	s.Nil(value)
+
+	value, err = Some(code{value: "RO"}).Value()
+	s.NoError(err)
+	s.Equal("RO", value)
+
+	value, err = Some(uint8(7)).Value()
+	s.NoError(err)
+	s.Equal(int64(7), value)
+
+	_, err = Some(struct{}{}).Value()
+	s.ErrorIs(err, ErrUnsupportedOptionalValue)
+}
@@ -0,0 +1,82 @@
+// Package otel builds OpenTelemetry span and metric attributes from value objects, so every
+// instrumentation records them the same way and personal data never reaches a trace backend
+// in clear.
+//
+// Values marked domain.Sensitive that hold personal data, such as web.Email,
+// contact.PhoneNumber, person.FullName, person.NationalID or finance.AccountNumber, are
+// recorded masked. Secrets, such as auth.Token or auth.SessionID, are recorded with their
+// redacted string form. Values wrapped in a domain.Optional are recorded the same way as the
+// values they hold. Other values are recorded raw:
+//
+//	span.SetAttributes(otel.Attributes(
+//		"user.email", customer.Email(), // "j•••@example.com"
+//		"order.total", order.Total(),   // "19.99 EUR"
+//	)...)
+//
+// The package only depends on the OpenTelemetry attribute API, not on the SDK.
+package otel
+
+import (
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// masker is implemented by value objects holding personal data
+type masker interface {
+	Masked() string
+}
+
+// Attribute returns the attribute recording the value object under key: masked when it holds
+// personal data, redacted when it is a secret and raw otherwise
+func Attribute(key string, value any) attribute.KeyValue {
+	return attribute.String(key, attributeValue(value))
+}
+
+// Attributes returns the attributes recording each value object under its key, in argument
+// order. Nil values and absent domain.Optional values are skipped, so optional values can be
+// passed as they are.
+func Attributes(keysAndValues ...any) []attribute.KeyValue {
+	attributes := make([]attribute.KeyValue, 0, len(keysAndValues)/2)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		key, ok := keysAndValues[i].(string)
+		if !ok || keysAndValues[i+1] == nil {
+			continue
+		}
+		if _, present := domain.UnwrapOptional(keysAndValues[i+1]); !present {
+			continue
+		}
+		attributes = append(attributes, Attribute(key, keysAndValues[i+1]))
+	}
+	return attributes
+}
+
+// IsPII reports whether the value object, or the value a domain.Optional holds, is marked
+// domain.Sensitive, as holding personal data or a secret, and so is recorded masked or
+// redacted
+func IsPII(value any) bool {
+	value, _ = domain.UnwrapOptional(value)
+	_, ok := value.(domain.Sensitive)
+	return ok
+}
+
+// attributeValue returns the masked form of sensitive values and the string form of others.
+// Optional values are unwrapped first, an absent one being recorded as an empty string.
+func attributeValue(value any) string {
+	value, present := domain.UnwrapOptional(value)
+	if !present {
+		return ""
+	}
+
+	if masked, ok := value.(masker); ok && IsPII(value) {
+		return masked.Masked()
+	}
+
+	switch value := value.(type) {
+	case fmt.Stringer:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,120 @@
+package otel
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// loggedStatus has a LogValue method without holding personal data
+type loggedStatus string
+
+func (s loggedStatus) LogValue() slog.Value {
+	return slog.StringValue(string(s))
+}
+
+func (s loggedStatus) String() string {
+	return string(s)
+}
+
+type OtelTestSuite struct {
+	suite.Suite
+}
+
+func TestOtelSuite(t *testing.T) {
+	suite.Run(t, new(OtelTestSuite))
+}
+
+func (s *OtelTestSuite) TestItMasksPersonalData() {
+	testCases := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"email", web.ReconstituteEmail("jane@example.com"), "j•••@example.com"},
+		{"phone number", contact.ReconstitutePhoneNumber("+40721234567"), "+4•••••••567"},
+		{"full name", person.ReconstituteFullName("Jane", "", "Doe"), "J. D."},
+		{"account number", finance.ReconstituteAccountNumber("123456789"), "•••••6789"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.True(IsPII(tc.value))
+			s.Equal(attribute.String("user.pii", tc.expected), Attribute("user.pii", tc.value))
+		})
+	}
+}
+
+func (s *OtelTestSuite) TestItMasksPersonalDataWrappedInOptionals() {
+	email := web.ReconstituteEmail("jane.doe@example.com")
+
+	s.True(IsPII(domain.Some(email)))
+	s.Equal(
+		attribute.String("user.email", "j•••@example.com"),
+		Attribute("user.email", domain.Some(email)),
+	)
+	s.Equal(
+		attribute.String("user.email", "j•••@example.com"),
+		Attribute("user.email", domain.Some(domain.Some(email))),
+	)
+	s.Equal(attribute.String("user.email", ""), Attribute("user.email", domain.None[web.Email]()))
+	s.Equal(
+		[]attribute.KeyValue{attribute.String("user.email", "j•••@example.com")},
+		Attributes("user.email", domain.Some(email), "user.phone", domain.None[web.Email]()),
+	)
+}
+
+func (s *OtelTestSuite) TestItRedactsSecrets() {
+	token := auth.ReconstituteToken("AbCdEfGhIjKlMnOp")
+	s.True(IsPII(token))
+	s.Equal("AbCd••••", Attribute("session.token", token).Value.AsString())
+}
+
+func (s *OtelTestSuite) TestItRecordsOtherValuesRaw() {
+	price, err := finance.NewMoneyFromString("19.99", "EUR")
+	s.Require().NoError(err)
+
+	s.False(IsPII(price))
+	s.Equal(attribute.String("order.total", "19.99 EUR"), Attribute("order.total", price))
+	s.Equal(
+		attribute.String("geo.country", "RO"),
+		Attribute("geo.country", geography.ReconstituteCountryCode("RO")),
+	)
+	s.Equal(attribute.String("retry.count", "42"), Attribute("retry.count", 42))
+}
+
+func (s *OtelTestSuite) TestItRecordsLogValuersThatAreNotMarkedSensitiveRaw() {
+	var _ slog.LogValuer = loggedStatus("")
+	s.False(IsPII(loggedStatus("active")))
+	s.Equal(
+		attribute.String("account.status", "active"),
+		Attribute("account.status", loggedStatus("active")),
+	)
+
+	err := domain.NewError("order not found")
+	s.False(IsPII(err))
+}
+
+func (s *OtelTestSuite) TestItBuildsAttributeSets() {
+	attributes := Attributes(
+		"user.email", web.ReconstituteEmail("jane@example.com"),
+		"user.phone", nil,
+		7, "ignored",
+		"geo.country", geography.ReconstituteCountryCode("FR"),
+		"dangling",
+	)
+
+	s.Equal([]attribute.KeyValue{
+		attribute.String("user.email", "j•••@example.com"),
+		attribute.String("geo.country", "FR"),
+	}, attributes)
+}
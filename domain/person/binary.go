@@ -0,0 +1,72 @@
+package person
+
+import (
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+// MarshalBinary encodes every part of the full name, including prefix and suffix
+func (f FullName) MarshalBinary() ([]byte, error) {
+	if f == (FullName{}) {
+		return nil, nil
+	}
+
+	return domain.MarshalBinaryFields(
+		f.prefix, f.firstName, f.middleName, f.lastName, f.suffix,
+	), nil
+}
+
+// UnmarshalBinary decodes the full name, validating every part
+func (f *FullName) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 5)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewFullNameWithAffixes(fields[0], fields[1], fields[2], fields[3], fields[4])
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+	return nil
+}
+
+// MarshalBinary encodes the country and the full, unmasked national ID value. Unlike
+// MarshalJSON it is meant for trusted storage, so only cache it where the raw value may live.
+func (n NationalID) MarshalBinary() ([]byte, error) {
+	if n == (NationalID{}) {
+		return nil, nil
+	}
+
+	return domain.MarshalBinaryFields(n.country.Value(), n.value), nil
+}
+
+// UnmarshalBinary decodes the national ID, with validation
+func (n *NationalID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 2)
+	if err != nil {
+		return err
+	}
+
+	country, err := geography.NewCountryCode(fields[0])
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewNationalID(country, fields[1])
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
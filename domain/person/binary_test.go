@@ -0,0 +1,62 @@
+package person
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+)
+
+type BinaryTestSuite struct {
+	suite.Suite
+}
+
+func TestBinarySuite(t *testing.T) {
+	suite.Run(t, new(BinaryTestSuite))
+}
+
+type cachedPerson struct {
+	Name       FullName
+	NationalID NationalID
+	Alias      FullName
+}
+
+func (s *BinaryTestSuite) TestItCanRoundTripValueObjectsThroughGob() {
+	name, err := NewFullNameWithAffixes("Dr.", "Jane", "Ann", "Doe", "Jr.")
+	s.Require().NoError(err)
+	country, _ := geography.NewCountryCode("US")
+	nationalID, err := NewNationalID(country, "123-45-6789")
+	s.Require().NoError(err)
+
+	original := cachedPerson{Name: name, NationalID: nationalID}
+
+	var buffer bytes.Buffer
+	s.Require().NoError(gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded cachedPerson
+	s.Require().NoError(gob.NewDecoder(&buffer).Decode(&decoded))
+
+	s.True(original.Name.Equals(decoded.Name))
+	s.True(original.NationalID.Equals(decoded.NationalID))
+	s.Equal("123456789", decoded.NationalID.Value())
+	s.Equal(FullName{}, decoded.Alias)
+}
+
+func (s *BinaryTestSuite) TestItValidatesWhenUnmarshalingBinary() {
+	var name FullName
+	s.ErrorIs(
+		name.UnmarshalBinary(domain.MarshalBinaryFields("Jane", "Doe")),
+		domain.ErrInvalidBinary,
+	)
+	s.Error(name.UnmarshalBinary(domain.MarshalBinaryFields("", "", "", "Doe", "")))
+
+	var nationalID NationalID
+	s.ErrorIs(
+		nationalID.UnmarshalBinary(domain.MarshalBinaryFields("USA", "123456789")),
+		geography.ErrInvalidCountryCode,
+	)
+	s.Error(nationalID.UnmarshalBinary(domain.MarshalBinaryFields("US", "000000000")))
+}
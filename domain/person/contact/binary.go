@@ -0,0 +1,43 @@
+package contact
+
+import "github.com/golibry/go-common-domain/domain"
+
+// MarshalBinary encodes the phone number and its extension, so it can be cached or sent over
+// gob-based RPC. The zero value encodes as empty data.
+func (p PhoneNumber) MarshalBinary() ([]byte, error) {
+	if p == (PhoneNumber{}) {
+		return nil, nil
+	}
+
+	return domain.MarshalBinaryFields(p.value, p.extension), nil
+}
+
+// UnmarshalBinary decodes the phone number, with validation. Empty data leaves the value
+// unchanged, mirroring how JSON decoders treat null.
+func (p *PhoneNumber) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	fields, err := domain.UnmarshalBinaryFieldsN(data, 2)
+	if err != nil {
+		return err
+	}
+
+	normalized, err := NormalizePhoneNumber(fields[0])
+	if err != nil {
+		return err
+	}
+
+	if fields[1] != "" {
+		if err := IsValidPhoneNumberExtension(fields[1]); err != nil {
+			return err
+		}
+	}
+
+	*p = PhoneNumber{
+		value:     normalized,
+		extension: fields[1],
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package contact
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type BinaryTestSuite struct {
+	suite.Suite
+}
+
+func TestBinarySuite(t *testing.T) {
+	suite.Run(t, new(BinaryTestSuite))
+}
+
+func (s *BinaryTestSuite) TestItCanRoundTripPhoneNumbersThroughGob() {
+	testCases := []string{"+1 234 567 8900", "+1 234 567 8900 ext. 123"}
+
+	for _, input := range testCases {
+		s.Run(
+			input, func() {
+				original, err := NewPhoneNumber(input)
+				s.Require().NoError(err)
+
+				var buffer bytes.Buffer
+				s.Require().NoError(gob.NewEncoder(&buffer).Encode(original))
+
+				var decoded PhoneNumber
+				s.Require().NoError(gob.NewDecoder(&buffer).Decode(&decoded))
+				s.True(original.Equals(decoded))
+			},
+		)
+	}
+}
+
+func (s *BinaryTestSuite) TestItValidatesWhenUnmarshalingBinary() {
+	var phone PhoneNumber
+	s.ErrorIs(
+		phone.UnmarshalBinary(domain.MarshalBinaryFields("+12345678900", "12a")),
+		ErrInvalidPhoneExtension,
+	)
+	s.ErrorIs(
+		phone.UnmarshalBinary(domain.MarshalBinaryFields("abc", "")),
+		ErrInvalidPhoneNumberChars,
+	)
+	s.ErrorIs(phone.UnmarshalBinary([]byte{1}), domain.ErrInvalidBinary)
+}
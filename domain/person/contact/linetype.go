@@ -0,0 +1,127 @@
+package contact
+
+import "strings"
+
+// PhoneNumberType is the line type of a phone number, derived from numbering-plan metadata
+type PhoneNumberType int
+
+const (
+	PhoneNumberTypeUnknown PhoneNumberType = iota
+	PhoneNumberTypeMobile
+	PhoneNumberTypeFixedLine
+	// PhoneNumberTypeFixedLineOrMobile is used where the numbering plan does not distinguish
+	// mobile from fixed-line numbers, e.g., in the North American Numbering Plan
+	PhoneNumberTypeFixedLineOrMobile
+	PhoneNumberTypeTollFree
+	PhoneNumberTypePremiumRate
+)
+
+// String returns a string representation of the phone number type
+func (t PhoneNumberType) String() string {
+	switch t {
+	case PhoneNumberTypeMobile:
+		return "mobile"
+	case PhoneNumberTypeFixedLine:
+		return "fixed-line"
+	case PhoneNumberTypeFixedLineOrMobile:
+		return "fixed-line-or-mobile"
+	case PhoneNumberTypeTollFree:
+		return "toll-free"
+	case PhoneNumberTypePremiumRate:
+		return "premium-rate"
+	default:
+		return "unknown"
+	}
+}
+
+// IsSMSCapable reports whether numbers of this type can usually receive SMS
+func (t PhoneNumberType) IsSMSCapable() bool {
+	return t == PhoneNumberTypeMobile || t == PhoneNumberTypeFixedLineOrMobile
+}
+
+// maxNumberingPlanPrefixLength is the length of the longest prefix in numberingPlan
+const maxNumberingPlanPrefixLength = 6
+
+// numberingPlan maps international prefixes (country calling code followed by the leading
+// national digits) to line types for major regions. The longest matching prefix wins.
+var numberingPlan = map[string]PhoneNumberType{
+	// North America (+1): mobile and fixed-line numbers share area codes
+	"1": PhoneNumberTypeFixedLineOrMobile, "1800": PhoneNumberTypeTollFree,
+	"1833": PhoneNumberTypeTollFree, "1844": PhoneNumberTypeTollFree,
+	"1855": PhoneNumberTypeTollFree, "1866": PhoneNumberTypeTollFree,
+	"1877": PhoneNumberTypeTollFree, "1888": PhoneNumberTypeTollFree,
+	"1900": PhoneNumberTypePremiumRate,
+
+	// United Kingdom (+44)
+	"441": PhoneNumberTypeFixedLine, "442": PhoneNumberTypeFixedLine,
+	"4471": PhoneNumberTypeMobile, "4472": PhoneNumberTypeMobile,
+	"4473": PhoneNumberTypeMobile, "4474": PhoneNumberTypeMobile,
+	"4475": PhoneNumberTypeMobile, "4477": PhoneNumberTypeMobile,
+	"4478": PhoneNumberTypeMobile, "4479": PhoneNumberTypeMobile,
+	"44800": PhoneNumberTypeTollFree, "44808": PhoneNumberTypeTollFree,
+	"4490": PhoneNumberTypePremiumRate, "4491": PhoneNumberTypePremiumRate,
+	"4498": PhoneNumberTypePremiumRate,
+
+	// Germany (+49)
+	"492": PhoneNumberTypeFixedLine, "493": PhoneNumberTypeFixedLine,
+	"494": PhoneNumberTypeFixedLine, "495": PhoneNumberTypeFixedLine,
+	"496": PhoneNumberTypeFixedLine, "497": PhoneNumberTypeFixedLine,
+	"498": PhoneNumberTypeFixedLine, "499": PhoneNumberTypeFixedLine,
+	"4915": PhoneNumberTypeMobile, "4916": PhoneNumberTypeMobile,
+	"4917": PhoneNumberTypeMobile, "49800": PhoneNumberTypeTollFree,
+	"49900": PhoneNumberTypePremiumRate,
+
+	// France (+33)
+	"331": PhoneNumberTypeFixedLine, "332": PhoneNumberTypeFixedLine,
+	"333": PhoneNumberTypeFixedLine, "334": PhoneNumberTypeFixedLine,
+	"335": PhoneNumberTypeFixedLine, "339": PhoneNumberTypeFixedLine,
+	"336": PhoneNumberTypeMobile, "337": PhoneNumberTypeMobile,
+	"33800": PhoneNumberTypeTollFree, "33805": PhoneNumberTypeTollFree,
+	"3389": PhoneNumberTypePremiumRate,
+
+	// Spain (+34)
+	"346": PhoneNumberTypeMobile, "347": PhoneNumberTypeMobile,
+	"348": PhoneNumberTypeFixedLine, "349": PhoneNumberTypeFixedLine,
+	"34800": PhoneNumberTypeTollFree, "34900": PhoneNumberTypeTollFree,
+	"34803": PhoneNumberTypePremiumRate, "34806": PhoneNumberTypePremiumRate,
+	"34807": PhoneNumberTypePremiumRate,
+
+	// Italy (+39)
+	"390": PhoneNumberTypeFixedLine, "393": PhoneNumberTypeMobile,
+	"39800": PhoneNumberTypeTollFree, "39803": PhoneNumberTypeTollFree,
+	"39899": PhoneNumberTypePremiumRate,
+
+	// Romania (+40)
+	"402": PhoneNumberTypeFixedLine, "403": PhoneNumberTypeFixedLine,
+	"407": PhoneNumberTypeMobile, "40800": PhoneNumberTypeTollFree,
+	"40900": PhoneNumberTypePremiumRate, "40906": PhoneNumberTypePremiumRate,
+
+	// Australia (+61)
+	"612": PhoneNumberTypeFixedLine, "613": PhoneNumberTypeFixedLine,
+	"617": PhoneNumberTypeFixedLine, "618": PhoneNumberTypeFixedLine,
+	"614": PhoneNumberTypeMobile, "611800": PhoneNumberTypeTollFree,
+	"61190": PhoneNumberTypePremiumRate,
+
+	// India (+91)
+	"916": PhoneNumberTypeMobile, "917": PhoneNumberTypeMobile,
+	"918": PhoneNumberTypeMobile, "919": PhoneNumberTypeMobile,
+	"911800": PhoneNumberTypeTollFree,
+}
+
+// Type returns the line type of the phone number.
+// Only numbers in international format (starting with '+') from regions covered by the
+// embedded numbering-plan metadata are classified; all others are PhoneNumberTypeUnknown.
+func (p PhoneNumber) Type() PhoneNumberType {
+	digits, international := strings.CutPrefix(p.value, "+")
+	if !international {
+		return PhoneNumberTypeUnknown
+	}
+
+	for length := min(maxNumberingPlanPrefixLength, len(digits)); length > 0; length-- {
+		if numberType, ok := numberingPlan[digits[:length]]; ok {
+			return numberType
+		}
+	}
+
+	return PhoneNumberTypeUnknown
+}
@@ -0,0 +1,57 @@
+package contact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LineTypeTestSuite struct {
+	suite.Suite
+}
+
+func TestLineTypeSuite(t *testing.T) {
+	suite.Run(t, new(LineTypeTestSuite))
+}
+
+func (s *LineTypeTestSuite) TestItDetectsLineTypes() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected PhoneNumberType
+	}{
+		{"US number", "+1 212 555 0100", PhoneNumberTypeFixedLineOrMobile},
+		{"US toll-free", "+1 800 555 0100", PhoneNumberTypeTollFree},
+		{"US premium", "+1 900 555 0100", PhoneNumberTypePremiumRate},
+		{"UK mobile", "+44 7911 123456", PhoneNumberTypeMobile},
+		{"UK fixed-line", "+44 20 7946 0958", PhoneNumberTypeFixedLine},
+		{"UK freephone", "+44 800 123 4567", PhoneNumberTypeTollFree},
+		{"DE mobile", "+49 151 23456789", PhoneNumberTypeMobile},
+		{"FR mobile", "+33 6 12 34 56 78", PhoneNumberTypeMobile},
+		{"ES toll-free", "+34 900 123 456", PhoneNumberTypeTollFree},
+		{"RO mobile", "+40 721 234 567", PhoneNumberTypeMobile},
+		{"RO fixed-line", "+40 21 123 4567", PhoneNumberTypeFixedLine},
+		{"AU toll-free", "+61 1800 123 456", PhoneNumberTypeTollFree},
+		{"uncovered region", "+81 3 1234 5678", PhoneNumberTypeUnknown},
+		{"national format", "721234567", PhoneNumberTypeUnknown},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				phoneNumber, err := NewPhoneNumber(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, phoneNumber.Type())
+			},
+		)
+	}
+}
+
+func (s *LineTypeTestSuite) TestTypeStringAndSMSCapability() {
+	s.Equal("mobile", PhoneNumberTypeMobile.String())
+	s.Equal("toll-free", PhoneNumberTypeTollFree.String())
+	s.Equal("unknown", PhoneNumberTypeUnknown.String())
+	s.True(PhoneNumberTypeMobile.IsSMSCapable())
+	s.True(PhoneNumberTypeFixedLineOrMobile.IsSMSCapable())
+	s.False(PhoneNumberTypePremiumRate.IsSMSCapable())
+}
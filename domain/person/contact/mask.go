@@ -0,0 +1,48 @@
+package contact
+
+import (
+	"log/slog"
+	"strings"
+)
+
+const (
+	// DefaultMaskedPrefixDigits is the number of leading digits left visible by Masked
+	DefaultMaskedPrefixDigits = 1
+	// DefaultMaskedSuffixDigits is the number of trailing digits left visible by Masked
+	DefaultMaskedSuffixDigits = 3
+	phoneMaskRune             = "•"
+)
+
+// Masked returns the phone number with its middle digits hidden, e.g., "+1•••••••890".
+// The extension is never included.
+func (p PhoneNumber) Masked() string {
+	return p.MaskedWith(DefaultMaskedPrefixDigits, DefaultMaskedSuffixDigits)
+}
+
+// MaskedWith returns the phone number with all digits hidden except the given number of
+// leading and trailing digits. At least half of the digits are always hidden, so short
+// numbers reveal fewer digits than requested.
+func (p PhoneNumber) MaskedWith(prefixDigits, suffixDigits int) string {
+	digits, international := strings.CutPrefix(p.value, "+")
+
+	visible := len(digits) / 2
+	suffixDigits = min(max(suffixDigits, 0), visible)
+	prefixDigits = min(max(prefixDigits, 0), visible-suffixDigits)
+
+	var result strings.Builder
+	if international {
+		result.WriteByte('+')
+	}
+	result.WriteString(digits[:prefixDigits])
+	result.WriteString(strings.Repeat(phoneMaskRune, len(digits)-prefixDigits-suffixDigits))
+	result.WriteString(digits[len(digits)-suffixDigits:])
+	return result.String()
+}
+
+// LogValue implements slog.LogValuer so that loggers record the masked phone number
+func (p PhoneNumber) LogValue() slog.Value {
+	return slog.StringValue(p.Masked())
+}
+
+// Sensitive marks the phone number as personal data for domain.Sensitive
+func (p PhoneNumber) Sensitive() {}
@@ -0,0 +1,59 @@
+package contact
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MaskTestSuite struct {
+	suite.Suite
+}
+
+func TestMaskSuite(t *testing.T) {
+	suite.Run(t, new(MaskTestSuite))
+}
+
+func (s *MaskTestSuite) TestItCanMaskPhoneNumbers() {
+	testCases := []struct {
+		name         string
+		input        string
+		prefixDigits int
+		suffixDigits int
+		expected     string
+	}{
+		{"default visibility", "+12345678890", 1, 3, "+1•••••••890"},
+		{"more visible digits", "+12345678890", 2, 2, "+12•••••••90"},
+		{"fully hidden", "+12345678890", 0, 0, "+•••••••••••"},
+		{"national number", "721234567", 1, 3, "7•••••567"},
+		{"short number is clamped", "123", 1, 3, "••3"},
+		{"negative counts are ignored", "+12345678890", -1, -1, "+•••••••••••"},
+		{"extension is omitted", "+12345678890 ext. 12", 1, 3, "+1•••••••890"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				phoneNumber, err := NewPhoneNumber(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, phoneNumber.MaskedWith(tc.prefixDigits, tc.suffixDigits))
+			},
+		)
+	}
+
+	phoneNumber, _ := NewPhoneNumber("+12345678890")
+	s.Equal("+1•••••••890", phoneNumber.Masked())
+}
+
+func (s *MaskTestSuite) TestItLogsMaskedValue() {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	phoneNumber, _ := NewPhoneNumber("+12345678890")
+
+	logger.Info("sms sent", slog.Any("phone", phoneNumber))
+
+	s.Contains(buf.String(), "phone=+1•••••••890")
+	s.NotContains(buf.String(), "2345678")
+}
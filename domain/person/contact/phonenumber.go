@@ -1,39 +1,85 @@
 package contact
 
 import (
-	"regexp"
 	"strings"
 	"unicode"
 
 	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
 )
 
 // MaxPhoneNumberLength defines the maximum number of digits allowed by E.164 (15 digits, excluding '+').
 const MaxPhoneNumberLength = 15
 
+// MaxPhoneNumberExtensionLength defines the maximum number of digits in an extension
+const MaxPhoneNumberExtensionLength = 10
+
 var (
-	ErrEmptyPhoneNumber        = domain.NewError("phone number cannot be empty")
-	ErrInvalidPhoneNumberChars = domain.NewError("phone number contains invalid characters")
-	ErrTooLongPhoneNumber      = domain.NewError("phone number is too long")
-	ErrTooShortPhoneNumber     = domain.NewError("phone number is too short")
+	ErrEmptyPhoneNumber = domain.NewLocalizedError(
+		"contact.phone_number.empty", nil,
+		"phone number cannot be empty",
+	)
+	ErrInvalidPhoneNumberChars = domain.NewLocalizedError(
+		"contact.phone_number.invalid_chars", nil,
+		"phone number contains invalid characters",
+	)
+	ErrTooLongPhoneNumber = domain.NewLocalizedError(
+		"contact.phone_number.too_long", nil,
+		"phone number is too long",
+	)
+	ErrTooShortPhoneNumber = domain.NewLocalizedError(
+		"contact.phone_number.too_short", nil,
+		"phone number is too short",
+	)
+	ErrInvalidPhoneExtension = domain.NewLocalizedError(
+		"contact.phone_number.invalid_extension",
+		domain.MessageParams{"max": MaxPhoneNumberExtensionLength},
+		"phone number extension must contain between 1 and %d digits",
+		MaxPhoneNumberExtensionLength,
+	)
 )
 
-var phoneNumberRegex = regexp.MustCompile(`^\+?[1-9]\d{1,14}$`)
+// PhoneNumberPattern is the regular expression a normalized phone number (without its
+// extension) must match
+const PhoneNumberPattern = `^\+?[1-9]\d{1,14}$`
+
+// phoneExtensionMarkers lists the lowercase markers that introduce an extension, longest first
+var phoneExtensionMarkers = []string{";ext=", "extension", "ext.", "ext", "x", "#"}
+
+// PhoneNumberValidators holds extra rules run by NewPhoneNumber after its own validation
+var PhoneNumberValidators domain.Validators[PhoneNumber]
 
 type PhoneNumber struct {
-	value string
+	value     string
+	extension string
 }
 
-// NewPhoneNumber creates a new instance of PhoneNumber with validation and normalization
+// NewPhoneNumber creates a new instance of PhoneNumber with validation and normalization.
+// An extension introduced by ";ext=", "ext.", "ext", "extension", "x" or "#"
+// (e.g., "+1 234 567 8900 ext. 123") is stored separately from the number.
 func NewPhoneNumber(value string) (PhoneNumber, error) {
-	normalized, err := NormalizePhoneNumber(value)
+	number, extension := splitPhoneNumberExtension(value)
+
+	normalized, err := NormalizePhoneNumber(number)
 	if err != nil {
 		return PhoneNumber{}, err
 	}
 
-	return PhoneNumber{
-		value: normalized,
-	}, nil
+	if extension != "" {
+		if err := IsValidPhoneNumberExtension(extension); err != nil {
+			return PhoneNumber{}, err
+		}
+	}
+
+	phoneNumber := PhoneNumber{
+		value:     normalized,
+		extension: extension,
+	}
+	if err := PhoneNumberValidators.Validate(phoneNumber); err != nil {
+		return PhoneNumber{}, err
+	}
+
+	return phoneNumber, nil
 }
 
 // ReconstitutePhoneNumber creates a new PhoneNumber instance without validation or normalization
@@ -43,45 +89,75 @@ func ReconstitutePhoneNumber(value string) PhoneNumber {
 	}
 }
 
-// Value returns the phone number value
+// ReconstitutePhoneNumberWithExtension creates a new PhoneNumber instance, including its
+// extension, without validation or normalization
+func ReconstitutePhoneNumberWithExtension(value, extension string) PhoneNumber {
+	return PhoneNumber{
+		value:     value,
+		extension: extension,
+	}
+}
+
+// Value returns the phone number value, without the extension
 func (p PhoneNumber) Value() string {
 	return p.value
 }
 
+// Extension returns the extension digits, or an empty string if there is no extension
+func (p PhoneNumber) Extension() string {
+	return p.extension
+}
+
+// CountryCode returns the country of an international number, written with its leading '+',
+// inferred from the calling code. Numbers in national format have no known country.
+func (p PhoneNumber) CountryCode() (geography.CountryCode, bool) {
+	if !strings.HasPrefix(p.value, "+") {
+		return geography.CountryCode{}, false
+	}
+	return geography.CountryForInternationalNumber(p.value)
+}
+
 // Equals compares two PhoneNumber objects for equality
 func (p PhoneNumber) Equals(other PhoneNumber) bool {
-	return p.value == other.value
+	return p.value == other.value && p.extension == other.extension
 }
 
-// String returns a string representation of the phone number
+// String returns a string representation of the phone number, e.g., "+12345678900 ext. 123"
 func (p PhoneNumber) String() string {
-	return p.value
+	if p.extension == "" {
+		return p.value
+	}
+	return p.value + " ext. " + p.extension
+}
+
+// RFC3966 returns the phone number as an RFC 3966 "tel" URI, e.g., "tel:+12345678900;ext=123".
+// Numbers without a leading '+' are rendered as-is, without a phone-context parameter.
+func (p PhoneNumber) RFC3966() string {
+	uri := "tel:" + p.value
+	if p.extension != "" {
+		uri += ";ext=" + p.extension
+	}
+	return uri
 }
 
 // NormalizePhoneNumber normalizes a phone number by removing spaces, dashes, parentheses, and dots
 func NormalizePhoneNumber(phoneNumber string) (string, error) {
-	// Trim spaces from the beginning and end
 	phoneNumber = strings.TrimSpace(phoneNumber)
 
-	// First check for invalid characters before normalization
-	for _, r := range phoneNumber {
-		// Allow digits, plus sign, spaces, dashes, parentheses, and dots
-		if !unicode.IsDigit(r) && r != '+' && r != ' ' && r != '-' && r != '(' && r != ')' && r != '.' {
-			return "", ErrInvalidPhoneNumberChars
-		}
-	}
-
+	// Keep only digits and plus signs, rejecting anything but the allowed separators
 	var result strings.Builder
-
+	result.Grow(len(phoneNumber))
 	for _, r := range phoneNumber {
-		// Keep only digits and plus sign
-		if unicode.IsDigit(r) || r == '+' {
+		switch {
+		case r == '+' || unicode.IsDigit(r):
 			result.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+		default:
+			return "", ErrInvalidPhoneNumberChars
 		}
 	}
 
 	normalized := result.String()
-
 	if err := IsValidPhoneNumber(normalized); err != nil {
 		return "", err
 	}
@@ -89,41 +165,98 @@ func NormalizePhoneNumber(phoneNumber string) (string, error) {
 	return normalized, nil
 }
 
-// IsValidPhoneNumber validates a phone number
+// IsValidPhoneNumberExtension validates a phone number extension (digits only)
+func IsValidPhoneNumberExtension(extension string) error {
+	if extension == "" || len(extension) > MaxPhoneNumberExtensionLength {
+		return ErrInvalidPhoneExtension
+	}
+
+	for _, r := range extension {
+		if r < '0' || r > '9' {
+			return ErrInvalidPhoneExtension
+		}
+	}
+
+	return nil
+}
+
+// splitPhoneNumberExtension separates the number from its extension at the first
+// extension marker. The extension is returned trimmed; it is empty if no marker is found.
+// A marker without digits after it yields a non-digit extension so that validation fails.
+func splitPhoneNumberExtension(value string) (string, string) {
+	for _, marker := range phoneExtensionMarkers {
+		index := indexASCIIFold(value, marker)
+		if index < 0 {
+			continue
+		}
+
+		extension := strings.TrimSpace(value[index+len(marker):])
+		if extension == "" {
+			extension = marker
+		}
+		return value[:index], extension
+	}
+
+	return value, ""
+}
+
+// indexASCIIFold returns the byte index of the first occurrence of the lowercase ASCII marker
+// in value, ignoring ASCII case, or -1. Unlike lowercasing the whole value first, it keeps
+// byte offsets valid for value whatever runes (or invalid UTF-8) it holds.
+func indexASCIIFold(value, marker string) int {
+	for i := 0; i+len(marker) <= len(value); i++ {
+		matches := true
+		for j := 0; j < len(marker); j++ {
+			c := value[i+j]
+			if c >= 'A' && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			if c != marker[j] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsValidPhoneNumber validates a phone number. It matches PhoneNumberPattern, checked in a
+// single pass so that length errors are reported before character errors.
 func IsValidPhoneNumber(phoneNumber string) error {
 	if phoneNumber == "" {
 		return ErrEmptyPhoneNumber
 	}
 
-	// Count only digits to comply with E.164 limits (exclude optional '+').
+	// Count only digits to comply with E.164 limits (exclude optional '+'), noting anything
+	// the pattern rejects: other characters, non-ASCII digits or a leading zero.
 	digits := 0
+	wellFormed := true
 	for i, r := range phoneNumber {
-		if i == 0 && r == '+' {
-			continue
-		}
-		if unicode.IsDigit(r) {
+		switch {
+		case r >= '0' && r <= '9':
+			if digits == 0 && r == '0' {
+				wellFormed = false
+			}
+			digits++
+		case i == 0 && r == '+':
+		case unicode.IsDigit(r):
 			digits++
+			wellFormed = false
+		default:
+			wellFormed = false
 		}
 	}
+
 	if digits > MaxPhoneNumberLength {
 		return ErrTooLongPhoneNumber
 	}
 	if digits < 3 { // maintain existing lower bound policy
 		return ErrTooShortPhoneNumber
 	}
-
-	// Check for invalid characters (should only contain digits and optionally start with +)
-	for i, r := range phoneNumber {
-		if i == 0 && r == '+' {
-			continue
-		}
-		if !unicode.IsDigit(r) {
-			return ErrInvalidPhoneNumberChars
-		}
-	}
-
-	// Use regex for final validation
-	if !phoneNumberRegex.MatchString(phoneNumber) {
+	if !wellFormed {
 		return ErrInvalidPhoneNumberChars
 	}
 
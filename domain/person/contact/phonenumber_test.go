@@ -201,3 +201,143 @@ func (s *PhoneNumberTestSuite) TestReconstitute() {
 	s.Equal("+1234567890", phoneNumber.Value())
 	s.Equal("+1234567890", phoneNumber.String())
 }
+
+func (s *PhoneNumberTestSuite) TestItCanParseExtensions() {
+	testCases := []struct {
+		name              string
+		input             string
+		expectedValue     string
+		expectedExtension string
+		expectedRFC3966   string
+		expectedError     error
+	}{
+		{
+			name:              "ext. marker",
+			input:             "+1 234 567 8900 ext. 123",
+			expectedValue:     "+12345678900",
+			expectedExtension: "123",
+			expectedRFC3966:   "tel:+12345678900;ext=123",
+		},
+		{
+			name:              "RFC 3966 marker",
+			input:             "+12345678900;ext=45",
+			expectedValue:     "+12345678900",
+			expectedExtension: "45",
+			expectedRFC3966:   "tel:+12345678900;ext=45",
+		},
+		{
+			name:              "x marker",
+			input:             "+1 (234) 567-8900 x7",
+			expectedValue:     "+12345678900",
+			expectedExtension: "7",
+			expectedRFC3966:   "tel:+12345678900;ext=7",
+		},
+		{
+			name:              "no extension",
+			input:             "+12345678900",
+			expectedValue:     "+12345678900",
+			expectedExtension: "",
+			expectedRFC3966:   "tel:+12345678900",
+		},
+		{
+			name:          "marker without digits",
+			input:         "+12345678900 ext.",
+			expectedError: ErrInvalidPhoneExtension,
+		},
+		{
+			name:          "extension with letters",
+			input:         "+12345678900 ext. 12a",
+			expectedError: ErrInvalidPhoneExtension,
+		},
+		{
+			name:          "extension too long",
+			input:         "+12345678900 ext. 12345678901",
+			expectedError: ErrInvalidPhoneExtension,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				phoneNumber, err := NewPhoneNumber(tc.input)
+				if tc.expectedError != nil {
+					s.ErrorIs(err, tc.expectedError)
+					return
+				}
+				s.NoError(err)
+				s.Equal(tc.expectedValue, phoneNumber.Value())
+				s.Equal(tc.expectedExtension, phoneNumber.Extension())
+				s.Equal(tc.expectedRFC3966, phoneNumber.RFC3966())
+			},
+		)
+	}
+}
+
+func (s *PhoneNumberTestSuite) TestItFindsExtensionMarkersWithoutLowercasingTheInput() {
+	for _, input := range []string{
+		"ȺȺȺȺȺȺȺȺx",
+		"a';base64,' *||\xff#",
+		"İİİİ+12345678900 x12",
+	} {
+		s.NotPanics(
+			func() {
+				_, err := NewPhoneNumber(input)
+				s.Error(err, "%q", input)
+			},
+		)
+	}
+
+	phoneNumber, err := NewPhoneNumber("+12345678900 EXT. 12")
+	s.Require().NoError(err)
+	s.Equal("+12345678900", phoneNumber.Value())
+	s.Equal("12", phoneNumber.Extension())
+}
+
+func (s *PhoneNumberTestSuite) TestExtensionAffectsEqualityAndString() {
+	withExtension, _ := NewPhoneNumber("+12345678900 ext 123")
+	withoutExtension, _ := NewPhoneNumber("+12345678900")
+
+	s.False(withExtension.Equals(withoutExtension))
+	s.True(withExtension.Equals(ReconstitutePhoneNumberWithExtension("+12345678900", "123")))
+	s.Equal("+12345678900 ext. 123", withExtension.String())
+}
+
+func BenchmarkNewPhoneNumber(b *testing.B) {
+	inputs := []string{"+1 (234) 567-8900", "+44 20 7946 0958 ext. 42"}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, input := range inputs {
+			if _, err := NewPhoneNumber(input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func (s *PhoneNumberTestSuite) TestItInfersTheCountryFromTheCallingCode() {
+	testCases := []struct {
+		input    string
+		expected string
+		found    bool
+	}{
+		{"+40 721 234 567", "RO", true},
+		{"+1 (416) 555-0100", "CA", true},
+		{"+1 212 555 0100", "US", true},
+		{"+44 20 7946 0958", "GB", true},
+		{"721 234 567", "", false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.input, func() {
+				phone, err := NewPhoneNumber(tc.input)
+				s.Require().NoError(err)
+
+				country, found := phone.CountryCode()
+				s.Equal(tc.found, found)
+				s.Equal(tc.expected, country.Value())
+			},
+		)
+	}
+}
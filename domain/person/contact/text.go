@@ -0,0 +1,19 @@
+package contact
+
+// Phone numbers are read from text in any format NewPhoneNumber accepts, including an
+// extension. Empty text is treated as a missing value.
+
+// UnmarshalText parses the phone number from text, with validation
+func (p *PhoneNumber) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewPhoneNumber(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
@@ -0,0 +1,26 @@
+package contact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TextTestSuite struct {
+	suite.Suite
+}
+
+func TestTextSuite(t *testing.T) {
+	suite.Run(t, new(TextTestSuite))
+}
+
+func (s *TextTestSuite) TestItCanParsePhoneNumbersFromText() {
+	var phone PhoneNumber
+	s.Require().NoError(phone.UnmarshalText([]byte("+1 234 567 8900 ext. 12")))
+	s.Equal("+12345678900", phone.Value())
+	s.Equal("12", phone.Extension())
+
+	s.ErrorIs(phone.UnmarshalText([]byte("12")), ErrTooShortPhoneNumber)
+	s.NoError(phone.UnmarshalText(nil))
+	s.Equal("+12345678900", phone.Value())
+}
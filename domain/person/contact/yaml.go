@@ -0,0 +1,29 @@
+package contact
+
+import "github.com/golibry/go-common-domain/domain"
+
+// MarshalYAML encodes the phone number as a YAML string, including its extension (e.g.,
+// "+12345678900 ext. 123")
+func (p PhoneNumber) MarshalYAML() (any, error) {
+	if p.value == "" {
+		return nil, nil
+	}
+
+	return p.String(), nil
+}
+
+// UnmarshalYAML decodes the phone number from a YAML string, with validation
+func (p *PhoneNumber) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid phone number YAML")
+	}
+
+	parsed, err := NewPhoneNumber(raw)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
@@ -0,0 +1,41 @@
+package contact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLTestSuite struct {
+	suite.Suite
+}
+
+func TestYAMLSuite(t *testing.T) {
+	suite.Run(t, new(YAMLTestSuite))
+}
+
+type supportConfig struct {
+	Hotline PhoneNumber `yaml:"hotline"`
+}
+
+func (s *YAMLTestSuite) TestItCanRoundTripPhoneNumbersThroughYAML() {
+	var config supportConfig
+	s.Require().NoError(yaml.Unmarshal([]byte("hotline: +1 (234) 567-8900 x123"), &config))
+
+	s.Equal("+12345678900", config.Hotline.Value())
+	s.Equal("123", config.Hotline.Extension())
+
+	encoded, err := yaml.Marshal(config)
+	s.Require().NoError(err)
+	s.Equal("hotline: +12345678900 ext. 123\n", string(encoded))
+
+	var decoded supportConfig
+	s.Require().NoError(yaml.Unmarshal(encoded, &decoded))
+	s.True(config.Hotline.Equals(decoded.Hotline))
+}
+
+func (s *YAMLTestSuite) TestItValidatesWhenUnmarshalingYAML() {
+	var config supportConfig
+	s.ErrorIs(yaml.Unmarshal([]byte("hotline: call-me"), &config), ErrInvalidPhoneNumberChars)
+}
@@ -0,0 +1,159 @@
+package person
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sync"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+var (
+	ErrEmptyDriverLicenseNumber = domain.NewLocalizedError(
+		"person.driver_license_number.empty", nil,
+		"driver license number cannot be empty",
+	)
+	ErrInvalidDriverLicenseNumber = domain.NewLocalizedError(
+		"person.driver_license_number.invalid_format", nil,
+		"driver license number has invalid format for its country",
+	)
+	ErrUnsupportedDriverLicenseCountry = domain.NewLocalizedError(
+		"person.driver_license_number.unsupported_country", nil,
+		"driver license country is not supported",
+	)
+)
+
+var (
+	driverLicenseFormatsMu sync.RWMutex
+	// driverLicenseFormats holds the current formats of each country, matched against the
+	// normalized number
+	driverLicenseFormats = map[string]*regexp.Regexp{
+		// Formats vary by state, from four to thirteen letters and digits
+		"US": regexp.MustCompile(`^[A-Z0-9]{4,13}$`),
+		// DVLA: surname, birth date, initials, a digit and two check characters
+		"GB": regexp.MustCompile(`^[A-Z9]{5}\d{6}[A-Z9]{2}\d[A-Z0-9]{2}$`),
+		"DE": regexp.MustCompile(`^[A-Z0-9]{11}$`),
+		// Twelve digits since 2013, "12AB12345" before
+		"FR": regexp.MustCompile(`^(\d{12}|\d{2}[A-Z]{2}\d{5})$`),
+		// The holder's DNI or NIE
+		"ES": regexp.MustCompile(`^[0-9XYZ]\d{7}[A-Z]$`),
+	}
+)
+
+// RegisterDriverLicenseFormat registers (or replaces) the format of a country's driver
+// license numbers. The pattern is matched against the normalized number, upper case and
+// without spaces or hyphens, and should be anchored. It is safe to call concurrently with
+// NewDriverLicenseNumber.
+func RegisterDriverLicenseFormat(country geography.CountryCode, pattern *regexp.Regexp) {
+	driverLicenseFormatsMu.Lock()
+	defer driverLicenseFormatsMu.Unlock()
+	driverLicenseFormats[country.Value()] = pattern
+}
+
+// DriverLicenseNumber is the number of a driver license issued in a country. Its String and
+// JSON representations are masked to avoid leaking the full value.
+type DriverLicenseNumber struct {
+	country geography.CountryCode
+	value   string
+}
+
+// NewDriverLicenseNumber creates a new instance of DriverLicenseNumber with validation and
+// normalization, using the format registered for the country
+func NewDriverLicenseNumber(
+	country geography.CountryCode,
+	value string,
+) (DriverLicenseNumber, error) {
+	normalized := normalizeDocumentNumber(value)
+	if normalized == "" {
+		return DriverLicenseNumber{}, ErrEmptyDriverLicenseNumber
+	}
+
+	driverLicenseFormatsMu.RLock()
+	format, found := driverLicenseFormats[country.Value()]
+	driverLicenseFormatsMu.RUnlock()
+	if !found {
+		return DriverLicenseNumber{}, ErrUnsupportedDriverLicenseCountry
+	}
+	if !format.MatchString(normalized) {
+		return DriverLicenseNumber{}, ErrInvalidDriverLicenseNumber
+	}
+
+	return DriverLicenseNumber{
+		country: country,
+		value:   normalized,
+	}, nil
+}
+
+// ReconstituteDriverLicenseNumber creates a new DriverLicenseNumber instance without
+// validation or normalization
+func ReconstituteDriverLicenseNumber(
+	country geography.CountryCode,
+	value string,
+) DriverLicenseNumber {
+	return DriverLicenseNumber{
+		country: country,
+		value:   value,
+	}
+}
+
+// Country returns the issuing country
+func (d DriverLicenseNumber) Country() geography.CountryCode {
+	return d.country
+}
+
+// Value returns the full, unmasked driver license number
+func (d DriverLicenseNumber) Value() string {
+	return d.value
+}
+
+// Masked returns the driver license number with all but the last DocumentNumberVisibleChars
+// characters replaced by bullets
+func (d DriverLicenseNumber) Masked() string {
+	return maskDocumentNumber(d.value)
+}
+
+// Sensitive marks the driver license number as personal data for domain.Sensitive
+func (d DriverLicenseNumber) Sensitive() {}
+
+// Equals compares two DriverLicenseNumber objects for equality
+func (d DriverLicenseNumber) Equals(other DriverLicenseNumber) bool {
+	return d.country.Equals(other.country) && d.value == other.value
+}
+
+// String returns a masked string representation of the driver license number
+func (d DriverLicenseNumber) String() string {
+	return d.Masked()
+}
+
+// MarshalJSON encodes the driver license number with a masked value, so it never leaves the
+// domain in full through JSON
+func (d DriverLicenseNumber) MarshalJSON() ([]byte, error) {
+	return json.Marshal(documentNumberJSON{Country: d.country.Value(), Value: d.Masked()})
+}
+
+// UnmarshalJSON decodes and validates a driver license number given with its full value
+func (d *DriverLicenseNumber) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw documentNumberJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid driver license number JSON")
+	}
+
+	country, err := geography.NewCountryCode(raw.Country)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewDriverLicenseNumber(country, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
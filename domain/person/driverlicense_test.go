@@ -0,0 +1,115 @@
+package person
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+)
+
+type DriverLicenseNumberTestSuite struct {
+	suite.Suite
+}
+
+func TestDriverLicenseNumberSuite(t *testing.T) {
+	suite.Run(t, new(DriverLicenseNumberTestSuite))
+}
+
+func (s *DriverLicenseNumberTestSuite) TestItCanCreateValidDriverLicenseNumbers() {
+	testCases := []struct {
+		country  string
+		value    string
+		expected string
+	}{
+		{"US", "D123-4567-8901", "D12345678901"},
+		{"GB", "MORGA 657054 SM9IJ", "MORGA657054SM9IJ"},
+		{"DE", "B072RRE2I55", "B072RRE2I55"},
+		{"FR", "123456789012", "123456789012"},
+		{"FR", "12ab12345", "12AB12345"},
+		{"ES", "12345678z", "12345678Z"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.country+" "+tc.value, func() {
+				country := geography.ReconstituteCountryCode(tc.country)
+				license, err := NewDriverLicenseNumber(country, tc.value)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, license.Value())
+				s.True(country.Equals(license.Country()))
+			},
+		)
+	}
+}
+
+func (s *DriverLicenseNumberTestSuite) TestItFailsWithInvalidDriverLicenseNumbers() {
+	testCases := []struct {
+		country string
+		value   string
+		err     error
+	}{
+		{"US", "", ErrEmptyDriverLicenseNumber},
+		{"US", "D12", ErrInvalidDriverLicenseNumber},
+		{"GB", "MORGA657054SM9I", ErrInvalidDriverLicenseNumber},
+		{"ES", "X1234567", ErrInvalidDriverLicenseNumber},
+		{"JP", "123456789012", ErrUnsupportedDriverLicenseCountry},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.country+" "+tc.value, func() {
+				_, err := NewDriverLicenseNumber(
+					geography.ReconstituteCountryCode(tc.country), tc.value,
+				)
+				s.True(errors.Is(err, tc.err), "got %v", err)
+			},
+		)
+	}
+}
+
+func (s *DriverLicenseNumberTestSuite) TestItCanRegisterFormats() {
+	jp := geography.ReconstituteCountryCode("JP")
+	RegisterDriverLicenseFormat(jp, regexp.MustCompile(`^\d{12}$`))
+	defer func() {
+		driverLicenseFormatsMu.Lock()
+		delete(driverLicenseFormats, "JP")
+		driverLicenseFormatsMu.Unlock()
+	}()
+
+	_, err := NewDriverLicenseNumber(jp, "1234 5678 9012")
+	s.NoError(err)
+}
+
+func (s *DriverLicenseNumberTestSuite) TestItMasksTheValueWhenMarshalingJSON() {
+	license := ReconstituteDriverLicenseNumber(
+		geography.ReconstituteCountryCode("DE"), "B072RRE2I55",
+	)
+	s.Equal("••••••••I55", license.String())
+
+	data, err := json.Marshal(license)
+	s.Require().NoError(err)
+	s.JSONEq(`{"country":"DE","value":"••••••••I55"}`, string(data))
+}
+
+func (s *DriverLicenseNumberTestSuite) TestItCanUnmarshalJSON() {
+	var license DriverLicenseNumber
+	s.Require().NoError(json.Unmarshal([]byte(`{"country":"ES","value":"12345678Z"}`), &license))
+	s.Equal("12345678Z", license.Value())
+
+	s.Require().NoError(json.Unmarshal([]byte(`null`), &license))
+	s.Equal("12345678Z", license.Value())
+
+	err := json.Unmarshal([]byte(`{"country":"ES","value":"1234"}`), &license)
+	s.True(errors.Is(err, ErrInvalidDriverLicenseNumber))
+}
+
+func (s *DriverLicenseNumberTestSuite) TestItCanCompareDriverLicenseNumbers() {
+	es := geography.ReconstituteCountryCode("ES")
+	license := ReconstituteDriverLicenseNumber(es, "12345678Z")
+
+	s.True(license.Equals(ReconstituteDriverLicenseNumber(es, "12345678Z")))
+	s.False(license.Equals(ReconstituteDriverLicenseNumber(es, "12345679Z")))
+}
@@ -0,0 +1,86 @@
+package person
+
+import "github.com/golibry/go-common-domain/domain"
+
+var ErrInvalidEmploymentStatus = domain.NewLocalizedError(
+	"person.employment_status.invalid", nil,
+	"employment status is not recognized",
+)
+
+// EmploymentStatus is the occupation situation of a person, as asked in credit and KYC
+// forms. The zero value is unspecified.
+type EmploymentStatus int
+
+const (
+	EmploymentStatusUnspecified EmploymentStatus = iota
+	EmploymentStatusEmployed
+	EmploymentStatusSelfEmployed
+	EmploymentStatusUnemployed
+	EmploymentStatusStudent
+	EmploymentStatusRetired
+	// EmploymentStatusHomemaker is a person looking after the home without pay
+	EmploymentStatusHomemaker
+)
+
+var employmentStatusNames = enumNames[EmploymentStatus]{
+	EmploymentStatusEmployed:     "employed",
+	EmploymentStatusSelfEmployed: "self-employed",
+	EmploymentStatusUnemployed:   "unemployed",
+	EmploymentStatusStudent:      "student",
+	EmploymentStatusRetired:      "retired",
+	EmploymentStatusHomemaker:    "homemaker",
+}
+
+// NewEmploymentStatus parses a employment status name, e.g., "employed" or "self-employed". Case
+// is ignored, and spaces or underscores are accepted in place of hyphens.
+func NewEmploymentStatus(value string) (EmploymentStatus, error) {
+	return employmentStatusNames.parse(value, ErrInvalidEmploymentStatus)
+}
+
+// EmploymentStatusValues returns every employment status but the unspecified one
+func EmploymentStatusValues() []EmploymentStatus {
+	return employmentStatusNames.values()
+}
+
+// Equals compares two EmploymentStatus values for equality
+func (e EmploymentStatus) Equals(other EmploymentStatus) bool {
+	return e == other
+}
+
+// String returns the name of the employment status, or an empty string when unspecified
+func (e EmploymentStatus) String() string {
+	return employmentStatusNames.name(e)
+}
+
+// MarshalJSON encodes the employment status by name, or as null when unspecified
+func (e EmploymentStatus) MarshalJSON() ([]byte, error) {
+	return employmentStatusNames.marshalJSON(e)
+}
+
+// UnmarshalJSON decodes the employment status from its name, with validation
+func (e *EmploymentStatus) UnmarshalJSON(data []byte) error {
+	parsed, err := employmentStatusNames.unmarshalJSON(
+		data, "employment status", ErrInvalidEmploymentStatus,
+	)
+	if err != nil {
+		return err
+	}
+
+	*e = parsed
+	return nil
+}
+
+// UnmarshalText parses the employment status from text, with validation
+func (e *EmploymentStatus) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewEmploymentStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*e = parsed
+	return nil
+}
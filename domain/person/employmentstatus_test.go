@@ -0,0 +1,59 @@
+package person
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EmploymentStatusTestSuite struct {
+	suite.Suite
+}
+
+func TestEmploymentStatusSuite(t *testing.T) {
+	suite.Run(t, new(EmploymentStatusTestSuite))
+}
+
+func (s *EmploymentStatusTestSuite) TestItCanParseEveryValueFromItsName() {
+	for _, status := range EmploymentStatusValues() {
+		parsed, err := NewEmploymentStatus(status.String())
+		s.Require().NoError(err)
+		s.True(status.Equals(parsed))
+	}
+	s.Equal(
+		[]EmploymentStatus{
+			EmploymentStatusEmployed, EmploymentStatusSelfEmployed, EmploymentStatusUnemployed,
+			EmploymentStatusStudent, EmploymentStatusRetired, EmploymentStatusHomemaker,
+		},
+		EmploymentStatusValues(),
+	)
+}
+
+func (s *EmploymentStatusTestSuite) TestItFailsOnUnknownNames() {
+	_, err := NewEmploymentStatus("freelancer")
+	s.True(errors.Is(err, ErrInvalidEmploymentStatus))
+}
+
+func (s *EmploymentStatusTestSuite) TestItCanRoundTripThroughJSON() {
+	data, err := json.Marshal(EmploymentStatusSelfEmployed)
+	s.Require().NoError(err)
+	s.Equal(`"self-employed"`, string(data))
+
+	var decoded EmploymentStatus
+	s.Require().NoError(json.Unmarshal([]byte(`"Self Employed"`), &decoded))
+	s.Equal(EmploymentStatusSelfEmployed, decoded)
+
+	err = json.Unmarshal([]byte(`"freelancer"`), &decoded)
+	s.True(errors.Is(err, ErrInvalidEmploymentStatus))
+}
+
+func (s *EmploymentStatusTestSuite) TestItCanParseText() {
+	var status EmploymentStatus
+	s.Require().NoError(status.UnmarshalText([]byte("retired")))
+	s.Equal(EmploymentStatusRetired, status)
+
+	s.Require().NoError(status.UnmarshalText(nil))
+	s.Equal(EmploymentStatusRetired, status)
+}
@@ -0,0 +1,66 @@
+package person
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// enumNames lists the names of the members of an enum value object, indexed by member. Index
+// zero is the unspecified member, which has no name and cannot be parsed.
+type enumNames[T ~int] []string
+
+// parse returns the member named value, accepting any case and spaces or underscores in
+// place of hyphens, or invalid when no member has the name
+func (n enumNames[T]) parse(value string, invalid error) (T, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	normalized = strings.NewReplacer(" ", "-", "_", "-").Replace(normalized)
+	for member, name := range n {
+		if member > 0 && name == normalized {
+			return T(member), nil
+		}
+	}
+	return 0, invalid
+}
+
+// name returns the name of the member, or an empty string for the unspecified member and
+// unknown values
+func (n enumNames[T]) name(member T) string {
+	if member <= 0 || int(member) >= len(n) {
+		return ""
+	}
+	return n[member]
+}
+
+// values returns every member but the unspecified one, in declaration order
+func (n enumNames[T]) values() []T {
+	members := make([]T, 0, len(n)-1)
+	for member := 1; member < len(n); member++ {
+		members = append(members, T(member))
+	}
+	return members
+}
+
+// marshalJSON encodes the member as its name, or null for the unspecified member
+func (n enumNames[T]) marshalJSON(member T) ([]byte, error) {
+	name := n.name(member)
+	if name == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(name)
+}
+
+// unmarshalJSON decodes the member from its name, or the unspecified member from null
+func (n enumNames[T]) unmarshalJSON(data []byte, kind string, invalid error) (T, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return 0, nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return 0, domain.NewErrorWithWrap(err, "invalid %s JSON", kind)
+	}
+	return n.parse(raw, invalid)
+}
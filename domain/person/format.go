@@ -0,0 +1,139 @@
+package person
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NameFormat selects how FullName.Format renders a name
+type NameFormat int
+
+const (
+	// NameFormatFull renders "Dr. John William Doe Jr.", the same as String()
+	NameFormatFull NameFormat = iota
+	// NameFormatFirstLast renders "John Doe"
+	NameFormatFirstLast
+	// NameFormatLastFirst renders "Doe, John William" (followed by ", Jr." when a suffix exists)
+	NameFormatLastFirst
+	// NameFormatEastern renders the family name first without a comma: "Doe John William"
+	NameFormatEastern
+	// NameFormatFirstInitialLast renders "John W. Doe"
+	NameFormatFirstInitialLast
+	// NameFormatInitialsLast renders "J. W. Doe"
+	NameFormatInitialsLast
+)
+
+// Format returns the full name rendered in the given format.
+// Unknown formats fall back to NameFormatFull.
+func (f FullName) Format(format NameFormat) string {
+	switch format {
+	case NameFormatFirstLast:
+		return joinNonEmpty(f.firstName, f.lastName)
+	case NameFormatLastFirst:
+		formatted := f.lastName + ", " + joinNonEmpty(f.firstName, f.middleName)
+		if f.suffix != "" {
+			formatted += ", " + f.suffix
+		}
+		return formatted
+	case NameFormatEastern:
+		return joinNonEmpty(f.lastName, f.firstName, f.middleName)
+	case NameFormatFirstInitialLast:
+		return joinNonEmpty(f.firstName, initialOf(f.middleName), f.lastName)
+	case NameFormatInitialsLast:
+		return joinNonEmpty(initialOf(f.firstName), initialOf(f.middleName), f.lastName)
+	default:
+		return f.String()
+	}
+}
+
+// SortKey returns a key for ordering names by family name, then first and middle name.
+// The key is case- and accent-insensitive, so plain string comparison of keys yields a
+// locale-neutral alphabetical order. Use CollationKey for language-specific ordering.
+func (f FullName) SortKey() string {
+	return strings.Join(
+		[]string{foldForSort(f.lastName), foldForSort(f.firstName), foldForSort(f.middleName)},
+		"\x00",
+	)
+}
+
+// CollationKey returns a binary key for ordering names by family name, then first and middle
+// name, following the collation rules of the given language (e.g., "ch" sorting after "h" in
+// Czech). Keys are compared with bytes.Compare.
+func (f FullName) CollationKey(locale language.Tag) []byte {
+	collator := collate.New(locale, collate.IgnoreCase)
+	var buf collate.Buffer
+	key := collator.KeyFromString(&buf, f.lastName)
+	key = append(key, 0)
+	key = append(key, collator.KeyFromString(&buf, f.firstName)...)
+	key = append(key, 0)
+	key = append(key, collator.KeyFromString(&buf, f.middleName)...)
+	return append([]byte(nil), key...)
+}
+
+// Initials returns the uppercased initials of the first, middle and last names, e.g., "JWD".
+// Hyphenated parts contribute one initial per segment ("Jean-Claude" gives "JC") and
+// combining marks are kept with their base letter ("Émile" gives "É").
+func (f FullName) Initials() string {
+	var result strings.Builder
+	for _, namePart := range []string{f.firstName, f.middleName, f.lastName} {
+		for _, segment := range strings.Split(namePart, "-") {
+			result.WriteString(firstLetterOf(segment))
+		}
+	}
+	return result.String()
+}
+
+// Monogram returns the two-letter avatar placeholder made of the first letters of the first
+// and last names, e.g., "JD" for "John William Doe" and "ŁW" for "Łukasz Wiśniewski"
+func (f FullName) Monogram() string {
+	return firstLetterOf(f.firstName) + firstLetterOf(f.lastName)
+}
+
+// initialOf returns the uppercased first letter of a name part followed by a period,
+// or an empty string for an empty part
+func initialOf(namePart string) string {
+	letter := firstLetterOf(namePart)
+	if letter == "" {
+		return ""
+	}
+	return letter + "."
+}
+
+// firstLetterOf returns the uppercased first letter of a name part in NFC form, together
+// with any combining marks that follow it, or an empty string if the part has no letter
+func firstLetterOf(namePart string) string {
+	namePart = norm.NFC.String(namePart)
+	start := strings.IndexFunc(namePart, unicode.IsLetter)
+	if start < 0 {
+		return ""
+	}
+
+	_, size := utf8.DecodeRuneInString(namePart[start:])
+	end := start + size
+	for end < len(namePart) {
+		r, size := utf8.DecodeRuneInString(namePart[end:])
+		if !unicode.Is(unicode.Mn, r) {
+			break
+		}
+		end += size
+	}
+
+	return norm.NFC.String(strings.ToUpper(namePart[start:end]))
+}
+
+// foldForSort lowercases a name part and removes its diacritics
+func foldForSort(namePart string) string {
+	stripMarks := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(stripMarks, namePart)
+	if err != nil {
+		folded = namePart
+	}
+	return strings.ToLower(folded)
+}
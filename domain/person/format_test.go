@@ -0,0 +1,108 @@
+package person
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/text/language"
+)
+
+type FormatTestSuite struct {
+	suite.Suite
+}
+
+func TestFormatSuite(t *testing.T) {
+	suite.Run(t, new(FormatTestSuite))
+}
+
+func (s *FormatTestSuite) TestItCanFormatNamesInEveryStyle() {
+	full, _ := NewFullNameWithAffixes("Dr.", "John", "William", "Doe", "Jr.")
+	noMiddle, _ := NewFullName("Jane", "", "Roe")
+
+	testCases := []struct {
+		name             string
+		format           NameFormat
+		expectedFull     string
+		expectedNoMiddle string
+	}{
+		{"full", NameFormatFull, "Dr. John William Doe Jr.", "Jane Roe"},
+		{"first last", NameFormatFirstLast, "John Doe", "Jane Roe"},
+		{"last first", NameFormatLastFirst, "Doe, John William, Jr.", "Roe, Jane"},
+		{"eastern", NameFormatEastern, "Doe John William", "Roe Jane"},
+		{"first initial last", NameFormatFirstInitialLast, "John W. Doe", "Jane Roe"},
+		{"initials last", NameFormatInitialsLast, "J. W. Doe", "J. Roe"},
+		{"unknown falls back to full", NameFormat(99), "Dr. John William Doe Jr.", "Jane Roe"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				s.Equal(tc.expectedFull, full.Format(tc.format))
+				s.Equal(tc.expectedNoMiddle, noMiddle.Format(tc.format))
+			},
+		)
+	}
+}
+
+func (s *FormatTestSuite) TestItCanSortByFamilyNameIgnoringCaseAndAccents() {
+	names := []FullName{
+		ReconstituteFullName("Zoe", "", "Émile"),
+		ReconstituteFullName("Adam", "", "emile"),
+		ReconstituteFullName("Bob", "", "Durand"),
+	}
+
+	sort.Slice(
+		names, func(i, j int) bool {
+			return names[i].SortKey() < names[j].SortKey()
+		},
+	)
+
+	s.Equal("Durand", names[0].LastName())
+	s.Equal("Adam", names[1].FirstName())
+	s.Equal("Zoe", names[2].FirstName())
+}
+
+func (s *FormatTestSuite) TestItCanCollateByLocale() {
+	hruska := ReconstituteFullName("Jan", "", "Hruška")
+	chalupa := ReconstituteFullName("Jan", "", "Chalupa")
+
+	// In Czech, "ch" is a separate letter sorted after "h"
+	s.Equal(
+		1,
+		bytes.Compare(chalupa.CollationKey(language.Czech), hruska.CollationKey(language.Czech)),
+	)
+	s.Equal(
+		-1,
+		bytes.Compare(
+			chalupa.CollationKey(language.English),
+			hruska.CollationKey(language.English),
+		),
+	)
+}
+
+func (s *FormatTestSuite) TestItCanBuildInitialsAndMonograms() {
+	testCases := []struct {
+		name             string
+		fullName         FullName
+		expectedInitials string
+		expectedMonogram string
+	}{
+		{"simple name", ReconstituteFullName("john", "William", "Doe"), "JWD", "JD"},
+		{"polish letters", ReconstituteFullName("Łukasz", "", "Wiśniewski"), "ŁW", "ŁW"},
+		{"hyphenated first name", ReconstituteFullName("Jean-Claude", "", "Van Damme"), "JCV", "JV"},
+		{"combining mark", ReconstituteFullName("E\u0301mile", "", "Zola"), "ÉZ", "ÉZ"},
+		{"middle initial", ReconstituteFullName("John", "F.", "Kennedy"), "JFK", "JK"},
+		{"leading apostrophe", ReconstituteFullName("Ana", "", "'Ewa"), "AE", "AE"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				s.Equal(tc.expectedInitials, tc.fullName.Initials())
+				s.Equal(tc.expectedMonogram, tc.fullName.Monogram())
+			},
+		)
+	}
+}
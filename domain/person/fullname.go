@@ -1,6 +1,8 @@
 package person
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"unicode"
@@ -9,18 +11,60 @@ import (
 	"github.com/golibry/go-common-domain/domain"
 )
 
-const MaxNamePartLength = 128
+const (
+	MaxNamePartLength  = 128
+	MaxNameAffixLength = 32
+)
 
 var (
-	ErrEmptyNamePart        = domain.NewError("name part cannot be empty")
-	ErrInvalidNamePartChars = domain.NewError("name part contains invalid characters; allowed: letters (Unicode), spaces, hyphens (-), apostrophes ('), and periods (.). Name parts cannot start or end with a hyphen, apostrophe, or period.")
-	ErrTooLongNamePart      = domain.NewError("name part is too long")
+	ErrEmptyNamePart = domain.NewLocalizedError(
+		"person.name.part_empty", nil,
+		"name part cannot be empty",
+	)
+	ErrInvalidNamePartChars = domain.NewLocalizedError(
+		"person.name.part_invalid_chars", nil,
+		"name part contains invalid characters; allowed: letters (Unicode), spaces, hyphens (-), apostrophes ('), and periods (.). Name parts cannot start or end with a hyphen, apostrophe, or period.",
+	)
+	ErrTooLongNamePart = domain.NewLocalizedError(
+		"person.name.part_too_long", nil,
+		"name part is too long",
+	)
+	ErrInvalidNameAffix = domain.NewLocalizedError(
+		"person.name.affix_invalid", nil,
+		"name prefix or suffix contains invalid characters; allowed: letters (Unicode), digits, spaces, hyphens (-), apostrophes ('), and periods (.). It must start with a letter or digit.",
+	)
+	ErrTooLongNameAffix = domain.NewLocalizedError(
+		"person.name.affix_too_long", nil,
+		"name prefix or suffix is too long",
+	)
 )
 
+// FullNameValidators holds extra rules run by NewFullName and NewFullNameWithAffixes
+var FullNameValidators domain.Validators[FullName]
+
+// NameOption tunes the limits enforced by NewFullNameWithOptions
+type NameOption func(*nameOptions)
+
+type nameOptions struct {
+	maxPartLength int
+}
+
+// WithMaxPartLength overrides MaxNamePartLength for the first, middle and last names.
+// Values below one keep the default.
+func WithMaxPartLength(maxPartLength int) NameOption {
+	return func(o *nameOptions) {
+		if maxPartLength > 0 {
+			o.maxPartLength = maxPartLength
+		}
+	}
+}
+
 type FullName struct {
+	prefix     string
 	firstName  string
 	middleName string
 	lastName   string
+	suffix     string
 }
 
 // NewFullName creates a new instance of FullName.
@@ -29,14 +73,43 @@ type FullName struct {
 // Name parts cannot start or end with a hyphen, apostrophe, or period.
 // The middle name can be empty or a single-letter initial followed by a period (e.g., "F.").
 func NewFullName(firstName, middleName, lastName string) (FullName, error) {
+	return NewFullNameWithOptions(firstName, middleName, lastName)
+}
+
+// NewFullNameWithOptions creates a new instance of FullName like NewFullName, with limits
+// tuned by options such as WithMaxPartLength. Decoders (JSON, binary and YAML) validate with
+// the default limits; load names accepted with a longer limit with ReconstituteFullName.
+func NewFullNameWithOptions(
+	firstName, middleName, lastName string,
+	opts ...NameOption,
+) (FullName, error) {
+	fullName, err := newFullName(firstName, middleName, lastName, opts)
+	if err != nil {
+		return FullName{}, err
+	}
+
+	if err := FullNameValidators.Validate(fullName); err != nil {
+		return FullName{}, err
+	}
+
+	return fullName, nil
+}
+
+// newFullName normalizes and validates the name parts, without running FullNameValidators
+func newFullName(firstName, middleName, lastName string, opts []NameOption) (FullName, error) {
+	config := nameOptions{maxPartLength: MaxNamePartLength}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
 	normalizedFirst, _ := NormalizeNamePart(firstName)
-	if err := IsValidNamePart(normalizedFirst); err != nil {
+	if err := isValidNamePart(normalizedFirst, config.maxPartLength); err != nil {
 		return FullName{}, fmt.Errorf("%w (first name)", err)
 	}
 
 	normalizedMiddle, _ := NormalizeNamePart(middleName)
 	if normalizedMiddle != "" {
-		if err := IsValidNamePart(normalizedMiddle); err != nil {
+		if err := isValidNamePart(normalizedMiddle, config.maxPartLength); err != nil {
 			if !isInitialWithPeriod(normalizedMiddle) {
 				return FullName{}, fmt.Errorf("%w (middle name)", err)
 			}
@@ -44,7 +117,7 @@ func NewFullName(firstName, middleName, lastName string) (FullName, error) {
 	}
 
 	normalizedLast, _ := NormalizeNamePart(lastName)
-	if err := IsValidNamePart(normalizedLast); err != nil {
+	if err := isValidNamePart(normalizedLast, config.maxPartLength); err != nil {
 		return FullName{}, fmt.Errorf("%w (last name)", err)
 	}
 
@@ -55,6 +128,40 @@ func NewFullName(firstName, middleName, lastName string) (FullName, error) {
 	}, nil
 }
 
+// NewFullNameWithAffixes creates a new instance of FullName with an honorific prefix
+// (e.g., "Dr.") and a suffix (e.g., "Jr." or "III"), both of which may be empty.
+// The name parts follow the same rules as NewFullName.
+func NewFullNameWithAffixes(
+	prefix, firstName, middleName, lastName, suffix string,
+) (FullName, error) {
+	fullName, err := newFullName(firstName, middleName, lastName, nil)
+	if err != nil {
+		return FullName{}, err
+	}
+
+	normalizedPrefix, _ := NormalizeNameAffix(prefix)
+	if normalizedPrefix != "" {
+		if err := IsValidNameAffix(normalizedPrefix); err != nil {
+			return FullName{}, fmt.Errorf("%w (prefix)", err)
+		}
+	}
+
+	normalizedSuffix, _ := NormalizeNameAffix(suffix)
+	if normalizedSuffix != "" {
+		if err := IsValidNameAffix(normalizedSuffix); err != nil {
+			return FullName{}, fmt.Errorf("%w (suffix)", err)
+		}
+	}
+
+	fullName.prefix = normalizedPrefix
+	fullName.suffix = normalizedSuffix
+	if err := FullNameValidators.Validate(fullName); err != nil {
+		return FullName{}, err
+	}
+
+	return fullName, nil
+}
+
 // ReconstituteFullName creates a new FullName instance without validation or normalization
 func ReconstituteFullName(firstName, middleName, lastName string) FullName {
 	return FullName{
@@ -64,6 +171,25 @@ func ReconstituteFullName(firstName, middleName, lastName string) FullName {
 	}
 }
 
+// ReconstituteFullNameWithAffixes creates a new FullName instance, including prefix and suffix,
+// without validation or normalization
+func ReconstituteFullNameWithAffixes(
+	prefix, firstName, middleName, lastName, suffix string,
+) FullName {
+	return FullName{
+		prefix:     prefix,
+		firstName:  firstName,
+		middleName: middleName,
+		lastName:   lastName,
+		suffix:     suffix,
+	}
+}
+
+// Prefix returns the honorific prefix (e.g., "Dr."), or an empty string
+func (f FullName) Prefix() string {
+	return f.prefix
+}
+
 // FirstName returns the first name
 func (f FullName) FirstName() string {
 	return f.firstName
@@ -79,19 +205,82 @@ func (f FullName) LastName() string {
 	return f.lastName
 }
 
+// Suffix returns the suffix (e.g., "Jr." or "III"), or an empty string
+func (f FullName) Suffix() string {
+	return f.suffix
+}
+
 // Equals compares two FullName objects for equality
 func (f FullName) Equals(other FullName) bool {
-	return f.firstName == other.firstName &&
+	return f.prefix == other.prefix &&
+		f.firstName == other.firstName &&
 		f.middleName == other.middleName &&
-		f.lastName == other.lastName
+		f.lastName == other.lastName &&
+		f.suffix == other.suffix
 }
 
-// String returns a string representation of the full name
+// String returns a string representation of the full name, including prefix and suffix
 func (f FullName) String() string {
-	if f.middleName == "" {
-		return fmt.Sprintf("%s %s", f.firstName, f.lastName)
+	return joinNonEmpty(f.prefix, f.firstName, f.middleName, f.lastName, f.suffix)
+}
+
+// Masked returns the initials of the first, middle and last names, e.g., "J. F. K.", for logs
+// and traces
+func (f FullName) Masked() string {
+	var initials []string
+	for _, part := range []string{f.firstName, f.middleName, f.lastName} {
+		if first, _ := utf8.DecodeRuneInString(part); part != "" {
+			initials = append(initials, string(first)+".")
+		}
 	}
-	return fmt.Sprintf("%s %s %s", f.firstName, f.middleName, f.lastName)
+	return strings.Join(initials, " ")
+}
+
+// Sensitive marks the full name as personal data for domain.Sensitive
+func (f FullName) Sensitive() {}
+
+// fullNameJSON is the JSON representation of FullName
+type fullNameJSON struct {
+	Prefix     string `json:"prefix,omitempty"`
+	FirstName  string `json:"firstName"`
+	MiddleName string `json:"middleName,omitempty"`
+	LastName   string `json:"lastName"`
+	Suffix     string `json:"suffix,omitempty"`
+}
+
+// MarshalJSON encodes the full name as a JSON object with one field per part
+func (f FullName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		fullNameJSON{
+			Prefix:     f.prefix,
+			FirstName:  f.firstName,
+			MiddleName: f.middleName,
+			LastName:   f.lastName,
+			Suffix:     f.suffix,
+		},
+	)
+}
+
+// UnmarshalJSON decodes the full name from a JSON object, validating every part
+func (f *FullName) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw fullNameJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid full name JSON")
+	}
+
+	parsed, err := NewFullNameWithAffixes(
+		raw.Prefix, raw.FirstName, raw.MiddleName, raw.LastName, raw.Suffix,
+	)
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+	return nil
 }
 
 func NormalizeNamePart(namePart string) (string, error) {
@@ -119,11 +308,16 @@ func NormalizeNamePart(namePart string) (string, error) {
 }
 
 func IsValidNamePart(namePart string) error {
+	return isValidNamePart(namePart, MaxNamePartLength)
+}
+
+// isValidNamePart validates a name part, allowing up to maxLength characters
+func isValidNamePart(namePart string, maxLength int) error {
 	if namePart == "" {
 		return ErrEmptyNamePart
 	}
 
-	if utf8.RuneCountInString(namePart) > MaxNamePartLength {
+	if utf8.RuneCountInString(namePart) > maxLength {
 		return ErrTooLongNamePart
 	}
 
@@ -149,6 +343,53 @@ func IsValidNamePart(namePart string) error {
 	return nil
 }
 
+// NormalizeNameAffix normalizes a name prefix or suffix by trimming and collapsing spaces
+func NormalizeNameAffix(affix string) (string, error) {
+	// Note: Validation is intentionally separated from normalization.
+	// Callers should validate the normalized value via IsValidNameAffix or custom rules.
+	return strings.Join(strings.Fields(affix), " "), nil
+}
+
+// IsValidNameAffix validates a non-empty name prefix or suffix such as "Dr.", "Jr." or "III"
+func IsValidNameAffix(affix string) error {
+	if affix == "" {
+		return ErrEmptyNamePart
+	}
+
+	if utf8.RuneCountInString(affix) > MaxNameAffixLength {
+		return ErrTooLongNameAffix
+	}
+
+	firstRune, _ := utf8.DecodeRuneInString(affix)
+	if !unicode.IsLetter(firstRune) && !unicode.IsDigit(firstRune) {
+		return ErrInvalidNameAffix
+	}
+
+	for _, r := range affix {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) &&
+			r != ' ' && r != '-' && r != '\'' && r != '.' {
+			return ErrInvalidNameAffix
+		}
+	}
+
+	return nil
+}
+
+// joinNonEmpty joins the non-empty parts with a single space
+func joinNonEmpty(parts ...string) string {
+	var result strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if result.Len() > 0 {
+			result.WriteByte(' ')
+		}
+		result.WriteString(part)
+	}
+	return result.String()
+}
+
 // isInitialWithPeriod reports whether the provided string is a single
 // Unicode letter followed by a period, e.g., "F.". This is allowed
 // for the middle name only.
@@ -1,6 +1,7 @@
 package person
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
@@ -182,3 +183,104 @@ func (s *FullNameTestSuite) TestReconstitute() {
 	s.Equal(middleName, fullName.MiddleName())
 	s.Equal(lastName, fullName.LastName())
 }
+
+func (s *FullNameTestSuite) TestItCanBuildFullNameWithAffixes() {
+	testCases := []struct {
+		name           string
+		prefix         string
+		suffix         string
+		expectedString string
+		expectedError  error
+	}{
+		{"Prefix and suffix", " Dr. ", "Jr.", "Dr. John William Doe Jr.", nil},
+		{"Roman numeral suffix", "", "III", "John William Doe III", nil},
+		{"Multi word prefix", "Rt.  Hon.", "", "Rt. Hon. John William Doe", nil},
+		{"Ordinal suffix", "", "2nd", "John William Doe 2nd", nil},
+		{"Prefix starting with period", ".Dr", "", "", ErrInvalidNameAffix},
+		{"Suffix with invalid characters", "", "Jr!", "", ErrInvalidNameAffix},
+		{"Too long prefix", strings.Repeat("A", MaxNameAffixLength+1), "", "", ErrTooLongNameAffix},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				fullName, err := NewFullNameWithAffixes(tc.prefix, "John", "William", "Doe", tc.suffix)
+				if tc.expectedError != nil {
+					s.ErrorIs(err, tc.expectedError)
+					return
+				}
+				s.NoError(err)
+				s.Equal(tc.expectedString, fullName.String())
+			},
+		)
+	}
+}
+
+func (s *FullNameTestSuite) TestItCanMarshalAndUnmarshalJSON() {
+	fullName, _ := NewFullNameWithAffixes("Dr.", "John", "", "Doe", "Jr.")
+
+	encoded, err := json.Marshal(fullName)
+	s.NoError(err)
+	s.JSONEq(
+		`{"prefix":"Dr.","firstName":"John","lastName":"Doe","suffix":"Jr."}`,
+		string(encoded),
+	)
+
+	var decoded FullName
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(fullName.Equals(decoded))
+	s.Equal("Dr.", decoded.Prefix())
+	s.Equal("Jr.", decoded.Suffix())
+
+	err = json.Unmarshal([]byte(`{"firstName":"John1","lastName":"Doe"}`), &decoded)
+	s.ErrorIs(err, ErrInvalidNamePartChars)
+}
+
+func (s *FullNameTestSuite) TestReconstituteWithAffixes() {
+	fullName := ReconstituteFullNameWithAffixes("Dr.", "John", "", "Doe", "PhD")
+	s.Equal("Dr.", fullName.Prefix())
+	s.Equal("PhD", fullName.Suffix())
+	s.False(fullName.Equals(ReconstituteFullName("John", "", "Doe")))
+}
+
+func (s *FullNameTestSuite) TestItRunsRegisteredValidatorsOnTheCompleteName() {
+	errNoPrefix := errors.New("prefix is required")
+	unregister := FullNameValidators.Register(
+		func(fullName FullName) error {
+			if fullName.Prefix() == "" {
+				return errNoPrefix
+			}
+			return nil
+		},
+	)
+	defer unregister()
+
+	_, err := NewFullName("Jane", "", "Doe")
+	s.ErrorIs(err, errNoPrefix)
+
+	_, err = NewFullNameWithAffixes("Dr.", "Jane", "", "Doe", "")
+	s.NoError(err)
+}
+
+func (s *FullNameTestSuite) TestItAppliesTheMaxPartLengthOption() {
+	_, err := NewFullNameWithOptions("Maximiliana", "", "Doe", WithMaxPartLength(8))
+	s.ErrorIs(err, ErrTooLongNamePart)
+	s.ErrorContains(err, "first name")
+
+	longName := strings.Repeat("a", MaxNamePartLength+1)
+	_, err = NewFullName("Jane", "", longName)
+	s.ErrorIs(err, ErrTooLongNamePart)
+
+	fullName, err := NewFullNameWithOptions("Jane", "", longName, WithMaxPartLength(256))
+	s.NoError(err)
+	s.Equal(longName, fullName.LastName())
+}
+
+func (s *FullNameTestSuite) TestItCanMaskTheNameToInitials() {
+	s.Equal("J. F. K.", ReconstituteFullName("John", "F.", "Kennedy").Masked())
+	s.Equal(
+		"Ș. P.",
+		ReconstituteFullNameWithAffixes("Dr.", "Ștefan", "", "Popescu", "Jr.").Masked(),
+	)
+	s.Empty(FullName{}.Masked())
+}
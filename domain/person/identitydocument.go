@@ -0,0 +1,148 @@
+package person
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// DocumentDateLayout is the layout of expiry dates in the JSON form of IdentityDocument
+const DocumentDateLayout = time.DateOnly
+
+var ErrIncompleteIdentityDocument = domain.NewLocalizedError(
+	"person.identity_document.incomplete", nil,
+	"identity document needs a number and an expiry date",
+)
+
+// documentNumber is implemented by the numbers an IdentityDocument can hold
+type documentNumber interface {
+	PassportNumber | DriverLicenseNumber
+}
+
+// IdentityDocument pairs a passport or driver license number with the date the document
+// expires on, for identity verification. Its String and JSON representations mask the number.
+type IdentityDocument[N documentNumber] struct {
+	number    N
+	expiresOn time.Time
+}
+
+// NewIdentityDocument creates a new instance of IdentityDocument with validation. Only the
+// calendar date of expiresOn is kept.
+func NewIdentityDocument[N documentNumber](
+	number N,
+	expiresOn time.Time,
+) (IdentityDocument[N], error) {
+	var zero N
+	if number == zero || expiresOn.IsZero() {
+		return IdentityDocument[N]{}, ErrIncompleteIdentityDocument
+	}
+
+	return IdentityDocument[N]{
+		number:    number,
+		expiresOn: calendarDate(expiresOn),
+	}, nil
+}
+
+// ReconstituteIdentityDocument creates a new IdentityDocument instance without validation
+func ReconstituteIdentityDocument[N documentNumber](
+	number N,
+	expiresOn time.Time,
+) IdentityDocument[N] {
+	return IdentityDocument[N]{
+		number:    number,
+		expiresOn: expiresOn,
+	}
+}
+
+// Number returns the document number
+func (d IdentityDocument[N]) Number() N {
+	return d.number
+}
+
+// ExpiresOn returns the last day the document is valid, at midnight UTC
+func (d IdentityDocument[N]) ExpiresOn() time.Time {
+	return d.expiresOn
+}
+
+// IsExpiredOn reports whether the document is no longer valid on the calendar date of day.
+// A document is valid through its expiry date.
+func (d IdentityDocument[N]) IsExpiredOn(day time.Time) bool {
+	return calendarDate(day).After(d.expiresOn)
+}
+
+// Equals compares two IdentityDocument objects for equality
+func (d IdentityDocument[N]) Equals(other IdentityDocument[N]) bool {
+	return d.number == other.number && d.expiresOn.Equal(other.expiresOn)
+}
+
+// String returns the masked number followed by the expiry date, e.g.,
+// "••••••789 (expires 2030-01-31)"
+func (d IdentityDocument[N]) String() string {
+	return d.maskedNumber() + " (expires " + d.expiresOn.Format(DocumentDateLayout) + ")"
+}
+
+// Sensitive marks the identity document as personal data for domain.Sensitive
+func (d IdentityDocument[N]) Sensitive() {}
+
+// identityDocumentJSON is the JSON representation of IdentityDocument
+type identityDocumentJSON[N documentNumber] struct {
+	Number    N      `json:"number"`
+	ExpiresOn string `json:"expiresOn"`
+}
+
+// MarshalJSON encodes the document with its masked number and its expiry date
+func (d IdentityDocument[N]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(identityDocumentJSON[N]{
+		Number:    d.number,
+		ExpiresOn: d.expiresOn.Format(DocumentDateLayout),
+	})
+}
+
+// UnmarshalJSON decodes and validates a document given with its full number
+func (d *IdentityDocument[N]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw identityDocumentJSON[N]
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid identity document JSON")
+	}
+
+	var expiresOn time.Time
+	if raw.ExpiresOn != "" {
+		parsed, err := time.Parse(DocumentDateLayout, raw.ExpiresOn)
+		if err != nil {
+			return domain.NewErrorWithWrap(err, "invalid identity document expiry date")
+		}
+		expiresOn = parsed
+	}
+
+	parsed, err := NewIdentityDocument(raw.Number, expiresOn)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// maskedNumber returns the masked document number
+func (d IdentityDocument[N]) maskedNumber() string {
+	switch number := any(d.number).(type) {
+	case PassportNumber:
+		return number.Masked()
+	case DriverLicenseNumber:
+		return number.Masked()
+	default:
+		return ""
+	}
+}
+
+// calendarDate returns midnight UTC of the calendar date of t, in t's location
+func calendarDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
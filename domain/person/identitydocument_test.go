@@ -0,0 +1,95 @@
+package person
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+)
+
+type IdentityDocumentTestSuite struct {
+	suite.Suite
+	passport PassportNumber
+}
+
+func TestIdentityDocumentSuite(t *testing.T) {
+	suite.Run(t, new(IdentityDocumentTestSuite))
+}
+
+func (s *IdentityDocumentTestSuite) SetupTest() {
+	s.passport = ReconstitutePassportNumber(geography.ReconstituteCountryCode("GB"), "123456789")
+}
+
+func (s *IdentityDocumentTestSuite) TestItKeepsOnlyTheExpiryDate() {
+	berlin := time.FixedZone("CET", 3600)
+	document, err := NewIdentityDocument(
+		s.passport, time.Date(2030, time.January, 31, 23, 30, 0, 0, berlin),
+	)
+	s.Require().NoError(err)
+
+	s.Equal(time.Date(2030, time.January, 31, 0, 0, 0, 0, time.UTC), document.ExpiresOn())
+	s.True(s.passport.Equals(document.Number()))
+	s.Equal("••••••789 (expires 2030-01-31)", document.String())
+}
+
+func (s *IdentityDocumentTestSuite) TestItIsValidThroughItsExpiryDate() {
+	document, _ := NewIdentityDocument(
+		s.passport, time.Date(2030, time.January, 31, 0, 0, 0, 0, time.UTC),
+	)
+
+	s.False(document.IsExpiredOn(time.Date(2030, time.January, 30, 12, 0, 0, 0, time.UTC)))
+	s.False(document.IsExpiredOn(time.Date(2030, time.January, 31, 23, 59, 0, 0, time.UTC)))
+	s.True(document.IsExpiredOn(time.Date(2030, time.February, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func (s *IdentityDocumentTestSuite) TestItNeedsANumberAndAnExpiryDate() {
+	_, err := NewIdentityDocument(s.passport, time.Time{})
+	s.True(errors.Is(err, ErrIncompleteIdentityDocument))
+
+	_, err = NewIdentityDocument(DriverLicenseNumber{}, time.Now())
+	s.True(errors.Is(err, ErrIncompleteIdentityDocument))
+}
+
+func (s *IdentityDocumentTestSuite) TestItCanRoundTripThroughJSONWithAMaskedNumber() {
+	document, _ := NewIdentityDocument(
+		s.passport, time.Date(2030, time.January, 31, 0, 0, 0, 0, time.UTC),
+	)
+
+	data, err := json.Marshal(document)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{"number":{"country":"GB","value":"••••••789"},"expiresOn":"2030-01-31"}`,
+		string(data),
+	)
+
+	var decoded IdentityDocument[PassportNumber]
+	s.Require().NoError(
+		json.Unmarshal(
+			[]byte(`{"number":{"country":"GB","value":"123456789"},"expiresOn":"2030-01-31"}`),
+			&decoded,
+		),
+	)
+	s.True(document.Equals(decoded))
+}
+
+func (s *IdentityDocumentTestSuite) TestItValidatesWhenUnmarshalingJSON() {
+	var document IdentityDocument[DriverLicenseNumber]
+
+	err := json.Unmarshal([]byte(`{"number":{"country":"ES","value":"12345678Z"}}`), &document)
+	s.True(errors.Is(err, ErrIncompleteIdentityDocument))
+
+	err = json.Unmarshal(
+		[]byte(`{"number":{"country":"ES","value":"12345678Z"},"expiresOn":"31/01/2030"}`),
+		&document,
+	)
+	s.Error(err)
+
+	err = json.Unmarshal(
+		[]byte(`{"number":{"country":"ES","value":"1234"},"expiresOn":"2030-01-31"}`),
+		&document,
+	)
+	s.True(errors.Is(err, ErrInvalidDriverLicenseNumber))
+}
@@ -0,0 +1,83 @@
+package person
+
+import "github.com/golibry/go-common-domain/domain"
+
+var ErrInvalidMaritalStatus = domain.NewLocalizedError(
+	"person.marital_status.invalid", nil,
+	"marital status is not recognized",
+)
+
+// MaritalStatus is the civil status of a person. The zero value is unspecified.
+type MaritalStatus int
+
+const (
+	MaritalStatusUnspecified MaritalStatus = iota
+	MaritalStatusSingle
+	MaritalStatusMarried
+	// MaritalStatusCivilPartnership covers registered partnerships and civil unions
+	MaritalStatusCivilPartnership
+	MaritalStatusSeparated
+	MaritalStatusDivorced
+	MaritalStatusWidowed
+)
+
+var maritalStatusNames = enumNames[MaritalStatus]{
+	MaritalStatusSingle:           "single",
+	MaritalStatusMarried:          "married",
+	MaritalStatusCivilPartnership: "civil-partnership",
+	MaritalStatusSeparated:        "separated",
+	MaritalStatusDivorced:         "divorced",
+	MaritalStatusWidowed:          "widowed",
+}
+
+// NewMaritalStatus parses a marital status name, e.g., "married" or "civil-partnership". Case
+// is ignored, and spaces or underscores are accepted in place of hyphens.
+func NewMaritalStatus(value string) (MaritalStatus, error) {
+	return maritalStatusNames.parse(value, ErrInvalidMaritalStatus)
+}
+
+// MaritalStatusValues returns every marital status but the unspecified one
+func MaritalStatusValues() []MaritalStatus {
+	return maritalStatusNames.values()
+}
+
+// Equals compares two MaritalStatus values for equality
+func (m MaritalStatus) Equals(other MaritalStatus) bool {
+	return m == other
+}
+
+// String returns the name of the marital status, or an empty string when unspecified
+func (m MaritalStatus) String() string {
+	return maritalStatusNames.name(m)
+}
+
+// MarshalJSON encodes the marital status by name, or as null when unspecified
+func (m MaritalStatus) MarshalJSON() ([]byte, error) {
+	return maritalStatusNames.marshalJSON(m)
+}
+
+// UnmarshalJSON decodes the marital status from its name, with validation
+func (m *MaritalStatus) UnmarshalJSON(data []byte) error {
+	parsed, err := maritalStatusNames.unmarshalJSON(data, "marital status", ErrInvalidMaritalStatus)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// UnmarshalText parses the marital status from text, with validation
+func (m *MaritalStatus) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewMaritalStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
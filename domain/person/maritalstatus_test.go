@@ -0,0 +1,79 @@
+package person
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MaritalStatusTestSuite struct {
+	suite.Suite
+}
+
+func TestMaritalStatusSuite(t *testing.T) {
+	suite.Run(t, new(MaritalStatusTestSuite))
+}
+
+func (s *MaritalStatusTestSuite) TestItCanParseEveryValueFromItsName() {
+	for _, status := range MaritalStatusValues() {
+		parsed, err := NewMaritalStatus(status.String())
+		s.Require().NoError(err)
+		s.True(status.Equals(parsed))
+	}
+	s.Len(MaritalStatusValues(), 6)
+}
+
+func (s *MaritalStatusTestSuite) TestItNormalizesNames() {
+	values := []string{"Civil Partnership", " civil_partnership ", "CIVIL-PARTNERSHIP"}
+	for _, value := range values {
+		parsed, err := NewMaritalStatus(value)
+		s.Require().NoError(err, value)
+		s.Equal(MaritalStatusCivilPartnership, parsed)
+	}
+}
+
+func (s *MaritalStatusTestSuite) TestItFailsOnUnknownNames() {
+	for _, value := range []string{"", "engaged", "unspecified"} {
+		_, err := NewMaritalStatus(value)
+		s.True(errors.Is(err, ErrInvalidMaritalStatus), value)
+	}
+}
+
+func (s *MaritalStatusTestSuite) TestItCanRoundTripThroughJSON() {
+	type profile struct {
+		Status MaritalStatus `json:"status"`
+	}
+
+	data, err := json.Marshal(profile{Status: MaritalStatusWidowed})
+	s.Require().NoError(err)
+	s.JSONEq(`{"status":"widowed"}`, string(data))
+
+	var decoded profile
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(MaritalStatusWidowed, decoded.Status)
+
+	data, err = json.Marshal(profile{})
+	s.Require().NoError(err)
+	s.JSONEq(`{"status":null}`, string(data))
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.Equal(MaritalStatusUnspecified, decoded.Status)
+
+	err = json.Unmarshal([]byte(`{"status":"engaged"}`), &decoded)
+	s.True(errors.Is(err, ErrInvalidMaritalStatus))
+	s.Error(json.Unmarshal([]byte(`{"status":1}`), &decoded))
+}
+
+func (s *MaritalStatusTestSuite) TestItCanParseText() {
+	var status MaritalStatus
+	s.Require().NoError(status.UnmarshalText([]byte("divorced")))
+	s.Equal(MaritalStatusDivorced, status)
+	s.Error(status.UnmarshalText([]byte("engaged")))
+}
+
+func (s *MaritalStatusTestSuite) TestItHasNoNameWhenUnspecifiedOrUnknown() {
+	s.Equal("", MaritalStatusUnspecified.String())
+	s.Equal("", MaritalStatus(99).String())
+	s.Equal("married", MaritalStatusMarried.String())
+}
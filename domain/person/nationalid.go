@@ -0,0 +1,296 @@
+package person
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+// NationalIDVisibleChars is the number of trailing characters left visible when masking
+const NationalIDVisibleChars = 4
+
+var (
+	ErrEmptyNationalID = domain.NewLocalizedError(
+		"person.national_id.empty", nil,
+		"national ID cannot be empty",
+	)
+	ErrInvalidNationalID = domain.NewLocalizedError(
+		"person.national_id.invalid_format", nil,
+		"national ID has invalid format",
+	)
+	ErrInvalidNationalIDChecksum = domain.NewLocalizedError(
+		"person.national_id.invalid_checksum", nil,
+		"national ID has an invalid check digit",
+	)
+	ErrUnsupportedNationalIDCountry = domain.NewLocalizedError(
+		"person.national_id.unsupported_country", nil,
+		"national ID country is not supported",
+	)
+)
+
+// NationalIDValidator validates a normalized national ID (uppercase, without spaces,
+// hyphens, dots or slashes) for a single country
+type NationalIDValidator func(normalized string) error
+
+var (
+	nationalIDValidatorsMu sync.RWMutex
+	nationalIDValidators   = map[string]NationalIDValidator{
+		"US": IsValidUSSocialSecurityNumber,
+		"GB": IsValidUKNationalInsuranceNumber,
+		"ES": IsValidSpanishDNI,
+		"RO": IsValidRomanianCNP,
+	}
+)
+
+// RegisterNationalIDValidator registers (or replaces) the validator used for a country.
+// It is safe to call concurrently with NewNationalID.
+func RegisterNationalIDValidator(country geography.CountryCode, validator NationalIDValidator) {
+	nationalIDValidatorsMu.Lock()
+	defer nationalIDValidatorsMu.Unlock()
+	nationalIDValidators[country.Value()] = validator
+}
+
+// NationalID is a government-issued personal identification number, such as a US SSN.
+// Its String and JSON representations are masked to avoid leaking the full value.
+type NationalID struct {
+	country geography.CountryCode
+	value   string
+}
+
+// NewNationalID creates a new instance of NationalID with validation and normalization,
+// using the validator registered for the country
+func NewNationalID(country geography.CountryCode, value string) (NationalID, error) {
+	normalized, err := NormalizeNationalID(value)
+	if err != nil {
+		return NationalID{}, err
+	}
+
+	if err := IsValidNationalID(country, normalized); err != nil {
+		return NationalID{}, err
+	}
+
+	return NationalID{
+		country: country,
+		value:   normalized,
+	}, nil
+}
+
+// ReconstituteNationalID creates a new NationalID instance without validation or normalization
+func ReconstituteNationalID(country geography.CountryCode, value string) NationalID {
+	return NationalID{
+		country: country,
+		value:   value,
+	}
+}
+
+// Country returns the issuing country
+func (n NationalID) Country() geography.CountryCode {
+	return n.country
+}
+
+// Value returns the full, unmasked national ID
+func (n NationalID) Value() string {
+	return n.value
+}
+
+// Masked returns the national ID with all but the last NationalIDVisibleChars characters
+// replaced by bullets, e.g., "•••••6789"
+func (n NationalID) Masked() string {
+	runes := []rune(n.value)
+	visible := min(NationalIDVisibleChars, len(runes)/2)
+	return strings.Repeat("•", len(runes)-visible) + string(runes[len(runes)-visible:])
+}
+
+// Sensitive marks the national ID as personal data for domain.Sensitive
+func (n NationalID) Sensitive() {}
+
+// Equals compares two NationalID objects for equality
+func (n NationalID) Equals(other NationalID) bool {
+	return n.country.Equals(other.country) && n.value == other.value
+}
+
+// String returns a masked string representation of the national ID
+func (n NationalID) String() string {
+	return n.Masked()
+}
+
+// nationalIDJSON is the JSON representation of NationalID
+type nationalIDJSON struct {
+	Country string `json:"country"`
+	Value   string `json:"value"`
+}
+
+// MarshalJSON encodes the national ID with a masked value, so it never leaves the domain
+// in full through JSON
+func (n NationalID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(nationalIDJSON{Country: n.country.Value(), Value: n.Masked()})
+}
+
+// UnmarshalJSON decodes and validates a national ID given with its full value
+func (n *NationalID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw nationalIDJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid national ID JSON")
+	}
+
+	country, err := geography.NewCountryCode(raw.Country)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewNationalID(country, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
+
+// NormalizeNationalID normalizes a national ID by converting to uppercase and removing spaces,
+// hyphens, dots and slashes
+func NormalizeNationalID(value string) (string, error) {
+	var result strings.Builder
+	for _, r := range value {
+		if unicode.IsSpace(r) || r == '-' || r == '.' || r == '/' {
+			continue
+		}
+		result.WriteRune(unicode.ToUpper(r))
+	}
+
+	normalized := result.String()
+	if normalized == "" {
+		return "", ErrEmptyNationalID
+	}
+
+	return normalized, nil
+}
+
+// IsValidNationalID validates a normalized national ID with the validator registered for
+// the country
+func IsValidNationalID(country geography.CountryCode, normalized string) error {
+	if normalized == "" {
+		return ErrEmptyNationalID
+	}
+
+	nationalIDValidatorsMu.RLock()
+	validator, ok := nationalIDValidators[country.Value()]
+	nationalIDValidatorsMu.RUnlock()
+	if !ok {
+		return ErrUnsupportedNationalIDCountry
+	}
+
+	return validator(normalized)
+}
+
+// IsValidUSSocialSecurityNumber validates a US Social Security Number ("123456789")
+func IsValidUSSocialSecurityNumber(normalized string) error {
+	if len(normalized) != 9 || !isDigits(normalized) {
+		return ErrInvalidNationalID
+	}
+
+	area, group, serial := normalized[:3], normalized[3:5], normalized[5:]
+	if area == "000" || area == "666" || area[0] == '9' || group == "00" || serial == "0000" {
+		return ErrInvalidNationalID
+	}
+
+	return nil
+}
+
+// IsValidUKNationalInsuranceNumber validates a UK National Insurance number ("AB123456C")
+func IsValidUKNationalInsuranceNumber(normalized string) error {
+	if len(normalized) != 9 || !isDigits(normalized[2:8]) {
+		return ErrInvalidNationalID
+	}
+
+	first, second, last := normalized[0], normalized[1], normalized[8]
+	if !strings.ContainsRune("ABCEGHJKLMNOPRSTWXYZ", rune(first)) ||
+		!strings.ContainsRune("ABCEGHJKLMNPRSTWXYZ", rune(second)) ||
+		!strings.ContainsRune("ABCD", rune(last)) {
+		return ErrInvalidNationalID
+	}
+
+	switch normalized[:2] {
+	case "BG", "GB", "NK", "KN", "TN", "NT", "ZZ":
+		return ErrInvalidNationalID
+	}
+
+	return nil
+}
+
+// IsValidSpanishDNI validates a Spanish DNI ("12345678Z") or NIE ("X1234567L"),
+// including the control letter
+func IsValidSpanishDNI(normalized string) error {
+	if len(normalized) != 9 {
+		return ErrInvalidNationalID
+	}
+
+	number := normalized[:8]
+	if prefix := strings.IndexByte("XYZ", number[0]); prefix >= 0 {
+		number = string(rune('0'+prefix)) + number[1:]
+	}
+	if !isDigits(number) {
+		return ErrInvalidNationalID
+	}
+
+	remainder := 0
+	for _, digit := range number {
+		remainder = (remainder*10 + int(digit-'0')) % 23
+	}
+	if "TRWAGMYFPDXBNJZSQVHLCKE"[remainder] != normalized[8] {
+		return ErrInvalidNationalIDChecksum
+	}
+
+	return nil
+}
+
+// IsValidRomanianCNP validates a Romanian personal numeric code (CNP), including its
+// birth-date month and day ranges and check digit
+func IsValidRomanianCNP(normalized string) error {
+	if len(normalized) != 13 || !isDigits(normalized) || normalized[0] == '0' {
+		return ErrInvalidNationalID
+	}
+
+	month := int(normalized[3]-'0')*10 + int(normalized[4]-'0')
+	day := int(normalized[5]-'0')*10 + int(normalized[6]-'0')
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return ErrInvalidNationalID
+	}
+
+	const weights = "279146358279"
+	sum := 0
+	for i := 0; i < len(weights); i++ {
+		sum += int(normalized[i]-'0') * int(weights[i]-'0')
+	}
+	check := sum % 11
+	if check == 10 {
+		check = 1
+	}
+	if int(normalized[12]-'0') != check {
+		return ErrInvalidNationalIDChecksum
+	}
+
+	return nil
+}
+
+// isDigits reports whether the string is non-empty and made only of ASCII digits
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
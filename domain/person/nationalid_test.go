@@ -0,0 +1,132 @@
+package person
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+)
+
+type NationalIDTestSuite struct {
+	suite.Suite
+}
+
+func TestNationalIDSuite(t *testing.T) {
+	suite.Run(t, new(NationalIDTestSuite))
+}
+
+func (s *NationalIDTestSuite) TestItCanBuildValidNationalIDs() {
+	testCases := []struct {
+		name     string
+		country  string
+		input    string
+		expected string
+	}{
+		{"US SSN with hyphens", "US", "123-45-6789", "123456789"},
+		{"UK NINO with spaces", "GB", "ab 12 34 56 c", "AB123456C"},
+		{"ES DNI", "ES", "12345678-z", "12345678Z"},
+		{"ES NIE", "ES", "X1234567L", "X1234567L"},
+		{"RO CNP", "RO", "1800101221144", "1800101221144"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				country, _ := geography.NewCountryCode(tc.country)
+				nationalID, err := NewNationalID(country, tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, nationalID.Value())
+				s.True(nationalID.Country().Equals(country))
+			},
+		)
+	}
+}
+
+func (s *NationalIDTestSuite) TestItFailsToBuildInvalidNationalIDs() {
+	testCases := []struct {
+		name          string
+		country       string
+		input         string
+		expectedError error
+	}{
+		{"empty", "US", " - ", ErrEmptyNationalID},
+		{"SSN area 000", "US", "000-12-3456", ErrInvalidNationalID},
+		{"SSN area 9xx", "US", "912-12-3456", ErrInvalidNationalID},
+		{"SSN too short", "US", "12345678", ErrInvalidNationalID},
+		{"NINO forbidden prefix", "GB", "GB123456A", ErrInvalidNationalID},
+		{"NINO invalid suffix", "GB", "AB123456E", ErrInvalidNationalID},
+		{"DNI wrong letter", "ES", "12345678A", ErrInvalidNationalIDChecksum},
+		{"DNI non numeric", "ES", "1234A678Z", ErrInvalidNationalID},
+		{"CNP wrong check digit", "RO", "1800101221145", ErrInvalidNationalIDChecksum},
+		{"CNP invalid month", "RO", "1801301221144", ErrInvalidNationalID},
+		{"unsupported country", "FR", "123", ErrUnsupportedNationalIDCountry},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				country, _ := geography.NewCountryCode(tc.country)
+				_, err := NewNationalID(country, tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *NationalIDTestSuite) TestItCanRegisterCustomValidators() {
+	country, _ := geography.NewCountryCode("ZZ")
+	RegisterNationalIDValidator(
+		country, func(normalized string) error {
+			if !strings.HasPrefix(normalized, "ZZ") {
+				return ErrInvalidNationalID
+			}
+			return nil
+		},
+	)
+
+	nationalID, err := NewNationalID(country, "zz-123")
+	s.NoError(err)
+	s.Equal("ZZ123", nationalID.Value())
+
+	_, err = NewNationalID(country, "123")
+	s.ErrorIs(err, ErrInvalidNationalID)
+}
+
+func (s *NationalIDTestSuite) TestItMasksOutput() {
+	country, _ := geography.NewCountryCode("US")
+	nationalID, _ := NewNationalID(country, "123-45-6789")
+
+	s.Equal("•••••6789", nationalID.Masked())
+	s.Equal("•••••6789", nationalID.String())
+	s.Equal("•••456", ReconstituteNationalID(country, "123456").Masked())
+
+	encoded, err := json.Marshal(nationalID)
+	s.NoError(err)
+	s.JSONEq(`{"country":"US","value":"•••••6789"}`, string(encoded))
+	s.NotContains(string(encoded), "12345")
+}
+
+func (s *NationalIDTestSuite) TestItCanUnmarshalFullValues() {
+	var nationalID NationalID
+	s.NoError(json.Unmarshal([]byte(`{"country":"es","value":"12345678Z"}`), &nationalID))
+	s.Equal("12345678Z", nationalID.Value())
+	s.Equal("ES", nationalID.Country().Value())
+
+	err := json.Unmarshal([]byte(`{"country":"ES","value":"12345678A"}`), &nationalID)
+	s.ErrorIs(err, ErrInvalidNationalIDChecksum)
+
+	err = json.Unmarshal([]byte(`{"country":"","value":"12345678Z"}`), &nationalID)
+	s.ErrorIs(err, geography.ErrEmptyCountryCode)
+}
+
+func (s *NationalIDTestSuite) TestEquals() {
+	us, _ := geography.NewCountryCode("US")
+	first, _ := NewNationalID(us, "123-45-6789")
+	second, _ := NewNationalID(us, "123 45 6789")
+	third, _ := NewNationalID(us, "123-45-6780")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
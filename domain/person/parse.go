@@ -0,0 +1,168 @@
+package person
+
+import (
+	"strings"
+)
+
+// ParseConfidence indicates how reliable the split performed by ParseFullName is
+type ParseConfidence int
+
+const (
+	// ParseConfidenceLow means several splits were plausible and a guess was made
+	ParseConfidenceLow ParseConfidence = iota
+	// ParseConfidenceMedium means the split relied on heuristics such as surname particles
+	ParseConfidenceMedium
+	// ParseConfidenceHigh means the input had an unambiguous structure
+	ParseConfidenceHigh
+)
+
+// String returns a string representation of the confidence level
+func (c ParseConfidence) String() string {
+	switch c {
+	case ParseConfidenceHigh:
+		return "high"
+	case ParseConfidenceMedium:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// namePrefixes lists honorifics recognized by ParseFullName (lowercase, without periods)
+var namePrefixes = map[string]struct{}{
+	"mr": {}, "mrs": {}, "ms": {}, "miss": {}, "mx": {}, "dr": {}, "prof": {},
+	"rev": {}, "fr": {}, "sir": {}, "dame": {}, "lord": {}, "lady": {}, "hon": {},
+	"rt": {}, "capt": {}, "col": {}, "gen": {}, "lt": {}, "sgt": {}, "maj": {},
+}
+
+// nameSuffixes lists generational and professional suffixes recognized by ParseFullName
+// (lowercase, without periods)
+var nameSuffixes = map[string]struct{}{
+	"jr": {}, "sr": {}, "ii": {}, "iii": {}, "iv": {}, "v": {}, "phd": {}, "md": {},
+	"esq": {}, "dds": {}, "cpa": {}, "mba": {}, "obe": {}, "mbe": {}, "kbe": {}, "mp": {},
+}
+
+// surnameParticles lists lowercase words that start compound surnames ("van der Berg")
+var surnameParticles = map[string]struct{}{
+	"van": {}, "von": {}, "der": {}, "den": {}, "de": {}, "del": {}, "della": {},
+	"di": {}, "da": {}, "du": {}, "la": {}, "le": {}, "dos": {}, "das": {}, "ter": {},
+	"ten": {}, "bin": {}, "ibn": {}, "al": {}, "el": {}, "st": {}, "mac": {},
+}
+
+// ParseFullName splits a single display string such as "Dr. John W. Doe Jr." or
+// "van der Berg, Anna" into a FullName. It recognizes honorific prefixes, generational and
+// professional suffixes, compound surnames introduced by particles, and the
+// "Last, First Middle" form. The returned confidence reports how much guessing was needed.
+func ParseFullName(value string) (FullName, ParseConfidence, error) {
+	value = strings.Join(strings.Fields(value), " ")
+
+	var givenNames, lastName, suffixes []string
+	head, tail, hasComma := strings.Cut(value, ",")
+	tailTokens := strings.Fields(tail)
+	surnameFirst := hasComma && !allAffixes(tailTokens, nameSuffixes)
+
+	if surnameFirst {
+		lastName = strings.Fields(head)
+		givenNames = tailTokens
+	} else {
+		givenNames = strings.Fields(head)
+		suffixes = tailTokens
+	}
+
+	var prefixes []string
+	for len(givenNames) > 1 && isAffix(givenNames[0], namePrefixes) {
+		prefixes = append(prefixes, givenNames[0])
+		givenNames = givenNames[1:]
+	}
+
+	minGivenTokens := 2
+	if surnameFirst {
+		minGivenTokens = 1
+	}
+	for len(givenNames) > minGivenTokens && isAffix(givenNames[len(givenNames)-1], nameSuffixes) {
+		suffixes = append([]string{givenNames[len(givenNames)-1]}, suffixes...)
+		givenNames = givenNames[:len(givenNames)-1]
+	}
+
+	confidence := ParseConfidenceHigh
+	if !surnameFirst && len(givenNames) >= 2 {
+		lastStart := len(givenNames) - 1
+		for lastStart > 1 && isSurnameParticle(givenNames[lastStart-1]) {
+			lastStart--
+		}
+		if lastStart < len(givenNames)-1 {
+			confidence = ParseConfidenceMedium
+		}
+		lastName = givenNames[lastStart:]
+		givenNames = givenNames[:lastStart]
+	}
+
+	var firstName, middleName string
+	if len(givenNames) > 0 {
+		firstName = givenNames[0]
+		middleNames := givenNames[1:]
+		middleName = strings.Join(middleNames, " ")
+		confidence = min(confidence, middleNamesConfidence(middleNames, surnameFirst))
+	}
+
+	fullName, err := NewFullNameWithAffixes(
+		strings.Join(prefixes, " "),
+		firstName,
+		middleName,
+		strings.Join(lastName, " "),
+		strings.Join(suffixes, " "),
+	)
+	if err != nil {
+		return FullName{}, ParseConfidenceLow, err
+	}
+
+	return fullName, confidence, nil
+}
+
+// middleNamesConfidence rates how certain it is that the given words are middle names.
+// Without an explicit surname, a single full middle word could also be the start of a
+// compound surname ("Gabriel García Márquez"); several words make the split a guess.
+func middleNamesConfidence(middleNames []string, surnameFirst bool) ParseConfidence {
+	switch {
+	case len(middleNames) == 0:
+		return ParseConfidenceHigh
+	case len(middleNames) == 1 && (surnameFirst || isInitial(middleNames[0])):
+		return ParseConfidenceHigh
+	case len(middleNames) == 1 || surnameFirst:
+		return ParseConfidenceMedium
+	default:
+		return ParseConfidenceLow
+	}
+}
+
+// isAffix reports whether the token, ignoring case and periods, is in the affix set
+func isAffix(token string, affixes map[string]struct{}) bool {
+	_, ok := affixes[strings.ToLower(strings.ReplaceAll(token, ".", ""))]
+	return ok
+}
+
+// allAffixes reports whether every token is in the affix set (and there is at least one)
+func allAffixes(tokens []string, affixes map[string]struct{}) bool {
+	if len(tokens) == 0 {
+		return false
+	}
+	for _, token := range tokens {
+		if !isAffix(token, affixes) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSurnameParticle reports whether the token is a lowercase surname particle such as "van"
+func isSurnameParticle(token string) bool {
+	if token != strings.ToLower(token) {
+		return false
+	}
+	return isAffix(token, surnameParticles)
+}
+
+// isInitial reports whether the token is a single letter, optionally followed by a period
+func isInitial(token string) bool {
+	return len([]rune(strings.TrimSuffix(token, "."))) == 1
+}
@@ -0,0 +1,100 @@
+package person
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ParseTestSuite struct {
+	suite.Suite
+}
+
+func TestParseSuite(t *testing.T) {
+	suite.Run(t, new(ParseTestSuite))
+}
+
+func (s *ParseTestSuite) TestItCanParseDisplayStrings() {
+	testCases := []struct {
+		name               string
+		input              string
+		expectedPrefix     string
+		expectedFirst      string
+		expectedMiddle     string
+		expectedLast       string
+		expectedSuffix     string
+		expectedConfidence ParseConfidence
+	}{
+		{"first last", "John Doe", "", "John", "", "Doe", "", ParseConfidenceHigh},
+		{
+			"prefix initial and suffix", " Dr.  John W. Doe Jr. ",
+			"Dr.", "John", "W.", "Doe", "Jr.", ParseConfidenceHigh,
+		},
+		{
+			"compound surname", "Anna van der Berg",
+			"", "Anna", "", "van der Berg", "", ParseConfidenceMedium,
+		},
+		{
+			"comma suffix", "John Doe, III",
+			"", "John", "", "Doe", "III", ParseConfidenceHigh,
+		},
+		{
+			"surname first", "van der Berg, Anna Maria",
+			"", "Anna", "Maria", "van der Berg", "", ParseConfidenceHigh,
+		},
+		{
+			"ambiguous middle word", "Gabriel García Márquez",
+			"", "Gabriel", "García", "Márquez", "", ParseConfidenceMedium,
+		},
+		{
+			"several middle words", "Maria Anna Sofia Rossi",
+			"", "Maria", "Anna Sofia", "Rossi", "", ParseConfidenceLow,
+		},
+		{
+			"multiple prefixes", "Rt. Hon. John Smith MP",
+			"Rt. Hon.", "John", "", "Smith", "MP", ParseConfidenceHigh,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				fullName, confidence, err := ParseFullName(tc.input)
+				s.NoError(err)
+				s.Equal(tc.expectedPrefix, fullName.Prefix())
+				s.Equal(tc.expectedFirst, fullName.FirstName())
+				s.Equal(tc.expectedMiddle, fullName.MiddleName())
+				s.Equal(tc.expectedLast, fullName.LastName())
+				s.Equal(tc.expectedSuffix, fullName.Suffix())
+				s.Equal(tc.expectedConfidence, confidence)
+			},
+		)
+	}
+}
+
+func (s *ParseTestSuite) TestItFailsToParseIncompleteNames() {
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"single word", "Madonna"},
+		{"prefix and single word", "Dr. Who"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, confidence, err := ParseFullName(tc.input)
+				s.ErrorIs(err, ErrEmptyNamePart)
+				s.Equal(ParseConfidenceLow, confidence)
+			},
+		)
+	}
+}
+
+func (s *ParseTestSuite) TestConfidenceString() {
+	s.Equal("high", ParseConfidenceHigh.String())
+	s.Equal("medium", ParseConfidenceMedium.String())
+	s.Equal("low", ParseConfidenceLow.String())
+}
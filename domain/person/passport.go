@@ -0,0 +1,189 @@
+package person
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+// DocumentNumberVisibleChars is the number of trailing characters of passport and driver
+// license numbers left visible when masking
+const DocumentNumberVisibleChars = 3
+
+var (
+	ErrEmptyPassportNumber = domain.NewLocalizedError(
+		"person.passport_number.empty", nil,
+		"passport number cannot be empty",
+	)
+	ErrInvalidPassportNumber = domain.NewLocalizedError(
+		"person.passport_number.invalid_format", nil,
+		"passport number has invalid format for its country",
+	)
+)
+
+// icaoPassportNumberFormat is the document number field of the ICAO 9303 machine readable
+// zone, which every passport number fits
+var icaoPassportNumberFormat = regexp.MustCompile(`^[A-Z0-9]{5,9}$`)
+
+var (
+	passportNumberFormatsMu sync.RWMutex
+	// passportNumberFormats holds the current formats of each country, matched against the
+	// normalized number. Other countries are checked against icaoPassportNumberFormat.
+	passportNumberFormats = map[string]*regexp.Regexp{
+		// Nine digits, or a letter and eight digits for next-generation books
+		"US": regexp.MustCompile(`^(\d{9}|[A-Z]\d{8})$`),
+		"GB": regexp.MustCompile(`^\d{9}$`),
+		// Nine characters without vowels or B, D, Q, S
+		"DE": regexp.MustCompile(`^[CFGHJKLMNPRTVWXYZ0-9]{9}$`),
+		// "12AB34567"
+		"FR": regexp.MustCompile(`^\d{2}[A-Z]{2}\d{5}$`),
+		// "ABC123456"
+		"ES": regexp.MustCompile(`^[A-Z]{3}\d{6}$`),
+		"RO": regexp.MustCompile(`^\d{8,9}$`),
+	}
+)
+
+// RegisterPassportNumberFormat registers (or replaces) the format of a country's passport
+// numbers. The pattern is matched against the normalized number, upper case and without
+// spaces or hyphens, and should be anchored. It is safe to call concurrently with
+// NewPassportNumber.
+func RegisterPassportNumberFormat(country geography.CountryCode, pattern *regexp.Regexp) {
+	passportNumberFormatsMu.Lock()
+	defer passportNumberFormatsMu.Unlock()
+	passportNumberFormats[country.Value()] = pattern
+}
+
+// PassportNumber is the document number of a passport issued by a country. Its String and
+// JSON representations are masked to avoid leaking the full value.
+type PassportNumber struct {
+	country geography.CountryCode
+	value   string
+}
+
+// NewPassportNumber creates a new instance of PassportNumber with validation and
+// normalization, using the format registered for the country or, for other countries, the
+// ICAO 9303 limits of five to nine letters and digits
+func NewPassportNumber(country geography.CountryCode, value string) (PassportNumber, error) {
+	normalized := normalizeDocumentNumber(value)
+	if normalized == "" {
+		return PassportNumber{}, ErrEmptyPassportNumber
+	}
+
+	passportNumberFormatsMu.RLock()
+	format, found := passportNumberFormats[country.Value()]
+	passportNumberFormatsMu.RUnlock()
+	if !found {
+		format = icaoPassportNumberFormat
+	}
+	if !format.MatchString(normalized) {
+		return PassportNumber{}, ErrInvalidPassportNumber
+	}
+
+	return PassportNumber{
+		country: country,
+		value:   normalized,
+	}, nil
+}
+
+// ReconstitutePassportNumber creates a new PassportNumber instance without validation or
+// normalization
+func ReconstitutePassportNumber(country geography.CountryCode, value string) PassportNumber {
+	return PassportNumber{
+		country: country,
+		value:   value,
+	}
+}
+
+// Country returns the issuing country
+func (p PassportNumber) Country() geography.CountryCode {
+	return p.country
+}
+
+// Value returns the full, unmasked passport number
+func (p PassportNumber) Value() string {
+	return p.value
+}
+
+// Masked returns the passport number with all but the last DocumentNumberVisibleChars
+// characters replaced by bullets, e.g., "••••••789"
+func (p PassportNumber) Masked() string {
+	return maskDocumentNumber(p.value)
+}
+
+// Sensitive marks the passport number as personal data for domain.Sensitive
+func (p PassportNumber) Sensitive() {}
+
+// Equals compares two PassportNumber objects for equality
+func (p PassportNumber) Equals(other PassportNumber) bool {
+	return p.country.Equals(other.country) && p.value == other.value
+}
+
+// String returns a masked string representation of the passport number
+func (p PassportNumber) String() string {
+	return p.Masked()
+}
+
+// documentNumberJSON is the JSON representation of PassportNumber and DriverLicenseNumber
+type documentNumberJSON struct {
+	Country string `json:"country"`
+	Value   string `json:"value"`
+}
+
+// MarshalJSON encodes the passport number with a masked value, so it never leaves the domain
+// in full through JSON
+func (p PassportNumber) MarshalJSON() ([]byte, error) {
+	return json.Marshal(documentNumberJSON{Country: p.country.Value(), Value: p.Masked()})
+}
+
+// UnmarshalJSON decodes and validates a passport number given with its full value
+func (p *PassportNumber) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw documentNumberJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid passport number JSON")
+	}
+
+	country, err := geography.NewCountryCode(raw.Country)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewPassportNumber(country, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// normalizeDocumentNumber converts a document number to uppercase and removes spaces and
+// hyphens
+func normalizeDocumentNumber(value string) string {
+	return strings.Map(
+		func(r rune) rune {
+			if unicode.IsSpace(r) || r == '-' {
+				return -1
+			}
+			return unicode.ToUpper(r)
+		},
+		value,
+	)
+}
+
+// maskDocumentNumber replaces all but the last DocumentNumberVisibleChars characters of a
+// document number with bullets, leaving at least half of a short number hidden
+func maskDocumentNumber(value string) string {
+	runes := []rune(value)
+	visible := min(DocumentNumberVisibleChars, len(runes)/2)
+	return strings.Repeat("•", len(runes)-visible) + string(runes[len(runes)-visible:])
+}
@@ -0,0 +1,127 @@
+package person
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+)
+
+type PassportNumberTestSuite struct {
+	suite.Suite
+}
+
+func TestPassportNumberSuite(t *testing.T) {
+	suite.Run(t, new(PassportNumberTestSuite))
+}
+
+func (s *PassportNumberTestSuite) TestItCanCreateValidPassportNumbers() {
+	testCases := []struct {
+		country  string
+		value    string
+		expected string
+	}{
+		{"US", "123456789", "123456789"},
+		{"US", "a1234 5678", "A12345678"},
+		{"GB", "123-456-789", "123456789"},
+		{"DE", "C01X00T47", "C01X00T47"},
+		{"FR", "12ab34567", "12AB34567"},
+		{"ES", "ABC123456", "ABC123456"},
+		{"RO", "12345678", "12345678"},
+		{"JP", "TK1234567", "TK1234567"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.country+" "+tc.value, func() {
+				country := geography.ReconstituteCountryCode(tc.country)
+				passport, err := NewPassportNumber(country, tc.value)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, passport.Value())
+				s.True(country.Equals(passport.Country()))
+			},
+		)
+	}
+}
+
+func (s *PassportNumberTestSuite) TestItFailsWithInvalidPassportNumbers() {
+	testCases := []struct {
+		country string
+		value   string
+		err     error
+	}{
+		{"US", " ", ErrEmptyPassportNumber},
+		{"US", "12345678", ErrInvalidPassportNumber},
+		{"DE", "C01X00T4A", ErrInvalidPassportNumber},
+		{"FR", "123456789", ErrInvalidPassportNumber},
+		{"JP", "TK12345678", ErrInvalidPassportNumber},
+		{"JP", "TK-12/345", ErrInvalidPassportNumber},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.country+" "+tc.value, func() {
+				_, err := NewPassportNumber(geography.ReconstituteCountryCode(tc.country), tc.value)
+				s.True(errors.Is(err, tc.err), "got %v", err)
+			},
+		)
+	}
+}
+
+func (s *PassportNumberTestSuite) TestItCanRegisterFormats() {
+	jp := geography.ReconstituteCountryCode("JP")
+	RegisterPassportNumberFormat(jp, regexp.MustCompile(`^[A-Z]{2}\d{7}$`))
+	defer func() {
+		passportNumberFormatsMu.Lock()
+		delete(passportNumberFormats, "JP")
+		passportNumberFormatsMu.Unlock()
+	}()
+
+	_, err := NewPassportNumber(jp, "TK1234567")
+	s.NoError(err)
+	_, err = NewPassportNumber(jp, "123456789")
+	s.True(errors.Is(err, ErrInvalidPassportNumber))
+}
+
+func (s *PassportNumberTestSuite) TestItMasksTheValue() {
+	passport := ReconstitutePassportNumber(geography.ReconstituteCountryCode("GB"), "123456789")
+
+	s.Equal("••••••789", passport.Masked())
+	s.Equal("••••••789", passport.String())
+	s.Equal("•••45", ReconstitutePassportNumber(geography.CountryCode{}, "12345").Masked())
+}
+
+func (s *PassportNumberTestSuite) TestItMasksTheValueWhenMarshalingJSON() {
+	passport := ReconstitutePassportNumber(geography.ReconstituteCountryCode("GB"), "123456789")
+
+	data, err := json.Marshal(passport)
+	s.Require().NoError(err)
+	s.JSONEq(`{"country":"GB","value":"••••••789"}`, string(data))
+}
+
+func (s *PassportNumberTestSuite) TestItCanUnmarshalJSON() {
+	var passport PassportNumber
+	s.Require().NoError(json.Unmarshal([]byte(`{"country":"FR","value":"12AB34567"}`), &passport))
+	s.Equal("12AB34567", passport.Value())
+
+	err := json.Unmarshal([]byte(`{"country":"FR","value":"•••••4567"}`), &passport)
+	s.True(errors.Is(err, ErrInvalidPassportNumber))
+	s.Error(json.Unmarshal([]byte(`{"country":"F","value":"12AB34567"}`), &passport))
+	s.Error(json.Unmarshal([]byte(`[]`), &passport))
+}
+
+func (s *PassportNumberTestSuite) TestItCanComparePassportNumbers() {
+	gb := geography.ReconstituteCountryCode("GB")
+	passport := ReconstitutePassportNumber(gb, "123456789")
+
+	s.True(passport.Equals(ReconstitutePassportNumber(gb, "123456789")))
+	s.False(passport.Equals(ReconstitutePassportNumber(gb, "123456780")))
+	s.False(
+		passport.Equals(
+			ReconstitutePassportNumber(geography.ReconstituteCountryCode("RO"), "123456789"),
+		),
+	)
+}
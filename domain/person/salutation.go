@@ -0,0 +1,158 @@
+package person
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Gender selects the gendered form of a salutation
+type Gender int
+
+const (
+	// GenderUnspecified uses the neutral salutation
+	GenderUnspecified Gender = iota
+	GenderMale
+	GenderFemale
+	// GenderNonBinary uses the neutral salutation
+	GenderNonBinary
+)
+
+// String returns a string representation of the gender
+func (g Gender) String() string {
+	switch g {
+	case GenderMale:
+		return "male"
+	case GenderFemale:
+		return "female"
+	case GenderNonBinary:
+		return "non-binary"
+	default:
+		return "unspecified"
+	}
+}
+
+// SalutationTemplate holds the greetings of one language. Templates may use the placeholders
+// {prefix}, {first}, {last} and {full}; runs of spaces left by empty placeholders are
+// collapsed.
+type SalutationTemplate struct {
+	Male    string
+	Female  string
+	Neutral string
+	// Titled, when set, replaces the gendered greetings for names with an honorific prefix,
+	// e.g., "Dear {prefix} {last}" gives "Dear Dr. Doe"
+	Titled string
+}
+
+var (
+	salutationTemplatesMu sync.RWMutex
+	salutationTemplates   = map[language.Base]SalutationTemplate{
+		baseLanguage(language.English): {
+			Male:    "Dear Mr. {last}",
+			Female:  "Dear Ms. {last}",
+			Neutral: "Dear {first} {last}",
+			Titled:  "Dear {prefix} {last}",
+		},
+		baseLanguage(language.German): {
+			Male:    "Sehr geehrter Herr {prefix} {last}",
+			Female:  "Sehr geehrte Frau {prefix} {last}",
+			Neutral: "Guten Tag {first} {last}",
+		},
+		baseLanguage(language.French): {
+			Male:    "Cher Monsieur {last}",
+			Female:  "Chère Madame {last}",
+			Neutral: "Bonjour {first} {last}",
+		},
+		baseLanguage(language.Spanish): {
+			Male:    "Estimado Sr. {last}",
+			Female:  "Estimada Sra. {last}",
+			Neutral: "Estimado/a {first} {last}",
+		},
+		baseLanguage(language.Romanian): {
+			Male:    "Stimate domnule {prefix} {last}",
+			Female:  "Stimată doamnă {prefix} {last}",
+			Neutral: "Bună ziua, {first} {last}",
+		},
+	}
+)
+
+// RegisterSalutationTemplate registers (or replaces) the greetings of the locale's language.
+// It is safe to call concurrently with NewSalutation.
+func RegisterSalutationTemplate(locale language.Tag, template SalutationTemplate) {
+	salutationTemplatesMu.Lock()
+	defer salutationTemplatesMu.Unlock()
+	salutationTemplates[baseLanguage(locale)] = template
+}
+
+// Salutation is the greeting opening a letter or an email, e.g., "Dear Dr. Doe" or
+// "Sehr geehrter Herr Doe"
+type Salutation struct {
+	value string
+}
+
+// NewSalutation creates the greeting of the name in the locale's language, falling back to
+// English for languages without a registered template
+func NewSalutation(name FullName, gender Gender, locale language.Tag) (Salutation, error) {
+	if name.lastName == "" {
+		return Salutation{}, ErrEmptyNamePart
+	}
+
+	salutationTemplatesMu.RLock()
+	template, found := salutationTemplates[baseLanguage(locale)]
+	if !found {
+		template = salutationTemplates[baseLanguage(language.English)]
+	}
+	salutationTemplatesMu.RUnlock()
+
+	return Salutation{value: template.render(name, gender)}, nil
+}
+
+// ReconstituteSalutation creates a Salutation from a stored greeting, without validation
+func ReconstituteSalutation(value string) Salutation {
+	return Salutation{value: value}
+}
+
+// Value returns the greeting
+func (s Salutation) Value() string {
+	return s.value
+}
+
+// Equals compares two Salutation objects for equality
+func (s Salutation) Equals(other Salutation) bool {
+	return s.value == other.value
+}
+
+// String returns the greeting
+func (s Salutation) String() string {
+	return s.value
+}
+
+// render fills the template matching the name and gender
+func (t SalutationTemplate) render(name FullName, gender Gender) string {
+	var pattern string
+	switch {
+	case name.prefix != "" && t.Titled != "":
+		pattern = t.Titled
+	case gender == GenderMale:
+		pattern = t.Male
+	case gender == GenderFemale:
+		pattern = t.Female
+	default:
+		pattern = t.Neutral
+	}
+
+	replacer := strings.NewReplacer(
+		"{prefix}", name.prefix,
+		"{first}", name.firstName,
+		"{last}", name.lastName,
+		"{full}", name.String(),
+	)
+	return strings.Join(strings.Fields(replacer.Replace(pattern)), " ")
+}
+
+// baseLanguage returns the base language of the tag, e.g., "de" for de-AT
+func baseLanguage(locale language.Tag) language.Base {
+	base, _ := locale.Base()
+	return base
+}
@@ -0,0 +1,92 @@
+package person
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/text/language"
+)
+
+type SalutationTestSuite struct {
+	suite.Suite
+}
+
+func TestSalutationSuite(t *testing.T) {
+	suite.Run(t, new(SalutationTestSuite))
+}
+
+func (s *SalutationTestSuite) TestItCanGreetInEachBundledLanguage() {
+	doctor, _ := NewFullNameWithAffixes("Dr.", "John", "", "Doe", "")
+	plain, _ := NewFullName("Jane", "", "Doe")
+
+	testCases := []struct {
+		name     string
+		fullName FullName
+		gender   Gender
+		locale   language.Tag
+		expected string
+	}{
+		{"english titled", doctor, GenderMale, language.English, "Dear Dr. Doe"},
+		{"english male", plain, GenderMale, language.BritishEnglish, "Dear Mr. Doe"},
+		{"english female", plain, GenderFemale, language.English, "Dear Ms. Doe"},
+		{"english neutral", plain, GenderNonBinary, language.English, "Dear Jane Doe"},
+		{"german male", plain, GenderMale, language.German, "Sehr geehrter Herr Doe"},
+		{
+			"german titled female", doctor, GenderFemale, language.MustParse("de-AT"),
+			"Sehr geehrte Frau Dr. Doe",
+		},
+		{"german neutral", plain, GenderUnspecified, language.German, "Guten Tag Jane Doe"},
+		{"french female", plain, GenderFemale, language.French, "Chère Madame Doe"},
+		{"spanish male", plain, GenderMale, language.Spanish, "Estimado Sr. Doe"},
+		{"romanian female", plain, GenderFemale, language.Romanian, "Stimată doamnă Doe"},
+		{"unknown language", plain, GenderFemale, language.Japanese, "Dear Ms. Doe"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				salutation, err := NewSalutation(tc.fullName, tc.gender, tc.locale)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, salutation.String())
+			},
+		)
+	}
+}
+
+func (s *SalutationTestSuite) TestItCanRegisterTemplates() {
+	RegisterSalutationTemplate(language.Dutch, SalutationTemplate{
+		Male:    "Geachte heer {last}",
+		Female:  "Geachte mevrouw {last}",
+		Neutral: "Beste {full}",
+	})
+	name, _ := NewFullName("Anna", "", "de Vries")
+
+	salutation, err := NewSalutation(name, GenderFemale, language.Dutch)
+	s.Require().NoError(err)
+	s.Equal("Geachte mevrouw de Vries", salutation.Value())
+
+	salutation, err = NewSalutation(name, GenderUnspecified, language.Dutch)
+	s.Require().NoError(err)
+	s.Equal("Beste Anna de Vries", salutation.Value())
+}
+
+func (s *SalutationTestSuite) TestItFailsWithAnEmptyName() {
+	_, err := NewSalutation(FullName{}, GenderMale, language.English)
+	s.True(errors.Is(err, ErrEmptyNamePart))
+}
+
+func (s *SalutationTestSuite) TestItCanCompareSalutations() {
+	name, _ := NewFullName("John", "", "Doe")
+	salutation, _ := NewSalutation(name, GenderMale, language.English)
+
+	s.True(salutation.Equals(ReconstituteSalutation("Dear Mr. Doe")))
+	s.False(salutation.Equals(ReconstituteSalutation("Dear John Doe")))
+}
+
+func (s *SalutationTestSuite) TestItCanDescribeGenders() {
+	s.Equal("male", GenderMale.String())
+	s.Equal("female", GenderFemale.String())
+	s.Equal("non-binary", GenderNonBinary.String())
+	s.Equal("unspecified", Gender(99).String())
+}
@@ -0,0 +1,84 @@
+package person
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// TransliterationRules maps non-ASCII runes to their ASCII replacements
+type TransliterationRules map[rune]string
+
+// GermanTransliteration spells umlauts and eszett the German way ("Müller" becomes "Mueller")
+var GermanTransliteration = TransliterationRules{
+	'ä': "ae", 'ö': "oe", 'ü': "ue", 'Ä': "Ae", 'Ö': "Oe", 'Ü': "Ue", 'ß': "ss", 'ẞ': "SS",
+}
+
+// ScandinavianTransliteration spells Nordic letters the Scandinavian way ("Ørsted" becomes
+// "Oersted")
+var ScandinavianTransliteration = TransliterationRules{
+	'å': "aa", 'Å': "Aa", 'ø': "oe", 'Ø': "Oe", 'æ': "ae", 'Æ': "Ae",
+}
+
+// defaultTransliteration covers letters that do not decompose into an ASCII base letter
+// plus combining marks
+var defaultTransliteration = TransliterationRules{
+	'ß': "ss", 'ẞ': "SS", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ø': "o", 'Ø': "O",
+	'ł': "l", 'Ł': "L", 'đ': "d", 'Đ': "D", 'ð': "d", 'Ð': "D", 'þ': "th", 'Þ': "TH",
+	'ı': "i", 'ħ': "h", 'Ħ': "H", 'ŀ': "l", 'Ŀ': "L", 'ŧ': "t", 'Ŧ': "T",
+	'’': "'", '‘': "'", '‐': "-", '‑': "-",
+}
+
+// Transliterate converts a name to ASCII, e.g., for airline or bank integrations.
+// The given rules are consulted first, in order; remaining letters have their diacritics
+// removed ("Müller" becomes "Muller") and characters without an ASCII equivalent are dropped.
+func Transliterate(value string, rules ...TransliterationRules) string {
+	var result strings.Builder
+	result.Grow(len(value))
+
+	for _, r := range norm.NFC.String(value) {
+		if replacement, ok := lookupTransliteration(r, rules); ok {
+			result.WriteString(replacement)
+			continue
+		}
+
+		if r <= unicode.MaxASCII {
+			result.WriteRune(r)
+			continue
+		}
+
+		for _, decomposed := range norm.NFD.String(string(r)) {
+			if decomposed <= unicode.MaxASCII {
+				result.WriteRune(decomposed)
+			}
+		}
+	}
+
+	return result.String()
+}
+
+// ASCII returns a copy of the full name with every part transliterated to ASCII. The copy is
+// validated by NewFullNameWithAffixes, so it fails when a part has no ASCII mapping, as with
+// names written in Chinese characters.
+func (f FullName) ASCII(rules ...TransliterationRules) (FullName, error) {
+	return NewFullNameWithAffixes(
+		Transliterate(f.prefix, rules...),
+		Transliterate(f.firstName, rules...),
+		Transliterate(f.middleName, rules...),
+		Transliterate(f.lastName, rules...),
+		Transliterate(f.suffix, rules...),
+	)
+}
+
+// lookupTransliteration finds the replacement for a rune in the given rules, falling back
+// to the default rules
+func lookupTransliteration(r rune, rules []TransliterationRules) (string, bool) {
+	for _, ruleSet := range rules {
+		if replacement, ok := ruleSet[r]; ok {
+			return replacement, true
+		}
+	}
+	replacement, ok := defaultTransliteration[r]
+	return replacement, ok
+}
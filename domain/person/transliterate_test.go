@@ -0,0 +1,71 @@
+package person
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TransliterateTestSuite struct {
+	suite.Suite
+}
+
+func TestTransliterateSuite(t *testing.T) {
+	suite.Run(t, new(TransliterateTestSuite))
+}
+
+func (s *TransliterateTestSuite) TestItCanTransliterateNames() {
+	testCases := []struct {
+		name     string
+		input    string
+		rules    []TransliterationRules
+		expected string
+	}{
+		{"ascii is unchanged", "O'Connor-Smith", nil, "O'Connor-Smith"},
+		{"diacritics removed by default", "Müller", nil, "Muller"},
+		{"german rules", "Müller", []TransliterationRules{GermanTransliteration}, "Mueller"},
+		{"eszett by default", "Weiß", nil, "Weiss"},
+		{"polish stroke letter", "Łukasz Wiśniewski", nil, "Lukasz Wisniewski"},
+		{"decomposed input", "Zoë", nil, "Zoe"},
+		{"nordic default", "Ørsted", nil, "Orsted"},
+		{
+			"nordic rules", "Ørsted Åse",
+			[]TransliterationRules{ScandinavianTransliteration}, "Oersted Aase",
+		},
+		{"typographic apostrophe", "O’Brien", nil, "O'Brien"},
+		{"unsupported script dropped", "Ana 李", nil, "Ana "},
+		{
+			"custom rules take precedence", "Ünal",
+			[]TransliterationRules{{'Ü': "U"}, GermanTransliteration}, "Unal",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				s.Equal(tc.expected, Transliterate(tc.input, tc.rules...))
+			},
+		)
+	}
+}
+
+func (s *TransliterateTestSuite) TestItCanConvertFullNameToASCII() {
+	fullName, _ := NewFullNameWithAffixes("", "Jürgen", "", "Groß", "")
+
+	ascii, err := fullName.ASCII()
+	s.Require().NoError(err)
+	s.Equal("Jurgen Gross", ascii.String())
+
+	ascii, err = fullName.ASCII(GermanTransliteration)
+	s.Require().NoError(err)
+	s.Equal("Juergen Gross", ascii.String())
+	s.Equal("Jürgen Groß", fullName.String())
+}
+
+func (s *TransliterateTestSuite) TestItFailsToConvertNamesWithoutAnASCIIMapping() {
+	fullName, err := NewFullName("李", "", "王")
+	s.Require().NoError(err)
+
+	_, err = fullName.ASCII()
+	s.ErrorIs(err, ErrEmptyNamePart)
+}
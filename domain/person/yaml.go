@@ -0,0 +1,82 @@
+package person
+
+import (
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+// Names and national IDs encode as YAML mappings with the same keys as their JSON form.
+// Zero values encode as null.
+
+// fullNameYAML is the YAML representation of FullName
+type fullNameYAML struct {
+	Prefix     string `yaml:"prefix,omitempty"`
+	FirstName  string `yaml:"firstName"`
+	MiddleName string `yaml:"middleName,omitempty"`
+	LastName   string `yaml:"lastName"`
+	Suffix     string `yaml:"suffix,omitempty"`
+}
+
+// MarshalYAML encodes the full name as a YAML mapping with one key per part
+func (f FullName) MarshalYAML() (any, error) {
+	if f == (FullName{}) {
+		return nil, nil
+	}
+
+	return fullNameYAML{
+		Prefix:     f.prefix,
+		FirstName:  f.firstName,
+		MiddleName: f.middleName,
+		LastName:   f.lastName,
+		Suffix:     f.suffix,
+	}, nil
+}
+
+// UnmarshalYAML decodes the full name from a YAML mapping, validating every part
+func (f *FullName) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw fullNameYAML
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid full name YAML")
+	}
+
+	parsed, err := NewFullNameWithAffixes(
+		raw.Prefix, raw.FirstName, raw.MiddleName, raw.LastName, raw.Suffix,
+	)
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+	return nil
+}
+
+// nationalIDYAML is the YAML representation of NationalID
+type nationalIDYAML struct {
+	Country geography.CountryCode `yaml:"country"`
+	Value   string                `yaml:"value"`
+}
+
+// MarshalYAML encodes the national ID with a masked value, like MarshalJSON
+func (n NationalID) MarshalYAML() (any, error) {
+	if n == (NationalID{}) {
+		return nil, nil
+	}
+
+	return nationalIDYAML{Country: n.country, Value: n.Masked()}, nil
+}
+
+// UnmarshalYAML decodes and validates a national ID given with its full value
+func (n *NationalID) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw nationalIDYAML
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid national ID YAML")
+	}
+
+	parsed, err := NewNationalID(raw.Country, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
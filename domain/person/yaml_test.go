@@ -0,0 +1,82 @@
+package person
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLTestSuite struct {
+	suite.Suite
+}
+
+func TestYAMLSuite(t *testing.T) {
+	suite.Run(t, new(YAMLTestSuite))
+}
+
+type employeeConfig struct {
+	Name       FullName   `yaml:"name"`
+	NationalID NationalID `yaml:"nationalId"`
+}
+
+func (s *YAMLTestSuite) TestItCanBindValueObjectsFromYAML() {
+	document := `
+name:
+  prefix: Dr.
+  firstName: Jane
+  lastName: Doe
+nationalId:
+  country: us
+  value: 123-45-6789
+`
+
+	var config employeeConfig
+	s.Require().NoError(yaml.Unmarshal([]byte(document), &config))
+
+	s.Equal("Dr. Jane Doe", config.Name.String())
+	s.Equal("US", config.NationalID.Country().Value())
+	s.Equal("123456789", config.NationalID.Value())
+}
+
+func (s *YAMLTestSuite) TestItMarshalsYAML() {
+	name, _ := NewFullName("Jane", "", "Doe")
+	country, _ := geography.NewCountryCode("US")
+	nationalID, _ := NewNationalID(country, "123-45-6789")
+
+	encoded, err := yaml.Marshal(employeeConfig{Name: name, NationalID: nationalID})
+	s.Require().NoError(err)
+	s.Equal(
+		"name:\n"+
+			"    firstName: Jane\n"+
+			"    lastName: Doe\n"+
+			"nationalId:\n"+
+			"    country: US\n"+
+			"    value: •••••6789\n",
+		string(encoded),
+	)
+}
+
+func (s *YAMLTestSuite) TestItValidatesWhenUnmarshalingYAML() {
+	testCases := []struct {
+		name     string
+		document string
+		expected error
+	}{
+		{"Missing last name", "name: {firstName: Jane}", ErrEmptyNamePart},
+		{
+			"Invalid country", "nationalId: {country: USA, value: '123456789'}",
+			geography.ErrInvalidCountryCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				var config employeeConfig
+				s.ErrorIs(yaml.Unmarshal([]byte(tc.document), &config), tc.expected)
+			},
+		)
+	}
+}
@@ -0,0 +1,365 @@
+package pgxcodec
+
+import (
+	"context"
+	"math"
+	"net/netip"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// MoneyTypeName is the name of the composite type created by MoneyTypeDDL
+const MoneyTypeName = "money_value"
+
+var (
+	ErrIdentifierOutOfRange = domain.NewError("identifier does not fit in a Postgres bigint")
+	ErrInvalidMoneyAmount   = domain.NewError("money amount must be a finite number")
+)
+
+// RegisterTypes loads the money_value composite type from the database and registers it,
+// together with the codecs added by Register, on the connection's type map. It fits
+// pgxpool.Config.AfterConnect.
+func RegisterTypes(ctx context.Context, conn *pgx.Conn) error {
+	moneyType, err := conn.LoadType(ctx, MoneyTypeName)
+	if err != nil {
+		return domain.NewInternalErrorWithWrap(err, "cannot load the %s type", MoneyTypeName)
+	}
+
+	conn.TypeMap().RegisterType(moneyType)
+	Register(conn.TypeMap())
+	return nil
+}
+
+// Register teaches the type map to encode and scan value objects directly, without wrappers:
+// web.Email and web.URL as text, geography.CountryCode as text or char(2),
+// identifier.IntIdentifier as bigint, web.IPAddress as inet and finance.Money as the
+// money_value composite, once that type is registered (see RegisterTypes).
+func Register(m *pgtype.Map) {
+	m.TryWrapEncodePlanFuncs = append(
+		[]pgtype.TryWrapEncodePlanFunc{tryWrapEncodePlan},
+		m.TryWrapEncodePlanFuncs...,
+	)
+	m.TryWrapScanPlanFuncs = append(
+		[]pgtype.TryWrapScanPlanFunc{tryWrapScanPlan},
+		m.TryWrapScanPlanFuncs...,
+	)
+
+	m.RegisterDefaultPgType(web.Email{}, "text")
+	m.RegisterDefaultPgType(web.URL{}, "text")
+	m.RegisterDefaultPgType(geography.CountryCode{}, "bpchar")
+	m.RegisterDefaultPgType(identifier.IntIdentifier{}, "int8")
+	m.RegisterDefaultPgType(web.IPAddress{}, "inet")
+	m.RegisterDefaultPgType(finance.Money{}, MoneyTypeName)
+}
+
+// tryWrapEncodePlan wraps value objects in adapters implementing the pgtype valuer interfaces
+func tryWrapEncodePlan(value any) (pgtype.WrappedEncodePlanNextSetter, any, bool) {
+	switch value := value.(type) {
+	case web.Email:
+		return &wrapEncodePlan[web.Email]{adapt: emailText}, emailText(value), true
+	case web.URL:
+		return &wrapEncodePlan[web.URL]{adapt: urlText}, urlText(value), true
+	case geography.CountryCode:
+		return &wrapEncodePlan[geography.CountryCode]{adapt: countryText}, countryText(value), true
+	case identifier.IntIdentifier:
+		return &wrapEncodePlan[identifier.IntIdentifier]{adapt: identifierInt8},
+			identifierInt8(value), true
+	case web.IPAddress:
+		return &wrapEncodePlan[web.IPAddress]{adapt: ipInet}, ipInet(value), true
+	case finance.Money:
+		return &wrapEncodePlan[finance.Money]{adapt: moneyComposite}, moneyComposite(value), true
+	default:
+		return nil, nil, false
+	}
+}
+
+// tryWrapScanPlan wraps value object targets in adapters implementing the pgtype scanner
+// interfaces
+func tryWrapScanPlan(target any) (pgtype.WrappedScanPlanNextSetter, any, bool) {
+	switch target := target.(type) {
+	case *web.Email:
+		return &wrapScanPlan[web.Email]{adapt: emailTarget}, emailTarget(target), true
+	case *web.URL:
+		return &wrapScanPlan[web.URL]{adapt: urlTarget}, urlTarget(target), true
+	case *geography.CountryCode:
+		return &wrapScanPlan[geography.CountryCode]{adapt: countryTarget},
+			countryTarget(target), true
+	case *identifier.IntIdentifier:
+		return &wrapScanPlan[identifier.IntIdentifier]{adapt: identifierTarget},
+			identifierTarget(target), true
+	case *web.IPAddress:
+		return &wrapScanPlan[web.IPAddress]{adapt: ipTarget}, ipTarget(target), true
+	case *finance.Money:
+		return &wrapScanPlan[finance.Money]{adapt: moneyTarget}, moneyTarget(target), true
+	default:
+		return nil, nil, false
+	}
+}
+
+// wrapEncodePlan encodes a value object through the plan of its adapter
+type wrapEncodePlan[T any] struct {
+	next  pgtype.EncodePlan
+	adapt func(T) any
+}
+
+func (p *wrapEncodePlan[T]) SetNext(next pgtype.EncodePlan) {
+	p.next = next
+}
+
+func (p *wrapEncodePlan[T]) Encode(value any, buf []byte) ([]byte, error) {
+	return p.next.Encode(p.adapt(value.(T)), buf)
+}
+
+// wrapScanPlan scans into a value object through the plan of its adapter
+type wrapScanPlan[T any] struct {
+	next  pgtype.ScanPlan
+	adapt func(*T) any
+}
+
+func (p *wrapScanPlan[T]) SetNext(next pgtype.ScanPlan) {
+	p.next = next
+}
+
+func (p *wrapScanPlan[T]) Scan(src []byte, target any) error {
+	adapted := p.adapt(target.(*T))
+	if err := p.next.Scan(src, adapted); err != nil {
+		return err
+	}
+
+	if finisher, ok := adapted.(scanFinisher); ok {
+		return finisher.finishScan()
+	}
+	return nil
+}
+
+// scanFinisher is implemented by adapters that build the value object once every field of a
+// composite has been scanned
+type scanFinisher interface {
+	finishScan() error
+}
+
+// textValue is a value object in text form, implementing pgtype.TextValuer
+type textValue string
+
+func (v textValue) TextValue() (pgtype.Text, error) {
+	return pgtype.Text{String: string(v), Valid: v != ""}, nil
+}
+
+// textTarget parses text into a value object, implementing pgtype.TextScanner
+type textTarget[T any] struct {
+	target *T
+	parse  func(string) (T, error)
+}
+
+func (t textTarget[T]) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		var zero T
+		*t.target = zero
+		return nil
+	}
+
+	parsed, err := t.parse(v.String)
+	if err != nil {
+		return err
+	}
+
+	*t.target = parsed
+	return nil
+}
+
+func emailText(email web.Email) any {
+	return textValue(email.Value())
+}
+
+func emailTarget(email *web.Email) any {
+	return textTarget[web.Email]{target: email, parse: func(value string) (web.Email, error) {
+		return web.NewEmail(value)
+	}}
+}
+
+func urlText(url web.URL) any {
+	return textValue(url.Value())
+}
+
+func urlTarget(url *web.URL) any {
+	return textTarget[web.URL]{target: url, parse: func(value string) (web.URL, error) {
+		return web.NewURL(value)
+	}}
+}
+
+func countryText(country geography.CountryCode) any {
+	return textValue(country.Value())
+}
+
+func countryTarget(country *geography.CountryCode) any {
+	return textTarget[geography.CountryCode]{target: country, parse: geography.NewCountryCode}
+}
+
+// identifierValue is an integer identifier, implementing pgtype.Int64Valuer and
+// pgtype.Int64Scanner
+type identifierValue struct {
+	target *identifier.IntIdentifier
+}
+
+func identifierInt8(id identifier.IntIdentifier) any {
+	return identifierValue{target: &id}
+}
+
+func identifierTarget(id *identifier.IntIdentifier) any {
+	return identifierValue{target: id}
+}
+
+func (v identifierValue) Int64Value() (pgtype.Int8, error) {
+	value := v.target.Value()
+	if value > math.MaxInt64 {
+		return pgtype.Int8{}, ErrIdentifierOutOfRange
+	}
+	return pgtype.Int8{Int64: int64(value), Valid: value != 0}, nil
+}
+
+func (v identifierValue) ScanInt64(n pgtype.Int8) error {
+	if !n.Valid {
+		*v.target = identifier.IntIdentifier{}
+		return nil
+	}
+	if n.Int64 < 0 {
+		return ErrIdentifierOutOfRange
+	}
+
+	parsed, err := identifier.NewIntIdentifierFromInt(n.Int64)
+	if err != nil {
+		return err
+	}
+
+	*v.target = parsed
+	return nil
+}
+
+// inetValue is an IP address, implementing pgtype.NetipPrefixValuer and
+// pgtype.NetipPrefixScanner
+type inetValue struct {
+	target *web.IPAddress
+}
+
+func ipInet(address web.IPAddress) any {
+	return inetValue{target: &address}
+}
+
+func ipTarget(address *web.IPAddress) any {
+	return inetValue{target: address}
+}
+
+func (v inetValue) NetipPrefixValue() (netip.Prefix, error) {
+	if v.target.Value() == "" {
+		return netip.Prefix{}, nil
+	}
+
+	address, err := netip.ParseAddr(v.target.Value())
+	if err != nil {
+		return netip.Prefix{}, domain.NewErrorWithWrap(err, "invalid IP address")
+	}
+	return netip.PrefixFrom(address, address.BitLen()), nil
+}
+
+// ScanNetipPrefix scans an inet value, with validation. Prefixes other than the host prefix
+// are rejected as they denote networks rather than addresses.
+func (v inetValue) ScanNetipPrefix(prefix netip.Prefix) error {
+	if !prefix.IsValid() {
+		*v.target = web.IPAddress{}
+		return nil
+	}
+	if !prefix.IsSingleIP() {
+		return web.ErrInvalidIPAddress
+	}
+
+	parsed, err := web.NewIPAddress(prefix.Addr().String())
+	if err != nil {
+		return err
+	}
+
+	*v.target = parsed
+	return nil
+}
+
+// moneyValue is money as a money_value composite, implementing pgtype.CompositeIndexGetter
+// and pgtype.CompositeIndexScanner
+type moneyValue struct {
+	target   *finance.Money
+	null     bool
+	amount   pgtype.Numeric
+	currency pgtype.Text
+}
+
+func moneyComposite(money finance.Money) any {
+	return &moneyValue{target: &money}
+}
+
+func moneyTarget(money *finance.Money) any {
+	return &moneyValue{target: money}
+}
+
+func (v *moneyValue) IsNull() bool {
+	return v.target.Currency().Value() == ""
+}
+
+func (v *moneyValue) Index(i int) any {
+	switch i {
+	case 0:
+		amount := v.target.Amount()
+		return pgtype.Numeric{Int: amount.Coefficient(), Exp: amount.Exponent(), Valid: true}
+	case 1:
+		return v.target.Currency().Value()
+	default:
+		return nil
+	}
+}
+
+func (v *moneyValue) ScanNull() error {
+	v.null = true
+	*v.target = finance.Money{}
+	return nil
+}
+
+func (v *moneyValue) ScanIndex(i int) any {
+	switch i {
+	case 0:
+		return &v.amount
+	case 1:
+		return &v.currency
+	default:
+		return nil
+	}
+}
+
+// finishScan builds the money from the scanned amount and currency, with validation
+func (v *moneyValue) finishScan() error {
+	if v.null {
+		return nil
+	}
+	if !v.amount.Valid || v.amount.NaN || v.amount.InfinityModifier != pgtype.Finite {
+		return ErrInvalidMoneyAmount
+	}
+	if !v.currency.Valid {
+		return ErrInvalidComposite
+	}
+
+	currency, err := finance.NewCurrency(v.currency.String)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := finance.NewMoney(decimal.NewFromBigInt(v.amount.Int, v.amount.Exp), currency)
+	if err != nil {
+		return err
+	}
+
+	*v.target = parsed
+	return nil
+}
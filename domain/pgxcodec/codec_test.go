@@ -0,0 +1,181 @@
+package pgxcodec
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/suite"
+)
+
+// moneyTypeOID stands in for the OID Postgres assigns to the money_value type
+const moneyTypeOID = 100_000
+
+type CodecTestSuite struct {
+	suite.Suite
+	typeMap *pgtype.Map
+}
+
+func TestCodecSuite(t *testing.T) {
+	suite.Run(t, new(CodecTestSuite))
+}
+
+func (s *CodecTestSuite) SetupTest() {
+	s.typeMap = pgtype.NewMap()
+	numericType, _ := s.typeMap.TypeForName("numeric")
+	bpcharType, _ := s.typeMap.TypeForName("bpchar")
+	s.typeMap.RegisterType(&pgtype.Type{
+		Name: MoneyTypeName,
+		OID:  moneyTypeOID,
+		Codec: &pgtype.CompositeCodec{
+			Fields: []pgtype.CompositeCodecField{
+				{Name: "amount", Type: numericType},
+				{Name: "currency", Type: bpcharType},
+			},
+		},
+	})
+	Register(s.typeMap)
+}
+
+// roundTrip encodes the value and scans it back into target, in both wire formats
+func (s *CodecTestSuite) roundTrip(oid uint32, value any, target any, check func()) {
+	for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		encoded, err := s.typeMap.Encode(oid, format, value, nil)
+		s.Require().NoError(err)
+		s.Require().NoError(s.typeMap.Scan(oid, format, encoded, target))
+		check()
+	}
+}
+
+func (s *CodecTestSuite) TestItCanRoundTripTextValueObjects() {
+	email, err := web.NewEmail("jane@example.com")
+	s.Require().NoError(err)
+	var scannedEmail web.Email
+	s.roundTrip(pgtype.TextOID, email, &scannedEmail, func() {
+		s.True(email.Equals(scannedEmail))
+	})
+
+	url, err := web.NewURL("https://example.com/path")
+	s.Require().NoError(err)
+	var scannedURL web.URL
+	s.roundTrip(pgtype.VarcharOID, url, &scannedURL, func() {
+		s.Equal(url.Value(), scannedURL.Value())
+	})
+
+	country, err := geography.NewCountryCode("RO")
+	s.Require().NoError(err)
+	var scannedCountry geography.CountryCode
+	s.roundTrip(pgtype.BPCharOID, country, &scannedCountry, func() {
+		s.True(country.Equals(scannedCountry))
+	})
+}
+
+func (s *CodecTestSuite) TestItValidatesScannedText() {
+	var email web.Email
+	err := s.typeMap.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte("jane.example.com"), &email)
+	s.ErrorIs(err, web.ErrMissingAtSymbol)
+
+	var country geography.CountryCode
+	err = s.typeMap.Scan(pgtype.BPCharOID, pgtype.TextFormatCode, []byte("R1"), &country)
+	s.ErrorIs(err, geography.ErrInvalidCountryCode)
+}
+
+func (s *CodecTestSuite) TestItCanRoundTripIdentifiersAsBigint() {
+	id, err := identifier.NewIntIdentifier(42)
+	s.Require().NoError(err)
+
+	encoded, err := s.typeMap.Encode(pgtype.Int8OID, pgtype.TextFormatCode, id, nil)
+	s.Require().NoError(err)
+	s.Equal("42", string(encoded))
+
+	var scanned identifier.IntIdentifier
+	s.roundTrip(pgtype.Int8OID, id, &scanned, func() {
+		s.True(id.Equals(scanned))
+	})
+
+	_, err = s.typeMap.Encode(
+		pgtype.Int8OID, pgtype.BinaryFormatCode,
+		identifier.ReconstituteIntIdentifier(math.MaxUint64), nil,
+	)
+	s.ErrorIs(err, ErrIdentifierOutOfRange)
+
+	err = s.typeMap.Scan(pgtype.Int8OID, pgtype.TextFormatCode, []byte("-1"), &scanned)
+	s.ErrorIs(err, ErrIdentifierOutOfRange)
+}
+
+func (s *CodecTestSuite) TestItCanRoundTripIPAddressesAsInet() {
+	for _, value := range []string{"192.168.0.10", "2001:db8::1"} {
+		address, err := web.NewIPAddress(value)
+		s.Require().NoError(err)
+
+		var scanned web.IPAddress
+		s.roundTrip(pgtype.InetOID, address, &scanned, func() {
+			s.Equal(value, scanned.Value())
+		})
+	}
+
+	var scanned web.IPAddress
+	s.Require().NoError(
+		s.typeMap.Scan(pgtype.InetOID, pgtype.TextFormatCode, []byte("10.0.0.1/32"), &scanned),
+	)
+	s.Equal("10.0.0.1", scanned.Value())
+
+	err := s.typeMap.Scan(pgtype.InetOID, pgtype.TextFormatCode, []byte("10.0.0.0/8"), &scanned)
+	s.ErrorIs(err, web.ErrInvalidIPAddress)
+}
+
+func (s *CodecTestSuite) TestItCanRoundTripMoneyAsAComposite() {
+	price, err := finance.NewMoneyFromString("1234.5678", "EUR")
+	s.Require().NoError(err)
+
+	encoded, err := s.typeMap.Encode(moneyTypeOID, pgtype.TextFormatCode, price, nil)
+	s.Require().NoError(err)
+	s.Equal("(1234.5678,EUR)", string(encoded))
+
+	var scanned finance.Money
+	s.roundTrip(moneyTypeOID, price, &scanned, func() {
+		s.True(price.Equals(scanned))
+	})
+}
+
+func (s *CodecTestSuite) TestItValidatesScannedMoney() {
+	var scanned finance.Money
+	err := s.typeMap.Scan(moneyTypeOID, pgtype.TextFormatCode, []byte("(1.5,EURO)"), &scanned)
+	s.ErrorIs(err, finance.ErrInvalidCurrency)
+
+	err = s.typeMap.Scan(moneyTypeOID, pgtype.TextFormatCode, []byte("(NaN,EUR)"), &scanned)
+	s.ErrorIs(err, ErrInvalidMoneyAmount)
+
+	err = s.typeMap.Scan(moneyTypeOID, pgtype.TextFormatCode, []byte("(-1,EUR)"), &scanned)
+	s.ErrorIs(err, finance.ErrNegativeAmount)
+}
+
+func (s *CodecTestSuite) TestItMapsZeroValuesToNull() {
+	for _, value := range []any{
+		web.Email{},
+		web.URL{},
+		geography.CountryCode{},
+		identifier.IntIdentifier{},
+		web.IPAddress{},
+		finance.Money{},
+	} {
+		oid, ok := s.typeMap.TypeForValue(value)
+		s.Require().True(ok, "%T", value)
+
+		encoded, err := s.typeMap.Encode(oid.OID, pgtype.BinaryFormatCode, value, nil)
+		s.Require().NoError(err, "%T", value)
+		s.Nil(encoded, "%T", value)
+	}
+
+	email, _ := web.NewEmail("jane@example.com")
+	s.Require().NoError(s.typeMap.Scan(pgtype.TextOID, pgtype.TextFormatCode, nil, &email))
+	s.Equal(web.Email{}, email)
+
+	price, _ := finance.NewMoneyFromString("1", "EUR")
+	s.Require().NoError(s.typeMap.Scan(moneyTypeOID, pgtype.BinaryFormatCode, nil, &price))
+	s.Equal(finance.Money{}, price)
+}
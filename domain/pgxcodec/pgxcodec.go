@@ -0,0 +1,187 @@
+// Package pgxcodec maps value objects to native Postgres types for pgx v5 and database/sql.
+//
+// With pgx, call Register on a type map, or RegisterTypes on a connection (e.g., from
+// pgxpool.Config.AfterConnect), and use the value objects directly as query arguments and
+// scan targets:
+//
+//   - web.Email and web.URL as text
+//   - geography.CountryCode as text or char(2)
+//   - identifier.IntIdentifier as bigint
+//   - web.IPAddress as inet, accepting the host prefix ("/32" or "/128") Postgres adds when
+//     reading inet values
+//   - finance.Money as a composite of a numeric amount and a currency code; create the type
+//     once with MoneyTypeDDL
+//
+// For database/sql, the Money and IPAddress wrappers implement sql.Scanner and driver.Valuer
+// with the same mappings; scalar value objects map onto their column types through
+// gormx.Column.
+//
+// Zero values are stored as NULL, and NULL scans to the zero value. Every value is validated
+// when scanned.
+package pgxcodec
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/web"
+)
+
+// MoneyTypeDDL creates the composite type Money is stored as
+const MoneyTypeDDL = `CREATE TYPE ` + MoneyTypeName + ` AS (amount numeric, currency char(3))`
+
+var (
+	ErrUnsupportedScan  = domain.NewInternalError("unsupported source type for Postgres value")
+	ErrInvalidComposite = domain.NewError("money must be a composite of an amount and a currency")
+)
+
+// Money holds finance.Money in a money_value composite column
+type Money struct {
+	value finance.Money
+}
+
+// NewMoney wraps the money for a money_value composite column
+func NewMoney(value finance.Money) Money {
+	return Money{
+		value: value,
+	}
+}
+
+// Get returns the wrapped money
+func (m Money) Get() finance.Money {
+	return m.value
+}
+
+// Value implements driver.Valuer, returning the composite in text format, such as
+// "(12.50,EUR)"
+func (m Money) Value() (driver.Value, error) {
+	if m.value.Currency().Value() == "" {
+		return nil, nil
+	}
+	return fmt.Sprintf("(%s,%s)", m.value.Amount().String(), m.value.Currency().Value()), nil
+}
+
+// Scan implements sql.Scanner, parsing a composite in text format, with validation
+func (m *Money) Scan(src any) error {
+	text, isNull, err := scanText(src)
+	if err != nil || isNull {
+		*m = Money{}
+		return err
+	}
+
+	fields, ok := parseComposite(text)
+	if !ok || len(fields) != 2 {
+		return ErrInvalidComposite
+	}
+
+	parsed, err := finance.NewMoneyFromString(fields[0], strings.TrimSpace(fields[1]))
+	if err != nil {
+		return err
+	}
+
+	*m = NewMoney(parsed)
+	return nil
+}
+
+// IPAddress holds web.IPAddress in an inet column
+type IPAddress struct {
+	value web.IPAddress
+}
+
+// NewIPAddress wraps the IP address for an inet column
+func NewIPAddress(value web.IPAddress) IPAddress {
+	return IPAddress{
+		value: value,
+	}
+}
+
+// Get returns the wrapped IP address
+func (a IPAddress) Get() web.IPAddress {
+	return a.value
+}
+
+// Value implements driver.Valuer
+func (a IPAddress) Value() (driver.Value, error) {
+	if a.value.Value() == "" {
+		return nil, nil
+	}
+	return a.value.Value(), nil
+}
+
+// Scan implements sql.Scanner, with validation. Host prefixes are dropped, while other
+// prefixes are rejected as they denote networks rather than addresses.
+func (a *IPAddress) Scan(src any) error {
+	text, isNull, err := scanText(src)
+	if err != nil || isNull {
+		*a = IPAddress{}
+		return err
+	}
+
+	address, prefix, hasPrefix := strings.Cut(text, "/")
+	isHostPrefix := prefix == "32" && !strings.Contains(address, ":") ||
+		prefix == "128" && strings.Contains(address, ":")
+	if hasPrefix && isHostPrefix {
+		text = address
+	}
+
+	parsed, err := web.NewIPAddress(text)
+	if err != nil {
+		return err
+	}
+
+	*a = NewIPAddress(parsed)
+	return nil
+}
+
+// scanText reads a text-format source, reporting NULL
+func scanText(src any) (string, bool, error) {
+	switch src := src.(type) {
+	case nil:
+		return "", true, nil
+	case string:
+		return src, false, nil
+	case []byte:
+		return string(src), false, nil
+	default:
+		return "", false, fmt.Errorf("%w: %T", ErrUnsupportedScan, src)
+	}
+}
+
+// parseComposite splits a composite in Postgres text format into its fields. Fields may be
+// double-quoted, with "" or a backslash escaping quotes inside them.
+func parseComposite(text string) ([]string, bool) {
+	if len(text) < 2 || text[0] != '(' || text[len(text)-1] != ')' {
+		return nil, false
+	}
+
+	var fields []string
+	var field strings.Builder
+	inQuotes := false
+	body := text[1 : len(text)-1]
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case c == '\\' && i+1 < len(body):
+			i++
+			field.WriteByte(body[i])
+		case c == '"' && inQuotes && i+1 < len(body) && body[i+1] == '"':
+			i++
+			field.WriteByte('"')
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == ',' && !inQuotes:
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteByte(c)
+		}
+	}
+
+	if inQuotes {
+		return nil, false
+	}
+	return append(fields, field.String()), true
+}
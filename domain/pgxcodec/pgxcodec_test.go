@@ -0,0 +1,131 @@
+package pgxcodec
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type PgxCodecTestSuite struct {
+	suite.Suite
+}
+
+func TestPgxCodecSuite(t *testing.T) {
+	suite.Run(t, new(PgxCodecTestSuite))
+}
+
+func (s *PgxCodecTestSuite) TestItCanRoundTripMoneyComposites() {
+	price, err := finance.NewMoneyFromString("12.50", "EUR")
+	s.Require().NoError(err)
+
+	value, err := NewMoney(price).Value()
+	s.Require().NoError(err)
+	s.Equal("(12.5,EUR)", value)
+
+	var scanned Money
+	s.Require().NoError(scanned.Scan(value))
+	s.True(price.Equals(scanned.Get()))
+}
+
+func (s *PgxCodecTestSuite) TestItCanScanMoneyCompositesAsReturnedByPostgres() {
+	testCases := []struct {
+		name     string
+		src      any
+		expected string
+	}{
+		{"plain", "(3.75,RON)", "3.75 RON"},
+		{"bytes", []byte("(100,USD)"), "100 USD"},
+		{"quoted fields", `("1.5","EUR")`, "1.5 EUR"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var scanned Money
+			s.Require().NoError(scanned.Scan(tc.src))
+			s.Equal(tc.expected, scanned.Get().String())
+		})
+	}
+
+	var scanned Money
+	s.ErrorIs(scanned.Scan("(1.5,EURO)"), finance.ErrInvalidCurrency)
+}
+
+func (s *PgxCodecTestSuite) TestItUnescapesQuotedCompositeFields() {
+	fields, ok := parseComposite(`("a,b","say ""hi""",c\,d,)`)
+	s.Require().True(ok)
+	s.Equal([]string{"a,b", `say "hi"`, "c,d", ""}, fields)
+}
+
+func (s *PgxCodecTestSuite) TestItRejectsMalformedMoneyComposites() {
+	testCases := []struct {
+		name string
+		src  any
+	}{
+		{"not a composite", "12.50 EUR"},
+		{"single field", "(12.50)"},
+		{"three fields", "(12.50,EUR,x)"},
+		{"unterminated quote", `("12.50,EUR)`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var scanned Money
+			s.ErrorIs(scanned.Scan(tc.src), ErrInvalidComposite)
+		})
+	}
+
+	var scanned Money
+	s.ErrorIs(scanned.Scan(12.5), ErrUnsupportedScan)
+}
+
+func (s *PgxCodecTestSuite) TestItMapsZeroValuesToNull() {
+	value, err := Money{}.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	value, err = IPAddress{}.Value()
+	s.Require().NoError(err)
+	s.Nil(value)
+
+	eur, err := finance.NewMoneyFromString("1", "EUR")
+	s.Require().NoError(err)
+	price := NewMoney(eur)
+	s.Require().NoError(price.Scan(nil))
+	s.Empty(price.Get().Currency().Value())
+}
+
+func (s *PgxCodecTestSuite) TestItCanRoundTripInetAddresses() {
+	address, err := web.NewIPAddress("192.168.0.10")
+	s.Require().NoError(err)
+
+	value, err := NewIPAddress(address).Value()
+	s.Require().NoError(err)
+	s.Equal("192.168.0.10", value)
+
+	testCases := []struct {
+		name     string
+		src      any
+		expected string
+	}{
+		{"plain", "192.168.0.10", "192.168.0.10"},
+		{"IPv4 host prefix", "192.168.0.10/32", "192.168.0.10"},
+		{"IPv6 host prefix", []byte("2001:db8::1/128"), "2001:db8::1"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var scanned IPAddress
+			s.Require().NoError(scanned.Scan(tc.src))
+			s.Equal(tc.expected, scanned.Get().Value())
+		})
+	}
+}
+
+func (s *PgxCodecTestSuite) TestItRejectsInetNetworks() {
+	var scanned IPAddress
+	s.ErrorIs(scanned.Scan("192.168.0.0/24"), web.ErrInvalidIPAddress)
+	s.ErrorIs(scanned.Scan("2001:db8::1/32"), web.ErrInvalidIPAddress)
+	s.ErrorIs(scanned.Scan(int64(1)), ErrUnsupportedScan)
+}
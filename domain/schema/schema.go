@@ -0,0 +1,198 @@
+// Package schema provides a declarative way to map raw request fields onto value objects.
+// An aggregate lists its fields together with the value-object constructors to apply,
+// and a single Validate call produces either the typed values or a field-mapped
+// ValidationErrors describing every invalid field.
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrMissingField = domain.NewLocalizedError("schema.field.missing", nil, "field is required")
+	ErrUnknownField = domain.NewLocalizedError(
+		"schema.field.unknown", nil,
+		"field is not declared in the schema",
+	)
+	ErrFieldTypeMatch = domain.NewError("field value has a different type")
+)
+
+// FieldDefinition describes how a single raw field is turned into a value object
+type FieldDefinition struct {
+	name     string
+	optional bool
+	build    func(raw string) (any, error)
+}
+
+// Field declares a required field built with the given value-object constructor
+func Field[T any](name string, constructor func(string) (T, error)) FieldDefinition {
+	return FieldDefinition{
+		name:  name,
+		build: wrapConstructor(constructor),
+	}
+}
+
+// OptionalField declares a field that is skipped when missing or blank in the input
+func OptionalField[T any](name string, constructor func(string) (T, error)) FieldDefinition {
+	return FieldDefinition{
+		name:     name,
+		optional: true,
+		build:    wrapConstructor(constructor),
+	}
+}
+
+// Name returns the field name
+func (f FieldDefinition) Name() string {
+	return f.name
+}
+
+// IsOptional reports whether the field may be omitted
+func (f FieldDefinition) IsOptional() bool {
+	return f.optional
+}
+
+// Schema is an ordered list of field definitions for an aggregate
+type Schema struct {
+	fields []FieldDefinition
+}
+
+// New creates a new Schema from the given field definitions
+func New(fields ...FieldDefinition) Schema {
+	return Schema{
+		fields: append([]FieldDefinition(nil), fields...),
+	}
+}
+
+// Fields returns the field definitions in declaration order
+func (s Schema) Fields() []FieldDefinition {
+	return append([]FieldDefinition(nil), s.fields...)
+}
+
+// Validate builds every declared field from the raw input.
+// It returns the built values when all fields are valid; otherwise the returned error is
+// a ValidationErrors holding one FieldError per invalid field, in declaration order.
+func (s Schema) Validate(input map[string]string) (Values, error) {
+	values := make(Values, len(s.fields))
+	var errs ValidationErrors
+
+	for _, field := range s.fields {
+		raw, ok := input[field.name]
+		if !ok || strings.TrimSpace(raw) == "" {
+			if field.optional {
+				continue
+			}
+			errs = append(errs, &FieldError{Field: field.name, Err: ErrMissingField})
+			continue
+		}
+
+		value, err := field.build(raw)
+		if err != nil {
+			errs = append(errs, &FieldError{Field: field.name, Err: err})
+			continue
+		}
+		values[field.name] = value
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return values, nil
+}
+
+// Values holds the value objects built by Schema.Validate, keyed by field name
+type Values map[string]any
+
+// Has reports whether a value was built for the given field
+func (v Values) Has(field string) bool {
+	_, ok := v[field]
+	return ok
+}
+
+// Get returns the typed value built for the given field.
+// It returns ErrUnknownField if no value is present and ErrFieldTypeMatch if the value is
+// not of type T.
+func Get[T any](values Values, field string) (T, error) {
+	var zero T
+	raw, ok := values[field]
+	if !ok {
+		return zero, domain.NewErrorWithWrap(ErrUnknownField, "field %q", field)
+	}
+
+	typed, ok := raw.(T)
+	if !ok {
+		return zero, domain.NewErrorWithWrap(ErrFieldTypeMatch, "field %q", field)
+	}
+
+	return typed, nil
+}
+
+// FieldError associates a validation error with the field that produced it
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// Error returns the error message prefixed with the field name
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+// Unwrap returns the underlying validation error
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors is the list of field errors produced by Schema.Validate
+type ValidationErrors []*FieldError
+
+// Error returns all field errors joined by a semicolon
+func (v ValidationErrors) Error() string {
+	messages := make([]string, 0, len(v))
+	for _, fieldErr := range v {
+		messages = append(messages, fieldErr.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the field errors, enabling compatibility with errors.Is and errors.As
+func (v ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(v))
+	for _, fieldErr := range v {
+		errs = append(errs, fieldErr)
+	}
+	return errs
+}
+
+// For returns the error recorded for the given field, or nil if the field is valid
+func (v ValidationErrors) For(field string) error {
+	for _, fieldErr := range v {
+		if fieldErr.Field == field {
+			return fieldErr.Err
+		}
+	}
+	return nil
+}
+
+// ByField returns the field errors keyed by field name
+func (v ValidationErrors) ByField() map[string]error {
+	byField := make(map[string]error, len(v))
+	for _, fieldErr := range v {
+		byField[fieldErr.Field] = fieldErr.Err
+	}
+	return byField
+}
+
+// wrapConstructor adapts a typed value-object constructor to the untyped builder signature
+func wrapConstructor[T any](constructor func(string) (T, error)) func(string) (any, error) {
+	return func(raw string) (any, error) {
+		value, err := constructor(raw)
+		if err != nil {
+			return nil, err
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type SchemaTestSuite struct {
+	suite.Suite
+	schema Schema
+}
+
+func TestSchemaSuite(t *testing.T) {
+	suite.Run(t, new(SchemaTestSuite))
+}
+
+func (s *SchemaTestSuite) SetupTest() {
+	s.schema = New(
+		Field("email", web.NewEmail),
+		Field("country", geography.NewCountryCode),
+		OptionalField("website", web.NewURL),
+	)
+}
+
+func (s *SchemaTestSuite) TestItCanValidateAndReturnTypedValues() {
+	values, err := s.schema.Validate(
+		map[string]string{
+			"email":   " John@Example.com ",
+			"country": "ro",
+		},
+	)
+	s.NoError(err)
+
+	email, err := Get[web.Email](values, "email")
+	s.NoError(err)
+	s.Equal("john@example.com", email.Value())
+
+	country, err := Get[geography.CountryCode](values, "country")
+	s.NoError(err)
+	s.Equal("RO", country.Value())
+
+	s.False(values.Has("website"))
+	_, err = Get[web.URL](values, "website")
+	s.ErrorIs(err, ErrUnknownField)
+
+	_, err = Get[web.URL](values, "email")
+	s.ErrorIs(err, ErrFieldTypeMatch)
+}
+
+func (s *SchemaTestSuite) TestItCollectsFieldMappedErrors() {
+	_, err := s.schema.Validate(
+		map[string]string{
+			"email":   "not-an-email",
+			"website": "ftp://example.com",
+		},
+	)
+	s.Error(err)
+
+	var validationErrs ValidationErrors
+	s.True(errors.As(err, &validationErrs))
+	s.Len(validationErrs, 3)
+	s.ErrorIs(validationErrs.For("email"), web.ErrMissingAtSymbol)
+	s.ErrorIs(validationErrs.For("country"), ErrMissingField)
+	s.ErrorIs(validationErrs.For("website"), web.ErrInvalidURL)
+	s.Nil(validationErrs.For("unknown"))
+	s.Len(validationErrs.ByField(), 3)
+
+	s.ErrorIs(err, ErrMissingField)
+	var fieldErr *FieldError
+	s.True(errors.As(err, &fieldErr))
+	s.Equal("email", fieldErr.Field)
+	s.Contains(err.Error(), "country: field is required")
+}
+
+func (s *SchemaTestSuite) TestItExposesFieldDefinitions() {
+	fields := s.schema.Fields()
+	s.Len(fields, 3)
+	s.Equal("email", fields[0].Name())
+	s.False(fields[0].IsOptional())
+	s.True(fields[2].IsOptional())
+}
@@ -0,0 +1,165 @@
+package social
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golibry/go-common-domain/domain"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxHashtagLength is the maximum length of a hashtag without its '#', in characters
+const MaxHashtagLength = 100
+
+var (
+	ErrEmptyHashtag = domain.NewLocalizedError(
+		"social.hashtag.empty", nil,
+		"hashtag cannot be empty",
+	)
+	ErrTooLongHashtag = domain.NewLocalizedError(
+		"social.hashtag.too_long", domain.MessageParams{"max": MaxHashtagLength},
+		"hashtag cannot exceed %d characters",
+		MaxHashtagLength,
+	)
+	ErrInvalidHashtag = domain.NewLocalizedError(
+		"social.hashtag.invalid", nil,
+		"hashtag can only contain letters, digits and underscores, and cannot be only digits",
+	)
+)
+
+// Hashtag is a topic tag such as "#golang", stored without its '#'. Hashtags are case
+// insensitive, so they are normalized to lower case NFC form and "#GoLang" equals "#golang".
+type Hashtag struct {
+	value string
+}
+
+// NewHashtag creates a new instance of Hashtag with validation and normalization. The leading
+// '#' is optional. The tag may use letters and digits of any script, combining marks and
+// underscores, but not only digits, so "#2024" is rejected while "#2024vision" is accepted.
+func NewHashtag(value string) (Hashtag, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "#")
+	normalized := strings.ToLower(norm.NFC.String(value))
+	if err := IsValidHashtag(normalized); err != nil {
+		return Hashtag{}, err
+	}
+
+	return Hashtag{
+		value: normalized,
+	}, nil
+}
+
+// ReconstituteHashtag creates a new Hashtag instance without validation or normalization
+func ReconstituteHashtag(value string) Hashtag {
+	return Hashtag{
+		value: value,
+	}
+}
+
+// IsValidHashtag validates a hashtag given without its '#'
+func IsValidHashtag(value string) error {
+	if value == "" {
+		return ErrEmptyHashtag
+	}
+	if utf8.RuneCountInString(value) > MaxHashtagLength {
+		return ErrTooLongHashtag
+	}
+
+	onlyDigits := true
+	for _, r := range value {
+		if !isHashtagRune(r) {
+			return ErrInvalidHashtag
+		}
+		onlyDigits = onlyDigits && unicode.IsDigit(r)
+	}
+	if onlyDigits {
+		return ErrInvalidHashtag
+	}
+
+	return nil
+}
+
+// ExtractHashtags returns the valid hashtags of a text in order of first appearance, without
+// duplicates. A '#' only starts a hashtag at the beginning of the text or after a character
+// that cannot be part of one, so "C#", "page#section" and "&#39;" are not hashtags.
+func ExtractHashtags(text string) []Hashtag {
+	var hashtags []Hashtag
+	for _, candidate := range extractTagged(text, '#', isHashtagRune, "&#") {
+		hashtag, err := NewHashtag(candidate)
+		if err != nil {
+			continue
+		}
+		if !slices.Contains(hashtags, hashtag) {
+			hashtags = append(hashtags, hashtag)
+		}
+	}
+	return hashtags
+}
+
+// Value returns the hashtag without its '#'
+func (h Hashtag) Value() string {
+	return h.value
+}
+
+// Equals compares two Hashtag objects for equality
+func (h Hashtag) Equals(other Hashtag) bool {
+	return h.value == other.value
+}
+
+// String returns the hashtag with its '#', e.g., "#golang"
+func (h Hashtag) String() string {
+	return "#" + h.value
+}
+
+// UnmarshalText parses the hashtag from text, with validation and normalization. Empty text
+// leaves the value unchanged.
+func (h *Hashtag) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewHashtag(string(text))
+	if err != nil {
+		return err
+	}
+
+	*h = parsed
+	return nil
+}
+
+// isHashtagRune reports whether r can be part of a hashtag
+func isHashtagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
+
+// extractTagged returns the runs of tag runes that follow each sigil starting a tag: one at
+// the beginning of the text or preceded by neither a tag rune nor one of the excluded
+// characters
+func extractTagged(
+	text string,
+	sigil rune,
+	isTagRune func(rune) bool,
+	excludedBefore string,
+) []string {
+	var candidates []string
+	previous := rune(-1)
+	for index, r := range text {
+		startsTag := r == sigil && (previous < 0 ||
+			(!isTagRune(previous) && !strings.ContainsRune(excludedBefore, previous)))
+		previous = r
+		if !startsTag {
+			continue
+		}
+
+		rest := text[index+utf8.RuneLen(sigil):]
+		end := strings.IndexFunc(rest, func(r rune) bool { return !isTagRune(r) })
+		if end < 0 {
+			end = len(rest)
+		}
+		if end > 0 {
+			candidates = append(candidates, rest[:end])
+		}
+	}
+	return candidates
+}
@@ -0,0 +1,110 @@
+package social
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HashtagTestSuite struct {
+	suite.Suite
+}
+
+func TestHashtagSuite(t *testing.T) {
+	suite.Run(t, new(HashtagTestSuite))
+}
+
+func (s *HashtagTestSuite) TestItCanBuildNewHashtagWithValidValues() {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"#golang", "golang"},
+		{"GoLang", "golang"},
+		{" #Throwback_Thursday ", "throwback_thursday"},
+		{"#2024vision", "2024vision"},
+		{"#日本語", "日本語"},
+		{"#Café", "café"},
+		{"#Cafe\u0301", "café"},
+		{"#_", "_"},
+		{"#" + strings.Repeat("a", MaxHashtagLength), strings.Repeat("a", MaxHashtagLength)},
+	}
+
+	for _, tc := range testCases {
+		hashtag, err := NewHashtag(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, hashtag.Value())
+		s.Equal("#"+tc.expected, hashtag.String())
+	}
+}
+
+func (s *HashtagTestSuite) TestItFailsToBuildNewHashtagWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyHashtag},
+		{"#", ErrEmptyHashtag},
+		{"  ", ErrEmptyHashtag},
+		{strings.Repeat("a", MaxHashtagLength+1), ErrTooLongHashtag},
+		{"#2024", ErrInvalidHashtag},
+		{"##golang", ErrInvalidHashtag},
+		{"#go lang", ErrInvalidHashtag},
+		{"#go-lang", ErrInvalidHashtag},
+		{"#golang!", ErrInvalidHashtag},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewHashtag(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *HashtagTestSuite) TestItExtractsHashtags() {
+	testCases := []struct {
+		text     string
+		expected []string
+	}{
+		{"#GoLang is fun! #golang #Go_Lang.", []string{"golang", "go_lang"}},
+		{"Learning C# and F#", nil},
+		{"See page#section and &#39;quoted&#39;", nil},
+		{"(#first),#second;#third", []string{"first", "second", "third"}},
+		{"#2024 was #2024vision", []string{"2024vision"}},
+		{"Ünïcödé #日本語 ##double #", []string{"日本語"}},
+		{"no tags here", nil},
+	}
+
+	for _, tc := range testCases {
+		var values []string
+		for _, hashtag := range ExtractHashtags(tc.text) {
+			values = append(values, hashtag.Value())
+		}
+		s.Equal(tc.expected, values, tc.text)
+	}
+}
+
+func (s *HashtagTestSuite) TestItCanReconstituteHashtag() {
+	hashtag := ReconstituteHashtag("Not Valid")
+	s.Equal("Not Valid", hashtag.Value())
+}
+
+func (s *HashtagTestSuite) TestItComparesHashtags() {
+	first, _ := NewHashtag("#GoLang")
+	second, _ := NewHashtag("golang")
+	third, _ := NewHashtag("#rust")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *HashtagTestSuite) TestItUnmarshalsText() {
+	var hashtag Hashtag
+	s.NoError(hashtag.UnmarshalText([]byte("#GoLang")))
+	s.Equal("golang", hashtag.Value())
+
+	s.NoError(hashtag.UnmarshalText(nil))
+	s.Equal("golang", hashtag.Value())
+
+	s.ErrorIs(hashtag.UnmarshalText([]byte("#123")), ErrInvalidHashtag)
+}
@@ -0,0 +1,122 @@
+package social
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MaxMentionLength is the maximum length of a mentioned username without its '@'
+const MaxMentionLength = 30
+
+var (
+	ErrEmptyMention = domain.NewLocalizedError(
+		"social.mention.empty", nil,
+		"mention cannot be empty",
+	)
+	ErrTooLongMention = domain.NewLocalizedError(
+		"social.mention.too_long", domain.MessageParams{"max": MaxMentionLength},
+		"mention cannot exceed %d characters",
+		MaxMentionLength,
+	)
+	ErrInvalidMention = domain.NewLocalizedError(
+		"social.mention.invalid", nil,
+		"mention can only contain letters, digits and underscores",
+	)
+)
+
+// Mention is a reference to a user such as "@jane_doe", stored without its '@'. Usernames are
+// case insensitive, so mentions are normalized to lower case.
+type Mention struct {
+	value string
+}
+
+// NewMention creates a new instance of Mention with validation and normalization. The leading
+// '@' is optional and the username may use ASCII letters, digits and underscores.
+func NewMention(value string) (Mention, error) {
+	normalized := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), "@"))
+	if err := IsValidMention(normalized); err != nil {
+		return Mention{}, err
+	}
+
+	return Mention{
+		value: normalized,
+	}, nil
+}
+
+// ReconstituteMention creates a new Mention instance without validation or normalization
+func ReconstituteMention(value string) Mention {
+	return Mention{
+		value: value,
+	}
+}
+
+// IsValidMention validates a mentioned username given without its '@'
+func IsValidMention(value string) error {
+	if value == "" {
+		return ErrEmptyMention
+	}
+	if len(value) > MaxMentionLength {
+		return ErrTooLongMention
+	}
+	for _, r := range value {
+		if !isMentionRune(r) {
+			return ErrInvalidMention
+		}
+	}
+	return nil
+}
+
+// ExtractMentions returns the valid mentions of a text in order of first appearance, without
+// duplicates. An '@' only starts a mention at the beginning of the text or after a character
+// that cannot be part of one, so the '@' of "jane@example.com" does not.
+func ExtractMentions(text string) []Mention {
+	var mentions []Mention
+	for _, candidate := range extractTagged(text, '@', isMentionRune, "@") {
+		mention, err := NewMention(candidate)
+		if err != nil {
+			continue
+		}
+		if !slices.Contains(mentions, mention) {
+			mentions = append(mentions, mention)
+		}
+	}
+	return mentions
+}
+
+// Value returns the mentioned username without its '@'
+func (m Mention) Value() string {
+	return m.value
+}
+
+// Equals compares two Mention objects for equality
+func (m Mention) Equals(other Mention) bool {
+	return m.value == other.value
+}
+
+// String returns the mention with its '@', e.g., "@jane_doe"
+func (m Mention) String() string {
+	return "@" + m.value
+}
+
+// UnmarshalText parses the mention from text, with validation and normalization. Empty text
+// leaves the value unchanged.
+func (m *Mention) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewMention(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// isMentionRune reports whether r can be part of a mentioned username
+func isMentionRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_'
+}
@@ -0,0 +1,102 @@
+package social
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MentionTestSuite struct {
+	suite.Suite
+}
+
+func TestMentionSuite(t *testing.T) {
+	suite.Run(t, new(MentionTestSuite))
+}
+
+func (s *MentionTestSuite) TestItCanBuildNewMentionWithValidValues() {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"@jane_doe", "jane_doe"},
+		{"Jane_Doe", "jane_doe"},
+		{" @user42 ", "user42"},
+		{"@" + strings.Repeat("a", MaxMentionLength), strings.Repeat("a", MaxMentionLength)},
+	}
+
+	for _, tc := range testCases {
+		mention, err := NewMention(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, mention.Value())
+		s.Equal("@"+tc.expected, mention.String())
+	}
+}
+
+func (s *MentionTestSuite) TestItFailsToBuildNewMentionWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyMention},
+		{"@", ErrEmptyMention},
+		{strings.Repeat("a", MaxMentionLength+1), ErrTooLongMention},
+		{"@jane.doe", ErrInvalidMention},
+		{"@jane doe", ErrInvalidMention},
+		{"@@jane", ErrInvalidMention},
+		{"@jöhn", ErrInvalidMention},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewMention(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *MentionTestSuite) TestItExtractsMentions() {
+	testCases := []struct {
+		text     string
+		expected []string
+	}{
+		{"Thanks @Jane_Doe and @bob, cc @jane_doe!", []string{"jane_doe", "bob"}},
+		{"Write to jane@example.com", nil},
+		{"(@first),@second:@third", []string{"first", "second", "third"}},
+		{"@@double @" + strings.Repeat("a", MaxMentionLength+1), nil},
+		{"@jane.doe", []string{"jane"}},
+		{"no mentions here", nil},
+	}
+
+	for _, tc := range testCases {
+		var values []string
+		for _, mention := range ExtractMentions(tc.text) {
+			values = append(values, mention.Value())
+		}
+		s.Equal(tc.expected, values, tc.text)
+	}
+}
+
+func (s *MentionTestSuite) TestItCanReconstituteMention() {
+	mention := ReconstituteMention("Not Valid")
+	s.Equal("Not Valid", mention.Value())
+}
+
+func (s *MentionTestSuite) TestItComparesMentions() {
+	first, _ := NewMention("@Jane")
+	second, _ := NewMention("jane")
+	third, _ := NewMention("@bob")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *MentionTestSuite) TestItUnmarshalsText() {
+	var mention Mention
+	s.NoError(mention.UnmarshalText([]byte("@Jane")))
+	s.Equal("jane", mention.Value())
+
+	s.NoError(mention.UnmarshalText(nil))
+	s.Equal("jane", mention.Value())
+
+	s.ErrorIs(mention.UnmarshalText([]byte("@jane!")), ErrInvalidMention)
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	// maxStackDepth limits the number of frames captured per error
+	maxStackDepth = 32
+	// callersSkip skips runtime.Callers, the capturing helper and the constructor calling it
+	callersSkip = 3
+)
+
+// captureStackTraces reports whether constructors capture a stack trace
+var captureStackTraces atomic.Bool
+
+// CaptureStackTraces turns stack trace capture on or off for errors created afterwards by the
+// Error constructors. It is off by default, since package-level sentinel errors gain nothing
+// from a trace and capturing one costs an allocation per error. Use WithStack to capture a
+// trace at a single call site instead.
+func CaptureStackTraces(enabled bool) {
+	captureStackTraces.Store(enabled)
+}
+
+// callers returns the program counters of the calling stack when capture is enabled
+func callers(skip int) []uintptr {
+	if !captureStackTraces.Load() {
+		return nil
+	}
+	return captureCallers(skip + 1)
+}
+
+func captureCallers(skip int) []uintptr {
+	pcs := make([]uintptr, maxStackDepth)
+	return pcs[:runtime.Callers(skip, pcs)]
+}
+
+// WithStack returns a copy of the error with a stack trace of the caller, regardless of
+// CaptureStackTraces. The copy still matches the original with errors.Is.
+func (e *Error) WithStack() *Error {
+	derived := e.derive()
+	derived.stack = captureCallers(callersSkip)
+	return derived
+}
+
+// Frames returns the stack trace captured when the error was created, without runtime
+// frames, or nil when none was captured
+func (e *Error) Frames() []runtime.Frame {
+	if len(e.stack) == 0 {
+		return nil
+	}
+
+	var frames []runtime.Frame
+	iterator := runtime.CallersFrames(e.stack)
+	for {
+		frame, more := iterator.Next()
+		if !strings.HasPrefix(frame.Function, "runtime.") {
+			frames = append(frames, frame)
+		}
+		if !more {
+			return frames
+		}
+	}
+}
+
+// Format implements fmt.Formatter. The %+v verb prints the message followed by the stack
+// trace of the error, or of the innermost wrapped domain error that has one; other verbs
+// print the message as Error does.
+func (e *Error) Format(state fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && state.Flag('+'):
+		_, _ = io.WriteString(state, e.Error())
+		for _, frame := range e.tracedFrames() {
+			_, _ = fmt.Fprintf(state, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+		}
+	case verb == 'q':
+		_, _ = fmt.Fprintf(state, "%q", e.Error())
+	default:
+		_, _ = io.WriteString(state, e.Error())
+	}
+}
+
+// tracedFrames returns the frames of the error, or of the innermost wrapped domain error
+// that captured a stack trace
+func (e *Error) tracedFrames() []runtime.Frame {
+	var traced *Error
+	for current := error(e); current != nil; current = unwrapOne(current) {
+		if domainErr, ok := current.(*Error); ok && len(domainErr.stack) > 0 {
+			traced = domainErr
+		}
+	}
+
+	if traced == nil {
+		return nil
+	}
+	return traced.Frames()
+}
+
+// unwrapOne returns the single error wrapped by err, or nil
+func unwrapOne(err error) error {
+	wrapper, ok := err.(interface{ Unwrap() error })
+	if !ok {
+		return nil
+	}
+	return wrapper.Unwrap()
+}
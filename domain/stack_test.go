@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StackTestSuite struct {
+	suite.Suite
+}
+
+func TestStackSuite(t *testing.T) {
+	suite.Run(t, new(StackTestSuite))
+}
+
+func (s *StackTestSuite) TearDownTest() {
+	CaptureStackTraces(false)
+}
+
+func (s *StackTestSuite) TestItCapturesNoStackByDefault() {
+	err := NewError("plain")
+	s.Nil(err.Frames())
+	s.Equal("plain", fmt.Sprintf("%+v", err))
+}
+
+func (s *StackTestSuite) TestItCapturesTheCallerWhenEnabled() {
+	CaptureStackTraces(true)
+
+	constructors := map[string]func() *Error{
+		"NewError":           func() *Error { return NewError("failed") },
+		"NewErrorWithWrap":   func() *Error { return NewErrorWithWrap(errInvalidCode, "failed") },
+		"NewLocalizedError":  func() *Error { return NewLocalizedError("key", nil, "failed") },
+		"NewNotFoundError":   func() *Error { return NewNotFoundError("failed") },
+		"NewInvariantError":  func() *Error { return NewInvariantError("failed") },
+		"NewForbiddenError":  func() *Error { return NewForbiddenError("failed") },
+		"NewConflictError":   func() *Error { return NewConflictError("failed") },
+		"WithStack on error": func() *Error { return NewError("failed").WithStack() },
+	}
+
+	for name, construct := range constructors {
+		s.Run(name, func() {
+			frames := construct().Frames()
+			s.Require().NotEmpty(frames)
+			s.Contains(frames[0].Function, "TestItCapturesTheCallerWhenEnabled.func")
+			s.True(strings.HasSuffix(frames[0].File, "stack_test.go"))
+			for _, frame := range frames {
+				s.False(strings.HasPrefix(frame.Function, "runtime."))
+			}
+		})
+	}
+}
+
+func (s *StackTestSuite) TestWithStackCapturesWithoutTheGlobalSwitch() {
+	sentinel := NewError("sentinel")
+
+	traced := sentinel.WithStack()
+	s.ErrorIs(traced, sentinel)
+	s.NotEmpty(traced.Frames())
+	s.Nil(sentinel.Frames())
+}
+
+func (s *StackTestSuite) TestPlusVPrintsTheInnermostTrace() {
+	inner := NewError("inner").WithStack()
+	outer := NewErrorWithWrap(fmt.Errorf("middle: %w", inner), "outer")
+
+	formatted := fmt.Sprintf("%+v", outer)
+	lines := strings.Split(formatted, "\n")
+	s.Equal("outer: middle: inner", lines[0])
+	s.Contains(lines[1], "TestPlusVPrintsTheInnermostTrace")
+	s.Contains(lines[2], "stack_test.go:")
+
+	s.Equal("outer: middle: inner", fmt.Sprintf("%v", outer))
+	s.Equal("outer: middle: inner", fmt.Sprintf("%s", outer))
+	s.Equal(`"outer: middle: inner"`, fmt.Sprintf("%q", outer))
+}
@@ -0,0 +1,126 @@
+package testkit
+
+import (
+	"time"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/person"
+)
+
+// FullNameBuilder builds a FullName starting from valid defaults, so a test only spells out
+// the parts it cares about
+type FullNameBuilder struct {
+	prefix, firstName, middleName, lastName, suffix string
+}
+
+// AFullName starts a FullNameBuilder for "Jane Doe"
+func AFullName() FullNameBuilder {
+	return FullNameBuilder{firstName: "Jane", lastName: "Doe"}
+}
+
+// WithPrefix sets the honorific prefix (e.g., "Dr.")
+func (b FullNameBuilder) WithPrefix(prefix string) FullNameBuilder {
+	b.prefix = prefix
+	return b
+}
+
+// WithFirstName sets the first name
+func (b FullNameBuilder) WithFirstName(firstName string) FullNameBuilder {
+	b.firstName = firstName
+	return b
+}
+
+// WithMiddleName sets the middle name
+func (b FullNameBuilder) WithMiddleName(middleName string) FullNameBuilder {
+	b.middleName = middleName
+	return b
+}
+
+// WithLastName sets the last name
+func (b FullNameBuilder) WithLastName(lastName string) FullNameBuilder {
+	b.lastName = lastName
+	return b
+}
+
+// WithSuffix sets the suffix (e.g., "Jr.")
+func (b FullNameBuilder) WithSuffix(suffix string) FullNameBuilder {
+	b.suffix = suffix
+	return b
+}
+
+// Build creates the FullName, panicking when the parts are invalid
+func (b FullNameBuilder) Build() person.FullName {
+	return Must(
+		person.NewFullNameWithAffixes(b.prefix, b.firstName, b.middleName, b.lastName, b.suffix),
+	)
+}
+
+// MoneyBuilder builds Money starting from valid defaults
+type MoneyBuilder struct {
+	amount, currency string
+}
+
+// SomeMoney starts a MoneyBuilder for 100.00 USD
+func SomeMoney() MoneyBuilder {
+	return MoneyBuilder{amount: "100.00", currency: "USD"}
+}
+
+// WithAmount sets the decimal amount (e.g., "10.50")
+func (b MoneyBuilder) WithAmount(amount string) MoneyBuilder {
+	b.amount = amount
+	return b
+}
+
+// WithCurrency sets the ISO 4217 currency code
+func (b MoneyBuilder) WithCurrency(currency string) MoneyBuilder {
+	b.currency = currency
+	return b
+}
+
+// Build creates the Money, panicking when the amount or currency is invalid
+func (b MoneyBuilder) Build() finance.Money {
+	return MustMoney(b.amount, b.currency)
+}
+
+// ExpiringTokenBuilder builds an ExpiringToken starting from a random token issued at a fixed
+// instant and valid for an hour
+type ExpiringTokenBuilder struct {
+	token     auth.Token
+	issuedAt  time.Time
+	expiresAt time.Time
+}
+
+// AnExpiringToken starts an ExpiringTokenBuilder with a token from RandomToken issued at
+// FixedTime
+func AnExpiringToken() ExpiringTokenBuilder {
+	return ExpiringTokenBuilder{
+		token:     RandomToken(),
+		issuedAt:  FixedTime,
+		expiresAt: FixedTime.Add(time.Hour),
+	}
+}
+
+// WithToken sets the token value
+func (b ExpiringTokenBuilder) WithToken(token auth.Token) ExpiringTokenBuilder {
+	b.token = token
+	return b
+}
+
+// IssuedAt sets the issue time, keeping the lifetime unchanged
+func (b ExpiringTokenBuilder) IssuedAt(issuedAt time.Time) ExpiringTokenBuilder {
+	b.expiresAt = issuedAt.Add(b.expiresAt.Sub(b.issuedAt))
+	b.issuedAt = issuedAt
+	return b
+}
+
+// ValidFor sets the lifetime counted from the issue time
+func (b ExpiringTokenBuilder) ValidFor(lifetime time.Duration) ExpiringTokenBuilder {
+	b.expiresAt = b.issuedAt.Add(lifetime)
+	return b
+}
+
+// Build creates the ExpiringToken, panicking when the times are invalid
+func (b ExpiringTokenBuilder) Build() auth.ExpiringToken {
+	return Must(auth.NewExpiringToken(b.token, b.issuedAt, b.expiresAt))
+}
@@ -0,0 +1,65 @@
+package testkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BuildersTestSuite struct {
+	suite.Suite
+}
+
+func TestBuildersSuite(t *testing.T) {
+	suite.Run(t, new(BuildersTestSuite))
+}
+
+func (s *BuildersTestSuite) TestFullNameBuilder() {
+	s.Equal("Jane Doe", AFullName().Build().String())
+
+	name := AFullName().
+		WithPrefix("Dr.").
+		WithFirstName("John").
+		WithMiddleName("Q").
+		WithLastName("Public").
+		WithSuffix("Jr.").
+		Build()
+	s.Equal("Dr.", name.Prefix())
+	s.Equal("John", name.FirstName())
+	s.Equal("Q", name.MiddleName())
+	s.Equal("Public", name.LastName())
+	s.Equal("Jr.", name.Suffix())
+
+	s.Panics(func() { AFullName().WithLastName("").Build() })
+}
+
+func (s *BuildersTestSuite) TestBuildersDoNotShareState() {
+	base := AFullName()
+	_ = base.WithFirstName("John")
+	s.Equal("Jane", base.Build().FirstName())
+}
+
+func (s *BuildersTestSuite) TestMoneyBuilder() {
+	s.True(SomeMoney().Build().Equals(MustMoney("100", "USD")))
+	s.True(
+		SomeMoney().WithAmount("5.25").WithCurrency("EUR").Build().
+			Equals(MustMoney("5.25", "EUR")),
+	)
+	s.Panics(func() { SomeMoney().WithCurrency("XX").Build() })
+}
+
+func (s *BuildersTestSuite) TestExpiringTokenBuilder() {
+	token := AnExpiringToken().Build()
+	s.Equal(FixedTime, token.IssuedAt())
+	s.Equal(FixedTime.Add(time.Hour), token.ExpiresAt())
+
+	issuedAt := FixedTime.Add(24 * time.Hour)
+	moved := AnExpiringToken().WithToken(RandomToken()).IssuedAt(issuedAt).Build()
+	s.Equal(issuedAt.Add(time.Hour), moved.ExpiresAt())
+
+	short := AnExpiringToken().ValidFor(time.Minute).Build()
+	s.Equal(FixedTime.Add(time.Minute), short.ExpiresAt())
+
+	s.Panics(func() { AnExpiringToken().ValidFor(-time.Minute).Build() })
+}
@@ -0,0 +1,98 @@
+package testkit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/identifier"
+)
+
+var ErrNoQueuedValues = domain.NewError("fake generator has no queued values left")
+
+// FixedTime is the instant fakes and builders start from unless told otherwise
+var FixedTime = time.Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+var (
+	_ auth.Clock                            = (*FakeClock)(nil)
+	_ identifier.Generator[identifier.UUID] = (*FakeGenerator[identifier.UUID])(nil)
+)
+
+// FakeClock is an auth.Clock that only moves when told to. It is safe for concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a FakeClock stopped at now
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current fake time
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set moves the clock to now
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// FakeGenerator is an identifier.Generator returning queued values in order, so tests can
+// assert on the identifiers an aggregate receives. Once the queue is empty, Next returns
+// ErrNoQueuedValues, or the error set with FailWith.
+type FakeGenerator[T any] struct {
+	mu     sync.Mutex
+	values []T
+	err    error
+}
+
+// NewFakeGenerator creates a FakeGenerator returning values in order
+func NewFakeGenerator[T any](values ...T) *FakeGenerator[T] {
+	return &FakeGenerator[T]{values: values}
+}
+
+// Queue appends values to those returned by Next
+func (g *FakeGenerator[T]) Queue(values ...T) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values = append(g.values, values...)
+}
+
+// FailWith makes Next return err once the queued values are used up
+func (g *FakeGenerator[T]) FailWith(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.err = err
+}
+
+// Next returns the next queued value
+func (g *FakeGenerator[T]) Next() (T, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var zero T
+	if len(g.values) == 0 {
+		if g.err != nil {
+			return zero, g.err
+		}
+		return zero, ErrNoQueuedValues
+	}
+
+	value := g.values[0]
+	g.values = g.values[1:]
+	return value, nil
+}
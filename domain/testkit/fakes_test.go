@@ -0,0 +1,66 @@
+package testkit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/stretchr/testify/suite"
+)
+
+type FakesTestSuite struct {
+	suite.Suite
+}
+
+func TestFakesSuite(t *testing.T) {
+	suite.Run(t, new(FakesTestSuite))
+}
+
+func (s *FakesTestSuite) TestFakeClockOnlyMovesWhenTold() {
+	clock := NewFakeClock(FixedTime)
+	s.Equal(FixedTime, clock.Now())
+
+	clock.Advance(time.Minute)
+	s.Equal(FixedTime.Add(time.Minute), clock.Now())
+
+	later := FixedTime.Add(48 * time.Hour)
+	clock.Set(later)
+	s.Equal(later, clock.Now())
+}
+
+func (s *FakesTestSuite) TestFakeClockDrivesTokenExpiration() {
+	clock := NewFakeClock(FixedTime)
+	token, err := auth.GenerateExpiringToken(clock, time.Hour)
+	s.Require().NoError(err)
+
+	s.False(token.IsExpired(clock))
+	clock.Advance(time.Hour)
+	s.True(token.IsExpired(clock))
+}
+
+func (s *FakesTestSuite) TestFakeGeneratorReturnsQueuedValuesInOrder() {
+	first, second := MustIntIdentifier(1), MustIntIdentifier(2)
+	var generator identifier.Generator[identifier.IntIdentifier] = NewFakeGenerator(first)
+	generator.(*FakeGenerator[identifier.IntIdentifier]).Queue(second)
+
+	value, err := generator.Next()
+	s.NoError(err)
+	s.True(value.Equals(first))
+
+	value, err = generator.Next()
+	s.NoError(err)
+	s.True(value.Equals(second))
+
+	_, err = generator.Next()
+	s.ErrorIs(err, ErrNoQueuedValues)
+}
+
+func (s *FakesTestSuite) TestFakeGeneratorFailsWithTheConfiguredError() {
+	generator := NewFakeGenerator[identifier.UUID]()
+	generator.FailWith(identifier.ErrSequenceExhausted)
+
+	_, err := generator.Next()
+	s.True(errors.Is(err, identifier.ErrSequenceExhausted))
+}
@@ -0,0 +1,80 @@
+package gen
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"pgregory.net/rapid"
+)
+
+const usernamePattern = `[a-z0-9][a-z0-9._-]{1,30}[a-z0-9]`
+
+// Username generates valid usernames
+func Username() *rapid.Generator[auth.Username] {
+	return build(mixedCase(padded(rapid.StringMatching(usernamePattern))), auth.NewUsername)
+}
+
+// InvalidUsername generates inputs rejected by auth.NewUsername
+func InvalidUsername() *rapid.Generator[string] {
+	raw := rapid.StringMatching(usernamePattern)
+	return invalid(
+		[]string{"ab", ".jane", "jane.", "-jane", "jane_", "jane doe", "jane@doe", "jäne"},
+		rapid.Map(raw, func(s string) string { return "_" + s }),
+		rapid.Map(raw, func(s string) string { return s + "!" }),
+		rapid.Map(
+			raw,
+			func(s string) string { return s + strings.Repeat("a", auth.MaxUsernameLength) },
+		),
+	)
+}
+
+// PasswordPlaintext generates plaintext passwords accepted by auth.NewPassword. Plaintexts are
+// generated instead of Password values, since hashing each one would slow properties down.
+func PasswordPlaintext() *rapid.Generator[string] {
+	return rapid.Custom(
+		func(t *rapid.T) string {
+			classes := rapid.StringMatching(`[A-Z]{2,4}`).Draw(t, "upper") +
+				rapid.StringMatching(`[a-z]{3,8}`).Draw(t, "lower") +
+				rapid.StringMatching(`[0-9]{2,4}`).Draw(t, "digits") +
+				rapid.StringMatching(`[!@#$%^&*?~]{1,3}`).Draw(t, "special")
+			shuffled := rapid.Permutation([]rune(classes)).Draw(t, "shuffled")
+			return string(shuffled)
+		},
+	).Filter(func(plaintext string) bool { return auth.ValidatePassword(plaintext) == nil })
+}
+
+// InvalidPasswordPlaintext generates plaintext passwords rejected by auth.NewPassword
+func InvalidPasswordPlaintext() *rapid.Generator[string] {
+	return invalid(
+		[]string{
+			"Ab1!", "password", "PASSWORD1!", "password1!", "Password!!", "Password1",
+			"Aa1!\x00xyzw", strings.Repeat("Aa1!", auth.MaxPasswordLength/4+1),
+		},
+		rapid.StringMatching(`[a-z]{8,20}`),
+		rapid.StringMatching(`[A-Za-z]{8,20}`),
+	)
+}
+
+// Token generates valid opaque tokens in the base64url alphabet
+func Token() *rapid.Generator[auth.Token] {
+	raw := rapid.Map(
+		rapid.SliceOfN(rapid.Byte(), auth.MinTokenBytes, auth.DefaultTokenBytes),
+		base64.RawURLEncoding.EncodeToString,
+	)
+	return build(raw, auth.NewToken)
+}
+
+// InvalidToken generates inputs rejected by auth.NewToken
+func InvalidToken() *rapid.Generator[string] {
+	return invalid(
+		[]string{
+			"abc+def", "abc/def", "abc=", "abc def", strings.Repeat("a", auth.MinTokenLength-1),
+			strings.Repeat("a", auth.MaxTokenLength+1),
+		},
+		rapid.Map(
+			rapid.SliceOfN(rapid.Byte(), 3, 30),
+			func(raw []byte) string { return base64.StdEncoding.EncodeToString(raw) + "=" },
+		),
+	)
+}
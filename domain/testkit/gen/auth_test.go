@@ -0,0 +1,41 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/stretchr/testify/suite"
+	"pgregory.net/rapid"
+)
+
+type AuthTestSuite struct {
+	suite.Suite
+}
+
+func TestAuthSuite(t *testing.T) {
+	suite.Run(t, new(AuthTestSuite))
+}
+
+func (s *AuthTestSuite) TestValidGeneratorsProduceStableValues() {
+	checkNormalizationIsStable(s.T(), Username(), auth.Username.Value, auth.NewUsername)
+	checkNormalizationIsStable(s.T(), Token(), auth.Token.Value, auth.NewToken)
+
+	rapid.Check(
+		s.T(), func(t *rapid.T) {
+			plaintext := PasswordPlaintext().Draw(t, "plaintext")
+			if err := auth.ValidatePassword(plaintext); err != nil {
+				t.Fatalf("password %q was rejected: %v", plaintext, err)
+			}
+		},
+	)
+}
+
+func (s *AuthTestSuite) TestInvalidGeneratorsProduceRejectedInputs() {
+	checkRejected(s.T(), InvalidUsername(), auth.NewUsername)
+	checkRejected(s.T(), InvalidToken(), auth.NewToken)
+	checkRejected(
+		s.T(), InvalidPasswordPlaintext(), func(plaintext string) (struct{}, error) {
+			return struct{}{}, auth.ValidatePassword(plaintext)
+		},
+	)
+}
@@ -0,0 +1,209 @@
+package gen
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/shopspring/decimal"
+	"pgregory.net/rapid"
+)
+
+const (
+	isinBodyPattern  = `[A-Z]{2}[A-Z0-9]{9}`
+	cusipBodyPattern = `[A-Z0-9*@#]{8}`
+	digits           = "0123456789"
+)
+
+// vatBodies maps VAT prefixes to the national number formats generated for them; the check
+// digit of the countries in vatCheckDigit is appended separately
+var (
+	vatBodies = map[string]string{
+		"BG": `[0-9]{9,10}`,
+		"CY": `[0-9]{8}[A-Z]`,
+		"CZ": `[0-9]{8,10}`,
+		"DE": `[0-9]{8}`,
+		"EE": `[0-9]{9}`,
+		"EL": `[0-9]{9}`,
+		"ES": `[A-Z0-9][0-9]{7}[A-Z0-9]`,
+		"HU": `[0-9]{8}`,
+		"IT": `[0-9]{10}`,
+		"NL": `[0-9]{9}B[0-9]{2}`,
+		"RO": `[1-9][0-9]{1,9}`,
+		"SE": `[0-9]{10}01`,
+		"GB": `[0-9]{9}|[0-9]{12}`,
+	}
+	vatCheckDigit = map[string]bool{"DE": true, "IT": true}
+)
+
+// Currency generates valid ISO 4217 currency codes
+func Currency() *rapid.Generator[finance.Currency] {
+	return build(mixedCase(padded(rapid.StringMatching(`[A-Z]{3}`))), finance.NewCurrency)
+}
+
+// InvalidCurrency generates inputs rejected by finance.NewCurrency
+func InvalidCurrency() *rapid.Generator[string] {
+	return invalid(
+		[]string{"US", "EURO", "U$D", "12E", "€"},
+		rapid.StringMatching(`[A-Z]{4,6}`),
+	)
+}
+
+// Money generates valid amounts, with as many decimal places as the currency's minor units,
+// in the currencies drawn by Currency
+func Money() *rapid.Generator[finance.Money] {
+	return rapid.Custom(
+		func(t *rapid.T) finance.Money {
+			currency := Currency().Draw(t, "currency")
+			minorUnits := rapid.Int64Range(0, 1_000_000_000_000).Draw(t, "minorUnits")
+			amount := decimal.New(minorUnits, -currency.MinorUnits())
+
+			money, err := finance.NewMoney(amount, currency)
+			if err != nil {
+				t.Fatalf("gen: valid money %s was rejected: %v", amount, err)
+			}
+			return money
+		},
+	)
+}
+
+// InvalidAmount generates amounts rejected by finance.NewMoneyFromString
+func InvalidAmount() *rapid.Generator[string] {
+	return invalid(
+		[]string{"-0.01", "abc", "1,000.00", "1e", "NaN", "Infinity", "$10"},
+		rapid.Map(
+			rapid.Int64Range(1, 1_000_000),
+			func(cents int64) string { return decimal.New(-cents, -2).String() },
+		),
+	)
+}
+
+// InterestRate generates valid annual interest rates with up to four decimal places
+func InterestRate() *rapid.Generator[finance.InterestRate] {
+	return rapid.Custom(
+		func(t *rapid.T) finance.InterestRate {
+			maxBasisPoints := int64(finance.MaxInterestRatePercent) * 10_000
+			percent := decimal.New(rapid.Int64Range(0, maxBasisPoints).Draw(t, "rate"), -4)
+
+			rate, err := finance.NewInterestRate(percent)
+			if err != nil {
+				t.Fatalf("gen: valid interest rate %s was rejected: %v", percent, err)
+			}
+			return rate
+		},
+	)
+}
+
+// InvalidInterestRate generates annual percentages rejected by finance.NewInterestRateFromString
+func InvalidInterestRate() *rapid.Generator[string] {
+	return invalid(
+		[]string{"-0.0001", "1000.0001", "4.5%", "four"},
+		rapid.Map(
+			rapid.Int64Range(finance.MaxInterestRatePercent+1, 1_000_000),
+			func(percent int64) string { return decimal.NewFromInt(percent).String() },
+		),
+	)
+}
+
+// ISIN generates valid International Securities Identification Numbers
+func ISIN() *rapid.Generator[finance.ISIN] {
+	return build(mixedCase(padded(isinString())), finance.NewISIN)
+}
+
+// InvalidISIN generates inputs rejected by finance.NewISIN, including valid ISINs with a
+// wrong check digit
+func InvalidISIN() *rapid.Generator[string] {
+	return invalid(
+		[]string{"US037833100", "US03783310055", "1S0378331005", "US037833100X"},
+		replaceLast(isinString(), digits),
+	)
+}
+
+// CUSIP generates valid CUSIP numbers
+func CUSIP() *rapid.Generator[finance.CUSIP] {
+	return build(mixedCase(padded(cusipString())), finance.NewCUSIP)
+}
+
+// InvalidCUSIP generates inputs rejected by finance.NewCUSIP, including valid CUSIPs with a
+// wrong check digit
+func InvalidCUSIP() *rapid.Generator[string] {
+	return invalid(
+		[]string{"03783310", "0378331000", "03783310X", "0378331!0"},
+		replaceLast(cusipString(), digits),
+	)
+}
+
+// AccountNumber generates valid bank account numbers
+func AccountNumber() *rapid.Generator[finance.AccountNumber] {
+	return build(
+		mixedCase(padded(rapid.StringMatching(`[A-Z0-9]{4,34}`))),
+		finance.NewAccountNumber,
+	)
+}
+
+// InvalidAccountNumber generates inputs rejected by finance.NewAccountNumber
+func InvalidAccountNumber() *rapid.Generator[string] {
+	return invalid(
+		[]string{"123", "12$45", "1234.5678", "ÄBC1234", strings.Repeat("1", 35)},
+		rapid.StringMatching(`[A-Z0-9]{35,40}`),
+	)
+}
+
+// RoutingNumber generates valid bank routing numbers
+func RoutingNumber() *rapid.Generator[finance.RoutingNumber] {
+	return build(padded(rapid.StringMatching(`[0-9]{4,15}`)), finance.NewRoutingNumber)
+}
+
+// InvalidRoutingNumber generates inputs rejected by finance.NewRoutingNumber
+func InvalidRoutingNumber() *rapid.Generator[string] {
+	return invalid(
+		[]string{"123", "12A45", "1234567890123456", "1234/5678"},
+		rapid.StringMatching(`[0-9]{16,20}`),
+	)
+}
+
+// VATNumber generates valid VAT numbers for a sample of member states, including
+// check-digit-protected German and Italian numbers
+func VATNumber() *rapid.Generator[finance.VATNumber] {
+	prefixes := slices.Sorted(maps.Keys(vatBodies))
+
+	raw := rapid.Custom(
+		func(t *rapid.T) string {
+			prefix := rapid.SampledFrom(prefixes).Draw(t, "prefix")
+			body := rapid.StringMatching(`(` + vatBodies[prefix] + `)`)
+			if !vatCheckDigit[prefix] {
+				return prefix + body.Draw(t, "number")
+			}
+
+			valid := func(s string) bool { return finance.IsValidVATNumber(s) == nil }
+			prefixed := rapid.Map(body, func(number string) string { return prefix + number })
+			return withCheckCharacter(prefixed, digits, valid).Draw(t, "number")
+		},
+	)
+	return build(mixedCase(padded(raw)), finance.NewVATNumber)
+}
+
+// InvalidVATNumber generates inputs rejected by finance.NewVATNumber
+func InvalidVATNumber() *rapid.Generator[string] {
+	return invalid(
+		[]string{"US123456789", "DE12345678", "DE136695977", "ATU1234567", "NL123456789A01", "XX1"},
+		rapid.StringMatching(`DE[0-9]{10,12}`),
+	)
+}
+
+func isinString() *rapid.Generator[string] {
+	return withCheckCharacter(
+		rapid.StringMatching(isinBodyPattern),
+		digits,
+		func(s string) bool { return finance.IsValidISIN(s) == nil },
+	)
+}
+
+func cusipString() *rapid.Generator[string] {
+	return withCheckCharacter(
+		rapid.StringMatching(cusipBodyPattern),
+		digits,
+		func(s string) bool { return finance.IsValidCUSIP(s) == nil },
+	)
+}
@@ -0,0 +1,51 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/stretchr/testify/suite"
+)
+
+type FinanceTestSuite struct {
+	suite.Suite
+}
+
+func TestFinanceSuite(t *testing.T) {
+	suite.Run(t, new(FinanceTestSuite))
+}
+
+func (s *FinanceTestSuite) TestValidGeneratorsProduceStableValues() {
+	checkNormalizationIsStable(s.T(), Currency(), finance.Currency.Value, finance.NewCurrency)
+	checkNormalizationIsStable(s.T(), Money(), finance.Money.String, finance.ParseMoney)
+	checkNormalizationIsStable(
+		s.T(),
+		InterestRate(),
+		func(rate finance.InterestRate) string { return rate.AnnualPercent().String() },
+		finance.NewInterestRateFromString,
+	)
+	checkNormalizationIsStable(s.T(), ISIN(), finance.ISIN.Value, finance.NewISIN)
+	checkNormalizationIsStable(s.T(), CUSIP(), finance.CUSIP.Value, finance.NewCUSIP)
+	checkNormalizationIsStable(
+		s.T(), AccountNumber(), finance.AccountNumber.Value, finance.NewAccountNumber,
+	)
+	checkNormalizationIsStable(
+		s.T(), RoutingNumber(), finance.RoutingNumber.Value, finance.NewRoutingNumber,
+	)
+	checkNormalizationIsStable(s.T(), VATNumber(), finance.VATNumber.Value, finance.NewVATNumber)
+}
+
+func (s *FinanceTestSuite) TestInvalidGeneratorsProduceRejectedInputs() {
+	checkRejected(s.T(), InvalidCurrency(), finance.NewCurrency)
+	checkRejected(
+		s.T(), InvalidAmount(), func(amount string) (finance.Money, error) {
+			return finance.NewMoneyFromString(amount, "USD")
+		},
+	)
+	checkRejected(s.T(), InvalidInterestRate(), finance.NewInterestRateFromString)
+	checkRejected(s.T(), InvalidISIN(), finance.NewISIN)
+	checkRejected(s.T(), InvalidCUSIP(), finance.NewCUSIP)
+	checkRejected(s.T(), InvalidAccountNumber(), finance.NewAccountNumber)
+	checkRejected(s.T(), InvalidRoutingNumber(), finance.NewRoutingNumber)
+	checkRejected(s.T(), InvalidVATNumber(), finance.NewVATNumber)
+}
@@ -0,0 +1,116 @@
+// Package gen provides property-based testing generators, built on pgregory.net/rapid, for
+// every value object. Each X generator draws valid value objects from raw inputs with the
+// surrounding whitespace and letter case the normalizers accept; each InvalidX generator draws
+// adversarial raw inputs the matching constructor must reject.
+//
+//	rapid.Check(t, func(t *rapid.T) {
+//		email := gen.Email().Draw(t, "email")
+//		account := NewAccount(email)
+//		...
+//	})
+//
+// Frameworks other than rapid, such as gopter or testing/quick, can draw values through the
+// generators' Example method.
+package gen
+
+import (
+	"strings"
+
+	"pgregory.net/rapid"
+)
+
+// adversarial holds raw inputs every string constructor must reject
+var adversarial = []string{
+	"",
+	" ",
+	"\t\n",
+	"\x00",
+	"\u200b",
+	"<script>alert(1)</script>",
+	"'; DROP TABLE users; --",
+}
+
+// build passes raw inputs to the constructor, failing the test case when it rejects an input
+// the raw generator considers valid
+func build[T any](raw *rapid.Generator[string], parse func(string) (T, error)) *rapid.Generator[T] {
+	return rapid.Custom(
+		func(t *rapid.T) T {
+			value := raw.Draw(t, "raw")
+			result, err := parse(value)
+			if err != nil {
+				t.Fatalf("gen: valid input %q was rejected: %v", value, err)
+			}
+			return result
+		},
+	)
+}
+
+// invalid draws from the adversarial inputs, the type-specific samples and the mutations
+func invalid(samples []string, mutations ...*rapid.Generator[string]) *rapid.Generator[string] {
+	all := append([]string{}, adversarial...)
+	all = append(all, samples...)
+
+	generators := append([]*rapid.Generator[string]{rapid.SampledFrom(all)}, mutations...)
+	return rapid.OneOf(generators...)
+}
+
+// padded surrounds raw inputs with the whitespace normalizers trim
+func padded(raw *rapid.Generator[string]) *rapid.Generator[string] {
+	return rapid.Custom(
+		func(t *rapid.T) string {
+			padding := rapid.StringMatching(`[ \t]{0,2}`)
+			return padding.Draw(t, "leading") + raw.Draw(t, "value") + padding.Draw(t, "trailing")
+		},
+	)
+}
+
+// mixedCase converts raw inputs to upper or lower case, for values normalized case-insensitively
+func mixedCase(raw *rapid.Generator[string]) *rapid.Generator[string] {
+	return rapid.Custom(
+		func(t *rapid.T) string {
+			value := raw.Draw(t, "value")
+			switch rapid.IntRange(0, 2).Draw(t, "case") {
+			case 1:
+				return strings.ToUpper(value)
+			case 2:
+				return strings.ToLower(value)
+			default:
+				return value
+			}
+		},
+	)
+}
+
+// withCheckCharacter appends the first character of alphabet that makes the value valid
+func withCheckCharacter(
+	body *rapid.Generator[string],
+	alphabet string,
+	valid func(string) bool,
+) *rapid.Generator[string] {
+	return rapid.Custom(
+		func(t *rapid.T) string {
+			value := body.Draw(t, "body")
+			for _, c := range alphabet {
+				if valid(value + string(c)) {
+					return value + string(c)
+				}
+			}
+			t.Fatalf("gen: no check character completes %q", value)
+			return ""
+		},
+	)
+}
+
+// replaceLast swaps the last character of valid inputs for another one from alphabet,
+// breaking their check digit
+func replaceLast(valid *rapid.Generator[string], alphabet string) *rapid.Generator[string] {
+	return rapid.Custom(
+		func(t *rapid.T) string {
+			value := valid.Draw(t, "valid")
+			last := value[len(value)-1:]
+			others := strings.ReplaceAll(alphabet, last, "")
+			index := rapid.IntRange(0, len(others)-1).Draw(t, "replacement")
+			return value[:len(value)-1] + others[index:index+1]
+		},
+	)
+}
@@ -0,0 +1,96 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"pgregory.net/rapid"
+)
+
+type GenTestSuite struct {
+	suite.Suite
+}
+
+func TestGenSuite(t *testing.T) {
+	suite.Run(t, new(GenTestSuite))
+}
+
+// equatable is satisfied by every value object
+type equatable[T any] interface {
+	Equals(other T) bool
+}
+
+// checkNormalizationIsStable asserts that every generated value is accepted again, unchanged,
+// when its normalized text is passed back to the constructor
+func checkNormalizationIsStable[T equatable[T]](
+	t *testing.T,
+	generator *rapid.Generator[T],
+	text func(T) string,
+	parse func(string) (T, error),
+) {
+	rapid.Check(
+		t, func(t *rapid.T) {
+			value := generator.Draw(t, "value")
+			parsed, err := parse(text(value))
+			if err != nil {
+				t.Fatalf("normalized %q was rejected: %v", text(value), err)
+			}
+			if !parsed.Equals(value) {
+				t.Fatalf("normalized %q changed to %q", text(value), text(parsed))
+			}
+		},
+	)
+}
+
+// checkRejected asserts that the constructor rejects every generated input
+func checkRejected[T any](
+	t *testing.T,
+	generator *rapid.Generator[string],
+	parse func(string) (T, error),
+) {
+	rapid.Check(
+		t, func(t *rapid.T) {
+			input := generator.Draw(t, "input")
+			if _, err := parse(input); err == nil {
+				t.Fatalf("invalid input %q was accepted", input)
+			}
+		},
+	)
+}
+
+func (s *GenTestSuite) TestPaddedOnlyAddsTrimmableWhitespace() {
+	rapid.Check(
+		s.T(), func(t *rapid.T) {
+			value := padded(rapid.Just("value")).Draw(t, "padded")
+			if strings.TrimSpace(value) != "value" {
+				t.Fatalf("unexpected padding in %q", value)
+			}
+		},
+	)
+}
+
+func (s *GenTestSuite) TestWithCheckCharacterCompletesTheValue() {
+	even := func(value string) bool { return (value[len(value)-1]-'0')%2 == 0 }
+	generator := withCheckCharacter(rapid.StringMatching(`[0-9]{3}`), "13579", even)
+
+	s.Panics(func() { generator.Example(1) })
+
+	generator = withCheckCharacter(rapid.StringMatching(`[0-9]{3}`), "123", even)
+	s.True(strings.HasSuffix(generator.Example(1), "2"))
+}
+
+func (s *GenTestSuite) TestReplaceLastAlwaysChangesTheLastCharacter() {
+	rapid.Check(
+		s.T(), func(t *rapid.T) {
+			value := replaceLast(rapid.Just("1235"), digits).Draw(t, "replaced")
+			if value[:3] != "123" || value[3] == '5' {
+				t.Fatalf("unexpected replacement %q", value)
+			}
+		},
+	)
+}
+
+func (s *GenTestSuite) TestGeneratorsAreUsableOutsideRapid() {
+	s.Equal(Email().Example(7), Email().Example(7))
+}
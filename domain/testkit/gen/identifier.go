@@ -0,0 +1,116 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"pgregory.net/rapid"
+)
+
+const (
+	ulidPattern    = `[0-7][0-9A-HJKMNP-TV-Z]{25}`
+	compositePart  = `[a-zA-Z0-9_-]{1,8}`
+	uuidHexPattern = `[0-9a-f]{8}-[0-9a-f]{4}-[47][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}`
+)
+
+// IntIdentifier generates valid integer identifiers
+func IntIdentifier() *rapid.Generator[identifier.IntIdentifier] {
+	return rapid.Custom(
+		func(t *rapid.T) identifier.IntIdentifier {
+			id, err := identifier.NewIntIdentifier(rapid.Uint64Min(1).Draw(t, "value"))
+			if err != nil {
+				t.Fatalf("gen: valid identifier was rejected: %v", err)
+			}
+			return id
+		},
+	)
+}
+
+// InvalidIntIdentifier generates inputs rejected by identifier.NewIntIdentifierFromString
+func InvalidIntIdentifier() *rapid.Generator[string] {
+	return invalid(
+		[]string{"0", "-1", "abc", "1.5", "0x10", "18446744073709551616"},
+		rapid.Map(rapid.Int64Max(-1), func(value int64) string { return fmt.Sprint(value) }),
+	)
+}
+
+// Snowflake generates valid Snowflake identifiers
+func Snowflake() *rapid.Generator[identifier.Snowflake] {
+	return rapid.Custom(
+		func(t *rapid.T) identifier.Snowflake {
+			value := rapid.Uint64Range(1, math.MaxInt64).Draw(t, "value")
+			snowflake, err := identifier.NewSnowflake(value)
+			if err != nil {
+				t.Fatalf("gen: valid snowflake %d was rejected: %v", value, err)
+			}
+			return snowflake
+		},
+	)
+}
+
+// InvalidSnowflake generates inputs rejected by identifier.NewSnowflakeFromString
+func InvalidSnowflake() *rapid.Generator[string] {
+	return invalid(
+		[]string{"0", "-1", "abc", "9223372036854775808", "18446744073709551615"},
+		rapid.Map(
+			rapid.Uint64Min(math.MaxInt64+1),
+			func(value uint64) string { return fmt.Sprint(value) },
+		),
+	)
+}
+
+// UUID generates valid version 4 and version 7 UUIDs
+func UUID() *rapid.Generator[identifier.UUID] {
+	raw := mixedCase(padded(rapid.StringMatching(uuidHexPattern)))
+	return build(raw, identifier.NewUUIDFromString)
+}
+
+// InvalidUUID generates inputs rejected by identifier.NewUUIDFromString
+func InvalidUUID() *rapid.Generator[string] {
+	return invalid(
+		[]string{
+			"00000000-0000-0000-0000-000000000000", "not-a-uuid",
+			"0190a2b45c6d7e8f9a0b1c2d3e4f5a6b", "0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6",
+			"0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6g", "{0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b}",
+		},
+		rapid.Map(rapid.StringMatching(uuidHexPattern), func(s string) string { return s + "0" }),
+	)
+}
+
+// ULID generates valid ULIDs
+func ULID() *rapid.Generator[identifier.ULID] {
+	raw := mixedCase(padded(rapid.StringMatching(ulidPattern)))
+	return build(raw, identifier.NewULIDFromString)
+}
+
+// InvalidULID generates inputs rejected by identifier.NewULIDFromString
+func InvalidULID() *rapid.Generator[string] {
+	return invalid(
+		[]string{
+			"01ARZ3NDEKTSV4RRFFQ69G5FA", "01ARZ3NDEKTSV4RRFFQ69G5FAVX",
+			"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		},
+		rapid.StringMatching(`[89A-HJKMNP-TV-Z][0-9A-HJKMNP-TV-Z]{25}`),
+		rapid.StringMatching(`[0-7][0-9A-HJKMNP-TV-Z]{10}[ILOU][0-9A-HJKMNP-TV-Z]{14}`),
+	)
+}
+
+// Composite generates valid composite identifiers of two to four parts
+func Composite() *rapid.Generator[identifier.Composite] {
+	raw := rapid.StringMatching(
+		compositePart + `(` + identifier.CompositeSeparator + compositePart + `){1,3}`,
+	)
+	return build(raw, identifier.NewCompositeFromString)
+}
+
+// InvalidComposite generates inputs rejected by identifier.NewCompositeFromString
+func InvalidComposite() *rapid.Generator[string] {
+	separator := identifier.CompositeSeparator
+	return invalid(
+		[]string{
+			"tenant", separator + "42", "tenant" + separator, "a" + separator + separator + "b",
+		},
+		rapid.StringMatching(compositePart),
+	)
+}
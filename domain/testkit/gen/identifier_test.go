@@ -0,0 +1,41 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/stretchr/testify/suite"
+)
+
+type IdentifierTestSuite struct {
+	suite.Suite
+}
+
+func TestIdentifierSuite(t *testing.T) {
+	suite.Run(t, new(IdentifierTestSuite))
+}
+
+func (s *IdentifierTestSuite) TestValidGeneratorsProduceStableValues() {
+	checkNormalizationIsStable(
+		s.T(),
+		IntIdentifier(),
+		identifier.IntIdentifier.String,
+		identifier.NewIntIdentifierFromString,
+	)
+	checkNormalizationIsStable(
+		s.T(), Snowflake(), identifier.Snowflake.String, identifier.NewSnowflakeFromString,
+	)
+	checkNormalizationIsStable(s.T(), UUID(), identifier.UUID.String, identifier.NewUUIDFromString)
+	checkNormalizationIsStable(s.T(), ULID(), identifier.ULID.String, identifier.NewULIDFromString)
+	checkNormalizationIsStable(
+		s.T(), Composite(), identifier.Composite.String, identifier.NewCompositeFromString,
+	)
+}
+
+func (s *IdentifierTestSuite) TestInvalidGeneratorsProduceRejectedInputs() {
+	checkRejected(s.T(), InvalidIntIdentifier(), identifier.NewIntIdentifierFromString)
+	checkRejected(s.T(), InvalidSnowflake(), identifier.NewSnowflakeFromString)
+	checkRejected(s.T(), InvalidUUID(), identifier.NewUUIDFromString)
+	checkRejected(s.T(), InvalidULID(), identifier.NewULIDFromString)
+	checkRejected(s.T(), InvalidComposite(), identifier.NewCompositeFromString)
+}
@@ -0,0 +1,145 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"pgregory.net/rapid"
+)
+
+const (
+	namePartPattern    = `[A-ZÁÉÍÓÚÖÜ][a-záéíóúöüñç]{1,10}([-' ][A-Z][a-záéíóúöüñç]{1,10})?`
+	phoneNumberPattern = `\+[1-9][0-9]{6,13}`
+)
+
+// FullName generates valid full names, with an optional middle name or initial and optional
+// honorific prefix and suffix
+func FullName() *rapid.Generator[person.FullName] {
+	return rapid.Custom(
+		func(t *rapid.T) person.FullName {
+			namePart := padded(rapid.StringMatching(namePartPattern))
+			middleName := rapid.OneOf(
+				rapid.Just(""),
+				rapid.StringMatching(`[A-Z]\.`),
+				namePart,
+			)
+			prefix := rapid.SampledFrom([]string{"", "", "Dr.", "Mr.", "Ms.", "Prof."})
+			suffix := rapid.SampledFrom([]string{"", "", "Jr.", "Sr.", "III", "PhD"})
+
+			fullName, err := person.NewFullNameWithAffixes(
+				prefix.Draw(t, "prefix"),
+				namePart.Draw(t, "firstName"),
+				middleName.Draw(t, "middleName"),
+				namePart.Draw(t, "lastName"),
+				suffix.Draw(t, "suffix"),
+			)
+			if err != nil {
+				t.Fatalf("gen: valid full name was rejected: %v", err)
+			}
+			return fullName
+		},
+	)
+}
+
+// InvalidNamePart generates first or last names rejected by person.NewFullName
+func InvalidNamePart() *rapid.Generator[string] {
+	raw := rapid.StringMatching(namePartPattern)
+	return invalid(
+		[]string{"-Jane", "Jane-", "'Jane", "Jane.", "J4ne", "Jane_Doe", "Jane@Doe"},
+		rapid.Map(raw, func(s string) string { return s + "1" }),
+		rapid.Map(raw, func(s string) string { return "." + s }),
+		rapid.Map(
+			raw,
+			func(s string) string { return s + strings.Repeat("a", person.MaxNamePartLength) },
+		),
+	)
+}
+
+// NationalID generates valid US Social Security and Spanish DNI numbers
+func NationalID() *rapid.Generator[person.NationalID] {
+	return rapid.Custom(
+		func(t *rapid.T) person.NationalID {
+			var country, value string
+			if rapid.Bool().Draw(t, "us") {
+				country = "US"
+				area := rapid.IntRange(1, 899).Filter(func(area int) bool { return area != 666 })
+				value = fmt.Sprintf(
+					"%03d-%02d-%04d",
+					area.Draw(t, "area"),
+					rapid.IntRange(1, 99).Draw(t, "group"),
+					rapid.IntRange(1, 9999).Draw(t, "serial"),
+				)
+			} else {
+				country = "ES"
+				dni := withCheckCharacter(
+					rapid.StringMatching(`[0-9]{8}`),
+					"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+					func(s string) bool { return person.IsValidSpanishDNI(s) == nil },
+				)
+				value = dni.Draw(t, "dni")
+			}
+
+			code, _ := geography.NewCountryCode(country)
+			nationalID, err := person.NewNationalID(code, value)
+			if err != nil {
+				t.Fatalf("gen: valid %s national ID %q was rejected: %v", country, value, err)
+			}
+			return nationalID
+		},
+	)
+}
+
+// InvalidUSSocialSecurityNumber generates US national IDs rejected by person.NewNationalID
+func InvalidUSSocialSecurityNumber() *rapid.Generator[string] {
+	return invalid(
+		[]string{
+			"000-12-3456", "666-12-3456", "900-12-3456", "123-00-4567", "123-45-0000",
+			"12345678", "1234567890", "123-45-678A",
+		},
+		rapid.StringMatching(`9[0-9]{2}-[0-9]{2}-[0-9]{4}`),
+	)
+}
+
+// PhoneNumber generates valid phone numbers, formatted with separators and sometimes
+// carrying an extension
+func PhoneNumber() *rapid.Generator[contact.PhoneNumber] {
+	raw := rapid.Custom(
+		func(t *rapid.T) string {
+			number := rapid.StringMatching(phoneNumberPattern).Draw(t, "number")
+			if rapid.Bool().Draw(t, "formatted") {
+				number = number[:3] + " " + number[3:6] + "-" + number[6:]
+			}
+
+			extension := rapid.StringMatching(`( ext\. [0-9]{1,5})?`).Draw(t, "extension")
+			return number + extension
+		},
+	)
+	return build(padded(raw), contact.NewPhoneNumber)
+}
+
+// InvalidPhoneNumber generates inputs rejected by contact.NewPhoneNumber
+func InvalidPhoneNumber() *rapid.Generator[string] {
+	raw := rapid.StringMatching(phoneNumberPattern)
+	return invalid(
+		[]string{"12", "+0123456", "phone", "+1 555 CALL NOW", "+1234567890123456", "++1234567"},
+		rapid.Map(raw, func(s string) string { return s + " ext. " }),
+		rapid.Map(raw, func(s string) string { return s + " ext. 12345678901" }),
+		rapid.Map(raw, func(s string) string { return s + "0123456789" }),
+	)
+}
+
+// CountryCode generates valid ISO 3166-1 alpha-2 country codes
+func CountryCode() *rapid.Generator[geography.CountryCode] {
+	return build(mixedCase(padded(rapid.StringMatching(`[A-Z]{2}`))), geography.NewCountryCode)
+}
+
+// InvalidCountryCode generates inputs rejected by geography.NewCountryCode
+func InvalidCountryCode() *rapid.Generator[string] {
+	return invalid(
+		[]string{"U", "USA", "U1", "12", "U S", "ÜS"},
+		rapid.StringMatching(`[A-Z]{3,5}`),
+	)
+}
@@ -0,0 +1,63 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/stretchr/testify/suite"
+	"pgregory.net/rapid"
+)
+
+type PersonTestSuite struct {
+	suite.Suite
+}
+
+func TestPersonSuite(t *testing.T) {
+	suite.Run(t, new(PersonTestSuite))
+}
+
+func (s *PersonTestSuite) TestValidGeneratorsProduceStableValues() {
+	checkNormalizationIsStable(
+		s.T(), PhoneNumber(), contact.PhoneNumber.String, contact.NewPhoneNumber,
+	)
+	checkNormalizationIsStable(
+		s.T(), CountryCode(), geography.CountryCode.Value, geography.NewCountryCode,
+	)
+
+	rapid.Check(
+		s.T(), func(t *rapid.T) {
+			name := FullName().Draw(t, "name")
+			parsed, err := person.NewFullNameWithAffixes(
+				name.Prefix(), name.FirstName(), name.MiddleName(), name.LastName(), name.Suffix(),
+			)
+			if err != nil || !parsed.Equals(name) {
+				t.Fatalf("full name %q is not stable: %v", name, err)
+			}
+
+			nationalID := NationalID().Draw(t, "nationalID")
+			parsedID, err := person.NewNationalID(nationalID.Country(), nationalID.Value())
+			if err != nil || !parsedID.Equals(nationalID) {
+				t.Fatalf("national ID %q is not stable: %v", nationalID.Value(), err)
+			}
+		},
+	)
+}
+
+func (s *PersonTestSuite) TestInvalidGeneratorsProduceRejectedInputs() {
+	us, _ := geography.NewCountryCode("US")
+
+	checkRejected(s.T(), InvalidPhoneNumber(), contact.NewPhoneNumber)
+	checkRejected(s.T(), InvalidCountryCode(), geography.NewCountryCode)
+	checkRejected(
+		s.T(), InvalidNamePart(), func(part string) (person.FullName, error) {
+			return person.NewFullName(part, "", "Doe")
+		},
+	)
+	checkRejected(
+		s.T(), InvalidUSSocialSecurityNumber(), func(value string) (person.NationalID, error) {
+			return person.NewNationalID(us, value)
+		},
+	)
+}
@@ -0,0 +1,126 @@
+package gen
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain/web"
+	"pgregory.net/rapid"
+)
+
+const (
+	labelPattern      = `[a-z0-9]([a-z0-9-]{0,10}[a-z0-9])?`
+	domainNamePattern = labelPattern + `(\.` + labelPattern + `){0,2}\.[a-z]{2,6}`
+	localPartPattern  = `[a-z0-9!#$%&'*+/=?^_{|}~-]{1,12}(\.[a-z0-9_+-]{1,8}){0,2}`
+)
+
+// DomainName generates valid domain names
+func DomainName() *rapid.Generator[web.DomainName] {
+	return build(mixedCase(padded(rapid.StringMatching(domainNamePattern))), web.NewDomainName)
+}
+
+// InvalidDomainName generates inputs rejected by web.NewDomainName
+func InvalidDomainName() *rapid.Generator[string] {
+	raw := rapid.StringMatching(domainNamePattern)
+	return invalid(
+		[]string{
+			"-example.com", "example-.com", "example..com", ".example.com", "example.com.",
+			"exa mple.com", "exa_mple.com", "exämple.com",
+		},
+		rapid.Map(raw, func(s string) string { return strings.Repeat("a", 64) + "." + s }),
+		rapid.Map(raw, func(s string) string { return s + ".." }),
+		rapid.Map(raw, func(s string) string { return "-" + s }),
+	)
+}
+
+// Email generates valid email addresses
+func Email() *rapid.Generator[web.Email] {
+	return build(mixedCase(padded(emailString())), web.NewEmail)
+}
+
+// InvalidEmail generates inputs rejected by web.NewEmail
+func InvalidEmail() *rapid.Generator[string] {
+	raw := emailString()
+	return invalid(
+		[]string{
+			"plainaddress", "@example.com", "jane@", "jane@@example.com", "jane..doe@example.com",
+			".jane@example.com", "jane.@example.com", "jane@-example.com", "jane doe@example.com",
+			"jane@example..com", "jane\"doe@example.com",
+		},
+		rapid.Map(raw, func(s string) string { return strings.Replace(s, "@", "", 1) }),
+		rapid.Map(raw, func(s string) string { return strings.Replace(s, "@", "@@", 1) }),
+		rapid.Map(raw, func(s string) string { return "." + s }),
+		rapid.Map(
+			raw,
+			func(s string) string { return strings.Repeat("a", web.MaxLocalPartLength) + s },
+		),
+	)
+}
+
+// URL generates valid http and https URLs
+func URL() *rapid.Generator[web.URL] {
+	raw := rapid.Custom(
+		func(t *rapid.T) string {
+			scheme := rapid.SampledFrom([]string{"http", "https"}).Draw(t, "scheme")
+			host := rapid.StringMatching(domainNamePattern).Draw(t, "host")
+			path := rapid.StringMatching(`(/[a-zA-Z0-9_-]{1,8}){0,3}`).Draw(t, "path")
+			query := rapid.StringMatching(`(\?[a-z]{1,5}=[a-z0-9]{1,5})?`).Draw(t, "query")
+			return scheme + "://" + host + path + query
+		},
+	)
+	return build(padded(raw), web.NewURL)
+}
+
+// InvalidURL generates inputs rejected by web.NewURL
+func InvalidURL() *rapid.Generator[string] {
+	return invalid(
+		[]string{
+			"example.com", "//example.com", "http://", "http:///path", "ftp://example.com",
+			"javascript:alert(1)", "mailto:jane@example.com", "http://exa mple.com",
+			"https://example.com/" + strings.Repeat("a", web.MaxURLLength),
+		},
+		rapid.StringMatching(domainNamePattern),
+	)
+}
+
+// IPAddress generates valid IPv4 and IPv6 addresses
+func IPAddress() *rapid.Generator[web.IPAddress] {
+	raw := rapid.Custom(
+		func(t *rapid.T) string {
+			if rapid.Bool().Draw(t, "ipv4") {
+				var ipv4 [4]byte
+				copy(ipv4[:], rapid.SliceOfN(rapid.Byte(), 4, 4).Draw(t, "ipv4Bytes"))
+				return netip.AddrFrom4(ipv4).String()
+			}
+
+			var ipv6 [16]byte
+			copy(ipv6[:], rapid.SliceOfN(rapid.Byte(), 16, 16).Draw(t, "ipv6Bytes"))
+			return netip.AddrFrom16(ipv6).String()
+		},
+	)
+	return build(mixedCase(padded(raw)), web.NewIPAddress)
+}
+
+// InvalidIPAddress generates inputs rejected by web.NewIPAddress
+func InvalidIPAddress() *rapid.Generator[string] {
+	return invalid(
+		[]string{
+			"256.1.1.1", "1.2.3", "1.2.3.4.5", "1..2.3", "-1.2.3.4", "::g", "1:2:3:4:5:6:7:8:9",
+			"12345::", "1.2.3.4/24", "localhost",
+		},
+		rapid.Map(rapid.IntRange(256, 999), func(octet int) string {
+			return "10.0.0." + strconv.Itoa(octet)
+		}),
+	)
+}
+
+// emailString generates raw valid email addresses
+func emailString() *rapid.Generator[string] {
+	return rapid.Custom(
+		func(t *rapid.T) string {
+			return rapid.StringMatching(localPartPattern).Draw(t, "local") + "@" +
+				rapid.StringMatching(domainNamePattern).Draw(t, "domain")
+		},
+	)
+}
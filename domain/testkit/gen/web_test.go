@@ -0,0 +1,30 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebTestSuite struct {
+	suite.Suite
+}
+
+func TestWebSuite(t *testing.T) {
+	suite.Run(t, new(WebTestSuite))
+}
+
+func (s *WebTestSuite) TestValidGeneratorsProduceStableValues() {
+	checkNormalizationIsStable(s.T(), DomainName(), web.DomainName.Value, web.NewDomainName)
+	checkNormalizationIsStable(s.T(), Email(), web.Email.Value, web.NewEmail)
+	checkNormalizationIsStable(s.T(), URL(), web.URL.Value, web.NewURL)
+	checkNormalizationIsStable(s.T(), IPAddress(), web.IPAddress.Value, web.NewIPAddress)
+}
+
+func (s *WebTestSuite) TestInvalidGeneratorsProduceRejectedInputs() {
+	checkRejected(s.T(), InvalidDomainName(), web.NewDomainName)
+	checkRejected(s.T(), InvalidEmail(), web.NewEmail)
+	checkRejected(s.T(), InvalidURL(), web.NewURL)
+	checkRejected(s.T(), InvalidIPAddress(), web.NewIPAddress)
+}
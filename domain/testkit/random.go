@@ -0,0 +1,255 @@
+package testkit
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	firstNames = []string{
+		"Ana", "Ben", "Chloé", "David", "Elena", "Farah", "George", "Hana", "Ioana", "José",
+		"Kenji", "Laura", "Mihai", "Nora", "Omar", "Priya", "Radu", "Sofia", "Tomás", "Zoë",
+	}
+	lastNames = []string{
+		"Anderson", "Brown", "Costa", "Dubois", "Eriksson", "García", "Ionescu", "Kowalski",
+		"Müller", "Nakamura", "O'Brien", "Popescu", "Rossi", "Smith", "Van der Berg", "Wang",
+	}
+	countryCodes = []string{"AU", "BR", "CA", "DE", "ES", "FR", "GB", "IN", "IT", "JP", "RO", "US"}
+	currencies   = []string{"AUD", "CHF", "EUR", "GBP", "JPY", "RON", "USD"}
+
+	// mobilePrefixes holds the international prefix of mobile numbers and the count of digits
+	// that follow it, per region supported by RandomPhoneNumber
+	mobilePrefixes = map[string]struct {
+		prefix string
+		digits int
+	}{
+		"US": {"1", 10}, "CA": {"1", 10}, "GB": {"447", 9}, "DE": {"4917", 8},
+		"FR": {"336", 8}, "ES": {"346", 8}, "IT": {"393", 9}, "RO": {"407", 8},
+		"AU": {"614", 8}, "IN": {"919", 9},
+	}
+)
+
+// Random produces valid value objects from a seeded source, so a sequence of values can be
+// reproduced. It is safe for concurrent use.
+type Random struct {
+	mu     sync.Mutex
+	source *rand.Rand
+}
+
+// NewRandom creates a Random whose sequence of values is fully determined by seed
+func NewRandom(seed uint64) *Random {
+	return &Random{
+		source: rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+var defaultRandom atomic.Pointer[Random]
+
+func init() {
+	defaultRandom.Store(NewRandom(uint64(time.Now().UnixNano())))
+}
+
+// Seed resets the source behind the package-level Random functions, making them deterministic
+func Seed(seed uint64) {
+	defaultRandom.Store(NewRandom(seed))
+}
+
+// Default returns the Random behind the package-level Random functions
+func Default() *Random {
+	return defaultRandom.Load()
+}
+
+// RandomEmail returns a random email at a reserved example domain
+func RandomEmail() web.Email {
+	return Default().Email()
+}
+
+// RandomFullName returns a random full name, sometimes with a middle initial
+func RandomFullName() person.FullName {
+	return Default().FullName()
+}
+
+// RandomPhoneNumber returns a random mobile number in E.164 format for the region (e.g., "US",
+// "GB", "RO"); it panics for regions it has no numbering data for
+func RandomPhoneNumber(region string) contact.PhoneNumber {
+	return Default().PhoneNumber(region)
+}
+
+// RandomMoney returns a random amount between 0 and 10,000 in the currency, with as many
+// decimal places as the currency's minor units
+func RandomMoney(currency string) finance.Money {
+	return Default().Money(currency)
+}
+
+// RandomCountryCode returns a random country code among major markets
+func RandomCountryCode() geography.CountryCode {
+	return Default().CountryCode()
+}
+
+// RandomCurrency returns a random ISO 4217 currency among major currencies
+func RandomCurrency() finance.Currency {
+	return Default().Currency()
+}
+
+// RandomUsername returns a random username
+func RandomUsername() auth.Username {
+	return Default().Username()
+}
+
+// RandomToken returns a random base64url token of auth.DefaultTokenBytes bytes
+func RandomToken() auth.Token {
+	return Default().Token()
+}
+
+// RandomIntIdentifier returns a random positive integer identifier below 2^53, so it survives
+// a round trip through JavaScript numbers
+func RandomIntIdentifier() identifier.IntIdentifier {
+	return Default().IntIdentifier()
+}
+
+// RandomUUID returns a random version 4 UUID
+func RandomUUID() identifier.UUID {
+	return Default().UUID()
+}
+
+// Email returns a random email at a reserved example domain
+func (r *Random) Email() web.Email {
+	domains := []string{"example.com", "example.org", "example.net"}
+	local := r.letters(8)
+	return MustEmail(local + "@" + domains[r.intN(len(domains))])
+}
+
+// FullName returns a random full name, sometimes with a middle initial
+func (r *Random) FullName() person.FullName {
+	firstName := firstNames[r.intN(len(firstNames))]
+	lastName := lastNames[r.intN(len(lastNames))]
+
+	middleName := ""
+	if r.intN(4) == 0 {
+		middleName = strings.ToUpper(r.letters(1)) + "."
+	}
+
+	return Must(person.NewFullName(firstName, middleName, lastName))
+}
+
+// PhoneNumber returns a random mobile number in E.164 format for the region; it panics for
+// regions it has no numbering data for
+func (r *Random) PhoneNumber(region string) contact.PhoneNumber {
+	plan, ok := mobilePrefixes[strings.ToUpper(region)]
+	if !ok {
+		panic(fmt.Sprintf("testkit: no phone numbering data for region %q", region))
+	}
+
+	number := "+" + plan.prefix
+	if plan.prefix == "1" {
+		// North American area codes and exchanges cannot start with 0 or 1
+		number += fmt.Sprint(2+r.intN(8)) + r.digits(2) + fmt.Sprint(2+r.intN(8)) + r.digits(6)
+	} else {
+		number += r.digits(plan.digits)
+	}
+
+	return MustPhoneNumber(number)
+}
+
+// Money returns a random amount between 0 and 10,000 in the currency, with as many decimal
+// places as the currency's minor units
+func (r *Random) Money(currency string) finance.Money {
+	code := MustCurrency(currency)
+	places := code.MinorUnits()
+	scale := int64(1)
+	for range places {
+		scale *= 10
+	}
+
+	amount := decimal.New(r.int64N(10_000*scale+1), -places)
+	return Must(finance.NewMoney(amount, code))
+}
+
+// CountryCode returns a random country code among major markets
+func (r *Random) CountryCode() geography.CountryCode {
+	return MustCountryCode(countryCodes[r.intN(len(countryCodes))])
+}
+
+// Currency returns a random ISO 4217 currency among major currencies
+func (r *Random) Currency() finance.Currency {
+	return MustCurrency(currencies[r.intN(len(currencies))])
+}
+
+// Username returns a random username
+func (r *Random) Username() auth.Username {
+	return MustUsername(r.letters(6) + "." + r.digits(3))
+}
+
+// Token returns a random base64url token of auth.DefaultTokenBytes bytes
+func (r *Random) Token() auth.Token {
+	raw := make([]byte, auth.DefaultTokenBytes)
+	r.fill(raw)
+	return Must(auth.NewToken(base64.RawURLEncoding.EncodeToString(raw)))
+}
+
+// IntIdentifier returns a random positive integer identifier below 2^53
+func (r *Random) IntIdentifier() identifier.IntIdentifier {
+	return MustIntIdentifier(uint64(r.int64N(1<<53-1)) + 1)
+}
+
+// UUID returns a random version 4 UUID
+func (r *Random) UUID() identifier.UUID {
+	var value [16]byte
+	r.fill(value[:])
+	value[6] = value[6]&0x0f | 0x40
+	value[8] = value[8]&0x3f | 0x80
+	return identifier.ReconstituteUUID(value)
+}
+
+func (r *Random) intN(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.source.IntN(n)
+}
+
+func (r *Random) int64N(n int64) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.source.Int64N(n)
+}
+
+func (r *Random) fill(buffer []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range buffer {
+		buffer[i] = byte(r.source.Uint32())
+	}
+}
+
+func (r *Random) letters(count int) string {
+	return r.pick("abcdefghijklmnopqrstuvwxyz", count)
+}
+
+func (r *Random) digits(count int) string {
+	return r.pick("0123456789", count)
+}
+
+func (r *Random) pick(alphabet string, count int) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var builder strings.Builder
+	for range count {
+		builder.WriteByte(alphabet[r.source.IntN(len(alphabet))])
+	}
+	return builder.String()
+}
@@ -0,0 +1,114 @@
+package testkit
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type RandomTestSuite struct {
+	suite.Suite
+}
+
+func TestRandomSuite(t *testing.T) {
+	suite.Run(t, new(RandomTestSuite))
+}
+
+func (s *RandomTestSuite) TestTheSameSeedProducesTheSameValues() {
+	first, second := NewRandom(42), NewRandom(42)
+	for range 20 {
+		s.True(first.Email().Equals(second.Email()))
+		s.True(first.FullName().Equals(second.FullName()))
+		s.True(first.UUID().Equals(second.UUID()))
+	}
+
+	Seed(7)
+	email := RandomEmail()
+	Seed(7)
+	s.True(email.Equals(RandomEmail()))
+}
+
+func (s *RandomTestSuite) TestDifferentSeedsProduceDifferentValues() {
+	s.False(NewRandom(1).Email().Equals(NewRandom(2).Email()))
+}
+
+func (s *RandomTestSuite) TestRandomValuesPassTheirConstructors() {
+	random := NewRandom(3)
+	for range 200 {
+		email := random.Email()
+		s.NoError(check(web.NewEmail, email.Value()))
+		s.True(strings.Contains(email.Value(), "@example."))
+
+		name := random.FullName()
+		_, err := person.NewFullName(name.FirstName(), name.MiddleName(), name.LastName())
+		s.NoError(err)
+
+		s.NoError(check(auth.NewUsername, random.Username().Value()))
+		s.NoError(check(auth.NewToken, random.Token().Value()))
+		s.NoError(check(identifier.NewUUIDFromString, random.UUID().String()))
+		s.NoError(check(finance.NewCurrency, random.Currency().Value()))
+		s.LessOrEqual(random.IntIdentifier().Value(), uint64(1<<53))
+	}
+}
+
+func (s *RandomTestSuite) TestRandomUUIDsAreVersion4() {
+	uuid := NewRandom(4).UUID()
+	s.Equal(4, uuid.Version())
+}
+
+func (s *RandomTestSuite) TestRandomPhoneNumbersMatchTheRegion() {
+	random := NewRandom(5)
+	prefixes := map[string]string{"US": "+1", "GB": "+447", "RO": "+407", "in": "+919"}
+	for region, prefix := range prefixes {
+		for range 50 {
+			number := random.PhoneNumber(region)
+			s.NoError(check(contact.NewPhoneNumber, number.Value()))
+			s.True(strings.HasPrefix(number.Value(), prefix), number.Value())
+		}
+	}
+
+	s.Panics(func() { random.PhoneNumber("ZZ") })
+}
+
+func (s *RandomTestSuite) TestRandomMoneyUsesTheCurrencyMinorUnits() {
+	random := NewRandom(6)
+	for range 100 {
+		usd := random.Money("USD")
+		s.LessOrEqual(-usd.Amount().Exponent(), int32(2))
+		s.True(usd.Amount().IsPositive() || usd.Amount().IsZero())
+
+		yen := random.Money("JPY")
+		s.True(yen.Amount().IsInteger())
+	}
+
+	s.Panics(func() { random.Money("EURO") })
+}
+
+func (s *RandomTestSuite) TestItIsSafeForConcurrentUse() {
+	random := NewRandom(8)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				random.Email()
+				random.Money("EUR")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func check[T any](parse func(string) (T, error), value string) error {
+	_, err := parse(value)
+	return err
+}
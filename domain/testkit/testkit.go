@@ -0,0 +1,94 @@
+// Package testkit provides fixtures for tests of code built on the value objects: Must
+// constructors that panic instead of returning errors, seeded random values, builders for
+// multi-field value objects and fakes for the clock and identifier generator interfaces.
+//
+// It is meant for _test.go files only. Random values come from a package-level source that
+// can be reseeded with Seed, so a failing run can be replayed:
+//
+//	func (s *OrderTestSuite) SetupTest() {
+//		testkit.Seed(42)
+//	}
+package testkit
+
+import (
+	"fmt"
+
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+)
+
+// Must returns value, panicking when err is not nil. It wraps any constructor call:
+//
+//	rate := testkit.Must(finance.NewInterestRateFromString("4.5"))
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(fmt.Sprintf("testkit: %v", err))
+	}
+	return value
+}
+
+// MustEmail creates an email, panicking when it is invalid
+func MustEmail(value string) web.Email {
+	return Must(web.NewEmail(value))
+}
+
+// MustURL creates a URL, panicking when it is invalid
+func MustURL(value string) web.URL {
+	return Must(web.NewURL(value))
+}
+
+// MustDomainName creates a domain name, panicking when it is invalid
+func MustDomainName(value string) web.DomainName {
+	return Must(web.NewDomainName(value))
+}
+
+// MustIPAddress creates an IP address, panicking when it is invalid
+func MustIPAddress(value string) web.IPAddress {
+	return Must(web.NewIPAddress(value))
+}
+
+// MustCountryCode creates a country code, panicking when it is invalid
+func MustCountryCode(value string) geography.CountryCode {
+	return Must(geography.NewCountryCode(value))
+}
+
+// MustPhoneNumber creates a phone number, panicking when it is invalid
+func MustPhoneNumber(value string) contact.PhoneNumber {
+	return Must(contact.NewPhoneNumber(value))
+}
+
+// MustFullName creates a full name without a middle name, panicking when it is invalid
+func MustFullName(firstName, lastName string) person.FullName {
+	return Must(person.NewFullName(firstName, "", lastName))
+}
+
+// MustUsername creates a username, panicking when it is invalid
+func MustUsername(value string) auth.Username {
+	return Must(auth.NewUsername(value))
+}
+
+// MustIntIdentifier creates an integer identifier, panicking when it is invalid
+func MustIntIdentifier(value uint64) identifier.IntIdentifier {
+	return Must(identifier.NewIntIdentifier(value))
+}
+
+// MustUUID parses a UUID, panicking when it is invalid
+func MustUUID(value string) identifier.UUID {
+	return Must(identifier.NewUUIDFromString(value))
+}
+
+// MustCurrency creates a currency, panicking when it is invalid
+func MustCurrency(code string) finance.Currency {
+	return Must(finance.NewCurrency(code))
+}
+
+// MustMoney creates money from a decimal amount and a currency code, panicking when either is
+// invalid
+func MustMoney(amount, currency string) finance.Money {
+	return Must(finance.NewMoneyFromString(amount, currency))
+}
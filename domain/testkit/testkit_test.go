@@ -0,0 +1,40 @@
+package testkit
+
+import (
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestkitTestSuite struct {
+	suite.Suite
+}
+
+func TestTestkitSuite(t *testing.T) {
+	suite.Run(t, new(TestkitTestSuite))
+}
+
+func (s *TestkitTestSuite) TestMustReturnsTheValue() {
+	rate := Must(finance.NewInterestRateFromString("4.5"))
+	s.Equal("4.5%", rate.String())
+}
+
+func (s *TestkitTestSuite) TestMustPanicsOnError() {
+	s.PanicsWithValue(
+		"testkit: currency must be exactly 3 letters",
+		func() { MustCurrency("EURO") },
+	)
+}
+
+func (s *TestkitTestSuite) TestMustHelpersNormalizeLikeTheConstructors() {
+	s.Equal("jane@example.com", MustEmail("Jane@Example.com").Value())
+	s.Equal("RO", MustCountryCode("ro").Value())
+	s.Equal("Jane Doe", MustFullName(" Jane ", "Doe ").String())
+	s.Equal("10.5 EUR", MustMoney("10.5", "eur").String())
+	s.Equal(uint64(42), MustIntIdentifier(42).Value())
+
+	s.Panics(func() { MustEmail("not-an-email") })
+	s.Panics(func() { MustPhoneNumber("phone") })
+	s.Panics(func() { MustUUID("not-a-uuid") })
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"slices"
+	"sync"
+)
+
+// Validator is an extra rule applied to a value object after its built-in validation, such as
+// an allowlist of corporate email domains
+type Validator[T any] func(value T) error
+
+// Validators holds the extra rules registered for one value-object type. Constructors run
+// them after their own checks, so teams can tighten a type without forking its constructor.
+// The zero value has no rules and is ready to use; it is safe for concurrent use.
+type Validators[T any] struct {
+	mu      sync.RWMutex
+	entries []*Validator[T]
+}
+
+// Register adds a rule and returns a function that removes it again, which tests can defer
+func (v *Validators[T]) Register(validator Validator[T]) (unregister func()) {
+	entry := &validator
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.entries = append(slices.Clip(v.entries), entry)
+
+	return func() {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		// Copy on write, since Validate iterates over the previous slice without the lock
+		v.entries = slices.DeleteFunc(
+			slices.Clone(v.entries),
+			func(registered *Validator[T]) bool { return registered == entry },
+		)
+	}
+}
+
+// Reset removes every registered rule
+func (v *Validators[T]) Reset() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.entries = nil
+}
+
+// Len returns the number of registered rules
+func (v *Validators[T]) Len() int {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return len(v.entries)
+}
+
+// Validate runs the rules in registration order and returns the first error
+func (v *Validators[T]) Validate(value T) error {
+	v.mu.RLock()
+	entries := v.entries
+	v.mu.RUnlock()
+
+	for _, entry := range entries {
+		if err := (*entry)(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+var errTooLong = NewError("value is too long")
+
+type ValidatorsTestSuite struct {
+	suite.Suite
+}
+
+func TestValidatorsSuite(t *testing.T) {
+	suite.Run(t, new(ValidatorsTestSuite))
+}
+
+func maxLength(length int) Validator[string] {
+	return func(value string) error {
+		if len(value) > length {
+			return errTooLong
+		}
+		return nil
+	}
+}
+
+func (s *ValidatorsTestSuite) TestZeroValueAcceptsEverything() {
+	var validators Validators[string]
+	s.NoError(validators.Validate("anything"))
+	s.Equal(0, validators.Len())
+}
+
+func (s *ValidatorsTestSuite) TestItRunsRulesInOrderAndStopsAtTheFirstError() {
+	var validators Validators[string]
+	var calls []string
+	validators.Register(
+		func(value string) error {
+			calls = append(calls, "first")
+			return nil
+		},
+	)
+	validators.Register(maxLength(3))
+	validators.Register(
+		func(value string) error {
+			calls = append(calls, "third")
+			return nil
+		},
+	)
+
+	s.NoError(validators.Validate("abc"))
+	s.Equal([]string{"first", "third"}, calls)
+
+	calls = nil
+	s.ErrorIs(validators.Validate("abcd"), errTooLong)
+	s.Equal([]string{"first"}, calls)
+}
+
+func (s *ValidatorsTestSuite) TestUnregisterAndReset() {
+	var validators Validators[string]
+	unregister := validators.Register(maxLength(3))
+	validators.Register(maxLength(5))
+
+	unregister()
+	s.Equal(1, validators.Len())
+	s.NoError(validators.Validate("abcd"))
+
+	unregister()
+	s.Equal(1, validators.Len(), "unregistering twice is harmless")
+
+	validators.Reset()
+	s.NoError(validators.Validate("abcdef"))
+}
+
+func (s *ValidatorsTestSuite) TestItIsSafeForConcurrentUse() {
+	var validators Validators[string]
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			unregister := validators.Register(maxLength(10))
+			unregister()
+		}()
+		go func() {
+			defer wg.Done()
+			_ = validators.Validate("value")
+		}()
+	}
+	wg.Wait()
+	s.Equal(0, validators.Len())
+}
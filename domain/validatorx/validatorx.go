@@ -0,0 +1,208 @@
+// Package validatorx integrates the value objects with go-playground/validator.
+//
+// Register adds two things to a validator instance:
+//
+//   - custom type funcs, so value object fields are validated through their text form and the
+//     standard tags (required, omitempty, oneof, ...) work on them; zero values read as empty
+//   - one tag per value object (e.g., "emailvo", "currencyvo") that checks a raw string with
+//     the same rules as the value object's constructor
+//
+// For example:
+//
+//	type SignupRequest struct {
+//		Email    string    `validate:"required,emailvo"`
+//		Country  string    `validate:"omitempty,countryvo"`
+//		Referrer web.Email `validate:"required"`
+//	}
+//
+//	validate := validatorx.New()
+//	err := validate.Struct(request)
+package validatorx
+
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golibry/go-common-domain/domain/auth"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/person"
+	"github.com/golibry/go-common-domain/domain/person/contact"
+	"github.com/golibry/go-common-domain/domain/web"
+)
+
+// Tags checking a raw string against the rules of a value object
+const (
+	TagEmail         = "emailvo"
+	TagURL           = "urlvo"
+	TagDomainName    = "domainvo"
+	TagIPAddress     = "ipvo"
+	TagCountryCode   = "countryvo"
+	TagPhoneNumber   = "phonevo"
+	TagUsername      = "usernamevo"
+	TagUUID          = "uuidvo"
+	TagULID          = "ulidvo"
+	TagCurrency      = "currencyvo"
+	TagMoney         = "moneyvo"
+	TagInterestRate  = "interestratevo"
+	TagVATNumber     = "vatvo"
+	TagISIN          = "isinvo"
+	TagCUSIP         = "cusipvo"
+	TagAccountNumber = "accountnumbervo"
+	TagRoutingNumber = "routingnumbervo"
+)
+
+// stringRules maps every tag to the check applied to the raw string
+var stringRules = map[string]func(string) error{
+	TagEmail:         check(web.NewEmail),
+	TagURL:           check(web.NewURL),
+	TagDomainName:    check(web.NewDomainName),
+	TagIPAddress:     check(web.NewIPAddress),
+	TagCountryCode:   check(geography.NewCountryCode),
+	TagPhoneNumber:   check(contact.NewPhoneNumber),
+	TagUsername:      check(auth.NewUsername),
+	TagUUID:          check(identifier.NewUUIDFromString),
+	TagULID:          check(identifier.NewULIDFromString),
+	TagCurrency:      check(finance.NewCurrency),
+	TagMoney:         check(finance.ParseMoney),
+	TagInterestRate:  check(finance.NewInterestRateFromString),
+	TagVATNumber:     check(finance.NewVATNumber),
+	TagISIN:          check(finance.NewISIN),
+	TagCUSIP:         check(finance.NewCUSIP),
+	TagAccountNumber: check(finance.NewAccountNumber),
+	TagRoutingNumber: check(finance.NewRoutingNumber),
+}
+
+// New returns a validator with required struct validation enabled and every value object
+// registered
+func New() *validator.Validate {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := Register(validate); err != nil {
+		// The tags are constants known to be valid, so registration cannot fail
+		panic(err)
+	}
+	return validate
+}
+
+// Register adds the custom type funcs and the value object tags to an existing validator
+func Register(validate *validator.Validate) error {
+	validate.RegisterCustomTypeFunc(
+		textValue,
+		web.URL{},
+		web.Email{},
+		web.DomainName{},
+		web.IPAddress{},
+		geography.CountryCode{},
+		contact.PhoneNumber{},
+		auth.Username{},
+		person.FullName{},
+		identifier.UUID{},
+		identifier.ULID{},
+		identifier.Composite{},
+		finance.Currency{},
+		finance.Money{},
+		finance.InterestRate{},
+		finance.VATNumber{},
+		finance.ISIN{},
+		finance.CUSIP{},
+		finance.AccountNumber{},
+		finance.RoutingNumber{},
+	)
+	validate.RegisterCustomTypeFunc(
+		integerValue,
+		identifier.IntIdentifier{},
+		identifier.StringIntIdentifier{},
+		identifier.Snowflake{},
+	)
+
+	for tag, rule := range stringRules {
+		if err := validate.RegisterValidation(tag, stringValidation(rule)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check adapts a value object constructor to a string check
+func check[T any](parse func(string) (T, error)) func(string) error {
+	return func(value string) error {
+		_, err := parse(value)
+		return err
+	}
+}
+
+// stringValidation runs rule on string fields; any other kind fails validation
+func stringValidation(rule func(string) error) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+		return rule(field.String()) == nil
+	}
+}
+
+// textValue returns the text form of a value object, the same form its tag accepts, or nil for
+// the zero value
+func textValue(field reflect.Value) any {
+	if field.IsZero() {
+		return nil
+	}
+
+	switch value := field.Interface().(type) {
+	case web.URL:
+		return value.Value()
+	case web.Email:
+		return value.Value()
+	case web.DomainName:
+		return value.Value()
+	case web.IPAddress:
+		return value.Value()
+	case geography.CountryCode:
+		return value.Value()
+	case contact.PhoneNumber:
+		return value.String()
+	case auth.Username:
+		return value.Value()
+	case person.FullName:
+		return value.String()
+	case identifier.UUID:
+		return value.String()
+	case identifier.ULID:
+		return value.String()
+	case identifier.Composite:
+		return value.String()
+	case finance.Currency:
+		return value.Value()
+	case finance.Money:
+		return value.String()
+	case finance.InterestRate:
+		return value.AnnualPercent().String()
+	case finance.VATNumber:
+		return value.Value()
+	case finance.ISIN:
+		return value.Value()
+	case finance.CUSIP:
+		return value.Value()
+	case finance.AccountNumber:
+		return value.Value()
+	case finance.RoutingNumber:
+		return value.Value()
+	}
+	return nil
+}
+
+// integerValue returns the numeric value of an integer identifier, so numeric tags such as
+// "gt" apply to it
+func integerValue(field reflect.Value) any {
+	switch value := field.Interface().(type) {
+	case identifier.IntIdentifier:
+		return value.Value()
+	case identifier.StringIntIdentifier:
+		return value.Value()
+	case identifier.Snowflake:
+		return value.Value()
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package validatorx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golibry/go-common-domain/domain/finance"
+	"github.com/golibry/go-common-domain/domain/identifier"
+	"github.com/golibry/go-common-domain/domain/web"
+	"github.com/stretchr/testify/suite"
+)
+
+type ValidatorXTestSuite struct {
+	suite.Suite
+	validate *validator.Validate
+}
+
+func TestValidatorXSuite(t *testing.T) {
+	suite.Run(t, new(ValidatorXTestSuite))
+}
+
+func (s *ValidatorXTestSuite) SetupTest() {
+	s.validate = New()
+}
+
+type signupRequest struct {
+	Email    string `validate:"required,emailvo"`
+	Country  string `validate:"omitempty,countryvo"`
+	Currency string `validate:"required,currencyvo"`
+	Deposit  string `validate:"omitempty,moneyvo"`
+}
+
+type account struct {
+	ID       identifier.IntIdentifier `validate:"required,gt=10"`
+	Email    web.Email                `validate:"required,emailvo"`
+	Backup   web.Email                `validate:"omitempty,emailvo"`
+	Currency finance.Currency         `validate:"required,oneof=USD EUR"`
+	Balance  finance.Money            `validate:"required"`
+}
+
+func (s *ValidatorXTestSuite) failedTags(err error) map[string]string {
+	var validationErrors validator.ValidationErrors
+	s.Require().True(errors.As(err, &validationErrors))
+
+	failed := make(map[string]string, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		failed[fieldError.Field()] = fieldError.Tag()
+	}
+	return failed
+}
+
+func (s *ValidatorXTestSuite) TestItValidatesRawStringsWithValueObjectRules() {
+	valid := signupRequest{
+		Email:    "Jane@Example.com",
+		Country:  "ro",
+		Currency: "EUR",
+		Deposit:  "10.50 EUR",
+	}
+	s.NoError(s.validate.Struct(valid))
+
+	invalid := signupRequest{
+		Email:    "not-an-email",
+		Country:  "ROU",
+		Currency: "EURO",
+		Deposit:  "-5 EUR",
+	}
+	s.Equal(
+		map[string]string{
+			"Email":    TagEmail,
+			"Country":  TagCountryCode,
+			"Currency": TagCurrency,
+			"Deposit":  TagMoney,
+		},
+		s.failedTags(s.validate.Struct(invalid)),
+	)
+}
+
+func (s *ValidatorXTestSuite) TestItAppliesStandardTagsToValueObjectFields() {
+	id, _ := identifier.NewIntIdentifier(42)
+	email, _ := web.NewEmail("jane@example.com")
+	currency, _ := finance.NewCurrency("USD")
+	balance, _ := finance.NewMoneyFromString("0", "USD")
+
+	s.NoError(
+		s.validate.Struct(
+			account{ID: id, Email: email, Currency: currency, Balance: balance},
+		),
+	)
+
+	smallID, _ := identifier.NewIntIdentifier(7)
+	yen, _ := finance.NewCurrency("JPY")
+	s.Equal(
+		map[string]string{
+			"ID":       "gt",
+			"Email":    "required",
+			"Currency": "oneof",
+			"Balance":  "required",
+		},
+		s.failedTags(s.validate.Struct(account{ID: smallID, Currency: yen})),
+	)
+}
+
+func (s *ValidatorXTestSuite) TestItCanRegisterOnAnExistingValidator() {
+	validate := validator.New()
+	s.Require().NoError(Register(validate))
+
+	s.NoError(validate.Var("0190a2b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b", TagUUID))
+	s.Error(validate.Var("not-a-uuid", TagUUID))
+	s.Error(validate.Var(42, TagEmail))
+}
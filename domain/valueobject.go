@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Equatable is implemented by types compared by value rather than by identity
+type Equatable[T any] interface {
+	Equals(other T) bool
+}
+
+// ValueObject is implemented by every value object: it is compared by value and has a
+// string representation
+type ValueObject[T any] interface {
+	Equatable[T]
+	fmt.Stringer
+}
+
+// Sensitive is implemented by value objects holding personal data or secrets, whose String,
+// LogValue and JSON forms are masked or redacted. Observability code, such as the otel
+// package, relies on it to tell which values it may record raw.
+type Sensitive interface {
+	// Sensitive marks the type; it does nothing
+	Sensitive()
+}
+
+// Set is a collection of distinct values, where values are told apart with their Equals
+// method rather than with ==. It keeps insertion order. The zero value is an empty set
+// ready to use. Copies of a set are independent: Add and Remove never change the values
+// seen through another copy.
+type Set[T Equatable[T]] struct {
+	values []T
+}
+
+// NewSet creates a Set holding the distinct values
+func NewSet[T Equatable[T]](values ...T) Set[T] {
+	var set Set[T]
+	for _, value := range values {
+		set.add(value)
+	}
+	return set
+}
+
+// Add inserts the value and reports whether it was not already present
+func (s *Set[T]) Add(value T) bool {
+	if s.Contains(value) {
+		return false
+	}
+	// Clipping makes append copy the values, which copies of the set may share
+	s.values = append(slices.Clip(s.values), value)
+	return true
+}
+
+// Remove deletes the value and reports whether it was present
+func (s *Set[T]) Remove(value T) bool {
+	index := s.indexOf(value)
+	if index < 0 {
+		return false
+	}
+	s.values = slices.Delete(slices.Clone(s.values), index, index+1)
+	return true
+}
+
+// add inserts the value in place, for sets whose values no copy shares yet
+func (s *Set[T]) add(value T) {
+	if !s.Contains(value) {
+		s.values = append(s.values, value)
+	}
+}
+
+// Contains reports whether a value equal to value is in the set
+func (s Set[T]) Contains(value T) bool {
+	return s.indexOf(value) >= 0
+}
+
+// Len returns the number of values
+func (s Set[T]) Len() int {
+	return len(s.values)
+}
+
+// Values returns a copy of the values, in insertion order
+func (s Set[T]) Values() []T {
+	return slices.Clone(s.values)
+}
+
+// All returns an iterator over the values, in insertion order
+func (s Set[T]) All() iter.Seq[T] {
+	return slices.Values(s.values)
+}
+
+// Union returns a new set with the values of both sets
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	union := NewSet(s.values...)
+	for _, value := range other.values {
+		union.add(value)
+	}
+	return union
+}
+
+// Intersection returns a new set with the values present in both sets
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	var intersection Set[T]
+	for _, value := range s.values {
+		if other.Contains(value) {
+			intersection.values = append(intersection.values, value)
+		}
+	}
+	return intersection
+}
+
+// Difference returns a new set with the values not present in other
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	var difference Set[T]
+	for _, value := range s.values {
+		if !other.Contains(value) {
+			difference.values = append(difference.values, value)
+		}
+	}
+	return difference
+}
+
+// Equals reports whether both sets hold the same values, regardless of order
+func (s Set[T]) Equals(other Set[T]) bool {
+	if len(s.values) != len(other.values) {
+		return false
+	}
+	for _, value := range s.values {
+		if !other.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalJSON encodes the set as an array
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if s.values == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.values)
+}
+
+// UnmarshalJSON decodes an array, dropping duplicate values
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*s = NewSet(values...)
+	return nil
+}
+
+func (s Set[T]) indexOf(value T) int {
+	return slices.IndexFunc(s.values, value.Equals)
+}
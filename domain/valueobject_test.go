@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+var _ ValueObject[code] = code{}
+
+// caseInsensitive is equal to another caseInsensitive with the same letters in any case,
+// which == cannot express
+type caseInsensitive string
+
+func (c caseInsensitive) Equals(other caseInsensitive) bool {
+	return strings.EqualFold(string(c), string(other))
+}
+
+type ValueObjectTestSuite struct {
+	suite.Suite
+}
+
+func TestValueObjectSuite(t *testing.T) {
+	suite.Run(t, new(ValueObjectTestSuite))
+}
+
+func (s *ValueObjectTestSuite) TestSetUsesValueEquality() {
+	set := NewSet[caseInsensitive]("ro", "RO", "us")
+	s.Equal(2, set.Len())
+	s.True(set.Contains("Us"))
+	s.False(set.Contains("de"))
+	s.Equal([]caseInsensitive{"ro", "us"}, set.Values())
+
+	s.False(set.Add("US"))
+	s.True(set.Add("de"))
+	s.True(set.Remove("DE"))
+	s.False(set.Remove("de"))
+	s.Equal(2, set.Len())
+}
+
+func (s *ValueObjectTestSuite) TestZeroSetIsUsable() {
+	var set Set[code]
+	s.Equal(0, set.Len())
+	s.False(set.Contains(code{value: "RO"}))
+	s.True(set.Add(code{value: "RO"}))
+	s.True(set.Contains(code{value: "RO"}))
+}
+
+func (s *ValueObjectTestSuite) TestSetOperations() {
+	left := NewSet[caseInsensitive]("a", "b", "c")
+	right := NewSet[caseInsensitive]("B", "C", "d")
+
+	s.Equal([]caseInsensitive{"a", "b", "c", "d"}, left.Union(right).Values())
+	s.Equal([]caseInsensitive{"b", "c"}, left.Intersection(right).Values())
+	s.Equal([]caseInsensitive{"a"}, left.Difference(right).Values())
+	s.Equal(3, left.Len(), "operations leave the operands unchanged")
+
+	s.True(NewSet[caseInsensitive]("a", "b").Equals(NewSet[caseInsensitive]("B", "A")))
+	s.False(NewSet[caseInsensitive]("a", "b").Equals(NewSet[caseInsensitive]("a", "c")))
+	s.False(NewSet[caseInsensitive]("a").Equals(NewSet[caseInsensitive]("a", "b")))
+}
+
+func (s *ValueObjectTestSuite) TestCopiesOfASetAreIndependent() {
+	original := NewSet[caseInsensitive]("a", "b", "c")
+	removed := original
+	s.True(removed.Remove("b"))
+	s.Equal([]caseInsensitive{"a", "c"}, removed.Values())
+	s.Equal([]caseInsensitive{"a", "b", "c"}, original.Values())
+
+	base := NewSet[caseInsensitive]("a", "b", "x")
+	base.Remove("x")
+	first, second := base, base
+	s.True(first.Add("c"))
+	s.True(second.Add("d"))
+	s.Equal([]caseInsensitive{"a", "b", "c"}, first.Values())
+	s.Equal([]caseInsensitive{"a", "b", "d"}, second.Values())
+	s.Equal([]caseInsensitive{"a", "b"}, base.Values())
+}
+
+func (s *ValueObjectTestSuite) TestValuesReturnsACopy() {
+	set := NewSet(code{value: "RO"})
+	values := set.Values()
+	values[0] = code{value: "US"}
+	s.True(set.Contains(code{value: "RO"}))
+	s.Equal([]code{{value: "RO"}}, slices.Collect(set.All()))
+}
+
+func (s *ValueObjectTestSuite) TestSetJSON() {
+	encoded, err := json.Marshal(Set[code]{})
+	s.Require().NoError(err)
+	s.Equal("[]", string(encoded))
+
+	encoded, err = json.Marshal(NewSet(code{value: "RO"}, code{value: "US"}))
+	s.Require().NoError(err)
+	s.Equal(`["RO","US"]`, string(encoded))
+
+	var decoded Set[code]
+	s.Require().NoError(json.Unmarshal([]byte(`["RO","US","RO"]`), &decoded))
+	s.Equal(2, decoded.Len())
+
+	s.ErrorIs(json.Unmarshal([]byte(`["ro"]`), &decoded), errInvalidCode)
+}
@@ -0,0 +1,193 @@
+package vehicle
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/golibry/go-common-domain/domain/geography"
+)
+
+var (
+	ErrEmptyLicensePlate = domain.NewLocalizedError(
+		"vehicle.license_plate.empty", nil,
+		"license plate cannot be empty",
+	)
+	ErrInvalidLicensePlate = domain.NewLocalizedError(
+		"vehicle.license_plate.invalid_format", nil,
+		"license plate has invalid format for its country",
+	)
+	ErrUnsupportedLicensePlateCountry = domain.NewLocalizedError(
+		"vehicle.license_plate.unsupported_country", nil,
+		"license plate country is not supported",
+	)
+)
+
+var (
+	licensePlateFormatsMu sync.RWMutex
+	// licensePlateFormats holds the current formats of each country, matched against the
+	// normalized plate without separators
+	licensePlateFormats = map[string]*regexp.Regexp{
+		// Bucharest "B 123 ABC" or county "CJ 12 ABC"
+		"RO": regexp.MustCompile(`^(B\d{2,3}|[A-Z]{2}\d{2})[A-Z]{3}$`),
+		// District, letters and number, with an optional electric or historic suffix,
+		// e.g., "M-AB 1234" or "B-XY 123E"
+		"DE": regexp.MustCompile(`^[A-Z]{1,3}[A-Z]{1,2}[1-9]\d{0,3}[EH]?$`),
+		// SIV "AB-123-CD"
+		"FR": regexp.MustCompile(`^[A-Z]{2}\d{3}[A-Z]{2}$`),
+		// "AB12 CDE"
+		"GB": regexp.MustCompile(`^[A-Z]{2}\d{2}[A-Z]{3}$`),
+		// "1234 BCD", without vowels
+		"ES": regexp.MustCompile(`^\d{4}[BCDFGHJKLMNPRSTVWXYZ]{3}$`),
+		// "AB 123 CD"
+		"IT": regexp.MustCompile(`^[A-Z]{2}\d{3}[A-Z]{2}$`),
+		// Six letters and digits in one of the sidecodes, e.g., "AB-123-C"
+		"NL": regexp.MustCompile(`^[A-Z0-9]{6}$`),
+		// District and vehicle part, e.g., "WA 12345"
+		"PL": regexp.MustCompile(`^[A-Z]{2,3}[A-Z0-9]{4,5}$`),
+		// Formats vary by state, up to eight letters and digits
+		"US": regexp.MustCompile(`^[A-Z0-9]{1,8}$`),
+	}
+)
+
+// RegisterLicensePlateFormat registers (or replaces) the format of a country's license plates.
+// The pattern is matched against the normalized plate, upper case and without spaces, hyphens
+// or dots, and should be anchored. It is safe to call concurrently with NewLicensePlate.
+func RegisterLicensePlateFormat(country geography.CountryCode, pattern *regexp.Regexp) {
+	licensePlateFormatsMu.Lock()
+	defer licensePlateFormatsMu.Unlock()
+	licensePlateFormats[country.Value()] = pattern
+}
+
+// LicensePlate is the registration plate of a vehicle in a country, stored without
+// separators (e.g., "B123ABC" for "B 123 ABC"), the form plate recognition cameras read
+type LicensePlate struct {
+	country geography.CountryCode
+	value   string
+}
+
+// NewLicensePlate creates a new instance of LicensePlate with validation and normalization,
+// using the format registered for the country
+func NewLicensePlate(country geography.CountryCode, value string) (LicensePlate, error) {
+	normalized, err := NormalizeLicensePlate(value)
+	if err != nil {
+		return LicensePlate{}, err
+	}
+
+	if err := IsValidLicensePlate(country, normalized); err != nil {
+		return LicensePlate{}, err
+	}
+
+	return LicensePlate{
+		country: country,
+		value:   normalized,
+	}, nil
+}
+
+// ReconstituteLicensePlate creates a new LicensePlate instance without validation or
+// normalization
+func ReconstituteLicensePlate(country geography.CountryCode, value string) LicensePlate {
+	return LicensePlate{
+		country: country,
+		value:   value,
+	}
+}
+
+// NormalizeLicensePlate normalizes a license plate by converting to uppercase and removing
+// spaces, hyphens and dots
+func NormalizeLicensePlate(value string) (string, error) {
+	var result strings.Builder
+	for _, r := range value {
+		if unicode.IsSpace(r) || r == '-' || r == '.' {
+			continue
+		}
+		result.WriteRune(unicode.ToUpper(r))
+	}
+
+	normalized := result.String()
+	if normalized == "" {
+		return "", ErrEmptyLicensePlate
+	}
+
+	return normalized, nil
+}
+
+// IsValidLicensePlate validates a normalized license plate with the format registered for the
+// country
+func IsValidLicensePlate(country geography.CountryCode, normalized string) error {
+	if normalized == "" {
+		return ErrEmptyLicensePlate
+	}
+
+	licensePlateFormatsMu.RLock()
+	pattern, ok := licensePlateFormats[country.Value()]
+	licensePlateFormatsMu.RUnlock()
+	if !ok {
+		return ErrUnsupportedLicensePlateCountry
+	}
+
+	if !pattern.MatchString(normalized) {
+		return ErrInvalidLicensePlate
+	}
+	return nil
+}
+
+// Country returns the country that registered the vehicle
+func (l LicensePlate) Country() geography.CountryCode {
+	return l.country
+}
+
+// Value returns the license plate without separators
+func (l LicensePlate) Value() string {
+	return l.value
+}
+
+// Equals compares two LicensePlate objects for equality
+func (l LicensePlate) Equals(other LicensePlate) bool {
+	return l.country.Equals(other.country) && l.value == other.value
+}
+
+// String returns a string representation of the license plate
+func (l LicensePlate) String() string {
+	return l.value
+}
+
+// licensePlateJSON is the JSON representation of LicensePlate
+type licensePlateJSON struct {
+	Country string `json:"country"`
+	Value   string `json:"value"`
+}
+
+// MarshalJSON encodes the license plate with its country
+func (l LicensePlate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(licensePlateJSON{Country: l.country.Value(), Value: l.value})
+}
+
+// UnmarshalJSON decodes and validates a license plate given with its country
+func (l *LicensePlate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw licensePlateJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid license plate JSON")
+	}
+
+	country, err := geography.NewCountryCode(raw.Country)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewLicensePlate(country, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+	return nil
+}
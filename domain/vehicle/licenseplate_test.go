@@ -0,0 +1,144 @@
+package vehicle
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain/geography"
+	"github.com/stretchr/testify/suite"
+)
+
+type LicensePlateTestSuite struct {
+	suite.Suite
+}
+
+func TestLicensePlateSuite(t *testing.T) {
+	suite.Run(t, new(LicensePlateTestSuite))
+}
+
+func (s *LicensePlateTestSuite) TestItCanBuildValidLicensePlates() {
+	testCases := []struct {
+		name     string
+		country  string
+		input    string
+		expected string
+	}{
+		{"RO Bucharest", "RO", "B 123 ABC", "B123ABC"},
+		{"RO Bucharest short", "RO", "B-12-XYZ", "B12XYZ"},
+		{"RO county", "RO", "cj 12 abc", "CJ12ABC"},
+		{"DE", "DE", "M-AB 1234", "MAB1234"},
+		{"DE electric", "DE", "B-XY 123E", "BXY123E"},
+		{"FR", "FR", "AB-123-CD", "AB123CD"},
+		{"GB", "GB", "AB12 CDE", "AB12CDE"},
+		{"ES", "ES", "1234 BCD", "1234BCD"},
+		{"IT", "IT", "AB 123 CD", "AB123CD"},
+		{"NL", "NL", "AB-123-C", "AB123C"},
+		{"PL", "PL", "WA 12345", "WA12345"},
+		{"US", "US", "7ABC123", "7ABC123"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				country, _ := geography.NewCountryCode(tc.country)
+				plate, err := NewLicensePlate(country, tc.input)
+				s.NoError(err)
+				s.Equal(tc.expected, plate.Value())
+				s.Equal(tc.expected, plate.String())
+				s.True(plate.Country().Equals(country))
+			},
+		)
+	}
+}
+
+func (s *LicensePlateTestSuite) TestItFailsToBuildInvalidLicensePlates() {
+	testCases := []struct {
+		name          string
+		country       string
+		input         string
+		expectedError error
+	}{
+		{"empty", "RO", " - ", ErrEmptyLicensePlate},
+		{"RO county with three digits", "RO", "CJ 123 ABC", ErrInvalidLicensePlate},
+		{"RO missing letters", "RO", "B 123 AB", ErrInvalidLicensePlate},
+		{"DE number starting with zero", "DE", "M-AB 0123", ErrInvalidLicensePlate},
+		{"FR old format", "FR", "123 ABC 75", ErrInvalidLicensePlate},
+		{"ES vowel", "ES", "1234 ABC", ErrInvalidLicensePlate},
+		{"US too long", "US", "ABCDE12345", ErrInvalidLicensePlate},
+		{"US symbol", "US", "ABC_123", ErrInvalidLicensePlate},
+		{"unsupported country", "ZW", "ABC 1234", ErrUnsupportedLicensePlateCountry},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				country, _ := geography.NewCountryCode(tc.country)
+				_, err := NewLicensePlate(country, tc.input)
+				s.ErrorIs(err, tc.expectedError)
+			},
+		)
+	}
+}
+
+func (s *LicensePlateTestSuite) TestItCanRegisterCustomFormats() {
+	country, _ := geography.NewCountryCode("ZZ")
+	RegisterLicensePlateFormat(country, regexp.MustCompile(`^ZZ\d{3}$`))
+
+	plate, err := NewLicensePlate(country, "zz-123")
+	s.NoError(err)
+	s.Equal("ZZ123", plate.Value())
+
+	_, err = NewLicensePlate(country, "123")
+	s.ErrorIs(err, ErrInvalidLicensePlate)
+}
+
+func (s *LicensePlateTestSuite) TestItCanReconstituteLicensePlate() {
+	country, _ := geography.NewCountryCode("RO")
+	plate := ReconstituteLicensePlate(country, "b 123 abc")
+	s.Equal("b 123 abc", plate.Value())
+	s.Equal("RO", plate.Country().Value())
+}
+
+func (s *LicensePlateTestSuite) TestItMarshalsJSON() {
+	country, _ := geography.NewCountryCode("FR")
+	plate, _ := NewLicensePlate(country, "AB-123-CD")
+
+	encoded, err := json.Marshal(plate)
+	s.NoError(err)
+	s.JSONEq(`{"country":"FR","value":"AB123CD"}`, string(encoded))
+
+	var decoded LicensePlate
+	s.NoError(json.Unmarshal(encoded, &decoded))
+	s.True(plate.Equals(decoded))
+
+	s.NoError(json.Unmarshal([]byte("null"), &decoded))
+	s.True(plate.Equals(decoded))
+}
+
+func (s *LicensePlateTestSuite) TestItFailsToUnmarshalInvalidJSON() {
+	var plate LicensePlate
+
+	err := json.Unmarshal([]byte(`{"country":"FR","value":"123 ABC 75"}`), &plate)
+	s.ErrorIs(err, ErrInvalidLicensePlate)
+
+	err = json.Unmarshal([]byte(`{"country":"","value":"AB-123-CD"}`), &plate)
+	s.ErrorIs(err, geography.ErrEmptyCountryCode)
+
+	s.Error(json.Unmarshal([]byte(`"AB-123-CD"`), &plate))
+}
+
+func (s *LicensePlateTestSuite) TestEquals() {
+	ro, _ := geography.NewCountryCode("RO")
+	fr, _ := geography.NewCountryCode("FR")
+	first, _ := NewLicensePlate(ro, "B 123 ABC")
+	second, _ := NewLicensePlate(ro, "b-123-abc")
+	third, _ := NewLicensePlate(ro, "B 124 ABC")
+	other, _ := NewLicensePlate(fr, "AB-123-CD")
+	sameValue := ReconstituteLicensePlate(fr, "B123ABC")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+	s.False(first.Equals(other))
+	s.False(first.Equals(sameValue))
+}
@@ -0,0 +1,313 @@
+package version
+
+import (
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var ErrInvalidConstraint = domain.NewError("version constraint has invalid format")
+
+// constraintOperators lists the comparison operators, two-character ones first
+var constraintOperators = []string{">=", "<=", "!=", ">", "<", "=", "^", "~"}
+
+// Constraint is a version range such as "^1.2.0", ">=2, <3" or "~1.4 || ^2", in the syntax
+// used by npm and Composer:
+//
+//   - Comparisons: "=1.2.3" (or "1.2.3"), "!=1.2.3", ">1.2", ">=1.2", "<2", "<=2.1"
+//   - Caret ranges allow changes that keep the leftmost non-zero part: "^1.2.3" is
+//     ">=1.2.3, <2.0.0" and "^0.2.3" is ">=0.2.3, <0.3.0"
+//   - Tilde ranges allow patch changes: "~1.2.3" is ">=1.2.3, <1.3.0"
+//   - Partial versions and wildcards stand for every version they cover: "1.2", "1.2.x" and
+//     "1.2.*" are ">=1.2.0, <1.3.0", and "*" matches everything
+//
+// Comparisons separated by commas or spaces must all hold, and "||" separates alternatives.
+// Pre-releases only match a set of comparisons that names a pre-release of the same
+// major.minor.patch version, so "^1.2.0" never selects "1.3.0-beta".
+type Constraint struct {
+	value  string
+	ranges [][]comparator
+}
+
+// comparator is a single comparison against a version. Explicit comparators name a version
+// written in the constraint, rather than a bound derived from a partial version or range.
+type comparator struct {
+	operator string
+	version  SemVer
+	explicit bool
+}
+
+// NewConstraint creates a new instance of Constraint with validation
+func NewConstraint(value string) (Constraint, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Constraint{}, ErrInvalidConstraint
+	}
+
+	var ranges [][]comparator
+	for alternative := range strings.SplitSeq(value, "||") {
+		comparators, err := parseComparators(alternative)
+		if err != nil {
+			return Constraint{}, err
+		}
+		ranges = append(ranges, comparators)
+	}
+
+	return Constraint{
+		value:  value,
+		ranges: ranges,
+	}, nil
+}
+
+// MustConstraint is like NewConstraint but panics on an invalid constraint. It simplifies
+// declaring constraints known at compile time.
+func MustConstraint(value string) Constraint {
+	constraint, err := NewConstraint(value)
+	if err != nil {
+		panic(err)
+	}
+	return constraint
+}
+
+// Matches reports whether the version satisfies the constraint
+func (c Constraint) Matches(version SemVer) bool {
+	for _, comparators := range c.ranges {
+		if matchesAll(comparators, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equals compares two Constraint objects for equality
+func (c Constraint) Equals(other Constraint) bool {
+	return c.value == other.value
+}
+
+// String returns the constraint as it was written
+func (c Constraint) String() string {
+	return c.value
+}
+
+// matchesAll reports whether the version satisfies every comparator of a range
+func matchesAll(comparators []comparator, version SemVer) bool {
+	namesPrerelease := false
+	for _, comparator := range comparators {
+		if !comparator.matches(version) {
+			return false
+		}
+		if comparator.explicit && comparator.version.prerelease != "" &&
+			comparator.version.major == version.major &&
+			comparator.version.minor == version.minor &&
+			comparator.version.patch == version.patch {
+			namesPrerelease = true
+		}
+	}
+	return version.prerelease == "" || namesPrerelease
+}
+
+func (c comparator) matches(version SemVer) bool {
+	result := version.Compare(c.version)
+	switch c.operator {
+	case "=":
+		return result == 0
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	default: // "<="
+		return result <= 0
+	}
+}
+
+// parseComparators parses the comparisons of one alternative, which must all hold
+func parseComparators(alternative string) ([]comparator, error) {
+	tokens := strings.FieldsFunc(
+		alternative, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		},
+	)
+	if len(tokens) == 0 {
+		return nil, ErrInvalidConstraint
+	}
+
+	comparators := []comparator{}
+	for i := 0; i < len(tokens); i++ {
+		term := tokens[i]
+		// Allow a space between an operator and its version, as in ">= 1.2"
+		if isOperator(term) && i+1 < len(tokens) {
+			i++
+			term += tokens[i]
+		}
+
+		expanded, err := expandTerm(term)
+		if err != nil {
+			return nil, err
+		}
+		comparators = append(comparators, expanded...)
+	}
+	return comparators, nil
+}
+
+// expandTerm turns an operator and a possibly partial version into comparators
+func expandTerm(term string) ([]comparator, error) {
+	operator := ""
+	for _, candidate := range constraintOperators {
+		if strings.HasPrefix(term, candidate) {
+			operator = candidate
+			break
+		}
+	}
+
+	p, ok := parsePartial(strings.TrimPrefix(term, operator))
+	if !ok {
+		return nil, ErrInvalidConstraint
+	}
+
+	lower := comparator{operator: ">=", version: p.lower(), explicit: p.parts == 3}
+	switch {
+	case p.parts == 0 && (operator == ">" || operator == "<"):
+		// Nothing is greater or lower than every version
+		return []comparator{{operator: "<", version: SemVer{prerelease: "0"}}}, nil
+	case p.parts == 0 && operator == "!=":
+		return nil, ErrInvalidConstraint
+	case p.parts == 0:
+		return nil, nil
+	}
+
+	switch operator {
+	case "", "=":
+		if p.parts == 3 {
+			return []comparator{{operator: "=", version: p.lower(), explicit: true}}, nil
+		}
+		return []comparator{lower, {operator: "<", version: p.next()}}, nil
+	case "!=":
+		if p.parts < 3 {
+			return nil, ErrInvalidConstraint
+		}
+		return []comparator{{operator: "!=", version: p.lower(), explicit: true}}, nil
+	case ">":
+		if p.parts == 3 {
+			return []comparator{{operator: ">", version: p.lower(), explicit: true}}, nil
+		}
+		return []comparator{{operator: ">=", version: p.next()}}, nil
+	case ">=":
+		return []comparator{lower}, nil
+	case "<":
+		if p.parts == 3 {
+			return []comparator{{operator: "<", version: p.lower(), explicit: true}}, nil
+		}
+		return []comparator{{operator: "<", version: p.floor()}}, nil
+	case "<=":
+		if p.parts == 3 {
+			return []comparator{{operator: "<=", version: p.lower(), explicit: true}}, nil
+		}
+		return []comparator{{operator: "<", version: p.next()}}, nil
+	case "^":
+		return []comparator{lower, {operator: "<", version: p.caretUpper()}}, nil
+	default: // "~"
+		upper := p.next()
+		if p.parts == 3 {
+			upper = SemVer{major: p.major, minor: p.minor + 1, prerelease: "0"}
+		}
+		return []comparator{lower, {operator: "<", version: upper}}, nil
+	}
+}
+
+// partial is a version in a constraint, where trailing parts may be missing or wildcards
+type partial struct {
+	major, minor, patch uint64
+	prerelease          string
+	// parts is the number of leading parts given, from 0 ("*") to 3 ("1.2.3")
+	parts int
+}
+
+// parsePartial parses "*", "1", "1.2", "1.x", "1.2.3-rc.1" and similar versions
+func parsePartial(value string) (partial, bool) {
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "v"), "V")
+	value, _, _ = strings.Cut(value, "+")
+	if value == "" {
+		return partial{}, false
+	}
+
+	core, prerelease, hasPrerelease := strings.Cut(value, "-")
+	if hasPrerelease && !validIdentifiers(prerelease, true) {
+		return partial{}, false
+	}
+
+	var p partial
+	numbers := [3]*uint64{&p.major, &p.minor, &p.patch}
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return partial{}, false
+	}
+	wildcard := false
+	for i, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			wildcard = true
+			continue
+		}
+		number, ok := parseNumericIdentifier(part)
+		if !ok || wildcard {
+			return partial{}, false
+		}
+		*numbers[i] = number
+		p.parts++
+	}
+
+	if hasPrerelease && p.parts < 3 {
+		return partial{}, false
+	}
+	p.prerelease = prerelease
+	return p, true
+}
+
+// lower returns the lowest version the partial covers, its pre-releases aside
+func (p partial) lower() SemVer {
+	return SemVer{major: p.major, minor: p.minor, patch: p.patch, prerelease: p.prerelease}
+}
+
+// floor returns a version below every version, pre-releases included, the partial covers
+func (p partial) floor() SemVer {
+	return SemVer{major: p.major, minor: p.minor, patch: p.patch, prerelease: "0"}
+}
+
+// next returns the floor of the next partial version of the same precision, e.g.,
+// "1.3.0-0" for "1.2"
+func (p partial) next() SemVer {
+	switch p.parts {
+	case 1:
+		return SemVer{major: p.major + 1, prerelease: "0"}
+	case 2:
+		return SemVer{major: p.major, minor: p.minor + 1, prerelease: "0"}
+	default:
+		return SemVer{major: p.major, minor: p.minor, patch: p.patch + 1, prerelease: "0"}
+	}
+}
+
+// caretUpper returns the exclusive upper bound of a caret range: the next version of the
+// leftmost non-zero part, or of the last given part when all of them are zero
+func (p partial) caretUpper() SemVer {
+	switch {
+	case p.major > 0 || p.parts == 1:
+		return SemVer{major: p.major + 1, prerelease: "0"}
+	case p.minor > 0 || p.parts == 2:
+		return SemVer{minor: p.minor + 1, prerelease: "0"}
+	default:
+		return SemVer{patch: p.patch + 1, prerelease: "0"}
+	}
+}
+
+// isOperator reports whether the token is an operator without a version
+func isOperator(token string) bool {
+	for _, operator := range constraintOperators {
+		if token == operator {
+			return true
+		}
+	}
+	return false
+}
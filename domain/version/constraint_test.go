@@ -0,0 +1,88 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ConstraintTestSuite struct {
+	suite.Suite
+}
+
+func TestConstraintSuite(t *testing.T) {
+	suite.Run(t, new(ConstraintTestSuite))
+}
+
+func (s *ConstraintTestSuite) TestItMatchesVersions() {
+	testCases := []struct {
+		constraint string
+		matching   []string
+		other      []string
+	}{
+		{"1.2.3", []string{"1.2.3", "1.2.3+build"}, []string{"1.2.4", "1.2.3-rc.1"}},
+		{"=1.2.3", []string{"1.2.3"}, []string{"1.2.2"}},
+		{"!=1.2.3", []string{"1.2.2", "2.0.0"}, []string{"1.2.3", "1.2.4-rc.1"}},
+		{">1.2.3", []string{"1.2.4", "2.0.0"}, []string{"1.2.3", "1.2.4-rc.1"}},
+		{">1.2", []string{"1.3.0"}, []string{"1.2.9"}},
+		{">=1.2", []string{"1.2.0", "5.0.0"}, []string{"1.1.9"}},
+		{"<2", []string{"1.99.99"}, []string{"2.0.0", "2.0.0-rc.1"}},
+		{"<=2.1", []string{"2.1.99"}, []string{"2.2.0"}},
+		{"<=2.1.0", []string{"2.1.0"}, []string{"2.1.1"}},
+		{">=2, <3", []string{"2.0.0", "2.9.9"}, []string{"1.9.9", "3.0.0", "3.0.0-rc.1"}},
+		{">= 2 < 3", []string{"2.5.0"}, []string{"3.0.0"}},
+		{"^1.2.3", []string{"1.2.3", "1.9.0"}, []string{"1.2.2", "2.0.0", "1.3.0-beta"}},
+		{"^1.2", []string{"1.2.0", "1.99.0"}, []string{"2.0.0"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"^0.0", []string{"0.0.9"}, []string{"0.1.0"}},
+		{"^0", []string{"0.9.9"}, []string{"1.0.0"}},
+		{"~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.3.0", "1.2.2"}},
+		{"~1.2", []string{"1.2.0", "1.2.9"}, []string{"1.3.0"}},
+		{"~1", []string{"1.0.0", "1.9.9"}, []string{"2.0.0"}},
+		{"1.2.x", []string{"1.2.0", "1.2.7"}, []string{"1.3.0"}},
+		{"1.*", []string{"1.0.0", "1.9.0"}, []string{"2.0.0"}},
+		{"*", []string{"0.0.0", "9.9.9"}, []string{"1.0.0-rc.1"}},
+		{"~1.4 || ^2", []string{"1.4.5", "2.7.0"}, []string{"1.5.0", "3.0.0"}},
+		{
+			"^1.2.3-beta.2", []string{"1.2.3-beta.2", "1.2.3-beta.10", "1.2.3", "1.8.0"},
+			[]string{"1.2.3-beta.1", "1.2.4-beta.1", "2.0.0"},
+		},
+		{">*", []string{}, []string{"1.0.0"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.constraint, func() {
+				constraint, err := NewConstraint(tc.constraint)
+				s.Require().NoError(err)
+				for _, version := range tc.matching {
+					s.True(constraint.Matches(mustSemVer(version)), version)
+				}
+				for _, version := range tc.other {
+					s.False(constraint.Matches(mustSemVer(version)), version)
+				}
+			},
+		)
+	}
+}
+
+func (s *ConstraintTestSuite) TestItFailsToBuildInvalidConstraints() {
+	invalid := []string{
+		"", "   ", "||", "^1.2 ||", "1.2.3.4", ">=x.1", "1.x.3", "!=1.2", "!=*", "1.2-rc.1",
+		"=>1.2", "^^1", "foo", ">=01.2", "1.2.3-",
+	}
+	for _, input := range invalid {
+		_, err := NewConstraint(input)
+		s.ErrorIs(err, ErrInvalidConstraint, input)
+	}
+
+	s.Panics(func() { MustConstraint("foo") })
+}
+
+func (s *ConstraintTestSuite) TestItKeepsTheConstraintAsWritten() {
+	constraint := MustConstraint(" >=2, <3 ")
+	s.Equal(">=2, <3", constraint.String())
+	s.True(constraint.Equals(MustConstraint(">=2, <3")))
+	s.False(constraint.Equals(MustConstraint(">=2 <3")))
+}
@@ -0,0 +1,295 @@
+package version
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyVersion = domain.NewLocalizedError(
+		"version.empty", nil,
+		"version cannot be empty",
+	)
+	ErrInvalidVersion = domain.NewLocalizedError(
+		"version.invalid", nil,
+		"version must follow semantic versioning, e.g., 1.4.2",
+	)
+)
+
+// SemVer is a Semantic Versioning 2.0.0 version such as "1.4.2-rc.1+build.7"
+type SemVer struct {
+	major      uint64
+	minor      uint64
+	patch      uint64
+	prerelease string
+	build      string
+}
+
+// NewSemVer creates a new instance of SemVer with validation. A leading "v", as in Git tags
+// such as "v1.4.2", is accepted and dropped.
+func NewSemVer(value string) (SemVer, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return SemVer{}, ErrEmptyVersion
+	}
+
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "v"), "V")
+	version, ok := parseSemVer(value)
+	if !ok {
+		return SemVer{}, ErrInvalidVersion
+	}
+
+	return version, nil
+}
+
+// ReconstituteSemVer creates a new SemVer instance from its parts without validation
+func ReconstituteSemVer(major, minor, patch uint64, prerelease, build string) SemVer {
+	return SemVer{
+		major:      major,
+		minor:      minor,
+		patch:      patch,
+		prerelease: prerelease,
+		build:      build,
+	}
+}
+
+// Major returns the major version
+func (v SemVer) Major() uint64 {
+	return v.major
+}
+
+// Minor returns the minor version
+func (v SemVer) Minor() uint64 {
+	return v.minor
+}
+
+// Patch returns the patch version
+func (v SemVer) Patch() uint64 {
+	return v.patch
+}
+
+// Prerelease returns the pre-release identifiers, e.g., "rc.1", or an empty string
+func (v SemVer) Prerelease() string {
+	return v.prerelease
+}
+
+// Build returns the build metadata, e.g., "build.7", or an empty string
+func (v SemVer) Build() string {
+	return v.build
+}
+
+// IsPrerelease reports whether the version is a pre-release
+func (v SemVer) IsPrerelease() bool {
+	return v.prerelease != ""
+}
+
+// Compare returns -1, 0 or +1 depending on whether the version has lower, equal or higher
+// precedence than other. Build metadata is ignored, as the specification requires.
+func (v SemVer) Compare(other SemVer) int {
+	if result := cmp.Compare(v.major, other.major); result != 0 {
+		return result
+	}
+	if result := cmp.Compare(v.minor, other.minor); result != 0 {
+		return result
+	}
+	if result := cmp.Compare(v.patch, other.patch); result != 0 {
+		return result
+	}
+	return comparePrerelease(v.prerelease, other.prerelease)
+}
+
+// LessThan reports whether the version has lower precedence than other
+func (v SemVer) LessThan(other SemVer) bool {
+	return v.Compare(other) < 0
+}
+
+// IncrementMajor returns the next major version. A pre-release of a major version, such as
+// "2.0.0-rc.1", increments to its release ("2.0.0"). Build metadata is dropped.
+func (v SemVer) IncrementMajor() SemVer {
+	if v.prerelease != "" && v.minor == 0 && v.patch == 0 {
+		return SemVer{major: v.major}
+	}
+	return SemVer{major: v.major + 1}
+}
+
+// IncrementMinor returns the next minor version. A pre-release of a minor version, such as
+// "1.5.0-rc.1", increments to its release ("1.5.0"). Build metadata is dropped.
+func (v SemVer) IncrementMinor() SemVer {
+	if v.prerelease != "" && v.patch == 0 {
+		return SemVer{major: v.major, minor: v.minor}
+	}
+	return SemVer{major: v.major, minor: v.minor + 1}
+}
+
+// IncrementPatch returns the next patch version. A pre-release, such as "1.4.2-rc.1",
+// increments to its release ("1.4.2"). Build metadata is dropped.
+func (v SemVer) IncrementPatch() SemVer {
+	if v.prerelease != "" {
+		return SemVer{major: v.major, minor: v.minor, patch: v.patch}
+	}
+	return SemVer{major: v.major, minor: v.minor, patch: v.patch + 1}
+}
+
+// Equals compares two SemVer objects for equality, including their build metadata
+func (v SemVer) Equals(other SemVer) bool {
+	return v == other
+}
+
+// String returns the canonical form of the version, without a "v" prefix
+func (v SemVer) String() string {
+	var b strings.Builder
+	b.WriteString(strconv.FormatUint(v.major, 10))
+	b.WriteByte('.')
+	b.WriteString(strconv.FormatUint(v.minor, 10))
+	b.WriteByte('.')
+	b.WriteString(strconv.FormatUint(v.patch, 10))
+	if v.prerelease != "" {
+		b.WriteByte('-')
+		b.WriteString(v.prerelease)
+	}
+	if v.build != "" {
+		b.WriteByte('+')
+		b.WriteString(v.build)
+	}
+	return b.String()
+}
+
+// MarshalText encodes the version in its canonical form
+func (v SemVer) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText parses the version from text, with validation. Empty text leaves the value
+// unchanged.
+func (v *SemVer) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewSemVer(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+	return nil
+}
+
+// parseSemVer parses a version without a "v" prefix
+func parseSemVer(value string) (SemVer, bool) {
+	value, build, hasBuild := strings.Cut(value, "+")
+	if hasBuild && !validIdentifiers(build, false) {
+		return SemVer{}, false
+	}
+	core, prerelease, hasPrerelease := strings.Cut(value, "-")
+	if hasPrerelease && !validIdentifiers(prerelease, true) {
+		return SemVer{}, false
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return SemVer{}, false
+	}
+	var numbers [3]uint64
+	for i, part := range parts {
+		number, ok := parseNumericIdentifier(part)
+		if !ok {
+			return SemVer{}, false
+		}
+		numbers[i] = number
+	}
+
+	return ReconstituteSemVer(numbers[0], numbers[1], numbers[2], prerelease, build), true
+}
+
+// parseNumericIdentifier parses a major, minor or patch number, which must not have leading
+// zeros
+func parseNumericIdentifier(value string) (uint64, bool) {
+	if value == "" || (len(value) > 1 && value[0] == '0') {
+		return 0, false
+	}
+	if !isNumeric(value) {
+		return 0, false
+	}
+
+	number, err := strconv.ParseUint(value, 10, 64)
+	return number, err == nil
+}
+
+// validIdentifiers reports whether value is a dot-separated list of non-empty identifiers
+// made of ASCII letters, digits and hyphens. Numeric pre-release identifiers must not have
+// leading zeros; build identifiers may.
+func validIdentifiers(value string, prerelease bool) bool {
+	for identifier := range strings.SplitSeq(value, ".") {
+		if identifier == "" {
+			return false
+		}
+		for i := 0; i < len(identifier); i++ {
+			c := identifier[i]
+			if !isDigit(c) && !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && c != '-' {
+				return false
+			}
+		}
+		if prerelease && len(identifier) > 1 && identifier[0] == '0' && isNumeric(identifier) {
+			return false
+		}
+	}
+	return true
+}
+
+// comparePrerelease compares pre-release identifiers by the specification's precedence rules:
+// a release ranks above its pre-releases, numeric identifiers compare numerically and rank
+// below alphanumeric ones, and a longer list ranks above its prefix
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	left, right := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(left) && i < len(right); i++ {
+		if result := compareIdentifier(left[i], right[i]); result != 0 {
+			return result
+		}
+	}
+	return cmp.Compare(len(left), len(right))
+}
+
+// compareIdentifier compares two pre-release identifiers
+func compareIdentifier(a, b string) int {
+	aNumeric, bNumeric := isNumeric(a), isNumeric(b)
+	switch {
+	case aNumeric && bNumeric:
+		// Without leading zeros, a longer number is a larger one
+		if result := cmp.Compare(len(a), len(b)); result != 0 {
+			return result
+		}
+		return strings.Compare(a, b)
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// isNumeric reports whether the identifier holds only digits
+func isNumeric(identifier string) bool {
+	for i := 0; i < len(identifier); i++ {
+		if !isDigit(identifier[i]) {
+			return false
+		}
+	}
+	return identifier != ""
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
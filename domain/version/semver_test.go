@@ -0,0 +1,155 @@
+package version
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SemVerTestSuite struct {
+	suite.Suite
+}
+
+func TestSemVerSuite(t *testing.T) {
+	suite.Run(t, new(SemVerTestSuite))
+}
+
+func (s *SemVerTestSuite) TestItCanBuildNewSemVerWithValidValues() {
+	testCases := []struct {
+		input      string
+		expected   string
+		prerelease string
+		build      string
+	}{
+		{"0.0.0", "0.0.0", "", ""},
+		{"1.4.2", "1.4.2", "", ""},
+		{" v1.4.2 ", "1.4.2", "", ""},
+		{"V10.20.30", "10.20.30", "", ""},
+		{"1.0.0-alpha", "1.0.0-alpha", "alpha", ""},
+		{"1.0.0-rc.1+build.7", "1.0.0-rc.1+build.7", "rc.1", "build.7"},
+		{"1.0.0-x-y-z.--", "1.0.0-x-y-z.--", "x-y-z.--", ""},
+		{"1.0.0+0017.sha-5114f85", "1.0.0+0017.sha-5114f85", "", "0017.sha-5114f85"},
+		{"18446744073709551615.0.0", "18446744073709551615.0.0", "", ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.input, func() {
+				version, err := NewSemVer(tc.input)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, version.String())
+				s.Equal(tc.prerelease, version.Prerelease())
+				s.Equal(tc.build, version.Build())
+				s.Equal(tc.prerelease != "", version.IsPrerelease())
+			},
+		)
+	}
+
+	version, err := NewSemVer("3.14.159")
+	s.NoError(err)
+	s.Equal(uint64(3), version.Major())
+	s.Equal(uint64(14), version.Minor())
+	s.Equal(uint64(159), version.Patch())
+}
+
+func (s *SemVerTestSuite) TestItFailsToBuildNewSemVerWithInvalidValues() {
+	_, err := NewSemVer("  ")
+	s.ErrorIs(err, ErrEmptyVersion)
+
+	invalid := []string{
+		"1", "1.2", "1.2.3.4", "01.2.3", "1.02.3", "1.2.03", "1.2.3-", "1.2.3+", "1.2.3-01",
+		"1.2.3-rc..1", "1.2.3+build+7", "1.2.3-rc_1", "a.b.c", "1.2.-3", "vv1.2.3",
+		"18446744073709551616.0.0", "1 .2.3",
+	}
+	for _, input := range invalid {
+		_, err := NewSemVer(input)
+		s.ErrorIs(err, ErrInvalidVersion, input)
+	}
+}
+
+func (s *SemVerTestSuite) TestItComparesVersionsByPrecedence() {
+	// Ordered as in the Semantic Versioning specification
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0", "10.0.0",
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			left, right := mustSemVer(ordered[i]), mustSemVer(ordered[j])
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			s.Equal(expected, left.Compare(right), "%s vs %s", ordered[i], ordered[j])
+			s.Equal(i < j, left.LessThan(right))
+		}
+	}
+
+	shuffled := []SemVer{mustSemVer("2.0.0"), mustSemVer("1.0.0-rc.1"), mustSemVer("1.0.0")}
+	slices.SortFunc(shuffled, SemVer.Compare)
+	s.Equal("1.0.0-rc.1", shuffled[0].String())
+}
+
+func (s *SemVerTestSuite) TestItIgnoresBuildMetadataInPrecedenceButNotInEquality() {
+	left, right := mustSemVer("1.0.0+build.1"), mustSemVer("1.0.0+build.2")
+	s.Equal(0, left.Compare(right))
+	s.False(left.Equals(right))
+	s.True(left.Equals(mustSemVer("v1.0.0+build.1")))
+}
+
+func (s *SemVerTestSuite) TestItIncrementsVersions() {
+	testCases := []struct {
+		input string
+		major string
+		minor string
+		patch string
+	}{
+		{"1.4.2", "2.0.0", "1.5.0", "1.4.3"},
+		{"1.4.2+build.7", "2.0.0", "1.5.0", "1.4.3"},
+		{"1.4.2-rc.1", "2.0.0", "1.5.0", "1.4.2"},
+		{"1.5.0-rc.1", "2.0.0", "1.5.0", "1.5.0"},
+		{"2.0.0-rc.1", "2.0.0", "2.0.0", "2.0.0"},
+		{"0.0.0", "1.0.0", "0.1.0", "0.0.1"},
+	}
+
+	for _, tc := range testCases {
+		version := mustSemVer(tc.input)
+		s.Equal(tc.major, version.IncrementMajor().String(), tc.input)
+		s.Equal(tc.minor, version.IncrementMinor().String(), tc.input)
+		s.Equal(tc.patch, version.IncrementPatch().String(), tc.input)
+	}
+}
+
+func (s *SemVerTestSuite) TestItCanReconstituteSemVerWithoutValidation() {
+	version := ReconstituteSemVer(1, 2, 3, "rc..1", "")
+	s.Equal("1.2.3-rc..1", version.String())
+}
+
+func (s *SemVerTestSuite) TestItCanEncodeAndDecodeText() {
+	type plugin struct {
+		Version SemVer `json:"version"`
+	}
+
+	data, err := json.Marshal(plugin{Version: mustSemVer("v1.2.3-rc.1")})
+	s.NoError(err)
+	s.JSONEq(`{"version":"1.2.3-rc.1"}`, string(data))
+
+	var decoded plugin
+	s.NoError(json.Unmarshal(data, &decoded))
+	s.True(decoded.Version.Equals(mustSemVer("1.2.3-rc.1")))
+
+	s.ErrorIs(json.Unmarshal([]byte(`{"version":"1.2"}`), &decoded), ErrInvalidVersion)
+}
+
+func mustSemVer(value string) SemVer {
+	version, err := NewSemVer(value)
+	if err != nil {
+		panic(err)
+	}
+	return version
+}
@@ -0,0 +1,103 @@
+package web
+
+// Each value object encodes as its canonical string.
+
+// MarshalBinary encodes the URL as its canonical string
+func (u URL) MarshalBinary() ([]byte, error) {
+	return []byte(u.value), nil
+}
+
+// UnmarshalBinary decodes the URL, with validation
+func (u *URL) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewURL(string(data))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// MarshalBinary encodes the email as its canonical string
+func (e Email) MarshalBinary() ([]byte, error) {
+	return []byte(e.value), nil
+}
+
+// UnmarshalBinary decodes the email, with validation
+func (e *Email) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewEmail(string(data))
+	if err != nil {
+		return err
+	}
+
+	*e = parsed
+	return nil
+}
+
+// MarshalBinary encodes the domain name as its canonical string
+func (d DomainName) MarshalBinary() ([]byte, error) {
+	return []byte(d.value), nil
+}
+
+// UnmarshalBinary decodes the domain name, with validation
+func (d *DomainName) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewDomainName(string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// MarshalBinary encodes the IP address as its canonical string
+func (ip IPAddress) MarshalBinary() ([]byte, error) {
+	return []byte(ip.value), nil
+}
+
+// UnmarshalBinary decodes the IP address, with validation
+func (ip *IPAddress) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewIPAddress(string(data))
+	if err != nil {
+		return err
+	}
+
+	*ip = parsed
+	return nil
+}
+
+// MarshalBinary encodes the IP network as its canonical string
+func (n IPNetwork) MarshalBinary() ([]byte, error) {
+	return []byte(n.value), nil
+}
+
+// UnmarshalBinary decodes the IP network, with validation
+func (n *IPNetwork) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	parsed, err := NewIPNetwork(string(data))
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
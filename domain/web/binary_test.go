@@ -0,0 +1,66 @@
+package web
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BinaryTestSuite struct {
+	suite.Suite
+}
+
+func TestBinarySuite(t *testing.T) {
+	suite.Run(t, new(BinaryTestSuite))
+}
+
+type cachedContact struct {
+	Website URL
+	Email   Email
+	Domain  DomainName
+	IP      IPAddress
+	Network IPNetwork
+	Backup  Email
+}
+
+func (s *BinaryTestSuite) TestItCanRoundTripValueObjectsThroughGob() {
+	website, _ := NewURL("https://example.com/path?q=1")
+	email, _ := NewEmail("Alice@Example.com")
+	domainName, _ := NewDomainName("example.com")
+	ip, _ := NewIPAddress("2001:db8::1")
+	network, _ := NewIPNetwork("2001:db8::/32")
+
+	original := cachedContact{
+		Website: website,
+		Email:   email,
+		Domain:  domainName,
+		IP:      ip,
+		Network: network,
+	}
+
+	var buffer bytes.Buffer
+	s.Require().NoError(gob.NewEncoder(&buffer).Encode(original))
+
+	var decoded cachedContact
+	s.Require().NoError(gob.NewDecoder(&buffer).Decode(&decoded))
+
+	s.True(original.Website.Equals(decoded.Website))
+	s.True(original.Email.Equals(decoded.Email))
+	s.True(original.Domain.Equals(decoded.Domain))
+	s.True(original.IP.Equals(decoded.IP))
+	s.True(original.Network.Equals(decoded.Network))
+	s.Equal(Email{}, decoded.Backup)
+}
+
+func (s *BinaryTestSuite) TestItValidatesWhenUnmarshalingBinary() {
+	var email Email
+	s.Error(email.UnmarshalBinary([]byte("not-an-email")))
+
+	var ip IPAddress
+	s.ErrorIs(ip.UnmarshalBinary([]byte("999.1.1.1")), ErrInvalidIPAddress)
+
+	var network IPNetwork
+	s.ErrorIs(network.UnmarshalBinary([]byte("10.0.0.0")), ErrInvalidIPNetwork)
+}
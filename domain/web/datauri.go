@@ -0,0 +1,200 @@
+package web
+
+import (
+	"encoding/base64"
+	"mime"
+	"net/url"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// MaxDataURILength is the default maximum length of a data URI, 1 MiB, enough for icons and
+// small images while keeping oversized payloads out of JSON bodies and database columns
+const MaxDataURILength = 1 << 20
+
+// defaultDataURIMediaType is the media type of data URIs that name none (RFC 2397)
+const defaultDataURIMediaType = "text/plain"
+
+var (
+	ErrEmptyDataURI = domain.NewLocalizedError(
+		"web.data_uri.empty", nil,
+		"data URI cannot be empty",
+	)
+	ErrInvalidDataURI = domain.NewLocalizedError(
+		"web.data_uri.invalid", nil,
+		"data URI format is invalid",
+	)
+	ErrTooLongDataURI = domain.NewLocalizedError(
+		"web.data_uri.too_long", nil,
+		"data URI is too long",
+	)
+)
+
+// DataURI is a "data:" URL embedding its content (RFC 2397), such as
+// "data:image/png;base64,iVBORw0KGgo...", for inline images and avatars that URL rejects
+type DataURI struct {
+	value     string
+	mediaType string
+	params    map[string]string
+	isBase64  bool
+	data      string
+}
+
+// NewDataURI creates a new instance of DataURI with validation. The payload must be valid
+// base64 when the URI declares it, and percent-encoded otherwise. WithMaxLength overrides
+// MaxDataURILength.
+func NewDataURI(value string, opts ...Option) (DataURI, error) {
+	config := applyOptions(options{maxLength: MaxDataURILength}, opts)
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return DataURI{}, ErrEmptyDataURI
+	}
+	if len(value) > config.maxLength {
+		return DataURI{}, ErrTooLongDataURI
+	}
+
+	return parseDataURI(value)
+}
+
+// NewDataURIFromBytes creates a new base64 DataURI holding the data, with validation of the
+// media type, e.g., "image/png"
+func NewDataURIFromBytes(mediaType string, data []byte, opts ...Option) (DataURI, error) {
+	parsedType, params, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return DataURI{}, ErrInvalidDataURI
+	}
+
+	return NewDataURI(
+		"data:"+mime.FormatMediaType(parsedType, params)+";base64,"+
+			base64.StdEncoding.EncodeToString(data),
+		opts...,
+	)
+}
+
+// ReconstituteDataURI creates a new DataURI instance without validation. Accessors of a value
+// that does not parse return empty results.
+func ReconstituteDataURI(value string) DataURI {
+	parsed, err := parseDataURI(value)
+	if err != nil {
+		return DataURI{value: value}
+	}
+	return parsed
+}
+
+// Value returns the data URI
+func (d DataURI) Value() string {
+	return d.value
+}
+
+// MediaType returns the lower case media type without parameters, "text/plain" when the URI
+// names none
+func (d DataURI) MediaType() string {
+	return d.mediaType
+}
+
+// Parameter returns a media type parameter, such as "charset", or an empty string
+func (d DataURI) Parameter(name string) string {
+	return d.params[strings.ToLower(name)]
+}
+
+// IsBase64 reports whether the payload is base64 encoded
+func (d DataURI) IsBase64() bool {
+	return d.isBase64
+}
+
+// Decode returns the decoded payload
+func (d DataURI) Decode() ([]byte, error) {
+	if d.mediaType == "" {
+		return nil, ErrInvalidDataURI
+	}
+	return decodeDataURIPayload(d.data, d.isBase64)
+}
+
+// Size returns the size of the decoded payload in bytes
+func (d DataURI) Size() int {
+	data, _ := d.Decode()
+	return len(data)
+}
+
+// Equals compares two DataURI objects for equality
+func (d DataURI) Equals(other DataURI) bool {
+	return d.value == other.value
+}
+
+// String returns a string representation of the data URI
+func (d DataURI) String() string {
+	return d.value
+}
+
+// parseDataURI splits a data URI into its media type, parameters and payload, checking the
+// payload decodes
+func parseDataURI(value string) (DataURI, error) {
+	if len(value) < 5 || !strings.EqualFold(value[:5], "data:") {
+		return DataURI{}, ErrInvalidDataURI
+	}
+
+	metadata, data, found := strings.Cut(value[5:], ",")
+	if !found {
+		return DataURI{}, ErrInvalidDataURI
+	}
+
+	metadata, isBase64 := cutDataURIBase64(metadata)
+	mediaType, params := defaultDataURIMediaType, map[string]string{}
+	if metadata != "" {
+		// A missing type with parameters, as in "data:;charset=utf-8,", stays text/plain
+		if strings.HasPrefix(metadata, ";") {
+			metadata = defaultDataURIMediaType + metadata
+		}
+
+		var err error
+		if mediaType, params, err = mime.ParseMediaType(metadata); err != nil {
+			return DataURI{}, ErrInvalidDataURI
+		}
+	}
+
+	if _, err := decodeDataURIPayload(data, isBase64); err != nil {
+		return DataURI{}, err
+	}
+
+	return DataURI{
+		value:     "data:" + value[5:],
+		mediaType: mediaType,
+		params:    params,
+		isBase64:  isBase64,
+		data:      data,
+	}, nil
+}
+
+// cutDataURIBase64 removes a trailing ";base64" marker from the metadata
+func cutDataURIBase64(metadata string) (string, bool) {
+	const marker = ";base64"
+	if len(metadata) >= len(marker) &&
+		strings.EqualFold(metadata[len(metadata)-len(marker):], marker) {
+		return metadata[:len(metadata)-len(marker)], true
+	}
+	return metadata, false
+}
+
+// decodeDataURIPayload percent-decodes the payload and then decodes base64, accepting
+// base64 with or without padding
+func decodeDataURIPayload(data string, isBase64 bool) ([]byte, error) {
+	unescaped, err := url.PathUnescape(data)
+	if err != nil {
+		return nil, ErrInvalidDataURI
+	}
+	if !isBase64 {
+		return []byte(unescaped), nil
+	}
+
+	encoding := base64.StdEncoding
+	if len(unescaped)%4 != 0 {
+		encoding = base64.RawStdEncoding
+	}
+	decoded, err := encoding.DecodeString(unescaped)
+	if err != nil {
+		return nil, ErrInvalidDataURI
+	}
+	return decoded, nil
+}
@@ -0,0 +1,174 @@
+package web
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DataURITestSuite struct {
+	suite.Suite
+}
+
+func TestDataURISuite(t *testing.T) {
+	suite.Run(t, new(DataURITestSuite))
+}
+
+func (s *DataURITestSuite) TestItCanBuildNewDataURIWithValidValues() {
+	testCases := []struct {
+		name      string
+		input     string
+		mediaType string
+		isBase64  bool
+		decoded   string
+	}{
+		{
+			name:      "base64 image",
+			input:     "data:image/png;base64,iVBORw0KGgo=",
+			mediaType: "image/png",
+			isBase64:  true,
+			decoded:   "\x89PNG\r\n\x1a\n",
+		},
+		{
+			name:      "unpadded base64",
+			input:     "data:image/png;base64,iVBORw0KGgo",
+			mediaType: "image/png",
+			isBase64:  true,
+			decoded:   "\x89PNG\r\n\x1a\n",
+		},
+		{
+			name:      "percent-encoded text",
+			input:     "data:text/html;charset=utf-8,%3Ch1%3EHi%3C%2Fh1%3E",
+			mediaType: "text/html",
+			decoded:   "<h1>Hi</h1>",
+		},
+		{
+			name:      "default media type",
+			input:     "data:,Hello%2C%20World",
+			mediaType: "text/plain",
+			decoded:   "Hello, World",
+		},
+		{
+			name:      "parameters without media type",
+			input:     "data:;charset=utf-8;base64,SGk=",
+			mediaType: "text/plain",
+			isBase64:  true,
+			decoded:   "Hi",
+		},
+		{
+			name:      "upper case scheme and media type",
+			input:     "  DATA:Image/SVG+XML;BASE64,PHN2Zy8+ ",
+			mediaType: "image/svg+xml",
+			isBase64:  true,
+			decoded:   "<svg/>",
+		},
+		{
+			name:      "empty payload",
+			input:     "data:text/plain,",
+			mediaType: "text/plain",
+			decoded:   "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				dataURI, err := NewDataURI(tc.input)
+				s.Require().NoError(err)
+				s.True(strings.HasPrefix(dataURI.Value(), "data:"))
+				s.Equal(tc.mediaType, dataURI.MediaType())
+				s.Equal(tc.isBase64, dataURI.IsBase64())
+
+				decoded, err := dataURI.Decode()
+				s.Require().NoError(err)
+				s.Equal(tc.decoded, string(decoded))
+				s.Equal(len(tc.decoded), dataURI.Size())
+			},
+		)
+	}
+}
+
+func (s *DataURITestSuite) TestItFailsToBuildNewDataURIWithInvalidValues() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{name: "empty", input: " ", expected: ErrEmptyDataURI},
+		{name: "http URL", input: "https://example.com/a.png", expected: ErrInvalidDataURI},
+		{name: "missing comma", input: "data:image/png;base64", expected: ErrInvalidDataURI},
+		{name: "invalid media type", input: "data:image/;base64,SGk=", expected: ErrInvalidDataURI},
+		{name: "invalid base64", input: "data:image/png;base64,***", expected: ErrInvalidDataURI},
+		{name: "invalid percent escape", input: "data:,100%", expected: ErrInvalidDataURI},
+		{
+			name:     "too long",
+			input:    "data:," + strings.Repeat("a", MaxDataURILength),
+			expected: ErrTooLongDataURI,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewDataURI(tc.input)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *DataURITestSuite) TestItCanOverrideTheMaxLength() {
+	_, err := NewDataURI("data:,Hello", WithMaxLength(8))
+	s.ErrorIs(err, ErrTooLongDataURI)
+
+	_, err = NewDataURI("data:,Hello", WithMaxLength(11))
+	s.NoError(err)
+}
+
+func (s *DataURITestSuite) TestItExposesMediaTypeParameters() {
+	dataURI, err := NewDataURI("data:text/plain;Charset=UTF-8,Hi")
+	s.Require().NoError(err)
+
+	s.Equal("UTF-8", dataURI.Parameter("charset"))
+	s.Equal("UTF-8", dataURI.Parameter("CHARSET"))
+	s.Empty(dataURI.Parameter("name"))
+}
+
+func (s *DataURITestSuite) TestItCanBuildFromBytes() {
+	dataURI, err := NewDataURIFromBytes("Image/GIF", []byte("GIF89a"))
+	s.Require().NoError(err)
+	s.Equal("data:image/gif;base64,R0lGODlh", dataURI.Value())
+
+	decoded, err := dataURI.Decode()
+	s.Require().NoError(err)
+	s.Equal("GIF89a", string(decoded))
+
+	_, err = NewDataURIFromBytes("not a type", nil)
+	s.ErrorIs(err, ErrInvalidDataURI)
+
+	_, err = NewDataURIFromBytes("image/gif", make([]byte, 100), WithMaxLength(50))
+	s.ErrorIs(err, ErrTooLongDataURI)
+}
+
+func (s *DataURITestSuite) TestItCanReconstituteDataURIs() {
+	dataURI := ReconstituteDataURI("data:image/png;base64,iVBORw0KGgo=")
+	s.Equal("image/png", dataURI.MediaType())
+	s.Equal(8, dataURI.Size())
+
+	invalid := ReconstituteDataURI("not a data URI")
+	s.Equal("not a data URI", invalid.Value())
+	s.Empty(invalid.MediaType())
+	_, err := invalid.Decode()
+	s.ErrorIs(err, ErrInvalidDataURI)
+}
+
+func (s *DataURITestSuite) TestItCanCompareForEquality() {
+	first, _ := NewDataURI("data:,Hi")
+	second, _ := NewDataURI("DATA:,Hi")
+	third, _ := NewDataURI("data:,Bye")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+	s.Equal("data:,Hi", first.String())
+}
@@ -1,9 +1,7 @@
 package web
 
 import (
-	"regexp"
 	"strings"
-	"unicode/utf8"
 
 	"github.com/golibry/go-common-domain/domain"
 )
@@ -15,18 +13,47 @@ const (
 )
 
 var (
-	ErrEmptyDomainName        = domain.NewError("domain name cannot be empty")
-	ErrInvalidDomainNameChars = domain.NewError("domain name contains invalid characters")
-	ErrTooLongDomainName      = domain.NewError("domain name is too long")
-	ErrTooLongDomainLabel     = domain.NewError("domain name label is too long")
-	ErrInvalidDomainFormat    = domain.NewError("domain name has invalid format")
-	ErrConsecutiveDots        = domain.NewError("domain name cannot have consecutive dots")
-	ErrStartsOrEndsWithDot    = domain.NewError("domain name cannot start or end with a dot")
-	ErrStartsOrEndsWithHyphen = domain.NewError("domain name label cannot start or end with hyphen")
+	ErrEmptyDomainName = domain.NewLocalizedError(
+		"web.domain_name.empty", nil,
+		"domain name cannot be empty",
+	)
+	ErrInvalidDomainNameChars = domain.NewLocalizedError(
+		"web.domain_name.invalid_chars", nil,
+		"domain name contains invalid characters",
+	)
+	ErrTooLongDomainName = domain.NewLocalizedError(
+		"web.domain_name.too_long", nil,
+		"domain name is too long",
+	)
+	ErrTooLongDomainLabel = domain.NewLocalizedError(
+		"web.domain_name.label_too_long", nil,
+		"domain name label is too long",
+	)
+	ErrInvalidDomainFormat = domain.NewLocalizedError(
+		"web.domain_name.invalid_format", nil,
+		"domain name has invalid format",
+	)
+	ErrConsecutiveDots = domain.NewLocalizedError(
+		"web.domain_name.consecutive_dots", nil,
+		"domain name cannot have consecutive dots",
+	)
+	ErrStartsOrEndsWithDot = domain.NewLocalizedError(
+		"web.domain_name.dot_at_edge", nil,
+		"domain name cannot start or end with a dot",
+	)
+	ErrStartsOrEndsWithHyphen = domain.NewLocalizedError(
+		"web.domain_name.hyphen_at_edge", nil,
+		"domain name label cannot start or end with hyphen",
+	)
 )
 
-// domainNameRegex validates basic domain name format
-var domainNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?)*$`)
+// DomainNamePattern is the regular expression a normalized domain name must match.
+// IsValidDomainName accepts exactly the names it matches, within MaxDomainNameLength, without
+// running it.
+const DomainNamePattern = `^[a-zA-Z0-9]([a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?)*$`
+
+// DomainNameValidators holds extra rules run by NewDomainName after its own validation
+var DomainNameValidators domain.Validators[DomainName]
 
 type DomainName struct {
 	value string
@@ -34,14 +61,19 @@ type DomainName struct {
 
 // NewDomainName creates a new instance of DomainName with validation and normalization
 func NewDomainName(value string) (DomainName, error) {
-	normalized, err := NormalizeDomainName(value)
+	normalized, err := parseDomainName(value)
 	if err != nil {
 		return DomainName{}, err
 	}
 
-	return DomainName{
+	domainName := DomainName{
 		value: normalized,
-	}, nil
+	}
+	if err := DomainNameValidators.Validate(domainName); err != nil {
+		return DomainName{}, err
+	}
+
+	return domainName, nil
 }
 
 // ReconstituteDomainName creates a new DomainName instance without validation or normalization
@@ -68,80 +100,86 @@ func (d DomainName) String() string {
 
 // NormalizeDomainName normalizes a domain name by converting to lowercase and trimming spaces
 func NormalizeDomainName(domainName string) (string, error) {
-	// Trim spaces from the beginning and end
-	domainName = strings.TrimSpace(domainName)
-
-	// Convert to lowercase
-	domainName = strings.ToLower(domainName)
-
-	if err := IsValidDomainName(domainName); err != nil {
-		return domainName, err
-	}
+	return parseDomainName(domainName)
+}
 
-	return domainName, nil
+// parseDomainName trims and lowercases a domain name and validates it in the same call. On
+// failure it still returns the normalized name, as NormalizeDomainName always has.
+func parseDomainName(domainName string) (string, error) {
+	domainName = strings.ToLower(strings.TrimSpace(domainName))
+	return domainName, IsValidDomainName(domainName)
 }
 
-// IsValidDomainName validates a domain name according to RFC standards
+// IsValidDomainName validates a domain name according to RFC standards. The name is scanned
+// once; misplaced dots anywhere in the name are reported before problems with a label, and
+// label problems are reported for the first offending label.
 func IsValidDomainName(domainName string) error {
 	if domainName == "" {
 		return ErrEmptyDomainName
 	}
 
-	if utf8.RuneCountInString(domainName) > MaxDomainNameLength {
-		return ErrTooLongDomainName
-	}
+	length := 0
+	consecutiveDots := false
+	var labelErr error
+	labelStart, labelLength := 0, 0
+	invalidChar := false
+	var previous rune
+
+	for i, r := range domainName {
+		length++
+		if r != '.' {
+			labelLength++
+			invalidChar = invalidChar || !isDomainLabelChar(r)
+			previous = r
+			continue
+		}
 
-	if utf8.RuneCountInString(domainName) < MinDomainNameLength {
-		return ErrEmptyDomainName
+		if previous == '.' {
+			consecutiveDots = true
+		}
+		if labelErr == nil {
+			labelErr = domainLabelError(domainName[labelStart:i], labelLength, invalidChar)
+		}
+		labelStart, labelLength, invalidChar = i+1, 0, false
+		previous = r
 	}
-
-	// Check for consecutive dots
-	if strings.Contains(domainName, "..") {
-		return ErrConsecutiveDots
+	if labelErr == nil {
+		labelErr = domainLabelError(domainName[labelStart:], labelLength, invalidChar)
 	}
 
-	// Check if starts or ends with dot
-	if strings.HasPrefix(domainName, ".") || strings.HasSuffix(domainName, ".") {
+	switch {
+	case length > MaxDomainNameLength:
+		return ErrTooLongDomainName
+	case length < MinDomainNameLength:
+		return ErrEmptyDomainName
+	case consecutiveDots:
+		return ErrConsecutiveDots
+	case domainName[0] == '.' || domainName[len(domainName)-1] == '.':
 		return ErrStartsOrEndsWithDot
-	}
-
-	// Split into labels and validate each
-	labels := strings.Split(domainName, ".")
-	for _, label := range labels {
-		if err := isValidDomainLabel(label); err != nil {
-			return err
-		}
-	}
-
-	// Use regex for final validation
-	if !domainNameRegex.MatchString(domainName) {
-		return ErrInvalidDomainFormat
+	case labelErr != nil:
+		return labelErr
 	}
 
 	return nil
 }
 
-// isValidDomainLabel validates a single domain label
-func isValidDomainLabel(label string) error {
-	if label == "" {
+// domainLabelError returns the error for a single label of length runes, or nil when it is
+// valid. A too long label is reported first, then edge hyphens, then invalid characters.
+func domainLabelError(label string, length int, invalidChar bool) error {
+	switch {
+	case label == "":
 		return ErrInvalidDomainFormat
-	}
-
-	if utf8.RuneCountInString(label) > MaxLabelLength {
+	case length > MaxLabelLength:
 		return ErrTooLongDomainLabel
-	}
-
-	// Check if starts or ends with hyphen
-	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+	case label[0] == '-' || label[len(label)-1] == '-':
 		return ErrStartsOrEndsWithHyphen
+	case invalidChar:
+		return ErrInvalidDomainNameChars
 	}
-
-	// Check for valid characters (letters, numbers, hyphens only)
-	for _, r := range label {
-		if !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-') {
-			return ErrInvalidDomainNameChars
-		}
-	}
-
 	return nil
 }
+
+// isDomainLabelChar reports whether r may appear in a domain label (letters, digits, hyphens)
+func isDomainLabelChar(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-'
+}
@@ -3,8 +3,10 @@ package web
 import (
 	"encoding/json"
 	"errors"
+	"regexp"
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -320,3 +322,87 @@ func (s *DomainNameTestSuite) TestIsValidDomainName() {
 		)
 	}
 }
+
+func BenchmarkNewDomainName(b *testing.B) {
+	inputs := []string{" Mail.Example.COM ", "sub-domain.example.co.uk"}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, input := range inputs {
+			if _, err := NewDomainName(input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func FuzzIsValidDomainName(f *testing.F) {
+	seeds := []string{
+		"example.com", "sub.Example.COM", "a", "-a.com", "a-.com", "a..com", ".a.com", "a.com.",
+		"ex_ample.com", "exämple.com", strings.Repeat("a", 64) + ".com", "a.b-c.d",
+		strings.Repeat("abcdefghi.", 26),
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, domainName string) {
+			got, want := IsValidDomainName(domainName), referenceIsValidDomainName(domainName)
+			if got != want {
+				t.Fatalf("IsValidDomainName(%q) = %v, want %v", domainName, got, want)
+			}
+		},
+	)
+}
+
+// referenceDomainNameRegex and referenceIsValidDomainName are the regular-expression based
+// validation IsValidDomainName replaced, kept to prove the parser behaves the same
+var referenceDomainNameRegex = regexp.MustCompile(DomainNamePattern)
+
+func referenceIsValidDomainName(domainName string) error {
+	if domainName == "" {
+		return ErrEmptyDomainName
+	}
+	if utf8.RuneCountInString(domainName) > MaxDomainNameLength {
+		return ErrTooLongDomainName
+	}
+	if utf8.RuneCountInString(domainName) < MinDomainNameLength {
+		return ErrEmptyDomainName
+	}
+	if strings.Contains(domainName, "..") {
+		return ErrConsecutiveDots
+	}
+	if strings.HasPrefix(domainName, ".") || strings.HasSuffix(domainName, ".") {
+		return ErrStartsOrEndsWithDot
+	}
+
+	for _, label := range strings.Split(domainName, ".") {
+		if err := referenceIsValidDomainLabel(label); err != nil {
+			return err
+		}
+	}
+
+	if !referenceDomainNameRegex.MatchString(domainName) {
+		return ErrInvalidDomainFormat
+	}
+	return nil
+}
+
+func referenceIsValidDomainLabel(label string) error {
+	if label == "" {
+		return ErrInvalidDomainFormat
+	}
+	if utf8.RuneCountInString(label) > MaxLabelLength {
+		return ErrTooLongDomainLabel
+	}
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return ErrStartsOrEndsWithHyphen
+	}
+	for _, r := range label {
+		if !isDomainLabelChar(r) {
+			return ErrInvalidDomainNameChars
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var ErrInvalidDomainPattern = domain.NewLocalizedError(
+	"web.domain_pattern.invalid", nil,
+	"domain pattern can only have a wildcard as its whole first label, e.g., *.example.com",
+)
+
+// DomainPattern is a domain name that may start with a wildcard label, as in TLS certificates
+// and virtual host routing:
+//
+//   - "example.com" matches only example.com
+//   - "*.example.com" matches a single extra label, e.g., api.example.com, but neither
+//     example.com nor v1.api.example.com, as certificate wildcards do
+//   - "**.example.com" matches any number of extra labels, so v1.api.example.com too
+type DomainPattern struct {
+	value string
+}
+
+// DomainPatternOption configures how domain patterns are parsed
+type DomainPatternOption func(*domainPatternOptions)
+
+type domainPatternOptions struct {
+	multiLabel bool
+}
+
+// WithMultiLabelWildcard makes a "*." wildcard match any number of labels, as "**." does. The
+// pattern is normalized to "**.", so it keeps matching the same names once stored.
+func WithMultiLabelWildcard() DomainPatternOption {
+	return func(o *domainPatternOptions) {
+		o.multiLabel = true
+	}
+}
+
+// NewDomainPattern creates a new instance of DomainPattern with validation and normalization
+func NewDomainPattern(value string, opts ...DomainPatternOption) (DomainPattern, error) {
+	var config domainPatternOptions
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return DomainPattern{}, ErrEmptyDomainName
+	}
+
+	wildcard, base := splitDomainPattern(value)
+	if strings.Contains(base, "*") {
+		return DomainPattern{}, ErrInvalidDomainPattern
+	}
+	if err := IsValidDomainName(base); err != nil {
+		return DomainPattern{}, err
+	}
+	if wildcard == "*." && config.multiLabel {
+		wildcard = "**."
+	}
+
+	return DomainPattern{
+		value: wildcard + base,
+	}, nil
+}
+
+// ReconstituteDomainPattern creates a new DomainPattern instance without validation or
+// normalization
+func ReconstituteDomainPattern(value string) DomainPattern {
+	return DomainPattern{
+		value: value,
+	}
+}
+
+// Value returns the domain pattern value
+func (p DomainPattern) Value() string {
+	return p.value
+}
+
+// IsWildcard reports whether the pattern starts with a wildcard label
+func (p DomainPattern) IsWildcard() bool {
+	wildcard, _ := splitDomainPattern(p.value)
+	return wildcard != ""
+}
+
+// Base returns the domain name after the wildcard label, or the whole name when the pattern
+// has no wildcard
+func (p DomainPattern) Base() DomainName {
+	_, base := splitDomainPattern(p.value)
+	return DomainName{value: base}
+}
+
+// Matches reports whether the domain name matches the pattern
+func (p DomainPattern) Matches(name DomainName) bool {
+	wildcard, base := splitDomainPattern(p.value)
+	if wildcard == "" {
+		return name.value == base
+	}
+
+	labels, found := strings.CutSuffix(name.value, "."+base)
+	if !found || labels == "" {
+		return false
+	}
+	return wildcard == "**." || !strings.Contains(labels, ".")
+}
+
+// Equals compares two DomainPattern objects for equality
+func (p DomainPattern) Equals(other DomainPattern) bool {
+	return p.value == other.value
+}
+
+// String returns a string representation of the domain pattern
+func (p DomainPattern) String() string {
+	return p.value
+}
+
+// MarshalJSON encodes the domain pattern as a JSON string
+func (p DomainPattern) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.value)
+}
+
+// UnmarshalJSON decodes and validates a domain pattern from a JSON string
+func (p *DomainPattern) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid domain pattern JSON")
+	}
+
+	parsed, err := NewDomainPattern(raw)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// splitDomainPattern splits a pattern into its wildcard label, "*.", "**." or none, and the
+// domain name after it
+func splitDomainPattern(value string) (string, string) {
+	for _, wildcard := range []string{"**.", "*."} {
+		if base, found := strings.CutPrefix(value, wildcard); found {
+			return wildcard, base
+		}
+	}
+	return "", value
+}
@@ -0,0 +1,144 @@
+package web
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DomainPatternTestSuite struct {
+	suite.Suite
+}
+
+func TestDomainPatternSuite(t *testing.T) {
+	suite.Run(t, new(DomainPatternTestSuite))
+}
+
+func (s *DomainPatternTestSuite) TestItCanBuildNewDomainPatternWithValidValues() {
+	testCases := []struct {
+		value      string
+		expected   string
+		isWildcard bool
+		base       string
+	}{
+		{"example.com", "example.com", false, "example.com"},
+		{" *.Example.COM ", "*.example.com", true, "example.com"},
+		{"**.example.com", "**.example.com", true, "example.com"},
+		{"*.localhost", "*.localhost", true, "localhost"},
+	}
+
+	for _, tc := range testCases {
+		pattern, err := NewDomainPattern(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, pattern.Value())
+		s.Equal(tc.expected, pattern.String())
+		s.Equal(tc.isWildcard, pattern.IsWildcard())
+		s.Equal(tc.base, pattern.Base().Value())
+	}
+}
+
+func (s *DomainPatternTestSuite) TestItFailsToBuildNewDomainPatternWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyDomainName},
+		{"   ", ErrEmptyDomainName},
+		{"*", ErrInvalidDomainPattern},
+		{"*.", ErrEmptyDomainName},
+		{"w*.example.com", ErrInvalidDomainPattern},
+		{"api.*.example.com", ErrInvalidDomainPattern},
+		{"*.*.example.com", ErrInvalidDomainPattern},
+		{"***.example.com", ErrInvalidDomainPattern},
+		{"*example.com", ErrInvalidDomainPattern},
+		{"*.exa_mple.com", ErrInvalidDomainNameChars},
+		{"*..example.com", ErrStartsOrEndsWithDot},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewDomainPattern(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *DomainPatternTestSuite) TestItMatchesDomainNames() {
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"example.com", "example.com", true},
+		{"example.com", "api.example.com", false},
+		{"*.example.com", "api.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "v1.api.example.com", false},
+		{"*.example.com", "badexample.com", false},
+		{"*.example.com", "api.example.org", false},
+		{"**.example.com", "api.example.com", true},
+		{"**.example.com", "v1.api.example.com", true},
+		{"**.example.com", "example.com", false},
+		{"**.example.com", "notexample.com", false},
+	}
+
+	for _, tc := range testCases {
+		pattern, _ := NewDomainPattern(tc.pattern)
+		name, _ := NewDomainName(tc.name)
+		s.Equal(tc.expected, pattern.Matches(name), tc.pattern+" "+tc.name)
+	}
+}
+
+func (s *DomainPatternTestSuite) TestItAppliesTheMultiLabelOption() {
+	pattern, err := NewDomainPattern("*.example.com", WithMultiLabelWildcard())
+	s.Require().NoError(err)
+	s.Equal("**.example.com", pattern.Value())
+
+	name, _ := NewDomainName("v1.api.example.com")
+	s.True(pattern.Matches(name))
+
+	exact, err := NewDomainPattern("example.com", WithMultiLabelWildcard())
+	s.Require().NoError(err)
+	s.Equal("example.com", exact.Value())
+}
+
+func (s *DomainPatternTestSuite) TestItCanReconstituteDomainPattern() {
+	pattern := ReconstituteDomainPattern("*.Example.com")
+	s.Equal("*.Example.com", pattern.Value())
+}
+
+func (s *DomainPatternTestSuite) TestItComparesDomainPatterns() {
+	first, _ := NewDomainPattern("*.example.com")
+	second, _ := NewDomainPattern("*.EXAMPLE.com")
+	third, _ := NewDomainPattern("**.example.com")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *DomainPatternTestSuite) TestItRoundTripsThroughJSON() {
+	pattern, _ := NewDomainPattern("**.example.com")
+
+	encoded, err := json.Marshal(map[string]DomainPattern{"host": pattern})
+	s.Require().NoError(err)
+	s.JSONEq(`{"host":"**.example.com"}`, string(encoded))
+
+	var decoded map[string]DomainPattern
+	s.Require().NoError(json.Unmarshal(encoded, &decoded))
+	s.True(pattern.Equals(decoded["host"]))
+
+	var unchanged DomainPattern
+	s.NoError(json.Unmarshal([]byte("null"), &unchanged))
+	s.Equal(DomainPattern{}, unchanged)
+
+	s.ErrorIs(json.Unmarshal([]byte(`"a.*.com"`), &unchanged), ErrInvalidDomainPattern)
+	s.Error(json.Unmarshal([]byte(`42`), &unchanged))
+}
+
+func (s *DomainPatternTestSuite) TestItUnmarshalsText() {
+	var pattern DomainPattern
+	s.NoError(pattern.UnmarshalText([]byte("*.Example.com")))
+	s.Equal("*.example.com", pattern.Value())
+
+	s.NoError(pattern.UnmarshalText(nil))
+	s.Equal("*.example.com", pattern.Value())
+}
@@ -1,7 +1,6 @@
 package web
 
 import (
-	"regexp"
 	"strings"
 	"unicode/utf8"
 
@@ -16,22 +15,67 @@ const (
 )
 
 var (
-	ErrEmptyEmail         = domain.NewError("email address cannot be empty")
-	ErrInvalidEmailFormat = domain.NewError("email address has invalid format")
-	ErrTooLongEmail       = domain.NewError("email address is too long")
-	ErrTooLongLocalPart   = domain.NewError("email local part is too long")
-	ErrTooLongDomainPart  = domain.NewError("email domain part is too long")
-	ErrInvalidEmailChars  = domain.NewError("email address contains invalid characters")
-	ErrMissingAtSymbol    = domain.NewError("email address must contain exactly one @ symbol")
-	ErrMultipleAtSymbols  = domain.NewError("email address cannot contain multiple @ symbols")
-	ErrEmptyLocalPart     = domain.NewError("email local part cannot be empty")
-	ErrEmptyDomainPart    = domain.NewError("email domain part cannot be empty")
-	ErrInvalidLocalPart   = domain.NewError("email local part has invalid format")
-	ErrInvalidDomainPart  = domain.NewError("email domain part has invalid format")
+	ErrEmptyEmail = domain.NewLocalizedError(
+		"web.email.empty", nil,
+		"email address cannot be empty",
+	)
+	ErrInvalidEmailFormat = domain.NewLocalizedError(
+		"web.email.invalid_format", nil,
+		"email address has invalid format",
+	)
+	ErrTooLongEmail = domain.NewLocalizedError(
+		"web.email.too_long", nil,
+		"email address is too long",
+	)
+	ErrTooLongLocalPart = domain.NewLocalizedError(
+		"web.email.local_part_too_long", nil,
+		"email local part is too long",
+	)
+	ErrTooLongDomainPart = domain.NewLocalizedError(
+		"web.email.domain_part_too_long", nil,
+		"email domain part is too long",
+	)
+	ErrInvalidEmailChars = domain.NewLocalizedError(
+		"web.email.invalid_chars", nil,
+		"email address contains invalid characters",
+	)
+	ErrMissingAtSymbol = domain.NewLocalizedError(
+		"web.email.missing_at", nil,
+		"email address must contain exactly one @ symbol",
+	)
+	ErrMultipleAtSymbols = domain.NewLocalizedError(
+		"web.email.multiple_at", nil,
+		"email address cannot contain multiple @ symbols",
+	)
+	ErrEmptyLocalPart = domain.NewLocalizedError(
+		"web.email.local_part_empty", nil,
+		"email local part cannot be empty",
+	)
+	ErrEmptyDomainPart = domain.NewLocalizedError(
+		"web.email.domain_part_empty", nil,
+		"email domain part cannot be empty",
+	)
+	ErrInvalidLocalPart = domain.NewLocalizedError(
+		"web.email.local_part_invalid", nil,
+		"email local part has invalid format",
+	)
+	ErrInvalidDomainPart = domain.NewLocalizedError(
+		"web.email.domain_part_invalid", nil,
+		"email domain part has invalid format",
+	)
+	ErrEmailDomainNotAllowed = domain.NewLocalizedError(
+		"web.email.domain_not_allowed", nil,
+		"email domain is not allowed",
+	)
 )
 
-// emailRegex validates basic email format according to RFC 5322 (simplified)
-var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+// EmailPattern is the regular expression a normalized email address must match
+// (RFC 5322, simplified). IsValidEmail accepts exactly the addresses it matches, within the
+// length limits, without running it.
+const EmailPattern = `^[a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`
+
+// EmailValidators holds extra rules run by NewEmail after its own validation
+var EmailValidators domain.Validators[Email]
 
 type Email struct {
 	value string
@@ -39,14 +83,28 @@ type Email struct {
 
 // NewEmail creates a new instance of Email with validation and normalization
 func NewEmail(value string) (Email, error) {
-	normalized, err := NormalizeEmail(value)
+	return NewEmailWithOptions(value)
+}
+
+// NewEmailWithOptions creates a new instance of Email like NewEmail, with limits tuned by
+// options such as WithMaxLength. Decoders (JSON, text, binary, YAML, BSON and the pgx codec)
+// validate with NewEmail and its default limits; load addresses accepted with a larger limit
+// with ReconstituteEmail.
+func NewEmailWithOptions(value string, opts ...Option) (Email, error) {
+	config := applyOptions(options{maxLength: MaxEmailLength}, opts)
+	normalized, err := parseEmail(value, config.maxLength)
 	if err != nil {
 		return Email{}, err
 	}
 
-	return Email{
+	email := Email{
 		value: normalized,
-	}, nil
+	}
+	if err := EmailValidators.Validate(email); err != nil {
+		return Email{}, err
+	}
+
+	return email, nil
 }
 
 // ReconstituteEmail creates a new Email instance without validation or normalization
@@ -56,6 +114,23 @@ func ReconstituteEmail(value string) Email {
 	}
 }
 
+// AllowEmailDomains returns a rule, for EmailValidators, accepting only addresses at one of
+// the domains or their subdomains (e.g., a corporate domain allowlist). It is the Validator of
+// an EmailPolicy allowing only those domains; with no domains, every address is rejected.
+func AllowEmailDomains(domains ...string) domain.Validator[Email] {
+	allowed := make([]string, len(domains))
+	for i, domainName := range domains {
+		allowed[i] = strings.ToLower(strings.TrimSpace(domainName))
+	}
+
+	if len(allowed) == 0 {
+		return func(Email) error {
+			return ErrEmailDomainNotAllowed.WithField("reason", string(EmailPolicyNotAllowed))
+		}
+	}
+	return EmailPolicy{allowedDomains: allowed}.Validator()
+}
+
 // Value returns the email address value
 func (e Email) Value() string {
 	return e.value
@@ -79,6 +154,21 @@ func (e Email) DomainPart() string {
 	return parts[1]
 }
 
+// Masked returns the email address with its local part hidden except for the first character,
+// e.g., "j•••@example.com", for logs and traces. The domain stays visible.
+func (e Email) Masked() string {
+	local := e.LocalPart()
+	if local == "" {
+		return ""
+	}
+
+	first, _ := utf8.DecodeRuneInString(local)
+	return string(first) + "•••@" + e.DomainPart()
+}
+
+// Sensitive marks the email address as personal data for domain.Sensitive
+func (e Email) Sensitive() {}
+
 // Equals compares two Email objects for equality
 func (e Email) Equals(other Email) bool {
 	return e.value == other.value
@@ -91,98 +181,94 @@ func (e Email) String() string {
 
 // NormalizeEmail normalizes an email address by converting to lowercase and trimming spaces
 func NormalizeEmail(email string) (string, error) {
-	// Trim spaces from the beginning and end
-	email = strings.TrimSpace(email)
-
-	// Convert to lowercase
-	email = strings.ToLower(email)
+	return parseEmail(email, MaxEmailLength)
+}
 
-	if err := IsValidEmail(email); err != nil {
+// parseEmail trims and lowercases an email address and validates it in the same call,
+// allowing up to maxLength characters. NewEmail and NormalizeEmail share it, so the input is
+// only validated once.
+func parseEmail(email string, maxLength int) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if err := isValidEmail(email, maxLength); err != nil {
 		return "", err
 	}
-
 	return email, nil
 }
 
 // IsValidEmail validates an email address according to RFC standards
 func IsValidEmail(email string) error {
+	return isValidEmail(email, MaxEmailLength)
+}
+
+// isValidEmail validates an email address, allowing up to maxLength characters. Each part is
+// scanned once, and errors are reported in the order the individual checks are documented.
+// Valid parts on both sides of a single '@' are all EmailPattern requires.
+func isValidEmail(email string, maxLength int) error {
 	if email == "" {
 		return ErrEmptyEmail
 	}
 
-	if utf8.RuneCountInString(email) > MaxEmailLength {
+	length := utf8.RuneCountInString(email)
+	if length > maxLength {
 		return ErrTooLongEmail
 	}
 
 	// Check for exactly one @ symbol
-	atCount := strings.Count(email, "@")
-	if atCount == 0 {
+	at := strings.IndexByte(email, '@')
+	if at < 0 {
 		return ErrMissingAtSymbol
 	}
-	if atCount > 1 {
+	if strings.IndexByte(email[at+1:], '@') >= 0 {
 		return ErrMultipleAtSymbols
 	}
 
-	// Split into local and domain parts
-	parts := strings.Split(email, "@")
-	if len(parts) != 2 {
-		return ErrInvalidEmailFormat
-	}
-
-	localPart := parts[0]
-	domainPart := parts[1]
-
-	// Validate local part
-	if err := isValidLocalPart(localPart); err != nil {
+	if err := isValidLocalPart(email[:at]); err != nil {
 		return err
 	}
 
-	// Validate domain part
-	if err := isValidEmailDomainPart(domainPart); err != nil {
+	if err := isValidEmailDomainPart(email[at+1:]); err != nil {
 		return err
 	}
 
 	// Check minimum length after validating parts (for more specific error messages)
-	if utf8.RuneCountInString(email) < MinEmailLength {
-		return ErrInvalidEmailFormat
-	}
-
-	// Use regex for final validation
-	if !emailRegex.MatchString(email) {
+	if length < MinEmailLength {
 		return ErrInvalidEmailFormat
 	}
 
 	return nil
 }
 
-// isValidLocalPart validates the local part of an email address (before @)
+// isValidLocalPart validates the local part of an email address (before @) in a single scan.
+// A too long local part is reported first, then misplaced dots, then invalid characters.
 func isValidLocalPart(localPart string) error {
 	if localPart == "" {
 		return ErrEmptyLocalPart
 	}
 
-	if utf8.RuneCountInString(localPart) > MaxLocalPartLength {
-		return ErrTooLongLocalPart
-	}
-
-	// Check for invalid starting/ending characters
-	if strings.HasPrefix(localPart, ".") || strings.HasSuffix(localPart, ".") {
-		return ErrInvalidLocalPart
-	}
-
-	// Check for consecutive dots
-	if strings.Contains(localPart, "..") {
-		return ErrInvalidLocalPart
-	}
-
-	// Check for valid characters in local part
 	// RFC 5322 allows: a-z A-Z 0-9 . ! # $ % & ' * + - / = ? ^ _ ` { | } ~
+	length := 0
+	misplacedDot := localPart[0] == '.' || localPart[len(localPart)-1] == '.'
+	invalidChar := false
+	var previous rune
 	for _, r := range localPart {
+		length++
+		if r == '.' && previous == '.' {
+			misplacedDot = true
+		}
 		if !isValidLocalPartChar(r) {
-			return ErrInvalidEmailChars
+			invalidChar = true
 		}
+		previous = r
 	}
 
+	switch {
+	case length > MaxLocalPartLength:
+		return ErrTooLongLocalPart
+	case misplacedDot:
+		return ErrInvalidLocalPart
+	case invalidChar:
+		return ErrInvalidEmailChars
+	}
 	return nil
 }
 
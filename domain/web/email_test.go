@@ -3,8 +3,10 @@ package web
 import (
 	"encoding/json"
 	"errors"
+	"regexp"
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -423,3 +425,159 @@ func (s *EmailTestSuite) TestIsValidEmail() {
 		)
 	}
 }
+
+func (s *EmailTestSuite) TestItRunsRegisteredValidators() {
+	unregister := EmailValidators.Register(AllowEmailDomains("Corp.example", "partner.org"))
+	defer unregister()
+
+	for _, value := range []string{"jane@corp.example", "jane@eu.corp.example", "a@partner.org"} {
+		_, err := NewEmail(value)
+		s.NoError(err, value)
+	}
+
+	for _, value := range []string{"jane@example.com", "jane@notcorp.example"} {
+		_, err := NewEmail(value)
+		s.ErrorIs(err, ErrEmailDomainNotAllowed, value)
+	}
+
+	var decoded Email
+	s.ErrorIs(json.Unmarshal([]byte(`"jane@example.com"`), &decoded), ErrEmailDomainNotAllowed)
+
+	s.NotPanics(func() { ReconstituteEmail("jane@example.com") })
+}
+
+func (s *EmailTestSuite) TestAllowEmailDomainsMatchesLikeAnEmailPolicy() {
+	allow := AllowEmailDomains(" Corp.example ")
+	policy, err := NewEmailPolicy(AllowDomains("corp.example"))
+	s.Require().NoError(err)
+
+	for _, value := range []string{"a@corp.example", "a@eu.corp.example", "a@notcorp.example"} {
+		email := ReconstituteEmail(value)
+		s.Equal(policy.Validator()(email), allow(email), value)
+	}
+
+	err = AllowEmailDomains()(ReconstituteEmail("a@corp.example"))
+	s.ErrorIs(err, ErrEmailDomainNotAllowed)
+}
+
+func (s *EmailTestSuite) TestItAppliesTheMaxLengthOption() {
+	value := strings.Repeat("a", 60) + "@" + strings.Repeat("b", 60) + ".example.com"
+
+	_, err := NewEmailWithOptions(value, WithMaxLength(100))
+	s.ErrorIs(err, ErrTooLongEmail)
+
+	email, err := NewEmailWithOptions(value, WithMaxLength(320))
+	s.NoError(err)
+	s.Equal(value, email.Value())
+
+	_, err = NewEmailWithOptions(value, WithMaxLength(0))
+	s.NoError(err, "values below one keep the default")
+
+	_, err = NewEmailWithOptions(strings.Repeat("a", 65)+"@example.com", WithMaxLength(320))
+	s.ErrorIs(err, ErrTooLongLocalPart)
+}
+
+func BenchmarkNewEmail(b *testing.B) {
+	inputs := []string{"  Jane.Doe+news@Mail.Example.COM ", "jane@example.com"}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, input := range inputs {
+			if _, err := NewEmail(input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func FuzzIsValidEmail(f *testing.F) {
+	seeds := []string{
+		"jane@example.com", "Jane.Doe+news@Mail.Example.COM", "a@b", "@example.com", "jane@",
+		"jane", "jane@doe@example.com", ".jane@example.com", "jane.@example.com",
+		"ja..ne@example.com", "jäne@example.com", "jane@-example.com", "jane@exa_mple.com",
+		strings.Repeat("a", 65) + "@example.com", "jane@" + strings.Repeat("abcdefghi.", 26),
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, email string) {
+			got, want := IsValidEmail(email), referenceIsValidEmail(email)
+			if got != want {
+				t.Fatalf("IsValidEmail(%q) = %v, want %v", email, got, want)
+			}
+		},
+	)
+}
+
+// referenceEmailRegex and referenceIsValidEmail are the regular-expression based validation
+// IsValidEmail replaced, kept to prove the parser behaves the same
+var referenceEmailRegex = regexp.MustCompile(EmailPattern)
+
+func referenceIsValidEmail(email string) error {
+	if email == "" {
+		return ErrEmptyEmail
+	}
+	if utf8.RuneCountInString(email) > MaxEmailLength {
+		return ErrTooLongEmail
+	}
+
+	atCount := strings.Count(email, "@")
+	if atCount == 0 {
+		return ErrMissingAtSymbol
+	}
+	if atCount > 1 {
+		return ErrMultipleAtSymbols
+	}
+
+	localPart, domainPart, _ := strings.Cut(email, "@")
+	if err := referenceIsValidLocalPart(localPart); err != nil {
+		return err
+	}
+
+	if domainPart == "" {
+		return ErrEmptyDomainPart
+	}
+	if utf8.RuneCountInString(domainPart) > MaxDomainPartLength {
+		return ErrTooLongDomainPart
+	}
+	if referenceIsValidDomainName(domainPart) != nil {
+		return ErrInvalidDomainPart
+	}
+
+	if utf8.RuneCountInString(email) < MinEmailLength {
+		return ErrInvalidEmailFormat
+	}
+	if !referenceEmailRegex.MatchString(email) {
+		return ErrInvalidEmailFormat
+	}
+	return nil
+}
+
+func referenceIsValidLocalPart(localPart string) error {
+	if localPart == "" {
+		return ErrEmptyLocalPart
+	}
+	if utf8.RuneCountInString(localPart) > MaxLocalPartLength {
+		return ErrTooLongLocalPart
+	}
+	if strings.HasPrefix(localPart, ".") || strings.HasSuffix(localPart, ".") {
+		return ErrInvalidLocalPart
+	}
+	if strings.Contains(localPart, "..") {
+		return ErrInvalidLocalPart
+	}
+	for _, r := range localPart {
+		if !isValidLocalPartChar(r) {
+			return ErrInvalidEmailChars
+		}
+	}
+	return nil
+}
+
+func (s *EmailTestSuite) TestItCanMaskTheLocalPart() {
+	s.Equal("j•••@example.com", ReconstituteEmail("jane.doe@example.com").Masked())
+	s.Equal("ș•••@exemplu.ro", ReconstituteEmail("ștefan@exemplu.ro").Masked())
+	s.Empty(Email{}.Masked())
+}
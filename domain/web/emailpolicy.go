@@ -0,0 +1,274 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// EmailPolicyReason explains why an EmailPolicy allowed or denied an address
+type EmailPolicyReason string
+
+const (
+	// EmailPolicyNoRules allows any address when the policy has no allow rules and no block
+	// rule matched
+	EmailPolicyNoRules EmailPolicyReason = "no_rules"
+	// EmailPolicyAllowedDomain allows an address at an allowed domain or its subdomains
+	EmailPolicyAllowedDomain EmailPolicyReason = "allowed_domain"
+	// EmailPolicyAllowedTLD allows an address under an allowed top-level domain
+	EmailPolicyAllowedTLD EmailPolicyReason = "allowed_tld"
+	// EmailPolicyBlockedDomain denies an address at a blocked domain or its subdomains
+	EmailPolicyBlockedDomain EmailPolicyReason = "blocked_domain"
+	// EmailPolicyBlockedTLD denies an address under a blocked top-level domain
+	EmailPolicyBlockedTLD EmailPolicyReason = "blocked_tld"
+	// EmailPolicyNotAllowed denies an address no allow rule matched
+	EmailPolicyNotAllowed EmailPolicyReason = "not_allowed"
+)
+
+// EmailPolicy decides which email addresses a product accepts from allowed and blocked
+// domains and top-level domains, so rules like "corporate emails only" (blocking free mail
+// providers) or "universities only" (allowing "edu" and "ac.uk") are data rather than code.
+//
+// Block rules win over allow rules. When the policy has allow rules, an address must match one
+// of them; otherwise every address not blocked is allowed. Domains match themselves and their
+// subdomains, and top-level domains may have several labels, such as "ac.uk".
+type EmailPolicy struct {
+	allowedDomains []string
+	blockedDomains []string
+	allowedTLDs    []string
+	blockedTLDs    []string
+}
+
+// EmailPolicyOption adds rules to an EmailPolicy
+type EmailPolicyOption func(*emailPolicyRules)
+
+type emailPolicyRules struct {
+	allowedDomains []string
+	blockedDomains []string
+	allowedTLDs    []string
+	blockedTLDs    []string
+}
+
+// AllowDomains allows addresses at the domains or their subdomains
+func AllowDomains(domains ...string) EmailPolicyOption {
+	return func(r *emailPolicyRules) {
+		r.allowedDomains = append(r.allowedDomains, domains...)
+	}
+}
+
+// BlockDomains denies addresses at the domains or their subdomains, e.g., "gmail.com"
+func BlockDomains(domains ...string) EmailPolicyOption {
+	return func(r *emailPolicyRules) {
+		r.blockedDomains = append(r.blockedDomains, domains...)
+	}
+}
+
+// AllowTLDs allows addresses under the top-level domains, given with or without a leading dot
+func AllowTLDs(tlds ...string) EmailPolicyOption {
+	return func(r *emailPolicyRules) {
+		r.allowedTLDs = append(r.allowedTLDs, tlds...)
+	}
+}
+
+// BlockTLDs denies addresses under the top-level domains, given with or without a leading dot
+func BlockTLDs(tlds ...string) EmailPolicyOption {
+	return func(r *emailPolicyRules) {
+		r.blockedTLDs = append(r.blockedTLDs, tlds...)
+	}
+}
+
+// NewEmailPolicy creates a new instance of EmailPolicy with validation and normalization of
+// its domains, which are lower cased, sorted and deduplicated
+func NewEmailPolicy(opts ...EmailPolicyOption) (EmailPolicy, error) {
+	var rules emailPolicyRules
+	for _, opt := range opts {
+		opt(&rules)
+	}
+
+	var policy EmailPolicy
+	for _, list := range []struct {
+		target *[]string
+		values []string
+	}{
+		{&policy.allowedDomains, rules.allowedDomains},
+		{&policy.blockedDomains, rules.blockedDomains},
+		{&policy.allowedTLDs, rules.allowedTLDs},
+		{&policy.blockedTLDs, rules.blockedTLDs},
+	} {
+		normalized, err := normalizePolicyDomains(list.values)
+		if err != nil {
+			return EmailPolicy{}, err
+		}
+		*list.target = normalized
+	}
+
+	return policy, nil
+}
+
+// AllowedDomains returns the allowed domains in order
+func (p EmailPolicy) AllowedDomains() []string {
+	return slices.Clone(p.allowedDomains)
+}
+
+// BlockedDomains returns the blocked domains in order
+func (p EmailPolicy) BlockedDomains() []string {
+	return slices.Clone(p.blockedDomains)
+}
+
+// AllowedTLDs returns the allowed top-level domains in order
+func (p EmailPolicy) AllowedTLDs() []string {
+	return slices.Clone(p.allowedTLDs)
+}
+
+// BlockedTLDs returns the blocked top-level domains in order
+func (p EmailPolicy) BlockedTLDs() []string {
+	return slices.Clone(p.blockedTLDs)
+}
+
+// Evaluate reports whether the policy allows the address and why
+func (p EmailPolicy) Evaluate(email Email) (bool, EmailPolicyReason) {
+	domainPart := email.DomainPart()
+	switch {
+	case matchesPolicyDomain(p.blockedDomains, domainPart):
+		return false, EmailPolicyBlockedDomain
+	case matchesPolicyDomain(p.blockedTLDs, domainPart):
+		return false, EmailPolicyBlockedTLD
+	case matchesPolicyDomain(p.allowedDomains, domainPart):
+		return true, EmailPolicyAllowedDomain
+	case matchesPolicyDomain(p.allowedTLDs, domainPart):
+		return true, EmailPolicyAllowedTLD
+	case len(p.allowedDomains) > 0 || len(p.allowedTLDs) > 0:
+		return false, EmailPolicyNotAllowed
+	default:
+		return true, EmailPolicyNoRules
+	}
+}
+
+// Allows reports whether the policy allows the address
+func (p EmailPolicy) Allows(email Email) bool {
+	allowed, _ := p.Evaluate(email)
+	return allowed
+}
+
+// Validator returns a rule, for EmailValidators, rejecting the addresses the policy denies
+// with ErrEmailDomainNotAllowed, carrying the reason as its "reason" field
+func (p EmailPolicy) Validator() domain.Validator[Email] {
+	return func(email Email) error {
+		if allowed, reason := p.Evaluate(email); !allowed {
+			return ErrEmailDomainNotAllowed.WithField("reason", string(reason))
+		}
+		return nil
+	}
+}
+
+// Equals compares two EmailPolicy objects for equality
+func (p EmailPolicy) Equals(other EmailPolicy) bool {
+	return slices.Equal(p.allowedDomains, other.allowedDomains) &&
+		slices.Equal(p.blockedDomains, other.blockedDomains) &&
+		slices.Equal(p.allowedTLDs, other.allowedTLDs) &&
+		slices.Equal(p.blockedTLDs, other.blockedTLDs)
+}
+
+// String returns the rules of the policy, e.g., "block domains gmail.com; allow TLDs edu"
+func (p EmailPolicy) String() string {
+	var parts []string
+	for _, rule := range []struct {
+		label   string
+		domains []string
+	}{
+		{"block domains ", p.blockedDomains},
+		{"block TLDs ", p.blockedTLDs},
+		{"allow domains ", p.allowedDomains},
+		{"allow TLDs ", p.allowedTLDs},
+	} {
+		if len(rule.domains) > 0 {
+			parts = append(parts, rule.label+strings.Join(rule.domains, ", "))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// emailPolicyJSON is the JSON representation of EmailPolicy
+type emailPolicyJSON struct {
+	AllowedDomains []string `json:"allowedDomains"`
+	BlockedDomains []string `json:"blockedDomains"`
+	AllowedTLDs    []string `json:"allowedTlds"`
+	BlockedTLDs    []string `json:"blockedTlds"`
+}
+
+// MarshalJSON encodes the policy as its four lists of domains
+func (p EmailPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(
+		emailPolicyJSON{
+			AllowedDomains: nonNilStrings(p.allowedDomains),
+			BlockedDomains: nonNilStrings(p.blockedDomains),
+			AllowedTLDs:    nonNilStrings(p.allowedTLDs),
+			BlockedTLDs:    nonNilStrings(p.blockedTLDs),
+		},
+	)
+}
+
+// UnmarshalJSON decodes and validates a policy, where missing lists are empty
+func (p *EmailPolicy) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw emailPolicyJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid email policy JSON")
+	}
+
+	parsed, err := NewEmailPolicy(
+		AllowDomains(raw.AllowedDomains...),
+		BlockDomains(raw.BlockedDomains...),
+		AllowTLDs(raw.AllowedTLDs...),
+		BlockTLDs(raw.BlockedTLDs...),
+	)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// normalizePolicyDomains validates and lower cases domains, dropping a leading dot, and
+// returns them sorted without duplicates
+func normalizePolicyDomains(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		domainName, err := NewDomainName(strings.TrimPrefix(strings.TrimSpace(value), "."))
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, domainName.value)
+	}
+
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// matchesPolicyDomain reports whether the domain is one of the domains or a subdomain of one
+func matchesPolicyDomain(domains []string, domainPart string) bool {
+	for _, domainName := range domains {
+		if domainPart == domainName || strings.HasSuffix(domainPart, "."+domainName) {
+			return true
+		}
+	}
+	return false
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
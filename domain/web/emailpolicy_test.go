@@ -0,0 +1,150 @@
+package web
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmailPolicyTestSuite struct {
+	suite.Suite
+}
+
+func TestEmailPolicySuite(t *testing.T) {
+	suite.Run(t, new(EmailPolicyTestSuite))
+}
+
+func (s *EmailPolicyTestSuite) TestItCanBuildNormalizedPolicies() {
+	policy, err := NewEmailPolicy(
+		BlockDomains("Gmail.com", " yahoo.com ", "gmail.com"),
+		AllowTLDs(".edu", "AC.UK"),
+	)
+
+	s.Require().NoError(err)
+	s.Equal([]string{"gmail.com", "yahoo.com"}, policy.BlockedDomains())
+	s.Equal([]string{"ac.uk", "edu"}, policy.AllowedTLDs())
+	s.Empty(policy.AllowedDomains())
+	s.Empty(policy.BlockedTLDs())
+	s.Equal("block domains gmail.com, yahoo.com; allow TLDs ac.uk, edu", policy.String())
+}
+
+func (s *EmailPolicyTestSuite) TestItFailsToBuildPoliciesWithInvalidDomains() {
+	_, err := NewEmailPolicy(AllowDomains("example.com", "bad..domain"))
+	s.Error(err)
+
+	_, err = NewEmailPolicy(BlockTLDs(""))
+	s.ErrorIs(err, ErrEmptyDomainName)
+}
+
+func (s *EmailPolicyTestSuite) TestItCanEvaluateEmails() {
+	policy, err := NewEmailPolicy(
+		AllowDomains("partner.org"),
+		AllowTLDs("ac.uk"),
+		BlockDomains("spam.ac.uk"),
+		BlockTLDs("xyz"),
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		email    string
+		allowed  bool
+		expected EmailPolicyReason
+	}{
+		{"jane@partner.org", true, EmailPolicyAllowedDomain},
+		{"jane@eu.partner.org", true, EmailPolicyAllowedDomain},
+		{"jane@ox.ac.uk", true, EmailPolicyAllowedTLD},
+		{"jane@spam.ac.uk", false, EmailPolicyBlockedDomain},
+		{"jane@mail.spam.ac.uk", false, EmailPolicyBlockedDomain},
+		{"jane@partner.xyz", false, EmailPolicyBlockedTLD},
+		{"jane@notpartner.org", false, EmailPolicyNotAllowed},
+		{"jane@gmail.com", false, EmailPolicyNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.email, func() {
+				email, err := NewEmail(tc.email)
+				s.Require().NoError(err)
+
+				allowed, reason := policy.Evaluate(email)
+				s.Equal(tc.allowed, allowed)
+				s.Equal(tc.expected, reason)
+				s.Equal(tc.allowed, policy.Allows(email))
+			},
+		)
+	}
+}
+
+func (s *EmailPolicyTestSuite) TestItAllowsEverythingNotBlockedWithoutAllowRules() {
+	policy, err := NewEmailPolicy(BlockDomains("gmail.com"))
+	s.Require().NoError(err)
+
+	email, _ := NewEmail("jane@example.com")
+	allowed, reason := policy.Evaluate(email)
+	s.True(allowed)
+	s.Equal(EmailPolicyNoRules, reason)
+
+	allowed, reason = EmailPolicy{}.Evaluate(email)
+	s.True(allowed)
+	s.Equal(EmailPolicyNoRules, reason)
+}
+
+func (s *EmailPolicyTestSuite) TestItCanBeUsedAsAValidator() {
+	policy, err := NewEmailPolicy(BlockDomains("gmail.com"))
+	s.Require().NoError(err)
+	validate := policy.Validator()
+
+	allowed, _ := NewEmail("jane@example.com")
+	s.NoError(validate(allowed))
+
+	blocked, _ := NewEmail("jane@gmail.com")
+	err = validate(blocked)
+	s.ErrorIs(err, ErrEmailDomainNotAllowed)
+
+	var domainErr *domain.Error
+	s.Require().ErrorAs(err, &domainErr)
+	s.Equal(string(EmailPolicyBlockedDomain), domainErr.Fields()["reason"])
+}
+
+func (s *EmailPolicyTestSuite) TestItCanCompareForEquality() {
+	first, _ := NewEmailPolicy(BlockDomains("gmail.com", "yahoo.com"))
+	second, _ := NewEmailPolicy(BlockDomains("yahoo.com", "Gmail.com"))
+	third, _ := NewEmailPolicy(AllowDomains("gmail.com", "yahoo.com"))
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
+
+func (s *EmailPolicyTestSuite) TestItCanRoundTripThroughJSON() {
+	policy, err := NewEmailPolicy(AllowTLDs("edu"), BlockDomains("gmail.com"))
+	s.Require().NoError(err)
+
+	data, err := json.Marshal(policy)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{"allowedDomains":[],"blockedDomains":["gmail.com"],"allowedTlds":["edu"],`+
+			`"blockedTlds":[]}`,
+		string(data),
+	)
+
+	var decoded EmailPolicy
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(policy.Equals(decoded))
+}
+
+func (s *EmailPolicyTestSuite) TestItValidatesWhenUnmarshalingJSON() {
+	var policy EmailPolicy
+	s.Require().NoError(json.Unmarshal([]byte(`{"blockedTlds":[".XYZ"]}`), &policy))
+	s.Equal([]string{"xyz"}, policy.BlockedTLDs())
+
+	s.NoError(json.Unmarshal([]byte(`null`), &policy))
+	s.Equal([]string{"xyz"}, policy.BlockedTLDs())
+
+	s.ErrorIs(
+		json.Unmarshal([]byte(`{"allowedDomains":[""]}`), &policy),
+		ErrEmptyDomainName,
+	)
+	s.Error(json.Unmarshal([]byte(`{"allowedDomains":"example.com"}`), &policy))
+}
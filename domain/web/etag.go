@@ -0,0 +1,200 @@
+package web
+
+import (
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyETag = domain.NewLocalizedError(
+		"web.etag.empty", nil,
+		"ETag cannot be empty",
+	)
+	ErrInvalidETag = domain.NewLocalizedError(
+		"web.etag.invalid", nil,
+		"ETag format is invalid",
+	)
+)
+
+// ETag is an HTTP entity tag (RFC 7232), such as `"33a64df5"` or the weak `W/"0815"`, that
+// lets clients revalidate cached representations and guard updates against lost writes
+type ETag struct {
+	opaque string
+	weak   bool
+}
+
+// NewETag creates a new instance of ETag from its header form, with validation
+func NewETag(value string) (ETag, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ETag{}, ErrEmptyETag
+	}
+
+	etag, rest, ok := cutETag(value)
+	if !ok || rest != "" {
+		return ETag{}, ErrInvalidETag
+	}
+
+	return etag, nil
+}
+
+// NewStrongETag creates a new strong ETag from its opaque value, without quotes, e.g., a
+// content hash or a version number. Strong tags promise byte-for-byte identical content.
+func NewStrongETag(opaque string) (ETag, error) {
+	return newETag(opaque, false)
+}
+
+// NewWeakETag creates a new weak ETag from its opaque value, without quotes. Weak tags
+// promise semantically equivalent content, e.g., the same resource version rendered again.
+func NewWeakETag(opaque string) (ETag, error) {
+	return newETag(opaque, true)
+}
+
+// ReconstituteETag creates a new ETag instance without validation
+func ReconstituteETag(opaque string, weak bool) ETag {
+	return ETag{
+		opaque: opaque,
+		weak:   weak,
+	}
+}
+
+// Value returns the header form of the tag, e.g., `W/"0815"`
+func (e ETag) Value() string {
+	if e.weak {
+		return `W/"` + e.opaque + `"`
+	}
+	return `"` + e.opaque + `"`
+}
+
+// Opaque returns the tag without its quotes and weakness indicator
+func (e ETag) Opaque() string {
+	return e.opaque
+}
+
+// IsWeak reports whether the tag is weak
+func (e ETag) IsWeak() bool {
+	return e.weak
+}
+
+// StrongMatch compares the tags with the strong comparison of RFC 7232: both must be strong
+// and have the same opaque value. If-Match and range requests use it.
+func (e ETag) StrongMatch(other ETag) bool {
+	return !e.weak && !other.weak && e.opaque == other.opaque
+}
+
+// WeakMatch compares the tags with the weak comparison of RFC 7232, ignoring weakness.
+// If-None-Match uses it.
+func (e ETag) WeakMatch(other ETag) bool {
+	return e.opaque == other.opaque
+}
+
+// MatchesIfMatch reports whether an If-Match header lists the tag, "*" matching any tag.
+// A header that does not parse matches nothing.
+func (e ETag) MatchesIfMatch(header string) bool {
+	return e.matchesHeader(header, ETag.StrongMatch)
+}
+
+// MatchesIfNoneMatch reports whether an If-None-Match header lists the tag, "*" matching any
+// tag, in which case a GET can be answered with 304 Not Modified. A header that does not
+// parse matches nothing.
+func (e ETag) MatchesIfNoneMatch(header string) bool {
+	return e.matchesHeader(header, ETag.WeakMatch)
+}
+
+// Equals compares two ETag objects for equality, weakness included
+func (e ETag) Equals(other ETag) bool {
+	return e.opaque == other.opaque && e.weak == other.weak
+}
+
+// String returns the header form of the tag
+func (e ETag) String() string {
+	return e.Value()
+}
+
+// ParseETags parses a comma-separated list of tags, as sent in If-Match and If-None-Match
+// headers. The wildcard "*" is not a tag and must be handled by the caller.
+func ParseETags(header string) ([]ETag, error) {
+	var etags []ETag
+	rest := strings.TrimSpace(header)
+	for rest != "" {
+		etag, remaining, ok := cutETag(rest)
+		if !ok {
+			return nil, ErrInvalidETag
+		}
+		etags = append(etags, etag)
+
+		remaining = strings.TrimLeft(remaining, " \t")
+		if remaining != "" && remaining[0] != ',' {
+			return nil, ErrInvalidETag
+		}
+		// Empty list elements are allowed, as in `"a", , "b"`
+		rest = strings.TrimLeft(remaining, ", \t")
+	}
+
+	if len(etags) == 0 {
+		return nil, ErrEmptyETag
+	}
+	return etags, nil
+}
+
+// matchesHeader reports whether the header is "*" or lists a tag matching this one
+func (e ETag) matchesHeader(header string, match func(ETag, ETag) bool) bool {
+	header = strings.TrimSpace(header)
+	if header == "*" {
+		return true
+	}
+
+	etags, err := ParseETags(header)
+	if err != nil {
+		return false
+	}
+	for _, etag := range etags {
+		if match(e, etag) {
+			return true
+		}
+	}
+	return false
+}
+
+// newETag validates an opaque value and builds a tag
+func newETag(opaque string, weak bool) (ETag, error) {
+	for i := 0; i < len(opaque); i++ {
+		if !isETagChar(opaque[i]) {
+			return ETag{}, ErrInvalidETag
+		}
+	}
+
+	return ETag{
+		opaque: opaque,
+		weak:   weak,
+	}, nil
+}
+
+// cutETag parses the tag at the start of the value and returns the text after it
+func cutETag(value string) (ETag, string, bool) {
+	weak := strings.HasPrefix(value, "W/")
+	if weak {
+		value = value[2:]
+	}
+	if value == "" || value[0] != '"' {
+		return ETag{}, "", false
+	}
+
+	end := strings.IndexByte(value[1:], '"')
+	if end < 0 {
+		return ETag{}, "", false
+	}
+
+	etag, err := newETag(value[1:end+1], weak)
+	if err != nil {
+		return ETag{}, "", false
+	}
+	return etag, value[end+2:], true
+}
+
+// isETagChar reports whether c may appear between the quotes of a tag: any visible character
+// but '"', or obsolete text beyond ASCII
+func isETagChar(c byte) bool {
+	return c == 0x21 || (c >= 0x23 && c <= 0x7e) || c >= 0x80
+}
@@ -0,0 +1,148 @@
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ETagTestSuite struct {
+	suite.Suite
+}
+
+func TestETagSuite(t *testing.T) {
+	suite.Run(t, new(ETagTestSuite))
+}
+
+func (s *ETagTestSuite) TestItCanBuildNewETagWithValidValues() {
+	testCases := []struct {
+		name   string
+		input  string
+		opaque string
+		weak   bool
+	}{
+		{name: "strong", input: `"33a64df5"`, opaque: "33a64df5"},
+		{name: "weak", input: `W/"0815"`, opaque: "0815", weak: true},
+		{name: "surrounding spaces", input: `  "abc" `, opaque: "abc"},
+		{name: "empty opaque value", input: `""`, opaque: ""},
+		{name: "visible characters", input: `"a-b/c:d!"`, opaque: "a-b/c:d!"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				etag, err := NewETag(tc.input)
+				s.Require().NoError(err)
+				s.Equal(tc.opaque, etag.Opaque())
+				s.Equal(tc.weak, etag.IsWeak())
+			},
+		)
+	}
+}
+
+func (s *ETagTestSuite) TestItFailsToBuildNewETagWithInvalidValues() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{name: "empty", input: " ", expected: ErrEmptyETag},
+		{name: "unquoted", input: "abc", expected: ErrInvalidETag},
+		{name: "unterminated", input: `"abc`, expected: ErrInvalidETag},
+		{name: "lower case weak prefix", input: `w/"abc"`, expected: ErrInvalidETag},
+		{name: "space inside", input: `"a b"`, expected: ErrInvalidETag},
+		{name: "trailing text", input: `"abc"def`, expected: ErrInvalidETag},
+		{name: "list", input: `"a", "b"`, expected: ErrInvalidETag},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewETag(tc.input)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *ETagTestSuite) TestItCanBuildETagsFromOpaqueValues() {
+	strong, err := NewStrongETag("v42")
+	s.Require().NoError(err)
+	s.Equal(`"v42"`, strong.Value())
+	s.Equal(`"v42"`, strong.String())
+
+	weak, err := NewWeakETag("v42")
+	s.Require().NoError(err)
+	s.Equal(`W/"v42"`, weak.Value())
+
+	_, err = NewStrongETag(`v"42`)
+	s.ErrorIs(err, ErrInvalidETag)
+	_, err = NewWeakETag("v 42")
+	s.ErrorIs(err, ErrInvalidETag)
+}
+
+func (s *ETagTestSuite) TestItComparesETagsPerRFC7232() {
+	testCases := []struct {
+		first  string
+		second string
+		strong bool
+		weak   bool
+	}{
+		{`W/"1"`, `W/"1"`, false, true},
+		{`W/"1"`, `W/"2"`, false, false},
+		{`W/"1"`, `"1"`, false, true},
+		{`"1"`, `"1"`, true, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.first+" "+tc.second, func() {
+				first, _ := NewETag(tc.first)
+				second, _ := NewETag(tc.second)
+				s.Equal(tc.strong, first.StrongMatch(second))
+				s.Equal(tc.weak, first.WeakMatch(second))
+			},
+		)
+	}
+}
+
+func (s *ETagTestSuite) TestItCanParseETagLists() {
+	etags, err := ParseETags(`"a", W/"b",, "c"`)
+	s.Require().NoError(err)
+	s.Len(etags, 3)
+	s.Equal(`W/"b"`, etags[1].Value())
+
+	_, err = ParseETags(" ")
+	s.ErrorIs(err, ErrEmptyETag)
+	_, err = ParseETags(`"a" "b"`)
+	s.ErrorIs(err, ErrInvalidETag)
+	_, err = ParseETags("*")
+	s.ErrorIs(err, ErrInvalidETag)
+}
+
+func (s *ETagTestSuite) TestItEvaluatesConditionalHeaders() {
+	strong, _ := NewStrongETag("v2")
+	weak, _ := NewWeakETag("v2")
+
+	s.True(strong.MatchesIfMatch(`"v1", "v2"`))
+	s.True(strong.MatchesIfMatch("*"))
+	s.False(strong.MatchesIfMatch(`W/"v2"`))
+	s.False(weak.MatchesIfMatch(`"v2"`))
+	s.False(strong.MatchesIfMatch("not a tag"))
+
+	s.True(strong.MatchesIfNoneMatch(`W/"v2"`))
+	s.True(weak.MatchesIfNoneMatch(`"v1", "v2"`))
+	s.True(weak.MatchesIfNoneMatch(" * "))
+	s.False(weak.MatchesIfNoneMatch(`"v1"`))
+	s.False(weak.MatchesIfNoneMatch(""))
+}
+
+func (s *ETagTestSuite) TestItCanCompareForEquality() {
+	first, _ := NewETag(`"abc"`)
+	second, _ := NewStrongETag("abc")
+	third, _ := NewWeakETag("abc")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+	s.True(third.Equals(ReconstituteETag("abc", true)))
+}
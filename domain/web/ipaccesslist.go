@@ -0,0 +1,245 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// IPAccessList is a set of allowed and denied addresses and networks, such as the rules of an
+// admin panel or a webhook endpoint. Addresses are kept as single-host networks, e.g.,
+// "192.168.1.1/32", and each list is sorted and free of duplicates, so equal rules compare
+// and encode equally whatever order they were given in.
+//
+// Allows applies the most specific matching rule, with deny winning between equally specific
+// ones. An address no rule matches is allowed only when the allowed list is empty, so a list
+// of denied entries alone works as a blocklist and any allowed entry turns it into an
+// allowlist.
+type IPAccessList struct {
+	allowed []IPNetwork
+	denied  []IPNetwork
+}
+
+// NewIPAccessList creates a new instance of IPAccessList from allowed and denied entries, each
+// an IP address ("192.168.1.1") or a network ("10.0.0.0/8")
+func NewIPAccessList(allowed, denied []string) (IPAccessList, error) {
+	allowedNetworks, err := parseIPAccessEntries(allowed)
+	if err != nil {
+		return IPAccessList{}, err
+	}
+	deniedNetworks, err := parseIPAccessEntries(denied)
+	if err != nil {
+		return IPAccessList{}, err
+	}
+
+	return IPAccessList{}.Allow(allowedNetworks...).Deny(deniedNetworks...), nil
+}
+
+// Allow returns a copy of the list that also allows the networks
+func (l IPAccessList) Allow(networks ...IPNetwork) IPAccessList {
+	return IPAccessList{
+		allowed: mergeIPNetworks(l.allowed, networks),
+		denied:  l.denied,
+	}
+}
+
+// Deny returns a copy of the list that also denies the networks
+func (l IPAccessList) Deny(networks ...IPNetwork) IPAccessList {
+	return IPAccessList{
+		allowed: l.allowed,
+		denied:  mergeIPNetworks(l.denied, networks),
+	}
+}
+
+// Allowed returns the allowed networks in order
+func (l IPAccessList) Allowed() []IPNetwork {
+	return slices.Clone(l.allowed)
+}
+
+// Denied returns the denied networks in order
+func (l IPAccessList) Denied() []IPNetwork {
+	return slices.Clone(l.denied)
+}
+
+// IsEmpty reports whether the list has no rules, in which case it allows every address
+func (l IPAccessList) IsEmpty() bool {
+	return len(l.allowed) == 0 && len(l.denied) == 0
+}
+
+// Allows reports whether the list lets the address through. Invalid addresses are never
+// allowed.
+func (l IPAccessList) Allows(ip IPAddress) bool {
+	addr, ok := parseIPAddress(ip.value)
+	if !ok {
+		return false
+	}
+
+	allowedBits, allowedMatch := longestMatch(l.allowed, addr)
+	deniedBits, deniedMatch := longestMatch(l.denied, addr)
+	switch {
+	case deniedMatch && (!allowedMatch || deniedBits >= allowedBits):
+		return false
+	case allowedMatch:
+		return true
+	default:
+		return len(l.allowed) == 0
+	}
+}
+
+// Conflicts returns the pairs of an allowed and a denied network that overlap, e.g., an
+// allowed "10.1.0.0/16" inside a denied "10.0.0.0/8". Allows resolves them, but they often
+// reveal a mistake worth reporting when the rules are edited.
+func (l IPAccessList) Conflicts() [][2]IPNetwork {
+	var conflicts [][2]IPNetwork
+	for _, allowed := range l.allowed {
+		for _, denied := range l.denied {
+			if allowed.Overlaps(denied) {
+				conflicts = append(conflicts, [2]IPNetwork{allowed, denied})
+			}
+		}
+	}
+	return conflicts
+}
+
+// Overlaps returns the pairs of networks of the same list where the first contains the
+// second, which makes the second redundant
+func (l IPAccessList) Overlaps() [][2]IPNetwork {
+	var overlaps [][2]IPNetwork
+	for _, networks := range [][]IPNetwork{l.allowed, l.denied} {
+		for i, first := range networks {
+			for _, second := range networks[i+1:] {
+				switch {
+				case !first.Overlaps(second):
+				case first.PrefixLength() <= second.PrefixLength():
+					overlaps = append(overlaps, [2]IPNetwork{first, second})
+				default:
+					overlaps = append(overlaps, [2]IPNetwork{second, first})
+				}
+			}
+		}
+	}
+	return overlaps
+}
+
+// Equals compares two IPAccessList objects for equality
+func (l IPAccessList) Equals(other IPAccessList) bool {
+	return slices.Equal(l.allowed, other.allowed) && slices.Equal(l.denied, other.denied)
+}
+
+// String returns a string representation of the list, e.g., "allow 10.0.0.0/8; deny
+// 10.0.0.1/32"
+func (l IPAccessList) String() string {
+	var parts []string
+	if len(l.allowed) > 0 {
+		parts = append(parts, "allow "+joinIPNetworks(l.allowed))
+	}
+	if len(l.denied) > 0 {
+		parts = append(parts, "deny "+joinIPNetworks(l.denied))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// ipAccessListJSON is the JSON representation of IPAccessList
+type ipAccessListJSON struct {
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+// MarshalJSON encodes the list as its allowed and denied networks, in order
+func (l IPAccessList) MarshalJSON() ([]byte, error) {
+	raw := ipAccessListJSON{Allow: []string{}, Deny: []string{}}
+	for _, network := range l.allowed {
+		raw.Allow = append(raw.Allow, network.value)
+	}
+	for _, network := range l.denied {
+		raw.Deny = append(raw.Deny, network.value)
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON decodes and validates a list of allowed and denied addresses and networks
+func (l *IPAccessList) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw ipAccessListJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid IP access list JSON")
+	}
+
+	parsed, err := NewIPAccessList(raw.Allow, raw.Deny)
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+	return nil
+}
+
+// parseIPAccessEntries parses addresses and networks into networks
+func parseIPAccessEntries(entries []string) ([]IPNetwork, error) {
+	networks := make([]IPNetwork, 0, len(entries))
+	for _, entry := range entries {
+		if strings.Contains(entry, "/") {
+			network, err := NewIPNetwork(entry)
+			if err != nil {
+				return nil, err
+			}
+			networks = append(networks, network)
+			continue
+		}
+
+		ip, err := NewIPAddress(entry)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, HostIPNetwork(ip))
+	}
+	return networks, nil
+}
+
+// mergeIPNetworks returns a new sorted list of the networks of both lists, without duplicates
+// or invalid networks: IPv4 before IPv6, then by address and by prefix length
+func mergeIPNetworks(existing, added []IPNetwork) []IPNetwork {
+	merged := make([]IPNetwork, 0, len(existing)+len(added))
+	merged = append(merged, existing...)
+	for _, network := range added {
+		if network.prefix.IsValid() {
+			merged = append(merged, network)
+		}
+	}
+
+	slices.SortFunc(
+		merged, func(a, b IPNetwork) int {
+			if result := a.prefix.Addr().Compare(b.prefix.Addr()); result != 0 {
+				return result
+			}
+			return a.prefix.Bits() - b.prefix.Bits()
+		},
+	)
+	return slices.Compact(merged)
+}
+
+// longestMatch returns the prefix length of the most specific network holding the address
+func longestMatch(networks []IPNetwork, addr netip.Addr) (int, bool) {
+	bits, found := -1, false
+	for _, network := range networks {
+		if network.prefix.Contains(addr) && network.PrefixLength() > bits {
+			bits, found = network.PrefixLength(), true
+		}
+	}
+	return bits, found
+}
+
+func joinIPNetworks(networks []IPNetwork) string {
+	values := make([]string, len(networks))
+	for i, network := range networks {
+		values[i] = network.value
+	}
+	return strings.Join(values, ", ")
+}
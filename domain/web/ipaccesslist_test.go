@@ -0,0 +1,176 @@
+package web
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IPAccessListTestSuite struct {
+	suite.Suite
+}
+
+func TestIPAccessListSuite(t *testing.T) {
+	suite.Run(t, new(IPAccessListTestSuite))
+}
+
+func (s *IPAccessListTestSuite) TestItEvaluatesAnAllowlist() {
+	list, err := NewIPAccessList(
+		[]string{"10.0.0.0/8", "192.168.1.1", "2001:db8::/32"},
+		[]string{"10.0.0.0/24", "10.1.0.0/16"},
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		ip       string
+		expected bool
+	}{
+		{"10.2.3.4", true},
+		{"10.0.0.5", false},
+		{"10.1.2.3", false},
+		{"192.168.1.1", true},
+		{"192.168.1.2", false},
+		{"2001:db8::1", true},
+		{"2001:db9::1", false},
+	}
+
+	for _, tc := range testCases {
+		ip, _ := NewIPAddress(tc.ip)
+		s.Equal(tc.expected, list.Allows(ip), tc.ip)
+	}
+	s.False(list.Allows(IPAddress{}))
+}
+
+func (s *IPAccessListTestSuite) TestItEvaluatesABlocklist() {
+	list, err := NewIPAccessList(nil, []string{"203.0.113.0/24"})
+	s.Require().NoError(err)
+
+	blocked, _ := NewIPAddress("203.0.113.7")
+	other, _ := NewIPAddress("198.51.100.7")
+	s.False(list.Allows(blocked))
+	s.True(list.Allows(other))
+}
+
+func (s *IPAccessListTestSuite) TestItAppliesTheMostSpecificRule() {
+	list, err := NewIPAccessList(
+		[]string{"10.1.2.3", "10.0.0.0/8"},
+		[]string{"10.0.0.0/8", "10.1.0.0/16"},
+	)
+	s.Require().NoError(err)
+
+	exception, _ := NewIPAddress("10.1.2.3")
+	denied, _ := NewIPAddress("10.1.2.4")
+	tie, _ := NewIPAddress("10.200.0.1")
+	s.True(list.Allows(exception))
+	s.False(list.Allows(denied))
+	s.False(list.Allows(tie))
+}
+
+func (s *IPAccessListTestSuite) TestItAllowsEverythingWhenEmpty() {
+	var list IPAccessList
+	ip, _ := NewIPAddress("192.0.2.1")
+
+	s.True(list.IsEmpty())
+	s.True(list.Allows(ip))
+	s.Equal("", list.String())
+}
+
+func (s *IPAccessListTestSuite) TestItOrdersAndDeduplicatesEntries() {
+	first, _ := NewIPAccessList(
+		[]string{"2001:db8::/32", "192.168.1.1", "10.0.0.0/8", "10.0.0.0/16", "10.1.1.1/8"},
+		nil,
+	)
+	second, _ := NewIPAccessList(
+		[]string{"10.0.0.0/16", "10.0.0.0/8", "2001:DB8::/32", "192.168.001.001"},
+		nil,
+	)
+
+	s.Equal(
+		"allow 10.0.0.0/8, 10.0.0.0/16, 192.168.1.1/32, 2001:db8::/32",
+		first.String(),
+	)
+	s.True(first.Equals(second))
+	s.Len(first.Allowed(), 4)
+	s.Empty(first.Denied())
+}
+
+func (s *IPAccessListTestSuite) TestItAddsEntriesToACopy() {
+	original, _ := NewIPAccessList([]string{"10.0.0.0/8"}, nil)
+	network, _ := NewIPNetwork("10.1.0.0/16")
+	ip, _ := NewIPAddress("10.1.2.3")
+
+	updated := original.Deny(network).Allow(HostIPNetwork(ip), IPNetwork{})
+
+	s.Equal("allow 10.0.0.0/8", original.String())
+	s.Equal("allow 10.0.0.0/8, 10.1.2.3/32; deny 10.1.0.0/16", updated.String())
+	s.False(original.Equals(updated))
+
+	allowed := updated.Allowed()
+	allowed[0] = IPNetwork{}
+	s.Equal("10.0.0.0/8", updated.Allowed()[0].Value())
+}
+
+func (s *IPAccessListTestSuite) TestItFailsToBuildWithInvalidEntries() {
+	_, err := NewIPAccessList([]string{"10.0.0.0/33"}, nil)
+	s.ErrorIs(err, ErrInvalidIPNetwork)
+
+	_, err = NewIPAccessList(nil, []string{"999.1.1.1"})
+	s.ErrorIs(err, ErrInvalidIPAddress)
+
+	_, err = NewIPAccessList([]string{""}, nil)
+	s.ErrorIs(err, ErrEmptyIPAddress)
+}
+
+func (s *IPAccessListTestSuite) TestItDetectsConflictsAndOverlaps() {
+	list, _ := NewIPAccessList(
+		[]string{"10.0.0.0/8", "10.1.0.0/16", "192.168.0.0/16"},
+		[]string{"10.1.2.0/24", "172.16.0.0/12"},
+	)
+
+	var conflicts [][2]string
+	for _, conflict := range list.Conflicts() {
+		conflicts = append(conflicts, [2]string{conflict[0].Value(), conflict[1].Value()})
+	}
+	s.Equal(
+		[][2]string{{"10.0.0.0/8", "10.1.2.0/24"}, {"10.1.0.0/16", "10.1.2.0/24"}},
+		conflicts,
+	)
+
+	overlaps := list.Overlaps()
+	s.Require().Len(overlaps, 1)
+	s.Equal("10.0.0.0/8", overlaps[0][0].Value())
+	s.Equal("10.1.0.0/16", overlaps[0][1].Value())
+
+	clean, _ := NewIPAccessList([]string{"10.0.0.0/8"}, []string{"192.168.0.0/16"})
+	s.Empty(clean.Conflicts())
+	s.Empty(clean.Overlaps())
+}
+
+func (s *IPAccessListTestSuite) TestItRoundTripsThroughJSON() {
+	list, _ := NewIPAccessList([]string{"192.168.1.1", "10.0.0.0/8"}, []string{"10.0.0.1"})
+
+	encoded, err := json.Marshal(list)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{"allow":["10.0.0.0/8","192.168.1.1/32"],"deny":["10.0.0.1/32"]}`,
+		string(encoded),
+	)
+
+	var decoded IPAccessList
+	s.Require().NoError(json.Unmarshal(encoded, &decoded))
+	s.True(list.Equals(decoded))
+
+	s.NoError(json.Unmarshal([]byte("null"), &decoded))
+	s.True(list.Equals(decoded))
+
+	empty, err := json.Marshal(IPAccessList{})
+	s.NoError(err)
+	s.JSONEq(`{"allow":[],"deny":[]}`, string(empty))
+}
+
+func (s *IPAccessListTestSuite) TestItFailsToUnmarshalInvalidJSON() {
+	var list IPAccessList
+	s.ErrorIs(json.Unmarshal([]byte(`{"allow":["10.0.0.0/99"]}`), &list), ErrInvalidIPNetwork)
+	s.Error(json.Unmarshal([]byte(`{"allow":"10.0.0.0/8"}`), &list))
+}
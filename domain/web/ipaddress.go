@@ -1,19 +1,34 @@
 package web
 
 import (
-	"net"
+	"net/netip"
 	"strings"
 
 	"github.com/golibry/go-common-domain/domain"
 )
 
 var (
-	ErrEmptyIPAddress     = domain.NewError("IP address cannot be empty")
-	ErrInvalidIPAddress   = domain.NewError("IP address has invalid format")
-	ErrInvalidIPv4Address = domain.NewError("IPv4 address has invalid format")
-	ErrInvalidIPv6Address = domain.NewError("IPv6 address has invalid format")
+	ErrEmptyIPAddress = domain.NewLocalizedError(
+		"web.ip_address.empty", nil,
+		"IP address cannot be empty",
+	)
+	ErrInvalidIPAddress = domain.NewLocalizedError(
+		"web.ip_address.invalid", nil,
+		"IP address has invalid format",
+	)
+	ErrInvalidIPv4Address = domain.NewLocalizedError(
+		"web.ip_address.invalid_ipv4", nil,
+		"IPv4 address has invalid format",
+	)
+	ErrInvalidIPv6Address = domain.NewLocalizedError(
+		"web.ip_address.invalid_ipv6", nil,
+		"IPv6 address has invalid format",
+	)
 )
 
+// IPAddressValidators holds extra rules run by NewIPAddress after its own validation
+var IPAddressValidators domain.Validators[IPAddress]
+
 type IPAddress struct {
 	value string
 }
@@ -25,9 +40,14 @@ func NewIPAddress(value string) (IPAddress, error) {
 		return IPAddress{}, err
 	}
 
-	return IPAddress{
+	ipAddress := IPAddress{
 		value: normalized,
-	}, nil
+	}
+	if err := IPAddressValidators.Validate(ipAddress); err != nil {
+		return IPAddress{}, err
+	}
+
+	return ipAddress, nil
 }
 
 // ReconstituteIPAddress creates a new IPAddress instance without validation or normalization
@@ -44,14 +64,14 @@ func (ip IPAddress) Value() string {
 
 // IsIPv4 returns true if the IP address is IPv4
 func (ip IPAddress) IsIPv4() bool {
-	parsedIP := net.ParseIP(ip.value)
-	return parsedIP != nil && parsedIP.To4() != nil
+	parsedIP, ok := parseIPAddress(ip.value)
+	return ok && parsedIP.Is4()
 }
 
 // IsIPv6 returns true if the IP address is IPv6
 func (ip IPAddress) IsIPv6() bool {
-	parsedIP := net.ParseIP(ip.value)
-	return parsedIP != nil && parsedIP.To4() == nil
+	parsedIP, ok := parseIPAddress(ip.value)
+	return ok && !parsedIP.Is4()
 }
 
 // Equals compares two IPAddress objects for equality
@@ -77,17 +97,12 @@ func NormalizeIPAddress(ipAddress string) (string, error) {
 	}
 
 	// Parse and format to ensure consistent representation
-	parsedIP := net.ParseIP(preprocessed)
-	if parsedIP == nil {
+	parsedIP, ok := parseIPAddress(preprocessed)
+	if !ok {
 		return "", ErrInvalidIPAddress
 	}
 
-	// For IPv4, ensure standard dotted decimal notation
-	if parsedIP.To4() != nil {
-		return parsedIP.To4().String(), nil
-	}
-
-	// For IPv6, use standard representation
+	// IPv4 uses dotted decimal notation and IPv6 its compressed lowercase form
 	return parsedIP.String(), nil
 }
 
@@ -97,8 +112,7 @@ func IsValidIPAddress(ipAddress string) error {
 		return ErrEmptyIPAddress
 	}
 
-	parsedIP := net.ParseIP(ipAddress)
-	if parsedIP == nil {
+	if _, ok := parseIPAddress(ipAddress); !ok {
 		return ErrInvalidIPAddress
 	}
 
@@ -111,8 +125,8 @@ func IsValidIPv4Address(ipAddress string) error {
 		return ErrEmptyIPAddress
 	}
 
-	parsedIP := net.ParseIP(ipAddress)
-	if parsedIP == nil || parsedIP.To4() == nil {
+	parsedIP, ok := parseIPAddress(ipAddress)
+	if !ok || !parsedIP.Is4() {
 		return ErrInvalidIPv4Address
 	}
 
@@ -125,14 +139,25 @@ func IsValidIPv6Address(ipAddress string) error {
 		return ErrEmptyIPAddress
 	}
 
-	parsedIP := net.ParseIP(ipAddress)
-	if parsedIP == nil || parsedIP.To4() != nil {
+	parsedIP, ok := parseIPAddress(ipAddress)
+	if !ok || parsedIP.Is4() {
 		return ErrInvalidIPv6Address
 	}
 
 	return nil
 }
 
+// parseIPAddress parses an IPv4 or IPv6 address using net/netip, which keeps the package
+// free of the resolver-heavy net dependency on wasm and TinyGo targets.
+// IPv6 zones are rejected and IPv4-mapped IPv6 addresses are unmapped to plain IPv4.
+func parseIPAddress(ipAddress string) (netip.Addr, bool) {
+	parsedIP, err := netip.ParseAddr(ipAddress)
+	if err != nil || parsedIP.Zone() != "" {
+		return netip.Addr{}, false
+	}
+	return parsedIP.Unmap(), true
+}
+
 // preprocessIPv4 removes leading zeros from IPv4 addresses to avoid octal interpretation
 func preprocessIPv4(ipAddress string) string {
 	// Check if it looks like an IPv4 address (contains dots but not colons)
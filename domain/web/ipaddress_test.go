@@ -162,6 +162,11 @@ func (s *IPAddressTestSuite) TestItFailsToBuildNewIPAddressFromInvalidValues() {
 			input:         "hello world",
 			expectedError: ErrInvalidIPAddress,
 		},
+		{
+			name:          "IPv6 with zone",
+			input:         "fe80::1%eth0",
+			expectedError: ErrInvalidIPAddress,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -201,6 +206,11 @@ func (s *IPAddressTestSuite) TestIPAddressNormalization() {
 			input:    "  192.168.1.1  ",
 			expected: "192.168.1.1",
 		},
+		{
+			name:     "IPv4-mapped IPv6 unmapped",
+			input:    "::ffff:192.168.1.1",
+			expected: "192.168.1.1",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,132 @@
+package web
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyIPNetwork = domain.NewLocalizedError(
+		"web.ip_network.empty", nil,
+		"IP network cannot be empty",
+	)
+	ErrInvalidIPNetwork = domain.NewLocalizedError(
+		"web.ip_network.invalid", nil,
+		"IP network must be an IP address followed by a prefix length, e.g., 10.0.0.0/8",
+	)
+)
+
+// IPNetwork is an IPv4 or IPv6 network in CIDR notation, e.g., "10.0.0.0/8" or "2001:db8::/32"
+type IPNetwork struct {
+	value  string
+	prefix netip.Prefix
+}
+
+// NewIPNetwork creates a new instance of IPNetwork with validation and normalization. Host
+// bits are cleared, so "192.168.1.10/24" becomes "192.168.1.0/24".
+func NewIPNetwork(value string) (IPNetwork, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return IPNetwork{}, ErrEmptyIPNetwork
+	}
+
+	address, bits, found := strings.Cut(value, "/")
+	if !found {
+		return IPNetwork{}, ErrInvalidIPNetwork
+	}
+	normalized, err := NormalizeIPAddress(address)
+	if err != nil {
+		return IPNetwork{}, ErrInvalidIPNetwork
+	}
+	prefixLength, err := strconv.Atoi(bits)
+	if err != nil || bits[0] == '+' || bits[0] == '-' || (len(bits) > 1 && bits[0] == '0') {
+		return IPNetwork{}, ErrInvalidIPNetwork
+	}
+
+	prefix, err := netip.MustParseAddr(normalized).Prefix(prefixLength)
+	if err != nil {
+		return IPNetwork{}, ErrInvalidIPNetwork
+	}
+
+	return newIPNetwork(prefix), nil
+}
+
+// HostIPNetwork returns the network holding only the address, e.g., "192.168.1.1/32"
+func HostIPNetwork(ip IPAddress) IPNetwork {
+	addr, ok := parseIPAddress(ip.value)
+	if !ok {
+		return IPNetwork{}
+	}
+	return newIPNetwork(netip.PrefixFrom(addr, addr.BitLen()))
+}
+
+// ReconstituteIPNetwork creates a new IPNetwork instance without validation or normalization
+func ReconstituteIPNetwork(value string) IPNetwork {
+	prefix, _ := netip.ParsePrefix(value)
+	return IPNetwork{
+		value:  value,
+		prefix: prefix,
+	}
+}
+
+// Value returns the network in CIDR notation
+func (n IPNetwork) Value() string {
+	return n.value
+}
+
+// Address returns the first address of the network
+func (n IPNetwork) Address() IPAddress {
+	if !n.prefix.IsValid() {
+		return IPAddress{}
+	}
+	return IPAddress{value: n.prefix.Addr().String()}
+}
+
+// PrefixLength returns the number of leading bits that identify the network, e.g., 8 for
+// "10.0.0.0/8"
+func (n IPNetwork) PrefixLength() int {
+	return n.prefix.Bits()
+}
+
+// IsIPv4 returns true if the network is IPv4
+func (n IPNetwork) IsIPv4() bool {
+	return n.prefix.Addr().Is4()
+}
+
+// IsHost reports whether the network holds a single address
+func (n IPNetwork) IsHost() bool {
+	return n.prefix.IsValid() && n.prefix.IsSingleIP()
+}
+
+// Contains reports whether the address belongs to the network
+func (n IPNetwork) Contains(ip IPAddress) bool {
+	addr, ok := parseIPAddress(ip.value)
+	return ok && n.prefix.Contains(addr)
+}
+
+// Overlaps reports whether the two networks share any address, which for CIDR networks means
+// that one contains the other
+func (n IPNetwork) Overlaps(other IPNetwork) bool {
+	return n.prefix.Overlaps(other.prefix)
+}
+
+// Equals compares two IPNetwork objects for equality
+func (n IPNetwork) Equals(other IPNetwork) bool {
+	return n.value == other.value
+}
+
+// String returns a string representation of the network
+func (n IPNetwork) String() string {
+	return n.value
+}
+
+func newIPNetwork(prefix netip.Prefix) IPNetwork {
+	prefix = prefix.Masked()
+	return IPNetwork{
+		value:  prefix.String(),
+		prefix: prefix,
+	}
+}
@@ -0,0 +1,118 @@
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IPNetworkTestSuite struct {
+	suite.Suite
+}
+
+func TestIPNetworkSuite(t *testing.T) {
+	suite.Run(t, new(IPNetworkTestSuite))
+}
+
+func (s *IPNetworkTestSuite) TestItCanBuildNewIPNetworkWithValidValues() {
+	testCases := []struct {
+		value        string
+		expected     string
+		prefixLength int
+		isIPv4       bool
+	}{
+		{"10.0.0.0/8", "10.0.0.0/8", 8, true},
+		{" 192.168.1.10/24 ", "192.168.1.0/24", 24, true},
+		{"192.168.001.001/32", "192.168.1.1/32", 32, true},
+		{"0.0.0.0/0", "0.0.0.0/0", 0, true},
+		{"2001:DB8::1/32", "2001:db8::/32", 32, false},
+		{"::1/128", "::1/128", 128, false},
+	}
+
+	for _, tc := range testCases {
+		network, err := NewIPNetwork(tc.value)
+		s.Require().NoError(err, tc.value)
+		s.Equal(tc.expected, network.Value())
+		s.Equal(tc.expected, network.String())
+		s.Equal(tc.prefixLength, network.PrefixLength())
+		s.Equal(tc.isIPv4, network.IsIPv4())
+	}
+}
+
+func (s *IPNetworkTestSuite) TestItFailsToBuildNewIPNetworkWithInvalidValues() {
+	testCases := []struct {
+		value    string
+		expected error
+	}{
+		{"", ErrEmptyIPNetwork},
+		{"   ", ErrEmptyIPNetwork},
+		{"10.0.0.0", ErrInvalidIPNetwork},
+		{"10.0.0.0/", ErrInvalidIPNetwork},
+		{"10.0.0.0/33", ErrInvalidIPNetwork},
+		{"10.0.0.0/-1", ErrInvalidIPNetwork},
+		{"10.0.0.0/+8", ErrInvalidIPNetwork},
+		{"10.0.0.0/08", ErrInvalidIPNetwork},
+		{"10.0.0.0/8/8", ErrInvalidIPNetwork},
+		{"999.0.0.0/8", ErrInvalidIPNetwork},
+		{"2001:db8::/129", ErrInvalidIPNetwork},
+		{"fe80::1%eth0/64", ErrInvalidIPNetwork},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewIPNetwork(tc.value)
+		s.ErrorIs(err, tc.expected, tc.value)
+	}
+}
+
+func (s *IPNetworkTestSuite) TestItChecksMembership() {
+	network, _ := NewIPNetwork("192.168.1.0/24")
+	inside, _ := NewIPAddress("192.168.1.200")
+	outside, _ := NewIPAddress("192.168.2.1")
+	ipv6, _ := NewIPAddress("2001:db8::1")
+
+	s.True(network.Contains(inside))
+	s.False(network.Contains(outside))
+	s.False(network.Contains(ipv6))
+	s.False(network.Contains(IPAddress{}))
+	s.Equal("192.168.1.0", network.Address().Value())
+	s.False(network.IsHost())
+}
+
+func (s *IPNetworkTestSuite) TestItDetectsOverlaps() {
+	wide, _ := NewIPNetwork("10.0.0.0/8")
+	narrow, _ := NewIPNetwork("10.1.0.0/16")
+	other, _ := NewIPNetwork("192.168.0.0/16")
+
+	s.True(wide.Overlaps(narrow))
+	s.True(narrow.Overlaps(wide))
+	s.False(wide.Overlaps(other))
+}
+
+func (s *IPNetworkTestSuite) TestItBuildsHostNetworks() {
+	ipv4, _ := NewIPAddress("192.168.1.1")
+	ipv6, _ := NewIPAddress("2001:db8::1")
+
+	s.Equal("192.168.1.1/32", HostIPNetwork(ipv4).Value())
+	s.True(HostIPNetwork(ipv4).IsHost())
+	s.Equal("2001:db8::1/128", HostIPNetwork(ipv6).Value())
+	s.Equal(IPNetwork{}, HostIPNetwork(IPAddress{}))
+}
+
+func (s *IPNetworkTestSuite) TestItCanReconstituteIPNetwork() {
+	network := ReconstituteIPNetwork("10.0.0.0/8")
+	expected, _ := NewIPNetwork("10.0.0.0/8")
+	s.Equal(expected, network)
+
+	invalid := ReconstituteIPNetwork("not a network")
+	s.Equal("not a network", invalid.Value())
+	s.Equal(IPAddress{}, invalid.Address())
+}
+
+func (s *IPNetworkTestSuite) TestItComparesIPNetworks() {
+	first, _ := NewIPNetwork("10.0.0.0/8")
+	second, _ := NewIPNetwork("10.20.30.40/8")
+	third, _ := NewIPNetwork("10.0.0.0/16")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
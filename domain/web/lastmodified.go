@@ -0,0 +1,114 @@
+package web
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+// httpDateFormats lists the HTTP-date formats of RFC 7231: the preferred IMF-fixdate, then
+// the obsolete RFC 850 and asctime formats recipients must still accept
+var httpDateFormats = []string{
+	"Mon, 02 Jan 2006 15:04:05 GMT",
+	"Monday, 02-Jan-06 15:04:05 GMT",
+	"Mon Jan _2 15:04:05 2006",
+}
+
+var (
+	ErrEmptyLastModified = domain.NewLocalizedError(
+		"web.last_modified.empty", nil,
+		"last modified date cannot be empty",
+	)
+	ErrInvalidLastModified = domain.NewLocalizedError(
+		"web.last_modified.invalid", nil,
+		"last modified date must be a valid HTTP date",
+	)
+)
+
+// LastModified is the time a resource last changed, as carried by the Last-Modified header,
+// in UTC with the whole-second precision of HTTP dates
+type LastModified struct {
+	value time.Time
+}
+
+// NewLastModified creates a new instance of LastModified, truncating the time to seconds
+func NewLastModified(value time.Time) (LastModified, error) {
+	if value.IsZero() {
+		return LastModified{}, ErrEmptyLastModified
+	}
+
+	return LastModified{
+		value: value.UTC().Truncate(time.Second),
+	}, nil
+}
+
+// ParseLastModified creates a new instance of LastModified from an HTTP date, such as
+// "Wed, 21 Oct 2015 07:28:00 GMT", with validation
+func ParseLastModified(value string) (LastModified, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return LastModified{}, ErrEmptyLastModified
+	}
+
+	for _, format := range httpDateFormats {
+		if parsed, err := time.Parse(format, value); err == nil {
+			return NewLastModified(parsed)
+		}
+	}
+	return LastModified{}, ErrInvalidLastModified
+}
+
+// ReconstituteLastModified creates a new LastModified instance without validation
+func ReconstituteLastModified(value time.Time) LastModified {
+	return LastModified{
+		value: value,
+	}
+}
+
+// Time returns the time in UTC
+func (l LastModified) Time() time.Time {
+	return l.value
+}
+
+// Value returns the HTTP date of the time, e.g., "Wed, 21 Oct 2015 07:28:00 GMT"
+func (l LastModified) Value() string {
+	return l.value.UTC().Format(httpDateFormats[0])
+}
+
+// ModifiedSince reports whether the resource changed after the time, compared to the second
+func (l LastModified) ModifiedSince(since time.Time) bool {
+	return l.value.After(since.Truncate(time.Second))
+}
+
+// IsModifiedSince evaluates an If-Modified-Since header: it reports whether the resource
+// changed after the date, so that a GET must send it in full rather than 304 Not Modified. A
+// header that does not parse is ignored, as RFC 7232 requires, and reports true.
+func (l LastModified) IsModifiedSince(header string) bool {
+	since, err := ParseLastModified(header)
+	if err != nil {
+		return true
+	}
+	return l.ModifiedSince(since.value)
+}
+
+// IsUnmodifiedSince evaluates an If-Unmodified-Since header: it reports whether the resource
+// did not change after the date, so that a conditional update may proceed. A header that does
+// not parse is ignored, as RFC 7232 requires, and reports true.
+func (l LastModified) IsUnmodifiedSince(header string) bool {
+	since, err := ParseLastModified(header)
+	if err != nil {
+		return true
+	}
+	return !l.ModifiedSince(since.value)
+}
+
+// Equals compares two LastModified objects for equality
+func (l LastModified) Equals(other LastModified) bool {
+	return l.value.Equal(other.value)
+}
+
+// String returns the HTTP date of the time
+func (l LastModified) String() string {
+	return l.Value()
+}
@@ -0,0 +1,90 @@
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LastModifiedTestSuite struct {
+	suite.Suite
+}
+
+func TestLastModifiedSuite(t *testing.T) {
+	suite.Run(t, new(LastModifiedTestSuite))
+}
+
+func (s *LastModifiedTestSuite) TestItCanBuildNewLastModified() {
+	local := time.FixedZone("EEST", 3*60*60)
+	lastModified, err := NewLastModified(time.Date(2015, 10, 21, 10, 28, 0, 999, local))
+	s.Require().NoError(err)
+
+	s.Equal(time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC), lastModified.Time())
+	s.Equal("Wed, 21 Oct 2015 07:28:00 GMT", lastModified.Value())
+	s.Equal("Wed, 21 Oct 2015 07:28:00 GMT", lastModified.String())
+
+	_, err = NewLastModified(time.Time{})
+	s.ErrorIs(err, ErrEmptyLastModified)
+}
+
+func (s *LastModifiedTestSuite) TestItCanParseHTTPDates() {
+	expected := time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC)
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{name: "IMF-fixdate", input: "Sun, 06 Nov 1994 08:49:37 GMT"},
+		{name: "RFC 850", input: "Sunday, 06-Nov-94 08:49:37 GMT"},
+		{name: "asctime", input: "Sun Nov  6 08:49:37 1994"},
+		{name: "surrounding spaces", input: "  Sun, 06 Nov 1994 08:49:37 GMT "},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				lastModified, err := ParseLastModified(tc.input)
+				s.Require().NoError(err)
+				s.Equal(expected, lastModified.Time())
+			},
+		)
+	}
+}
+
+func (s *LastModifiedTestSuite) TestItFailsToParseInvalidHTTPDates() {
+	_, err := ParseLastModified(" ")
+	s.ErrorIs(err, ErrEmptyLastModified)
+
+	for _, input := range []string{
+		"2015-10-21T07:28:00Z",
+		"Wed, 21 Oct 2015 07:28:00 CET",
+		"Wed, 32 Oct 2015 07:28:00 GMT",
+	} {
+		_, err = ParseLastModified(input)
+		s.ErrorIs(err, ErrInvalidLastModified, input)
+	}
+}
+
+func (s *LastModifiedTestSuite) TestItEvaluatesConditionalHeaders() {
+	lastModified, _ := ParseLastModified("Wed, 21 Oct 2015 07:28:00 GMT")
+
+	s.True(lastModified.IsModifiedSince("Tue, 20 Oct 2015 07:28:00 GMT"))
+	s.False(lastModified.IsModifiedSince("Wed, 21 Oct 2015 07:28:00 GMT"))
+	s.False(lastModified.IsModifiedSince("Thu, 22 Oct 2015 07:28:00 GMT"))
+	s.True(lastModified.IsModifiedSince("yesterday"))
+
+	s.True(lastModified.IsUnmodifiedSince("Wed, 21 Oct 2015 07:28:00 GMT"))
+	s.False(lastModified.IsUnmodifiedSince("Tue, 20 Oct 2015 07:28:00 GMT"))
+	s.True(lastModified.IsUnmodifiedSince("yesterday"))
+
+	s.False(lastModified.ModifiedSince(time.Date(2015, 10, 21, 7, 28, 0, 500, time.UTC)))
+}
+
+func (s *LastModifiedTestSuite) TestItCanCompareForEquality() {
+	first, _ := ParseLastModified("Wed, 21 Oct 2015 07:28:00 GMT")
+	second, _ := NewLastModified(time.Date(2015, 10, 21, 7, 28, 0, 42, time.UTC))
+	third, _ := ParseLastModified("Wed, 21 Oct 2015 07:28:01 GMT")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+}
@@ -0,0 +1,28 @@
+package web
+
+// Option tunes the limits enforced by NewEmailWithOptions, NewURLWithOptions and NewDataURI,
+// for applications whose business rules differ from the package defaults
+type Option func(*options)
+
+type options struct {
+	maxLength int
+}
+
+// WithMaxLength overrides the maximum length of the whole value, MaxEmailLength for emails,
+// MaxURLLength for URLs and MaxDataURILength for data URIs. Values below one keep the
+// default. Email local and domain parts keep their RFC 5321 limits.
+func WithMaxLength(maxLength int) Option {
+	return func(o *options) {
+		if maxLength > 0 {
+			o.maxLength = maxLength
+		}
+	}
+}
+
+// applyOptions applies the options on top of the defaults
+func applyOptions(defaults options, opts []Option) options {
+	for _, opt := range opts {
+		opt(&defaults)
+	}
+	return defaults
+}
@@ -0,0 +1,76 @@
+package web
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var ErrMissingPTRResolver = domain.NewError("PTR lookup needs a resolver")
+
+// PTRResolver looks up the host names an address points back to. *net.Resolver satisfies
+// it, e.g., net.DefaultResolver; the package does not import net itself so it keeps building
+// for WebAssembly and TinyGo.
+type PTRResolver interface {
+	LookupAddr(ctx context.Context, addr string) ([]string, error)
+}
+
+// ReverseDNSName returns the name under which the PTR record of the address is published,
+// e.g., "1.2.0.192.in-addr.arpa" for 192.0.2.1, or the ip6.arpa name of an IPv6 address made
+// of its 32 hexadecimal nibbles in reverse order. It returns an empty DomainName for an
+// invalid address.
+func (ip IPAddress) ReverseDNSName() DomainName {
+	addr, ok := parseIPAddress(ip.value)
+	if !ok {
+		return DomainName{}
+	}
+
+	var name strings.Builder
+	octets := addr.AsSlice()
+	if addr.Is4() {
+		for i := len(octets) - 1; i >= 0; i-- {
+			name.WriteString(strconv.Itoa(int(octets[i])) + ".")
+		}
+		name.WriteString("in-addr.arpa")
+		return ReconstituteDomainName(name.String())
+	}
+
+	const hexDigits = "0123456789abcdef"
+	for i := len(octets) - 1; i >= 0; i-- {
+		name.WriteByte(hexDigits[octets[i]&0x0f])
+		name.WriteByte('.')
+		name.WriteByte(hexDigits[octets[i]>>4])
+		name.WriteByte('.')
+	}
+	name.WriteString("ip6.arpa")
+	return ReconstituteDomainName(name.String())
+}
+
+// LookupPTR resolves the host names the address points back to, which audit logs record and
+// anti-abuse rules match, e.g., to recognize crawlers. Names that are not valid domain names
+// are skipped. PTR records are controlled by whoever owns the address, so confirm a name
+// with a forward lookup before trusting it.
+func (ip IPAddress) LookupPTR(ctx context.Context, resolver PTRResolver) ([]DomainName, error) {
+	if resolver == nil {
+		return nil, ErrMissingPTRResolver
+	}
+	if _, ok := parseIPAddress(ip.value); !ok {
+		return nil, ErrInvalidIPAddress
+	}
+
+	hosts, err := resolver.LookupAddr(ctx, ip.value)
+	if err != nil {
+		return nil, domain.NewInternalErrorWithWrap(err, "PTR lookup of %s failed", ip.value)
+	}
+
+	names := make([]DomainName, 0, len(hosts))
+	for _, host := range hosts {
+		name, err := NewDomainName(strings.TrimSuffix(host, "."))
+		if err == nil {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
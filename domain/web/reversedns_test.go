@@ -0,0 +1,94 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReverseDNSTestSuite struct {
+	suite.Suite
+}
+
+func TestReverseDNSSuite(t *testing.T) {
+	suite.Run(t, new(ReverseDNSTestSuite))
+}
+
+func (s *ReverseDNSTestSuite) TestItBuildsReverseDNSNames() {
+	testCases := []struct {
+		ip       string
+		expected string
+	}{
+		{"192.0.2.1", "1.2.0.192.in-addr.arpa"},
+		{"10.0.0.255", "255.0.0.10.in-addr.arpa"},
+		{"::ffff:192.0.2.1", "1.2.0.192.in-addr.arpa"},
+		{
+			"2001:db8::567:89ab",
+			"b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+		},
+		{"::1", "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.ip6.arpa"},
+	}
+
+	for _, tc := range testCases {
+		ip, err := NewIPAddress(tc.ip)
+		s.Require().NoError(err)
+		name := ip.ReverseDNSName()
+		s.Equal(tc.expected, name.Value(), tc.ip)
+		s.NoError(IsValidDomainName(name.Value()), tc.ip)
+	}
+
+	s.Equal(DomainName{}, IPAddress{}.ReverseDNSName())
+}
+
+func (s *ReverseDNSTestSuite) TestItLooksUpPTRRecords() {
+	resolver := &fakePTRResolver{
+		hosts: []string{"crawl-66-249-66-1.googlebot.com.", "Mail.Example.com", "not a host"},
+	}
+	ip, _ := NewIPAddress("66.249.66.1")
+
+	names, err := ip.LookupPTR(context.Background(), resolver)
+	s.Require().NoError(err)
+	s.Equal("66.249.66.1", resolver.addr)
+	s.Require().Len(names, 2)
+	s.Equal("crawl-66-249-66-1.googlebot.com", names[0].Value())
+	s.Equal("mail.example.com", names[1].Value())
+}
+
+func (s *ReverseDNSTestSuite) TestItFailsToLookUpPTRRecords() {
+	ip, _ := NewIPAddress("192.0.2.1")
+
+	_, err := ip.LookupPTR(context.Background(), nil)
+	s.ErrorIs(err, ErrMissingPTRResolver)
+
+	_, err = IPAddress{}.LookupPTR(context.Background(), &fakePTRResolver{})
+	s.ErrorIs(err, ErrInvalidIPAddress)
+
+	lookupErr := errors.New("no such host")
+	_, err = ip.LookupPTR(context.Background(), &fakePTRResolver{err: lookupErr})
+	s.ErrorIs(err, lookupErr)
+	s.Equal(domain.CategoryInternal, domain.CategoryOf(err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ip.LookupPTR(ctx, &fakePTRResolver{})
+	s.ErrorIs(err, context.Canceled)
+}
+
+// fakePTRResolver is a PTRResolver for tests that returns fixed host names and records the
+// address it was asked for
+type fakePTRResolver struct {
+	hosts []string
+	err   error
+	addr  string
+}
+
+func (r *fakePTRResolver) LookupAddr(ctx context.Context, addr string) ([]string, error) {
+	r.addr = addr
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.hosts, r.err
+}
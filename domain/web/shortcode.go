@@ -0,0 +1,266 @@
+package web
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+const (
+	// ShortCodeAlphabetBase62 holds the digits and the ASCII letters in both cases
+	ShortCodeAlphabetBase62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// ShortCodeAlphabetBase58 is ShortCodeAlphabetBase62 without the characters that are easy
+	// to confuse when read aloud or copied by hand: 0, O, I and l
+	ShortCodeAlphabetBase58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	// DefaultShortCodeLength is the length of generated short codes, about 3.5 trillion codes
+	// with the default alphabet
+	DefaultShortCodeLength = 7
+	// MinShortCodeLength and MaxShortCodeLength bound the length of short codes
+	MinShortCodeLength = 4
+	MaxShortCodeLength = 32
+)
+
+var (
+	ErrEmptyShortCode = domain.NewLocalizedError(
+		"web.short_code.empty", nil,
+		"short code cannot be empty",
+	)
+	ErrInvalidShortCodeLength = domain.NewLocalizedError(
+		"web.short_code.invalid_length", nil,
+		"short code has an invalid length",
+	)
+	ErrInvalidShortCodeChars = domain.NewLocalizedError(
+		"web.short_code.invalid_chars", nil,
+		"short code contains invalid characters",
+	)
+	ErrInvalidShortCodeAlphabet = domain.NewError(
+		"short code alphabet must hold at least 2 distinct URL-safe ASCII characters",
+	)
+)
+
+// ShortCodeOption configures the alphabet and length of short codes
+type ShortCodeOption func(*shortCodeOptions)
+
+type shortCodeOptions struct {
+	alphabet  string
+	minLength int
+	maxLength int
+	length    int
+}
+
+// WithShortCodeAlphabet generates and accepts only characters of the alphabet, which must
+// hold at least 2 distinct unreserved URL characters: ASCII letters, digits, '-', '.', '_'
+// and '~'
+func WithShortCodeAlphabet(alphabet string) ShortCodeOption {
+	return func(o *shortCodeOptions) {
+		o.alphabet = alphabet
+	}
+}
+
+// WithBase58 uses ShortCodeAlphabetBase58, for codes people read or type
+func WithBase58() ShortCodeOption {
+	return WithShortCodeAlphabet(ShortCodeAlphabetBase58)
+}
+
+// WithShortCodeLength generates codes of the length and accepts no other length. Lengths
+// outside MinShortCodeLength and MaxShortCodeLength are ignored.
+func WithShortCodeLength(length int) ShortCodeOption {
+	return func(o *shortCodeOptions) {
+		if length >= MinShortCodeLength && length <= MaxShortCodeLength {
+			o.length, o.minLength, o.maxLength = length, length, length
+		}
+	}
+}
+
+// applyShortCodeOptions applies the options on top of the defaults, which generate
+// DefaultShortCodeLength Base62 characters and accept any length from MinShortCodeLength to
+// MaxShortCodeLength, so custom aliases such as "launch2025" remain valid
+func applyShortCodeOptions(opts []ShortCodeOption) (shortCodeOptions, error) {
+	config := shortCodeOptions{
+		alphabet:  ShortCodeAlphabetBase62,
+		minLength: MinShortCodeLength,
+		maxLength: MaxShortCodeLength,
+		length:    DefaultShortCodeLength,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if !isValidShortCodeAlphabet(config.alphabet) {
+		return shortCodeOptions{}, ErrInvalidShortCodeAlphabet
+	}
+	return config, nil
+}
+
+// ShortCode is the key of a shortened link, such as "aZ3kP9x" in "https://sho.rt/aZ3kP9x".
+// Codes are case-sensitive.
+type ShortCode struct {
+	value string
+}
+
+// NewShortCode creates a new instance of ShortCode with validation
+func NewShortCode(value string, opts ...ShortCodeOption) (ShortCode, error) {
+	config, err := applyShortCodeOptions(opts)
+	if err != nil {
+		return ShortCode{}, err
+	}
+
+	value = strings.TrimSpace(value)
+	if err := isValidShortCode(value, config); err != nil {
+		return ShortCode{}, err
+	}
+
+	return ShortCode{
+		value: value,
+	}, nil
+}
+
+// ReconstituteShortCode creates a new ShortCode instance without validation
+func ReconstituteShortCode(value string) ShortCode {
+	return ShortCode{
+		value: value,
+	}
+}
+
+// GenerateShortCode creates a new random short code from a cryptographically secure source,
+// with every character of the alphabet equally likely
+func GenerateShortCode(opts ...ShortCodeOption) (ShortCode, error) {
+	config, err := applyShortCodeOptions(opts)
+	if err != nil {
+		return ShortCode{}, err
+	}
+
+	// Reject random bytes from the incomplete last round of the alphabet, so the modulo
+	// below does not favor its first characters
+	size := len(config.alphabet)
+	limit := 256 - 256%size
+	code := make([]byte, 0, config.length)
+	buf := make([]byte, config.length*2)
+	for len(code) < config.length {
+		if _, err := rand.Read(buf); err != nil {
+			return ShortCode{}, domain.NewInternalErrorWithWrap(
+				err, "failed to generate short code",
+			)
+		}
+		for _, b := range buf {
+			if int(b) < limit && len(code) < config.length {
+				code = append(code, config.alphabet[int(b)%size])
+			}
+		}
+	}
+
+	return ShortCode{
+		value: string(code),
+	}, nil
+}
+
+// ShortCodeFromURL extracts the short code from the last path segment of a short link, such
+// as "https://sho.rt/aZ3kP9x", with validation
+func ShortCodeFromURL(link URL, opts ...ShortCodeOption) (ShortCode, error) {
+	parsed := link.Parsed()
+	segment := strings.TrimRight(parsed.Path, "/")
+	segment = segment[strings.LastIndexByte(segment, '/')+1:]
+	if segment == "" {
+		return ShortCode{}, ErrEmptyShortCode
+	}
+
+	return NewShortCode(segment, opts...)
+}
+
+// Value returns the short code
+func (c ShortCode) Value() string {
+	return c.value
+}
+
+// URL returns the short link of the code under a base URL, e.g., "https://sho.rt/aZ3kP9x"
+// for "https://sho.rt"
+func (c ShortCode) URL(base URL) (URL, error) {
+	if c.value == "" {
+		return URL{}, ErrEmptyShortCode
+	}
+
+	return NewURL(strings.TrimRight(base.Value(), "/") + "/" + c.value)
+}
+
+// Equals compares two ShortCode objects for equality
+func (c ShortCode) Equals(other ShortCode) bool {
+	return c.value == other.value
+}
+
+// String returns a string representation of the short code
+func (c ShortCode) String() string {
+	return c.value
+}
+
+// MarshalJSON encodes the short code as a JSON string
+func (c ShortCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.value)
+}
+
+// UnmarshalJSON decodes the short code from a JSON string, with validation against the
+// default options
+func (c *ShortCode) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid short code JSON")
+	}
+
+	parsed, err := NewShortCode(raw)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// isValidShortCode validates a short code against its alphabet and length limits
+func isValidShortCode(value string, config shortCodeOptions) error {
+	if value == "" {
+		return ErrEmptyShortCode
+	}
+	if !isShortCodeOf(value, config.alphabet) {
+		return ErrInvalidShortCodeChars
+	}
+	if len(value) < config.minLength || len(value) > config.maxLength {
+		return ErrInvalidShortCodeLength
+	}
+	return nil
+}
+
+// isShortCodeOf reports whether every byte of the value belongs to the alphabet
+func isShortCodeOf(value, alphabet string) bool {
+	for i := 0; i < len(value); i++ {
+		if strings.IndexByte(alphabet, value[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidShortCodeAlphabet reports whether the alphabet holds at least 2 distinct unreserved
+// URL characters and nothing else
+func isValidShortCodeAlphabet(alphabet string) bool {
+	if len(alphabet) < 2 {
+		return false
+	}
+
+	var seen [128]bool
+	for i := 0; i < len(alphabet); i++ {
+		c := alphabet[i]
+		unreserved := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+			(c >= '0' && c <= '9') || strings.IndexByte("-._~", c) >= 0
+		if !unreserved || seen[c] {
+			return false
+		}
+		seen[c] = true
+	}
+	return true
+}
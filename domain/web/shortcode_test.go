@@ -0,0 +1,199 @@
+package web
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ShortCodeTestSuite struct {
+	suite.Suite
+}
+
+func TestShortCodeSuite(t *testing.T) {
+	suite.Run(t, new(ShortCodeTestSuite))
+}
+
+func (s *ShortCodeTestSuite) TestItCanBuildNewShortCodeWithValidValues() {
+	testCases := []struct {
+		name     string
+		input    string
+		opts     []ShortCodeOption
+		expected string
+	}{
+		{name: "base62 code", input: "aZ3kP9x", expected: "aZ3kP9x"},
+		{name: "surrounding spaces", input: "  aZ3kP9x ", expected: "aZ3kP9x"},
+		{name: "custom alias", input: "launch2025", expected: "launch2025"},
+		{name: "minimum length", input: "abcd", expected: "abcd"},
+		{
+			name:     "base58 code",
+			input:    "3kP9xZa",
+			opts:     []ShortCodeOption{WithBase58()},
+			expected: "3kP9xZa",
+		},
+		{
+			name:     "custom alphabet and length",
+			input:    "ab-cd_ef",
+			opts:     []ShortCodeOption{WithShortCodeAlphabet("abcdef-_"), WithShortCodeLength(8)},
+			expected: "ab-cd_ef",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				code, err := NewShortCode(tc.input, tc.opts...)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, code.Value())
+				s.Equal(tc.expected, code.String())
+			},
+		)
+	}
+}
+
+func (s *ShortCodeTestSuite) TestItFailsToBuildNewShortCodeWithInvalidValues() {
+	testCases := []struct {
+		name     string
+		input    string
+		opts     []ShortCodeOption
+		expected error
+	}{
+		{name: "empty", input: "  ", expected: ErrEmptyShortCode},
+		{name: "too short", input: "abc", expected: ErrInvalidShortCodeLength},
+		{
+			name:     "too long",
+			input:    strings.Repeat("a", MaxShortCodeLength+1),
+			expected: ErrInvalidShortCodeLength,
+		},
+		{name: "slash", input: "abc/def", expected: ErrInvalidShortCodeChars},
+		{name: "non-ASCII", input: "abcdéf", expected: ErrInvalidShortCodeChars},
+		{
+			name:     "ambiguous base58 character",
+			input:    "abc0Ol1",
+			opts:     []ShortCodeOption{WithBase58()},
+			expected: ErrInvalidShortCodeChars,
+		},
+		{
+			name:     "length other than configured",
+			input:    "abcdefg",
+			opts:     []ShortCodeOption{WithShortCodeLength(8)},
+			expected: ErrInvalidShortCodeLength,
+		},
+		{
+			name:     "alphabet with duplicates",
+			input:    "abab",
+			opts:     []ShortCodeOption{WithShortCodeAlphabet("aab")},
+			expected: ErrInvalidShortCodeAlphabet,
+		},
+		{
+			name:     "alphabet with reserved characters",
+			input:    "abab",
+			opts:     []ShortCodeOption{WithShortCodeAlphabet("ab/")},
+			expected: ErrInvalidShortCodeAlphabet,
+		},
+		{
+			name:     "single character alphabet",
+			input:    "aaaa",
+			opts:     []ShortCodeOption{WithShortCodeAlphabet("a")},
+			expected: ErrInvalidShortCodeAlphabet,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewShortCode(tc.input, tc.opts...)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *ShortCodeTestSuite) TestItIgnoresOutOfRangeLengths() {
+	code, err := GenerateShortCode(WithShortCodeLength(MaxShortCodeLength + 1))
+	s.Require().NoError(err)
+	s.Len(code.Value(), DefaultShortCodeLength)
+}
+
+func (s *ShortCodeTestSuite) TestItCanGenerateShortCodes() {
+	code, err := GenerateShortCode()
+	s.Require().NoError(err)
+	s.Len(code.Value(), DefaultShortCodeLength)
+	_, err = NewShortCode(code.Value())
+	s.NoError(err)
+
+	code, err = GenerateShortCode(WithBase58(), WithShortCodeLength(12))
+	s.Require().NoError(err)
+	s.Len(code.Value(), 12)
+	s.NotContains(code.Value(), "0")
+	_, err = NewShortCode(code.Value(), WithBase58(), WithShortCodeLength(12))
+	s.NoError(err)
+
+	_, err = GenerateShortCode(WithShortCodeAlphabet(""))
+	s.ErrorIs(err, ErrInvalidShortCodeAlphabet)
+}
+
+func (s *ShortCodeTestSuite) TestItGeneratesEveryCharacterOfTheAlphabet() {
+	seen := map[rune]bool{}
+	for range 50 {
+		code, err := GenerateShortCode(WithShortCodeAlphabet("xyz"), WithShortCodeLength(32))
+		s.Require().NoError(err)
+		for _, r := range code.Value() {
+			seen[r] = true
+		}
+	}
+	s.Equal(map[rune]bool{'x': true, 'y': true, 'z': true}, seen)
+}
+
+func (s *ShortCodeTestSuite) TestItCanPairWithURLs() {
+	base, _ := NewURL("https://sho.rt/")
+	code, _ := NewShortCode("aZ3kP9x")
+
+	link, err := code.URL(base)
+	s.Require().NoError(err)
+	s.Equal("https://sho.rt/aZ3kP9x", link.Value())
+
+	parsed, err := ShortCodeFromURL(link)
+	s.Require().NoError(err)
+	s.True(code.Equals(parsed))
+
+	withSlash, _ := NewURL("https://sho.rt/go/aZ3kP9x/")
+	parsed, err = ShortCodeFromURL(withSlash)
+	s.Require().NoError(err)
+	s.Equal("aZ3kP9x", parsed.Value())
+
+	_, err = ShortCodeFromURL(base)
+	s.ErrorIs(err, ErrEmptyShortCode)
+
+	_, err = ShortCode{}.URL(base)
+	s.ErrorIs(err, ErrEmptyShortCode)
+}
+
+func (s *ShortCodeTestSuite) TestItCanCompareForEquality() {
+	first, _ := NewShortCode("aZ3kP9x")
+	second, _ := NewShortCode("aZ3kP9x")
+	third, _ := NewShortCode("az3kp9x")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+	s.True(first.Equals(ReconstituteShortCode("aZ3kP9x")))
+}
+
+func (s *ShortCodeTestSuite) TestItCanRoundTripThroughJSON() {
+	code, _ := NewShortCode("aZ3kP9x")
+
+	data, err := json.Marshal(code)
+	s.Require().NoError(err)
+	s.Equal(`"aZ3kP9x"`, string(data))
+
+	var decoded ShortCode
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(code.Equals(decoded))
+
+	s.NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(code.Equals(decoded))
+	s.ErrorIs(json.Unmarshal([]byte(`"a/b/c/d"`), &decoded), ErrInvalidShortCodeChars)
+	s.Error(json.Unmarshal([]byte(`42`), &decoded))
+}
@@ -0,0 +1,169 @@
+package web
+
+// Text decoding lets CSV imports and HTML forms fill these fields straight from their string
+// cells; Value gives the text to write back. Empty text is treated as a missing value.
+
+// UnmarshalText parses the URL from text, with validation
+func (u *URL) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewURL(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// UnmarshalText parses the email from text, with validation
+func (e *Email) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewEmail(string(text))
+	if err != nil {
+		return err
+	}
+
+	*e = parsed
+	return nil
+}
+
+// UnmarshalText parses the domain name from text, with validation
+func (d *DomainName) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewDomainName(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// UnmarshalText parses the IP address from text, with validation
+func (ip *IPAddress) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewIPAddress(string(text))
+	if err != nil {
+		return err
+	}
+
+	*ip = parsed
+	return nil
+}
+
+// UnmarshalText parses the IP network from text, with validation
+func (n *IPNetwork) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewIPNetwork(string(text))
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
+
+// UnmarshalText parses the domain pattern from text, with validation
+func (p *DomainPattern) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewDomainPattern(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// UnmarshalText parses the short code from text, with validation against the default options
+func (c *ShortCode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewShortCode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// UnmarshalText parses the data URI from text, with validation
+func (d *DataURI) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewDataURI(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// UnmarshalText parses the URI template from text, with validation
+func (t *URITemplate) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewURITemplate(string(text))
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// UnmarshalText parses the ETag from its header form, with validation
+func (e *ETag) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := NewETag(string(text))
+	if err != nil {
+		return err
+	}
+
+	*e = parsed
+	return nil
+}
+
+// UnmarshalText parses the last modified time from an HTTP date, with validation
+func (l *LastModified) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	parsed, err := ParseLastModified(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+	return nil
+}
@@ -0,0 +1,73 @@
+package web
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TextTestSuite struct {
+	suite.Suite
+}
+
+func TestTextSuite(t *testing.T) {
+	suite.Run(t, new(TextTestSuite))
+}
+
+func (s *TextTestSuite) TestItCanParseWebValuesFromText() {
+	var url URL
+	s.Require().NoError(url.UnmarshalText([]byte("https://example.com/docs")))
+	s.Equal("https://example.com/docs", url.Value())
+
+	var email Email
+	s.Require().NoError(email.UnmarshalText([]byte("Jane@Example.com")))
+	s.Equal("jane@example.com", email.Value())
+
+	var domainName DomainName
+	s.Require().NoError(domainName.UnmarshalText([]byte("example.com")))
+	s.Equal("example.com", domainName.Value())
+
+	var ip IPAddress
+	s.Require().NoError(ip.UnmarshalText([]byte("192.168.1.1")))
+	s.Equal("192.168.1.1", ip.Value())
+
+	var network IPNetwork
+	s.Require().NoError(network.UnmarshalText([]byte("10.1.2.3/8")))
+	s.Equal("10.0.0.0/8", network.Value())
+
+	var shortCode ShortCode
+	s.Require().NoError(shortCode.UnmarshalText([]byte("aZ3kP9x")))
+	s.Equal("aZ3kP9x", shortCode.Value())
+
+	var dataURI DataURI
+	s.Require().NoError(dataURI.UnmarshalText([]byte("data:,Hi")))
+	s.Equal("text/plain", dataURI.MediaType())
+
+	var template URITemplate
+	s.Require().NoError(template.UnmarshalText([]byte("https://example.com/{id}")))
+	s.Equal([]string{"id"}, template.Variables())
+
+	var etag ETag
+	s.Require().NoError(etag.UnmarshalText([]byte(`W/"v1"`)))
+	s.True(etag.IsWeak())
+
+	var lastModified LastModified
+	s.Require().NoError(lastModified.UnmarshalText([]byte("Wed, 21 Oct 2015 07:28:00 GMT")))
+	s.Equal(2015, lastModified.Time().Year())
+}
+
+func (s *TextTestSuite) TestItValidatesText() {
+	var email Email
+	s.ErrorIs(email.UnmarshalText([]byte("not-an-email")), ErrMissingAtSymbol)
+	s.NoError(email.UnmarshalText(nil))
+	s.Equal(Email{}, email)
+}
+
+func (s *TextTestSuite) TestItCanBeUsedAsAJSONMapKey() {
+	var owners map[Email]string
+	s.Require().NoError(json.Unmarshal([]byte(`{"Jane@Example.com": "admin"}`), &owners))
+
+	email, _ := NewEmail("jane@example.com")
+	s.Equal("admin", owners[email])
+}
@@ -0,0 +1,263 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/golibry/go-common-domain/domain"
+)
+
+var (
+	ErrEmptyURITemplate = domain.NewLocalizedError(
+		"web.uri_template.empty", nil,
+		"URI template cannot be empty",
+	)
+	ErrInvalidURITemplate = domain.NewLocalizedError(
+		"web.uri_template.invalid", nil,
+		"URI template format is invalid",
+	)
+	ErrMissingURITemplateVariable = domain.NewError("URI template variable is missing")
+)
+
+// uriTemplatePart is a literal or an expression of a URITemplate. Expressions have an
+// operator: 0 for simple expansion, '+' for reserved expansion or '#' for fragments.
+type uriTemplatePart struct {
+	literal    string
+	operator   byte
+	variable   string
+	expression bool
+}
+
+// URITemplate is an RFC 6570 URI template of level 2, such as
+// "https://api.example.com/users/{id}/orders{#section}", for API clients storing endpoints:
+//
+//   - "{var}" expands to the value with every character but the unreserved ones
+//     percent-encoded, so "a/b" becomes "a%2Fb"
+//   - "{+var}" keeps reserved characters such as '/' and '?' and existing percent-encodings
+//   - "{#var}" is like "{+var}" prefixed with '#'
+//
+// Expressions name a single variable; lists, prefixes and explosion (levels 3 and 4) are
+// rejected.
+type URITemplate struct {
+	value string
+	parts []uriTemplatePart
+}
+
+// NewURITemplate creates a new instance of URITemplate with validation
+func NewURITemplate(value string) (URITemplate, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return URITemplate{}, ErrEmptyURITemplate
+	}
+
+	parts, err := parseURITemplate(value)
+	if err != nil {
+		return URITemplate{}, err
+	}
+
+	return URITemplate{
+		value: value,
+		parts: parts,
+	}, nil
+}
+
+// ReconstituteURITemplate creates a new URITemplate instance without validation. A value that
+// does not parse expands to nothing.
+func ReconstituteURITemplate(value string) URITemplate {
+	parts, _ := parseURITemplate(value)
+	return URITemplate{
+		value: value,
+		parts: parts,
+	}
+}
+
+// Value returns the URI template
+func (t URITemplate) Value() string {
+	return t.value
+}
+
+// Variables returns the names of the variables in order of first appearance
+func (t URITemplate) Variables() []string {
+	var variables []string
+	for _, part := range t.parts {
+		if part.expression && !slices.Contains(variables, part.variable) {
+			variables = append(variables, part.variable)
+		}
+	}
+	return variables
+}
+
+// Expand substitutes the variables and returns the resulting URL, validated by
+// NewURLWithOptions with the options. Unlike RFC 6570, which expands undefined variables to
+// nothing, a variable missing from vars fails with ErrMissingURITemplateVariable, so a
+// forgotten identifier does not call the wrong endpoint; an empty value expands to nothing.
+func (t URITemplate) Expand(vars map[string]string, opts ...Option) (URL, error) {
+	if len(t.parts) == 0 {
+		return URL{}, ErrInvalidURITemplate
+	}
+
+	var expanded strings.Builder
+	for _, part := range t.parts {
+		if !part.expression {
+			expanded.WriteString(escapeURITemplateValue(part.literal, true))
+			continue
+		}
+
+		value, ok := vars[part.variable]
+		if !ok {
+			return URL{}, ErrMissingURITemplateVariable.WithField("variable", part.variable)
+		}
+		if part.operator == '#' {
+			expanded.WriteByte('#')
+		}
+		expanded.WriteString(escapeURITemplateValue(value, part.operator != 0))
+	}
+
+	return NewURLWithOptions(expanded.String(), opts...)
+}
+
+// Equals compares two URITemplate objects for equality
+func (t URITemplate) Equals(other URITemplate) bool {
+	return t.value == other.value
+}
+
+// String returns a string representation of the URI template
+func (t URITemplate) String() string {
+	return t.value
+}
+
+// MarshalJSON encodes the URI template as a JSON string
+func (t URITemplate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+// UnmarshalJSON decodes the URI template from a JSON string, with validation
+func (t *URITemplate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid URI template JSON")
+	}
+
+	parsed, err := NewURITemplate(raw)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// parseURITemplate splits a template into literals and expressions
+func parseURITemplate(value string) ([]uriTemplatePart, error) {
+	var parts []uriTemplatePart
+	for value != "" {
+		start := strings.IndexAny(value, "{}")
+		if start < 0 {
+			start = len(value)
+		}
+		if start > 0 {
+			if !isValidURITemplateLiteral(value[:start]) {
+				return nil, ErrInvalidURITemplate
+			}
+			parts = append(parts, uriTemplatePart{literal: value[:start]})
+			value = value[start:]
+			continue
+		}
+
+		end := strings.IndexByte(value, '}')
+		if value[0] == '}' || end < 0 {
+			return nil, ErrInvalidURITemplate
+		}
+
+		part := uriTemplatePart{variable: value[1:end], expression: true}
+		if part.variable != "" && (part.variable[0] == '+' || part.variable[0] == '#') {
+			part.operator, part.variable = part.variable[0], part.variable[1:]
+		}
+		if !isValidURITemplateVariable(part.variable) {
+			return nil, ErrInvalidURITemplate
+		}
+		parts = append(parts, part)
+		value = value[end+1:]
+	}
+	return parts, nil
+}
+
+// isValidURITemplateLiteral reports whether the literal holds no characters RFC 6570
+// excludes, and only complete percent-encodings
+func isValidURITemplateLiteral(literal string) bool {
+	for i := 0; i < len(literal); i++ {
+		c := literal[i]
+		switch {
+		case c <= ' ' || c == 0x7f || strings.IndexByte("\"'<>\\^`{|}", c) >= 0:
+			return false
+		case c == '%' && !isPercentEncoded(literal, i):
+			return false
+		}
+	}
+	return true
+}
+
+// isValidURITemplateVariable reports whether the name is dot-separated runs of letters,
+// digits, '_' and percent-encodings, such as "user_id" or "page.size"
+func isValidURITemplateVariable(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for segment := range strings.SplitSeq(name, ".") {
+		if segment == "" {
+			return false
+		}
+		for i := 0; i < len(segment); i++ {
+			c := segment[i]
+			switch {
+			case c == '%' && isPercentEncoded(segment, i):
+				i += 2
+			case !isASCIIAlphanumeric(c) && c != '_':
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// escapeURITemplateValue percent-encodes the value, keeping unreserved characters and, when
+// allowReserved is set, reserved characters and existing percent-encodings
+func escapeURITemplateValue(value string, allowReserved bool) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var escaped strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case isASCIIAlphanumeric(c) || strings.IndexByte("-._~", c) >= 0,
+			allowReserved && strings.IndexByte(":/?#[]@!$&'()*+,;=", c) >= 0,
+			allowReserved && c == '%' && isPercentEncoded(value, i):
+			escaped.WriteByte(c)
+		default:
+			escaped.WriteByte('%')
+			escaped.WriteByte(hexDigits[c>>4])
+			escaped.WriteByte(hexDigits[c&0x0f])
+		}
+	}
+	return escaped.String()
+}
+
+// isPercentEncoded reports whether a '%' at index i starts a percent-encoding
+func isPercentEncoded(value string, i int) bool {
+	return i+2 < len(value) && isHexDigit(value[i+1]) && isHexDigit(value[i+2])
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isASCIIAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,203 @@
+package web
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golibry/go-common-domain/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+type URITemplateTestSuite struct {
+	suite.Suite
+}
+
+func TestURITemplateSuite(t *testing.T) {
+	suite.Run(t, new(URITemplateTestSuite))
+}
+
+func (s *URITemplateTestSuite) TestItCanBuildNewURITemplateWithValidValues() {
+	testCases := []struct {
+		name      string
+		input     string
+		variables []string
+	}{
+		{name: "no variables", input: "https://api.example.com/users"},
+		{
+			name:      "simple variables",
+			input:     "https://api.example.com/users/{id}/orders/{order_id}",
+			variables: []string{"id", "order_id"},
+		},
+		{
+			name:      "reserved and fragment",
+			input:     "{+base}/docs{#section}",
+			variables: []string{"base", "section"},
+		},
+		{
+			name:      "repeated and dotted names",
+			input:     "https://example.com/{page.size}/{page.size}",
+			variables: []string{"page.size"},
+		},
+		{
+			name:      "percent-encodings",
+			input:     "https://example.com/a%20b/{caf%C3%A9}",
+			variables: []string{"caf%C3%A9"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				template, err := NewURITemplate(tc.input)
+				s.Require().NoError(err)
+				s.Equal(tc.input, template.Value())
+				s.Equal(tc.variables, template.Variables())
+			},
+		)
+	}
+}
+
+func (s *URITemplateTestSuite) TestItFailsToBuildNewURITemplateWithInvalidValues() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{name: "empty", input: "  ", expected: ErrEmptyURITemplate},
+		{name: "unclosed expression", input: "https://x.com/{id", expected: ErrInvalidURITemplate},
+		{name: "unopened expression", input: "https://x.com/id}", expected: ErrInvalidURITemplate},
+		{name: "empty expression", input: "https://x.com/{}", expected: ErrInvalidURITemplate},
+		{name: "nested expression", input: "https://x.com/{{id}}", expected: ErrInvalidURITemplate},
+		{name: "variable list", input: "https://x.com/{x,y}", expected: ErrInvalidURITemplate},
+		{name: "level 3 operator", input: "https://x.com{/path}", expected: ErrInvalidURITemplate},
+		{name: "prefix modifier", input: "https://x.com/{id:3}", expected: ErrInvalidURITemplate},
+		{name: "explode modifier", input: "https://x.com/{ids*}", expected: ErrInvalidURITemplate},
+		{name: "empty name part", input: "https://x.com/{a..b}", expected: ErrInvalidURITemplate},
+		{name: "space in literal", input: "https://x.com/a b", expected: ErrInvalidURITemplate},
+		{name: "bare percent", input: "https://x.com/100%", expected: ErrInvalidURITemplate},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				_, err := NewURITemplate(tc.input)
+				s.ErrorIs(err, tc.expected)
+			},
+		)
+	}
+}
+
+func (s *URITemplateTestSuite) TestItCanExpandVariables() {
+	testCases := []struct {
+		name     string
+		template string
+		vars     map[string]string
+		expected string
+	}{
+		{
+			name:     "simple expansion escapes reserved characters",
+			template: "https://example.com/search/{term}",
+			vars:     map[string]string{"term": "a/b c&d"},
+			expected: "https://example.com/search/a%2Fb%20c%26d",
+		},
+		{
+			name:     "simple expansion escapes non-ASCII",
+			template: "https://example.com/{name}",
+			vars:     map[string]string{"name": "café"},
+			expected: "https://example.com/caf%C3%A9",
+		},
+		{
+			name:     "reserved expansion keeps reserved characters",
+			template: "{+base}/users/{id}",
+			vars:     map[string]string{"base": "https://api.example.com/v1", "id": "42"},
+			expected: "https://api.example.com/v1/users/42",
+		},
+		{
+			name:     "reserved expansion keeps percent-encodings",
+			template: "https://example.com/{+path}",
+			vars:     map[string]string{"path": "a%20b/c d"},
+			expected: "https://example.com/a%20b/c%20d",
+		},
+		{
+			name:     "fragment expansion",
+			template: "https://example.com/docs{#section}",
+			vars:     map[string]string{"section": "setup/linux"},
+			expected: "https://example.com/docs#setup/linux",
+		},
+		{
+			name:     "empty value",
+			template: "https://example.com/items{id}",
+			vars:     map[string]string{"id": ""},
+			expected: "https://example.com/items",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				template, err := NewURITemplate(tc.template)
+				s.Require().NoError(err)
+
+				expanded, err := template.Expand(tc.vars)
+				s.Require().NoError(err)
+				s.Equal(tc.expected, expanded.Value())
+			},
+		)
+	}
+}
+
+func (s *URITemplateTestSuite) TestItFailsToExpandIncompleteOrInvalidURLs() {
+	template, _ := NewURITemplate("https://example.com/users/{id}")
+
+	_, err := template.Expand(map[string]string{})
+	s.ErrorIs(err, ErrMissingURITemplateVariable)
+	var domainErr *domain.Error
+	s.Require().ErrorAs(err, &domainErr)
+	s.Equal("id", domainErr.Fields()["variable"])
+
+	_, err = template.Expand(map[string]string{"id": "42"}, WithMaxLength(10))
+	s.ErrorIs(err, ErrTooLongURL)
+
+	relative, _ := NewURITemplate("/users/{id}")
+	_, err = relative.Expand(map[string]string{"id": "42"})
+	s.ErrorIs(err, ErrInvalidURL)
+
+	_, err = URITemplate{}.Expand(nil)
+	s.ErrorIs(err, ErrInvalidURITemplate)
+}
+
+func (s *URITemplateTestSuite) TestItCanReconstituteURITemplates() {
+	template := ReconstituteURITemplate("https://example.com/{id}")
+	s.Equal([]string{"id"}, template.Variables())
+
+	invalid := ReconstituteURITemplate("https://example.com/{id")
+	s.Equal("https://example.com/{id", invalid.Value())
+	s.Empty(invalid.Variables())
+}
+
+func (s *URITemplateTestSuite) TestItCanCompareForEquality() {
+	first, _ := NewURITemplate("https://example.com/{id}")
+	second, _ := NewURITemplate(" https://example.com/{id} ")
+	third, _ := NewURITemplate("https://example.com/{+id}")
+
+	s.True(first.Equals(second))
+	s.False(first.Equals(third))
+	s.Equal("https://example.com/{id}", first.String())
+}
+
+func (s *URITemplateTestSuite) TestItCanRoundTripThroughJSON() {
+	template, _ := NewURITemplate("https://example.com/{id}")
+
+	data, err := json.Marshal(template)
+	s.Require().NoError(err)
+	s.Equal(`"https://example.com/{id}"`, string(data))
+
+	var decoded URITemplate
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+	s.True(template.Equals(decoded))
+
+	s.NoError(json.Unmarshal([]byte(`null`), &decoded))
+	s.True(template.Equals(decoded))
+	s.ErrorIs(json.Unmarshal([]byte(`"{x,y}"`), &decoded), ErrInvalidURITemplate)
+	s.Error(json.Unmarshal([]byte(`42`), &decoded))
+}
@@ -10,25 +10,42 @@ import (
 const MaxURLLength = 2048
 
 var (
-	ErrEmptyURL   = domain.NewError("URL cannot be empty")
-	ErrInvalidURL = domain.NewError("URL format is invalid")
-	ErrTooLongURL = domain.NewError("URL is too long")
+	ErrEmptyURL   = domain.NewLocalizedError("web.url.empty", nil, "URL cannot be empty")
+	ErrInvalidURL = domain.NewLocalizedError("web.url.invalid", nil, "URL format is invalid")
+	ErrTooLongURL = domain.NewLocalizedError("web.url.too_long", nil, "URL is too long")
 )
 
+// URLValidators holds extra rules run by NewURL after its own validation
+var URLValidators domain.Validators[URL]
+
 type URL struct {
 	value string
 }
 
 // NewURL creates a new instance of URL with validation and normalization
 func NewURL(value string) (URL, error) {
-	normalized, err := NormalizeURL(value)
+	return NewURLWithOptions(value)
+}
+
+// NewURLWithOptions creates a new instance of URL like NewURL, with limits tuned by options
+// such as WithMaxLength. Decoders (JSON, text, binary, YAML, BSON and the pgx codec) validate
+// with NewURL and its default limits; load URLs accepted with a larger limit with
+// ReconstituteURL.
+func NewURLWithOptions(value string, opts ...Option) (URL, error) {
+	config := applyOptions(options{maxLength: MaxURLLength}, opts)
+	normalized, err := normalizeURL(value, config.maxLength)
 	if err != nil {
 		return URL{}, err
 	}
 
-	return URL{
+	webURL := URL{
 		value: normalized,
-	}, nil
+	}
+	if err := URLValidators.Validate(webURL); err != nil {
+		return URL{}, err
+	}
+
+	return webURL, nil
 }
 
 // ReconstituteURL creates a new URL instance without validation or normalization.
@@ -69,10 +86,15 @@ func (u URL) Equals(other URL) bool {
 
 // NormalizeURL normalizes a URL by trimming spaces and ensuring a proper format
 func NormalizeURL(urlStr string) (string, error) {
+	return normalizeURL(urlStr, MaxURLLength)
+}
+
+// normalizeURL normalizes a URL, allowing up to maxLength bytes
+func normalizeURL(urlStr string, maxLength int) (string, error) {
 	// Trim spaces from the beginning and end
 	urlStr = strings.TrimSpace(urlStr)
 
-	parsed, err := IsValidURL(urlStr)
+	parsed, err := isValidURL(urlStr, maxLength)
 	if err != nil {
 		return "", err
 	}
@@ -82,11 +104,16 @@ func NormalizeURL(urlStr string) (string, error) {
 
 // IsValidURL validates a URL
 func IsValidURL(urlStr string) (*url.URL, error) {
+	return isValidURL(urlStr, MaxURLLength)
+}
+
+// isValidURL validates a URL, allowing up to maxLength bytes
+func isValidURL(urlStr string, maxLength int) (*url.URL, error) {
 	if urlStr == "" {
 		return nil, ErrEmptyURL
 	}
 
-	if len(urlStr) > MaxURLLength {
+	if len(urlStr) > maxLength {
 		return nil, ErrTooLongURL
 	}
 
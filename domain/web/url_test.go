@@ -3,6 +3,7 @@ package web
 import (
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -198,3 +199,17 @@ func (s *URLTestSuite) TestTooLongURL() {
 	s.Error(err)
 	s.True(errors.Is(err, ErrTooLongURL))
 }
+
+func (s *URLTestSuite) TestItAppliesTheMaxLengthOption() {
+	longURL := "https://example.com/?q=" + strings.Repeat("a", MaxURLLength)
+
+	_, err := NewURL(longURL)
+	s.ErrorIs(err, ErrTooLongURL)
+
+	url, err := NewURLWithOptions(longURL, WithMaxLength(8192))
+	s.NoError(err)
+	s.Equal(longURL, url.Value())
+
+	_, err = NewURLWithOptions("https://example.com/some/path", WithMaxLength(20))
+	s.ErrorIs(err, ErrTooLongURL)
+}
@@ -0,0 +1,131 @@
+package web
+
+import "github.com/golibry/go-common-domain/domain"
+
+// URLs, emails, domains, IP addresses and networks encode as plain YAML strings, so
+// configuration files can bind them directly. Zero values encode as null.
+
+// MarshalYAML encodes the URL as a YAML string
+func (u URL) MarshalYAML() (any, error) {
+	if u.value == "" {
+		return nil, nil
+	}
+
+	return u.value, nil
+}
+
+// UnmarshalYAML decodes the URL from a YAML string, with validation
+func (u *URL) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid URL YAML")
+	}
+
+	parsed, err := NewURL(raw)
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+	return nil
+}
+
+// MarshalYAML encodes the email address as a YAML string
+func (e Email) MarshalYAML() (any, error) {
+	if e.value == "" {
+		return nil, nil
+	}
+
+	return e.value, nil
+}
+
+// UnmarshalYAML decodes the email address from a YAML string, with validation
+func (e *Email) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid email address YAML")
+	}
+
+	parsed, err := NewEmail(raw)
+	if err != nil {
+		return err
+	}
+
+	*e = parsed
+	return nil
+}
+
+// MarshalYAML encodes the domain name as a YAML string
+func (d DomainName) MarshalYAML() (any, error) {
+	if d.value == "" {
+		return nil, nil
+	}
+
+	return d.value, nil
+}
+
+// UnmarshalYAML decodes the domain name from a YAML string, with validation
+func (d *DomainName) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid domain name YAML")
+	}
+
+	parsed, err := NewDomainName(raw)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// MarshalYAML encodes the IP address as a YAML string
+func (ip IPAddress) MarshalYAML() (any, error) {
+	if ip.value == "" {
+		return nil, nil
+	}
+
+	return ip.value, nil
+}
+
+// UnmarshalYAML decodes the IP address from a YAML string, with validation
+func (ip *IPAddress) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid IP address YAML")
+	}
+
+	parsed, err := NewIPAddress(raw)
+	if err != nil {
+		return err
+	}
+
+	*ip = parsed
+	return nil
+}
+
+// MarshalYAML encodes the IP network as a YAML string
+func (n IPNetwork) MarshalYAML() (any, error) {
+	if n.value == "" {
+		return nil, nil
+	}
+
+	return n.value, nil
+}
+
+// UnmarshalYAML decodes the IP network from a YAML string, with validation
+func (n *IPNetwork) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return domain.NewErrorWithWrap(err, "invalid IP network YAML")
+	}
+
+	parsed, err := NewIPNetwork(raw)
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+	return nil
+}
@@ -0,0 +1,78 @@
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLTestSuite struct {
+	suite.Suite
+}
+
+func TestYAMLSuite(t *testing.T) {
+	suite.Run(t, new(YAMLTestSuite))
+}
+
+type serverConfig struct {
+	BaseURL   URL        `yaml:"baseUrl"`
+	Support   Email      `yaml:"support"`
+	Domain    DomainName `yaml:"domain"`
+	BindIP    IPAddress  `yaml:"bindIp"`
+	Trusted   IPNetwork  `yaml:"trusted"`
+	AllowList []Email    `yaml:"allowList"`
+}
+
+func (s *YAMLTestSuite) TestItCanBindValueObjectsFromYAML() {
+	document := `
+baseUrl: https://example.com/api
+support: Support@Example.com
+domain: Example.com
+bindIp: 127.0.0.1
+trusted: 10.0.0.0/8
+allowList:
+  - alice@example.com
+  - bob@example.com
+`
+
+	var config serverConfig
+	s.Require().NoError(yaml.Unmarshal([]byte(document), &config))
+
+	s.Equal("https://example.com/api", config.BaseURL.Value())
+	s.Equal("support@example.com", config.Support.Value())
+	s.Equal("example.com", config.Domain.Value())
+	s.Equal("127.0.0.1", config.BindIP.Value())
+	s.Equal("10.0.0.0/8", config.Trusted.Value())
+	s.Len(config.AllowList, 2)
+
+	encoded, err := yaml.Marshal(config)
+	s.Require().NoError(err)
+	s.Contains(string(encoded), "support: support@example.com\n")
+
+	var decoded serverConfig
+	s.Require().NoError(yaml.Unmarshal(encoded, &decoded))
+	s.Equal(config, decoded)
+}
+
+func (s *YAMLTestSuite) TestItValidatesWhenUnmarshalingYAML() {
+	testCases := []struct {
+		name     string
+		document string
+		expected error
+	}{
+		{"Invalid IP address", "bindIp: 999.1.1.1", ErrInvalidIPAddress},
+		{"Invalid IP network", "trusted: 10.0.0.0/33", ErrInvalidIPNetwork},
+		{"Empty email", `support: ""`, ErrEmptyEmail},
+		{"Invalid email in list", "allowList: [alice]", ErrMissingAtSymbol},
+	}
+
+	for _, tc := range testCases {
+		s.Run(
+			tc.name, func() {
+				var config serverConfig
+				s.ErrorIs(yaml.Unmarshal([]byte(tc.document), &config), tc.expected)
+			},
+		)
+	}
+}